@@ -53,7 +53,7 @@ func NewAudit(createdBy AuditUser) Audit {
 // It sets the `UpdatedAt` timestamp to the current time, records the user who made the change,
 // and increments the version number.
 func (a *Audit) Touch(updatedBy AuditUser) {
-	a.UpdatedAt.Touch()
+	a.UpdatedAt.TouchSince(a.CreatedAt)
 	a.UpdatedBy = updatedBy
 	a.Version = a.Version.Increment()
 }
@@ -98,3 +98,71 @@ func (s *AuditTimeSuite) TestUpdatedAt() {
 		s.Equal(ua, scannedUA)
 	})
 }
+
+func (s *AuditTimeSuite) TestTimestampPrecision() {
+	s.T().Cleanup(func() { wisp.SetTimestampPrecision(wisp.TimestampNanos) })
+
+	testCases := []struct {
+		name      string
+		precision wisp.TimestampPrecision
+		unit      time.Duration
+	}{
+		{name: "seconds", precision: wisp.TimestampSeconds, unit: time.Second},
+		{name: "millis", precision: wisp.TimestampMillis, unit: time.Millisecond},
+		{name: "micros", precision: wisp.TimestampMicros, unit: time.Microsecond},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			wisp.SetTimestampPrecision(tc.precision)
+
+			created := wisp.NewCreatedAt().Time()
+			s.Equal(created, created.Truncate(tc.unit))
+
+			updated := wisp.NewUpdatedAt().Time()
+			s.Equal(updated, updated.Truncate(tc.unit))
+
+			var touched wisp.UpdatedAt
+			touched.Touch()
+			s.Equal(touched.Time(), touched.Time().Truncate(tc.unit))
+		})
+	}
+
+	s.Run("should ignore unsupported precisions", func() {
+		wisp.SetTimestampPrecision(wisp.TimestampSeconds)
+		wisp.SetTimestampPrecision(wisp.TimestampPrecision(time.Hour))
+		created := wisp.NewCreatedAt().Time()
+		s.Equal(created, created.Truncate(time.Second))
+	})
+}
+
+func (s *AuditTimeSuite) TestUpdatedAtMonotonicity() {
+	future := time.Now().UTC().Add(time.Hour)
+
+	s.Run("Touch should never move backwards", func() {
+		ua := wisp.UpdatedAt(future)
+		ua.Touch()
+		s.Equal(future, ua.Time())
+	})
+
+	s.Run("Touch should move forward", func() {
+		past := time.Now().UTC().Add(-time.Hour)
+		ua := wisp.UpdatedAt(past)
+		ua.Touch()
+		s.True(ua.Time().After(past))
+	})
+
+	s.Run("NewUpdatedAtSince should not be before CreatedAt", func() {
+		created := wisp.CreatedAt(future)
+		s.Equal(future, wisp.NewUpdatedAtSince(created).Time())
+
+		past := wisp.CreatedAt(time.Now().UTC().Add(-time.Hour))
+		s.True(wisp.NewUpdatedAtSince(past).Time().After(past.Time()))
+	})
+
+	s.Run("TouchSince should not be before CreatedAt", func() {
+		var ua wisp.UpdatedAt
+		ua.TouchSince(wisp.CreatedAt(future))
+		s.Equal(future, ua.Time())
+	})
+}
@@ -0,0 +1,172 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// AuditedFlag is a boolean that remembers who changed it last and when, for consent and
+// opt-in fields (e.g., marketing e-mails, terms acceptance) that need traceability.
+//
+// The zero value is a disabled flag that has never been changed.
+// All operations are immutable, returning a new AuditedFlag instance.
+//
+// Example:
+//   var consent wisp.AuditedFlag
+//   consent, err := consent.Enable(user) // records user and the current time
+//   consent.IsEnabled()                   // true
+//   consent.ChangedBy()                   // user
+type AuditedFlag struct {
+	enabled   bool
+	changedBy AuditUser
+	changedAt NullableTime
+}
+
+// NewAuditedFlag restores an AuditedFlag from its stored state.
+// It returns an error if only one of changedBy and changedAt is set, since a change
+// is always recorded with both.
+func NewAuditedFlag(enabled bool, changedBy AuditUser, changedAt time.Time) (AuditedFlag, error) {
+	if changedBy.IsZero() != changedAt.IsZero() {
+		return AuditedFlag{}, fault.New(
+			"audited flag requires both changed_by and changed_at, or neither",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("changed_by", changedBy.String()),
+			fault.WithContext("changed_at", changedAt),
+		)
+	}
+	if enabled && changedBy.IsZero() {
+		return AuditedFlag{}, fault.New("an enabled audited flag must record who enabled it", fault.WithCode(fault.Invalid))
+	}
+	return AuditedFlag{enabled: enabled, changedBy: changedBy, changedAt: NewNullableTime(changedAt)}, nil
+}
+
+// Enable returns the flag turned on by actor at the current time.
+// If the flag is already enabled it is returned unchanged, keeping the original record.
+func (f AuditedFlag) Enable(actor AuditUser) (AuditedFlag, error) {
+	return f.set(true, actor)
+}
+
+// Disable returns the flag turned off by actor at the current time.
+// If the flag is already disabled it is returned unchanged, keeping the original record.
+func (f AuditedFlag) Disable(actor AuditUser) (AuditedFlag, error) {
+	return f.set(false, actor)
+}
+
+// set records a change of state on behalf of actor.
+func (f AuditedFlag) set(enabled bool, actor AuditUser) (AuditedFlag, error) {
+	if actor.IsZero() {
+		return f, fault.New("audited flag changes require an actor", fault.WithCode(fault.Invalid))
+	}
+	if f.enabled == enabled {
+		return f, nil
+	}
+	return AuditedFlag{
+		enabled:   enabled,
+		changedBy: actor,
+		changedAt: NewNullableTime(time.Now().UTC()),
+	}, nil
+}
+
+// IsEnabled returns true if the flag is on.
+func (f AuditedFlag) IsEnabled() bool {
+	return f.enabled
+}
+
+// ChangedBy returns who last toggled the flag, or EmptyAuditUser if it was never changed.
+func (f AuditedFlag) ChangedBy() AuditUser {
+	return f.changedBy
+}
+
+// ChangedAt returns when the flag was last toggled, or a null time if it was never changed.
+func (f AuditedFlag) ChangedAt() NullableTime {
+	return f.changedAt
+}
+
+// IsZero returns true if the flag is disabled and has never been changed.
+func (f AuditedFlag) IsZero() bool {
+	return f == AuditedFlag{}
+}
+
+// String returns "enabled" or "disabled".
+func (f AuditedFlag) String() string {
+	if f.enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// auditedFlagJSON is the JSON representation of an AuditedFlag.
+type auditedFlagJSON struct {
+	Enabled   bool         `json:"enabled"`
+	ChangedBy AuditUser    `json:"changed_by"`
+	ChangedAt NullableTime `json:"changed_at"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the flag to a JSON object with "enabled", "changed_by" and "changed_at" fields.
+func (f AuditedFlag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(auditedFlagJSON{Enabled: f.enabled, ChangedBy: f.changedBy, ChangedAt: f.changedAt})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object into an AuditedFlag, with validation.
+func (f *AuditedFlag) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*f = AuditedFlag{}
+		return nil
+	}
+
+	var dto auditedFlagJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for AuditedFlag", fault.WithCode(fault.Invalid))
+	}
+
+	flag, err := NewAuditedFlag(dto.Enabled, dto.ChangedBy, dto.ChangedAt.Time)
+	if err != nil {
+		return err
+	}
+	*f = flag
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the flag as a JSON string.
+func (f AuditedFlag) Value() (driver.Value, error) {
+	data, err := f.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal audited flag for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as AuditedFlag.
+func (f *AuditedFlag) Scan(src interface{}) error {
+	if src == nil {
+		*f = AuditedFlag{}
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for AuditedFlag",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return f.UnmarshalJSON(data)
+}
@@ -0,0 +1,130 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type AuditedFlagSuite struct {
+	suite.Suite
+	user wisp.AuditUser
+}
+
+func TestAuditedFlagSuite(t *testing.T) {
+	suite.Run(t, new(AuditedFlagSuite))
+}
+
+func (s *AuditedFlagSuite) SetupTest() {
+	s.user, _ = wisp.NewAuditUser("jane.doe@example.com")
+}
+
+func (s *AuditedFlagSuite) TestZeroValue() {
+	var f wisp.AuditedFlag
+	s.True(f.IsZero())
+	s.False(f.IsEnabled())
+	s.True(f.ChangedBy().IsZero())
+	s.True(f.ChangedAt().IsZero())
+	s.Equal("disabled", f.String())
+}
+
+func (s *AuditedFlagSuite) TestEnableDisable() {
+	s.Run("should record who enabled the flag and when", func() {
+		before := time.Now().UTC()
+		f, err := wisp.AuditedFlag{}.Enable(s.user)
+		s.Require().NoError(err)
+		s.True(f.IsEnabled())
+		s.Equal(s.user, f.ChangedBy())
+		s.False(f.ChangedAt().Time.Before(before))
+		s.Equal("enabled", f.String())
+	})
+
+	s.Run("should keep the original record when already in the requested state", func() {
+		f, _ := wisp.NewAuditedFlag(true, s.user, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+		same, err := f.Enable(wisp.SystemAuditUser)
+		s.Require().NoError(err)
+		s.Equal(f, same)
+	})
+
+	s.Run("should record who disabled the flag", func() {
+		f, _ := wisp.NewAuditedFlag(true, s.user, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+		off, err := f.Disable(wisp.SystemAuditUser)
+		s.Require().NoError(err)
+		s.False(off.IsEnabled())
+		s.Equal(wisp.SystemAuditUser, off.ChangedBy())
+		s.True(f.IsEnabled(), "original should be unchanged")
+	})
+
+	s.Run("should require an actor", func() {
+		_, err := wisp.AuditedFlag{}.Enable(wisp.EmptyAuditUser)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *AuditedFlagSuite) TestNewAuditedFlag() {
+	s.Run("should reject partial change records", func() {
+		_, err := wisp.NewAuditedFlag(false, s.user, time.Time{})
+		s.Require().Error(err)
+
+		_, err = wisp.NewAuditedFlag(false, wisp.EmptyAuditUser, time.Now())
+		s.Require().Error(err)
+	})
+
+	s.Run("should reject an enabled flag without a record", func() {
+		_, err := wisp.NewAuditedFlag(true, wisp.EmptyAuditUser, time.Time{})
+		s.Require().Error(err)
+	})
+}
+
+func (s *AuditedFlagSuite) TestJSONAndSQL() {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	f, err := wisp.NewAuditedFlag(true, s.user, at)
+	s.Require().NoError(err)
+
+	s.Run("should round trip through JSON", func() {
+		data, err := json.Marshal(f)
+		s.Require().NoError(err)
+		s.JSONEq(`{"enabled":true,"changed_by":"jane.doe@example.com","changed_at":"2024-01-02T03:04:05Z"}`, string(data))
+
+		var decoded wisp.AuditedFlag
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(f, decoded)
+	})
+
+	s.Run("should marshal the zero value without a change record", func() {
+		data, err := json.Marshal(wisp.AuditedFlag{})
+		s.Require().NoError(err)
+		s.JSONEq(`{"enabled":false,"changed_by":"","changed_at":null}`, string(data))
+
+		var decoded wisp.AuditedFlag
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.True(decoded.IsZero())
+	})
+
+	s.Run("should reject invalid JSON", func() {
+		var decoded wisp.AuditedFlag
+		s.Error(json.Unmarshal([]byte(`{"enabled":true}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`{"enabled":"yes"}`), &decoded))
+	})
+
+	s.Run("should round trip through the database", func() {
+		val, err := f.Value()
+		s.Require().NoError(err)
+
+		var scanned wisp.AuditedFlag
+		s.Require().NoError(scanned.Scan([]byte(val.(string))))
+		s.Equal(f, scanned)
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		err = scanned.Scan(1)
+		s.Require().Error(err)
+	})
+}
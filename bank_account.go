@@ -0,0 +1,395 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// BankAccountType is the type of a Brazilian bank account.
+type BankAccountType string
+
+// Defines the account types accepted by TED and Pix.
+const (
+	BankAccountChecking BankAccountType = "checking" // Conta corrente.
+	BankAccountSavings  BankAccountType = "savings"  // Conta poupança.
+	BankAccountPayment  BankAccountType = "payment"  // Conta de pagamento, used by digital banks.
+	BankAccountSalary   BankAccountType = "salary"   // Conta salário.
+)
+
+// IsValid checks if the account type is one of the defined types.
+func (t BankAccountType) IsValid() bool {
+	switch t {
+	case BankAccountChecking, BankAccountSavings, BankAccountPayment, BankAccountSalary:
+		return true
+	default:
+		return false
+	}
+}
+
+// maxBankAccountNumberLength is the maximum number of digits of an account number, without the
+// check digit.
+const maxBankAccountNumberLength = 20
+
+// BankAccountValidator checks the check digits of an account of a specific bank.
+// It receives an account whose fields are already parsed and returns an error if they are invalid.
+type BankAccountValidator func(account BankAccount) error
+
+// defaultBankAccountValidators returns the check digit rules of the banks supported by default.
+func defaultBankAccountValidators() map[BankCode]BankAccountValidator {
+	return map[BankCode]BankAccountValidator{
+		"001": validateBancoDoBrasilAccount,
+		"237": validateBradescoAccount,
+		"341": validateItauAccount,
+	}
+}
+
+// bankAccountValidators holds the check digit rules by bank.
+var bankAccountValidators = defaultBankAccountValidators()
+
+// RegisterBankAccountValidator sets the check digit rule of a bank, replacing any existing one.
+// Passing a nil validator removes the rule, so only the format of the account is checked.
+// Banco do Brasil (001), Bradesco (237) and Itaú (341) have rules by default.
+func RegisterBankAccountValidator(code BankCode, validator BankAccountValidator) {
+	if validator == nil {
+		delete(bankAccountValidators, code)
+		return
+	}
+	bankAccountValidators[code] = validator
+}
+
+// ResetBankAccountValidators restores the default check digit rules.
+// This is primarily for testing purposes to ensure a clean state.
+func ResetBankAccountValidators() {
+	bankAccountValidators = defaultBankAccountValidators()
+}
+
+// BankAccount is a value object representing a Brazilian bank account, as needed to send a TED or
+// a Pix by account details: the bank, the branch ("agência") with its optional check digit, the
+// account number with its check digit, and the account type.
+//
+// The format of every field is always validated. For banks with a registered rule (see
+// RegisterBankAccountValidator), the check digits are validated too.
+//
+// The zero value is ZeroBankAccount.
+//
+// Example:
+//   account, err := wisp.NewBankAccount("341", "2545", "02366-1", wisp.BankAccountChecking)
+//   account.Bank().Name() // "Itaú Unibanco"
+//   account.String()      // "341 2545 02366-1"
+type BankAccount struct {
+	bank        BankCode
+	branch      string
+	branchDigit string
+	number      string
+	digit       string
+	accountType BankAccountType
+}
+
+// ZeroBankAccount represents the zero value for the BankAccount type.
+var ZeroBankAccount BankAccount
+
+// NewBankAccount creates a new BankAccount. The branch is "1234" or "1234-5" and the account is
+// the number followed by its check digit, with or without a dash ("12345-6" or "123456").
+// Check digits may be "X" (or "P" for Bradesco), as printed by some banks.
+// Returns an error if a field is malformed or, for banks with a rule, a check digit is wrong.
+func NewBankAccount(bank string, branch string, account string, accountType BankAccountType) (BankAccount, error) {
+	code, err := NewBankCode(bank)
+	if err != nil {
+		return ZeroBankAccount, err
+	}
+	if code.IsZero() {
+		return ZeroBankAccount, fault.New("bank account requires a bank code", fault.WithCode(fault.Invalid))
+	}
+	if !accountType.IsValid() {
+		return ZeroBankAccount, fault.New(
+			"invalid bank account type",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("account_type", accountType),
+		)
+	}
+
+	branchNumber, branchDigit, hasBranchDigit := strings.Cut(strings.TrimSpace(branch), "-")
+	if len(branchNumber) != 4 || !isASCIIDigits(branchNumber) || (hasBranchDigit && !isBankCheckDigit(branchDigit)) {
+		return ZeroBankAccount, fault.New(
+			"bank branch must have 4 digits and an optional check digit",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("branch", branch),
+		)
+	}
+
+	number, digit, err := splitBankAccountNumber(account)
+	if err != nil {
+		return ZeroBankAccount, err
+	}
+
+	a := BankAccount{
+		bank:        code,
+		branch:      branchNumber,
+		branchDigit: strings.ToUpper(branchDigit),
+		number:      number,
+		digit:       digit,
+		accountType: accountType,
+	}
+	if validator, ok := bankAccountValidators[code]; ok {
+		if err := validator(a); err != nil {
+			return ZeroBankAccount, err
+		}
+	}
+	return a, nil
+}
+
+// splitBankAccountNumber separates the account number from its check digit.
+func splitBankAccountNumber(account string) (string, string, error) {
+	trimmed := strings.ToUpper(strings.TrimSpace(account))
+	number, digit, found := strings.Cut(trimmed, "-")
+	if !found && len(trimmed) > 1 {
+		number, digit = trimmed[:len(trimmed)-1], trimmed[len(trimmed)-1:]
+	}
+	if number == "" || len(number) > maxBankAccountNumberLength || !isASCIIDigits(number) || !isBankCheckDigit(digit) {
+		return "", "", fault.New(
+			"bank account must be a number of up to 20 digits followed by a check digit",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("account", account),
+		)
+	}
+	return number, digit, nil
+}
+
+// isBankCheckDigit reports whether s is a single digit, "X" or "P".
+func isBankCheckDigit(s string) bool {
+	if len(s) != 1 {
+		return false
+	}
+	c := s[0] &^ 0x20 // uppercases letters
+	return isDigit(s[0]) || c == 'X' || c == 'P'
+}
+
+// Bank returns the bank code.
+func (a BankAccount) Bank() BankCode {
+	return a.bank
+}
+
+// Branch returns the 4-digit branch number.
+func (a BankAccount) Branch() string {
+	return a.branch
+}
+
+// BranchDigit returns the check digit of the branch, or an empty string if not informed.
+func (a BankAccount) BranchDigit() string {
+	return a.branchDigit
+}
+
+// Number returns the account number without its check digit.
+func (a BankAccount) Number() string {
+	return a.number
+}
+
+// Digit returns the check digit of the account number.
+func (a BankAccount) Digit() string {
+	return a.digit
+}
+
+// Type returns the account type.
+func (a BankAccount) Type() BankAccountType {
+	return a.accountType
+}
+
+// IsZero returns true if the BankAccount is the zero value.
+func (a BankAccount) IsZero() bool {
+	return a == ZeroBankAccount
+}
+
+// Equals checks if two accounts have the same bank, branch, number and type.
+func (a BankAccount) Equals(other BankAccount) bool {
+	return a == other
+}
+
+// String returns the account as "bank branch[-digit] number-digit" (e.g., "001 1234-3 12345-5"),
+// or an empty string for the zero value.
+func (a BankAccount) String() string {
+	if a.IsZero() {
+		return ""
+	}
+	branch := a.branch
+	if a.branchDigit != "" {
+		branch += "-" + a.branchDigit
+	}
+	return a.bank.String() + " " + branch + " " + a.number + "-" + a.digit
+}
+
+// bankAccountJSON is the JSON representation of a BankAccount.
+type bankAccountJSON struct {
+	Bank        BankCode        `json:"bank"`
+	Branch      string          `json:"branch"`
+	BranchDigit string          `json:"branch_digit,omitempty"`
+	Number      string          `json:"number"`
+	Digit       string          `json:"digit"`
+	Type        BankAccountType `json:"type"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the BankAccount as an object such as
+// {"bank":"001","branch":"1234","branch_digit":"3","number":"12345","digit":"5","type":"checking"},
+// or null for the zero value.
+func (a BankAccount) MarshalJSON() ([]byte, error) {
+	if a.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(bankAccountJSON{
+		Bank:        a.bank,
+		Branch:      a.branch,
+		BranchDigit: a.branchDigit,
+		Number:      a.number,
+		Digit:       a.digit,
+		Type:        a.accountType,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object into a BankAccount, with validation; null results in ZeroBankAccount.
+func (a *BankAccount) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*a = ZeroBankAccount
+		return nil
+	}
+
+	var dto bankAccountJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for BankAccount", fault.WithCode(fault.Invalid))
+	}
+
+	branch := dto.Branch
+	if dto.BranchDigit != "" {
+		branch += "-" + dto.BranchDigit
+	}
+	account, err := NewBankAccount(dto.Bank.String(), branch, dto.Number+"-"+dto.Digit, dto.Type)
+	if err != nil {
+		return err
+	}
+	*a = account
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the BankAccount as a JSON string, or nil for the zero value.
+func (a BankAccount) Value() (driver.Value, error) {
+	if a.IsZero() {
+		return nil, nil
+	}
+
+	data, err := a.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal bank account for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as a BankAccount.
+func (a *BankAccount) Scan(src interface{}) error {
+	if src == nil {
+		*a = ZeroBankAccount
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for BankAccount",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return a.UnmarshalJSON(data)
+}
+
+// validateBancoDoBrasilAccount checks the Banco do Brasil rules: mod 11 with weights 5 to 2 for the
+// branch and 9 to 2 for an account of up to 8 digits, where 10 becomes "X" and 11 becomes "0".
+func validateBancoDoBrasilAccount(a BankAccount) error {
+	if a.branchDigit != "" && mod11BankDigit(a.branch, 9, 'X', '0') != a.branchDigit[0] {
+		return errBankCheckDigit(a, "branch")
+	}
+	number, ok := padBankNumber(a.number, 8)
+	if !ok || mod11BankDigit(number, 9, 'X', '0') != a.digit[0] {
+		return errBankCheckDigit(a, "account")
+	}
+	return nil
+}
+
+// validateBradescoAccount checks the Bradesco rules: mod 11 with weights 5 to 2 for the branch and
+// 2 to 7 for an account of up to 7 digits, where 10 becomes "P" and 11 becomes "0".
+func validateBradescoAccount(a BankAccount) error {
+	if a.branchDigit != "" && mod11BankDigit(a.branch, 7, 'P', '0') != a.branchDigit[0] {
+		return errBankCheckDigit(a, "branch")
+	}
+	number, ok := padBankNumber(a.number, 7)
+	if !ok || mod11BankDigit(number, 7, 'P', '0') != a.digit[0] {
+		return errBankCheckDigit(a, "account")
+	}
+	return nil
+}
+
+// validateItauAccount checks the Itaú rule: the branches have no check digit, and the account
+// check digit is the mod 10 of the branch followed by an account of up to 5 digits.
+func validateItauAccount(a BankAccount) error {
+	if a.branchDigit != "" {
+		return errBankCheckDigit(a, "branch")
+	}
+	number, ok := padBankNumber(a.number, 5)
+	if !ok || boletoMod10(a.branch+number) != a.digit[0] {
+		return errBankCheckDigit(a, "account")
+	}
+	return nil
+}
+
+// mod11BankDigit computes a mod 11 check digit with weights from 2 to maxWeight repeating from the
+// right, mapping 10 and 11 to the given characters.
+func mod11BankDigit(digits string, maxWeight int, ten, eleven byte) byte {
+	sum, weight := 0, 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+		if weight++; weight > maxWeight {
+			weight = 2
+		}
+	}
+
+	switch dv := 11 - sum%11; dv {
+	case 10:
+		return ten
+	case 11:
+		return eleven
+	default:
+		return byte('0' + dv)
+	}
+}
+
+// padBankNumber pads an account number with leading zeros to size, or returns false if it is longer.
+func padBankNumber(number string, size int) (string, bool) {
+	if len(number) > size {
+		return "", false
+	}
+	return strings.Repeat("0", size-len(number)) + number, true
+}
+
+// errBankCheckDigit builds the error returned when a check digit does not match the bank rule.
+func errBankCheckDigit(a BankAccount, field string) error {
+	return fault.New(
+		"bank account has an invalid check digit",
+		fault.WithCode(fault.Invalid),
+		fault.WithContext("bank", a.bank.String()),
+		fault.WithContext("field", field),
+	)
+}
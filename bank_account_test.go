@@ -0,0 +1,150 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type BankAccountSuite struct {
+	suite.Suite
+}
+
+func TestBankAccountSuite(t *testing.T) {
+	suite.Run(t, new(BankAccountSuite))
+}
+
+func (s *BankAccountSuite) TearDownTest() {
+	wisp.ResetBankAccountValidators()
+}
+
+func (s *BankAccountSuite) TestNewBankAccount() {
+	s.Run("should accept valid accounts", func() {
+		testCases := []struct {
+			name     string
+			bank     string
+			branch   string
+			account  string
+			expected string
+		}{
+			{name: "Banco do Brasil", bank: "001", branch: "1234-3", account: "12345-5", expected: "001 1234-3 12345-5"},
+			{name: "Banco do Brasil with X digit", bank: "1", branch: "1234", account: "1009-x", expected: "001 1234 1009-X"},
+			{name: "Bradesco", bank: "237", branch: "1234-3", account: "0123456-0", expected: "237 1234-3 0123456-0"},
+			{name: "Bradesco with P digit", bank: "237", branch: "1234", account: "100008P", expected: "237 1234 100008-P"},
+			{name: "Itaú", bank: "341", branch: "2545", account: "02366-1", expected: "341 2545 02366-1"},
+			{name: "Itaú without dash", bank: "341", branch: "2545", account: "023661", expected: "341 2545 02366-1"},
+			{name: "bank without rule", bank: "260", branch: "0001", account: "12345678-9", expected: "260 0001 12345678-9"},
+		}
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				account, err := wisp.NewBankAccount(tc.bank, tc.branch, tc.account, wisp.BankAccountChecking)
+				s.Require().NoError(err)
+				s.Equal(tc.expected, account.String())
+				s.Equal(wisp.BankAccountChecking, account.Type())
+			})
+		}
+	})
+
+	s.Run("should expose the fields", func() {
+		account, err := wisp.NewBankAccount("001", "1234-3", "12345-5", wisp.BankAccountSavings)
+		s.Require().NoError(err)
+		s.Equal(wisp.BankCode("001"), account.Bank())
+		s.Equal("Banco do Brasil", account.Bank().Name())
+		s.Equal("1234", account.Branch())
+		s.Equal("3", account.BranchDigit())
+		s.Equal("12345", account.Number())
+		s.Equal("5", account.Digit())
+		s.Equal(wisp.BankAccountSavings, account.Type())
+		s.False(account.IsZero())
+	})
+
+	s.Run("should reject invalid accounts", func() {
+		testCases := []struct {
+			name        string
+			bank        string
+			branch      string
+			account     string
+			accountType wisp.BankAccountType
+		}{
+			{name: "missing bank", bank: "", branch: "1234", account: "12345-5", accountType: wisp.BankAccountChecking},
+			{name: "invalid bank", bank: "abc", branch: "1234", account: "12345-5", accountType: wisp.BankAccountChecking},
+			{name: "invalid type", bank: "260", branch: "1234", account: "12345-5", accountType: "investment"},
+			{name: "short branch", bank: "260", branch: "123", account: "12345-5", accountType: wisp.BankAccountChecking},
+			{name: "invalid branch digit", bank: "260", branch: "1234-10", account: "12345-5", accountType: wisp.BankAccountChecking},
+			{name: "missing account digit", bank: "260", branch: "1234", account: "12345-", accountType: wisp.BankAccountChecking},
+			{name: "letters in account", bank: "260", branch: "1234", account: "12a45-5", accountType: wisp.BankAccountChecking},
+			{name: "account too long", bank: "260", branch: "1234", account: "123456789012345678901-5", accountType: wisp.BankAccountChecking},
+			{name: "wrong Banco do Brasil branch digit", bank: "001", branch: "1234-4", account: "12345-5", accountType: wisp.BankAccountChecking},
+			{name: "wrong Banco do Brasil account digit", bank: "001", branch: "1234-3", account: "12345-6", accountType: wisp.BankAccountChecking},
+			{name: "Banco do Brasil account too long", bank: "001", branch: "1234", account: "123456789-0", accountType: wisp.BankAccountChecking},
+			{name: "wrong Bradesco account digit", bank: "237", branch: "1234", account: "0123456-1", accountType: wisp.BankAccountChecking},
+			{name: "wrong Itaú account digit", bank: "341", branch: "2545", account: "02366-2", accountType: wisp.BankAccountChecking},
+			{name: "Itaú branch with digit", bank: "341", branch: "2545-1", account: "02366-1", accountType: wisp.BankAccountChecking},
+		}
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				account, err := wisp.NewBankAccount(tc.bank, tc.branch, tc.account, tc.accountType)
+				s.Require().Error(err)
+				s.True(account.IsZero())
+				s.Equal(fault.Invalid, err.(*fault.Error).Code)
+			})
+		}
+	})
+}
+
+func (s *BankAccountSuite) TestRegisterBankAccountValidator() {
+	code, _ := wisp.NewBankCode("260")
+	wisp.RegisterBankAccountValidator(code, func(a wisp.BankAccount) error {
+		if len(a.Number()) != 8 {
+			return fault.New("account must have 8 digits", fault.WithCode(fault.Invalid))
+		}
+		return nil
+	})
+
+	_, err := wisp.NewBankAccount("260", "0001", "1234-5", wisp.BankAccountPayment)
+	s.Error(err)
+	_, err = wisp.NewBankAccount("260", "0001", "12345678-9", wisp.BankAccountPayment)
+	s.NoError(err)
+
+	wisp.RegisterBankAccountValidator("001", nil)
+	_, err = wisp.NewBankAccount("001", "1234-4", "12345-6", wisp.BankAccountChecking)
+	s.NoError(err)
+}
+
+func (s *BankAccountSuite) TestJSON_SQL() {
+	account, _ := wisp.NewBankAccount("001", "1234-3", "12345-5", wisp.BankAccountChecking)
+
+	s.Run("should round-trip through JSON", func() {
+		data, err := json.Marshal(account)
+		s.Require().NoError(err)
+		s.JSONEq(`{"bank":"001","branch":"1234","branch_digit":"3","number":"12345","digit":"5","type":"checking"}`, string(data))
+
+		var decoded wisp.BankAccount
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.True(account.Equals(decoded))
+
+		s.Error(json.Unmarshal([]byte(`{"bank":"001","branch":"1234","number":"12345","digit":"6","type":"checking"}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`"001"`), &decoded))
+		s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+		s.True(decoded.IsZero())
+	})
+
+	s.Run("should be stored as JSON", func() {
+		v, err := account.Value()
+		s.Require().NoError(err)
+
+		var scanned wisp.BankAccount
+		s.Require().NoError(scanned.Scan(v))
+		s.True(account.Equals(scanned))
+
+		v, _ = wisp.ZeroBankAccount.Value()
+		s.Nil(v)
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+		s.Error(scanned.Scan(1))
+	})
+}
@@ -0,0 +1,238 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// BankInfo holds the metadata of a bank in the registry.
+type BankInfo struct {
+	// Code is the 3-digit COMPE code (e.g., "001").
+	Code BankCode
+	// ISPB is the 8-digit identifier of the institution in the Brazilian payment system (SPB),
+	// used by Pix and TED (e.g., "00000000").
+	ISPB string
+	// Name is the short name of the bank.
+	Name string
+}
+
+// defaultBanks returns the registry of the largest Brazilian banks and payment institutions.
+func defaultBanks() map[BankCode]BankInfo {
+	banks := []BankInfo{
+		{Code: "001", ISPB: "00000000", Name: "Banco do Brasil"},
+		{Code: "033", ISPB: "90400888", Name: "Santander"},
+		{Code: "041", ISPB: "92702067", Name: "Banrisul"},
+		{Code: "077", ISPB: "00416968", Name: "Banco Inter"},
+		{Code: "104", ISPB: "00360305", Name: "Caixa Econômica Federal"},
+		{Code: "212", ISPB: "92894922", Name: "Banco Original"},
+		{Code: "237", ISPB: "60746948", Name: "Bradesco"},
+		{Code: "260", ISPB: "18236120", Name: "Nu Pagamentos"},
+		{Code: "290", ISPB: "08561701", Name: "PagSeguro"},
+		{Code: "323", ISPB: "10573521", Name: "Mercado Pago"},
+		{Code: "336", ISPB: "31872495", Name: "Banco C6"},
+		{Code: "341", ISPB: "60701190", Name: "Itaú Unibanco"},
+		{Code: "422", ISPB: "58160789", Name: "Banco Safra"},
+		{Code: "748", ISPB: "01181521", Name: "Sicredi"},
+		{Code: "756", ISPB: "02038232", Name: "Sicoob"},
+	}
+	registry := make(map[BankCode]BankInfo, len(banks))
+	for _, b := range banks {
+		registry[b.Code] = b
+	}
+	return registry
+}
+
+// registeredBanks holds the registry of known banks by COMPE code.
+var registeredBanks = defaultBanks()
+
+// RegisterBank adds a bank to the registry, or overrides the metadata of a registered one.
+// Returns an error if the code does not have 1 to 3 digits or the ISPB does not have 8 digits.
+//
+// Example:
+//   err := wisp.RegisterBank(wisp.BankInfo{Code: "380", ISPB: "22896431", Name: "PicPay"})
+func RegisterBank(info BankInfo) error {
+	code, err := NewBankCode(string(info.Code))
+	if err != nil {
+		return err
+	}
+	if code.IsZero() {
+		return fault.New("bank code cannot be empty", fault.WithCode(fault.Invalid))
+	}
+	ispb, err := parseISPB(info.ISPB)
+	if err != nil {
+		return err
+	}
+
+	info.Code = code
+	info.ISPB = ispb
+	info.Name = strings.TrimSpace(info.Name)
+	registeredBanks[code] = info
+	return nil
+}
+
+// ResetBanks restores the registry to the built-in banks.
+// This is primarily for testing purposes to ensure a clean state.
+func ResetBanks() {
+	registeredBanks = defaultBanks()
+}
+
+// parseISPB validates an 8-digit ISPB.
+func parseISPB(input string) (string, error) {
+	ispb := strings.TrimSpace(input)
+	if len(ispb) != 8 || !isASCIIDigits(ispb) {
+		return "", fault.New(
+			"ISPB must have 8 digits",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	return ispb, nil
+}
+
+// BankCode is a value object representing the 3-digit COMPE code of a Brazilian bank
+// (e.g., "001" for Banco do Brasil), as used in boletos, TED and bank account records.
+// Any 3-digit code is valid; codes in the registry also provide their ISPB and name.
+//
+// The zero value is EmptyBankCode.
+//
+// Examples:
+//   code, err := wisp.NewBankCode("1")                // "001"
+//   code, err := wisp.NewBankCodeFromISPB("60746948") // "237"
+//   code.Name()                                       // "Bradesco"
+type BankCode string
+
+// EmptyBankCode represents the zero value for the BankCode type.
+var EmptyBankCode BankCode
+
+// NewBankCode creates a new BankCode from 1 to 3 digits, padded with leading zeros.
+// Returns EmptyBankCode for an empty input, or an error if the input is not 1 to 3 digits.
+func NewBankCode(input string) (BankCode, error) {
+	trimmed := strings.TrimSpace(input)
+	if trimmed == "" {
+		return EmptyBankCode, nil
+	}
+	if len(trimmed) > 3 || !isASCIIDigits(trimmed) || trimmed == strings.Repeat("0", len(trimmed)) {
+		return EmptyBankCode, fault.New(
+			"bank code must have 1 to 3 digits",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	return BankCode(strings.Repeat("0", 3-len(trimmed)) + trimmed), nil
+}
+
+// NewBankCodeFromISPB returns the BankCode registered with the given ISPB.
+// Returns an error if the ISPB does not have 8 digits, or a NotFound error if no registered bank
+// has it.
+func NewBankCodeFromISPB(ispb string) (BankCode, error) {
+	normalized, err := parseISPB(ispb)
+	if err != nil {
+		return EmptyBankCode, err
+	}
+	for code, info := range registeredBanks {
+		if info.ISPB == normalized {
+			return code, nil
+		}
+	}
+	return EmptyBankCode, fault.New(
+		"no registered bank has this ISPB",
+		fault.WithCode(fault.NotFound),
+		fault.WithContext("ispb", normalized),
+	)
+}
+
+// String returns the 3-digit code.
+func (c BankCode) String() string {
+	return string(c)
+}
+
+// IsZero returns true if the BankCode is the zero value.
+func (c BankCode) IsZero() bool {
+	return c == EmptyBankCode
+}
+
+// Info returns the registered metadata of the bank and true, or false if it is not registered.
+func (c BankCode) Info() (BankInfo, bool) {
+	info, ok := registeredBanks[c]
+	return info, ok
+}
+
+// IsRegistered checks if the bank is in the registry.
+func (c BankCode) IsRegistered() bool {
+	_, ok := registeredBanks[c]
+	return ok
+}
+
+// ISPB returns the ISPB of a registered bank, or an empty string if it is not registered.
+func (c BankCode) ISPB() string {
+	return registeredBanks[c].ISPB
+}
+
+// Name returns the name of a registered bank, or an empty string if it is not registered.
+func (c BankCode) Name() string {
+	return registeredBanks[c].Name
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the BankCode as a 3-digit JSON string.
+func (c BankCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a BankCode, with validation.
+func (c *BankCode) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "BankCode must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	code, err := NewBankCode(s)
+	if err != nil {
+		return err
+	}
+	*c = code
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the BankCode as a 3-digit string, or nil for the zero value.
+func (c BankCode) Value() (driver.Value, error) {
+	if c.IsZero() {
+		return nil, nil
+	}
+	return c.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a BankCode, with validation.
+func (c *BankCode) Scan(src interface{}) error {
+	if src == nil {
+		*c = EmptyBankCode
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for BankCode",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	code, err := NewBankCode(s)
+	if err != nil {
+		return err
+	}
+	*c = code
+	return nil
+}
@@ -0,0 +1,119 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type BankCodeSuite struct {
+	suite.Suite
+}
+
+func TestBankCodeSuite(t *testing.T) {
+	suite.Run(t, new(BankCodeSuite))
+}
+
+func (s *BankCodeSuite) TearDownTest() {
+	wisp.ResetBanks()
+}
+
+func (s *BankCodeSuite) TestNewBankCode() {
+	testCases := []struct {
+		input    string
+		expected wisp.BankCode
+	}{
+		{input: "001", expected: "001"},
+		{input: "1", expected: "001"},
+		{input: " 33 ", expected: "033"},
+		{input: "999", expected: "999"},
+		{input: "", expected: wisp.EmptyBankCode},
+	}
+	for _, tc := range testCases {
+		code, err := wisp.NewBankCode(tc.input)
+		s.Require().NoError(err, tc.input)
+		s.Equal(tc.expected, code)
+	}
+
+	for _, input := range []string{"0001", "abc", "000", "-1"} {
+		code, err := wisp.NewBankCode(input)
+		s.Require().Error(err, input)
+		s.True(code.IsZero())
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	}
+}
+
+func (s *BankCodeSuite) TestRegistry() {
+	s.Run("should provide metadata of built-in banks", func() {
+		code, _ := wisp.NewBankCode("237")
+		s.True(code.IsRegistered())
+		s.Equal("60746948", code.ISPB())
+		s.Equal("Bradesco", code.Name())
+
+		info, ok := code.Info()
+		s.True(ok)
+		s.Equal(wisp.BankCode("237"), info.Code)
+	})
+
+	s.Run("should accept unregistered codes without metadata", func() {
+		code, err := wisp.NewBankCode("999")
+		s.Require().NoError(err)
+		s.False(code.IsRegistered())
+		s.Empty(code.ISPB())
+		s.Empty(code.Name())
+	})
+
+	s.Run("should register banks", func() {
+		s.Require().NoError(wisp.RegisterBank(wisp.BankInfo{Code: "380", ISPB: "22896431", Name: " PicPay "}))
+		code, _ := wisp.NewBankCode("380")
+		s.Equal("PicPay", code.Name())
+
+		s.Error(wisp.RegisterBank(wisp.BankInfo{Code: "", ISPB: "22896431"}))
+		s.Error(wisp.RegisterBank(wisp.BankInfo{Code: "381", ISPB: "123"}))
+		s.Error(wisp.RegisterBank(wisp.BankInfo{Code: "1000", ISPB: "22896431"}))
+	})
+
+	s.Run("should find banks by ISPB", func() {
+		code, err := wisp.NewBankCodeFromISPB("00000000")
+		s.Require().NoError(err)
+		s.Equal(wisp.BankCode("001"), code)
+
+		_, err = wisp.NewBankCodeFromISPB("12345678")
+		s.Require().Error(err)
+		s.Equal(fault.NotFound, err.(*fault.Error).Code)
+
+		_, err = wisp.NewBankCodeFromISPB("1234")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *BankCodeSuite) TestJSON_SQL() {
+	code, _ := wisp.NewBankCode("341")
+
+	data, err := json.Marshal(code)
+	s.Require().NoError(err)
+	s.Equal(`"341"`, string(data))
+
+	var decoded wisp.BankCode
+	s.Require().NoError(json.Unmarshal([]byte(`"1"`), &decoded))
+	s.Equal(wisp.BankCode("001"), decoded)
+	s.Error(json.Unmarshal([]byte(`"abcd"`), &decoded))
+
+	v, err := code.Value()
+	s.Require().NoError(err)
+	s.Equal("341", v)
+	v, _ = wisp.EmptyBankCode.Value()
+	s.Nil(v)
+
+	var scanned wisp.BankCode
+	s.Require().NoError(scanned.Scan([]byte("237")))
+	s.Equal(wisp.BankCode("237"), scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Error(scanned.Scan(237))
+}
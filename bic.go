@@ -0,0 +1,198 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// bicPrimaryOffice is the branch code of the primary office of an institution.
+const bicPrimaryOffice = "XXX"
+
+// BIC is a value object representing a Business Identifier Code (ISO 9362), also known as SWIFT
+// code, which identifies the bank of an international transfer. It has 8 or 11 characters:
+//   - institution code: 4 letters or digits
+//   - country code: 2 letters (ISO 3166)
+//   - location code: 2 letters or digits
+//   - branch code: 3 letters or digits, optional ("XXX" is the primary office)
+//
+// It is stored uppercase. An 8-character BIC and the same BIC with branch "XXX" refer to the same
+// office; use Equals to compare them.
+//
+// The zero value is EmptyBIC.
+//
+// Example:
+//   bic, err := wisp.NewBIC("deutdeff500")
+//   bic.InstitutionCode() // "DEUT"
+//   bic.CountryCode()     // "DE"
+//   bic.BranchCode()      // "500"
+type BIC string
+
+// EmptyBIC represents the zero value for the BIC type.
+var EmptyBIC BIC
+
+// NewBIC creates a new BIC, ignoring surrounding spaces and case.
+// Returns EmptyBIC for an empty input, or an error if the length or structure is invalid.
+func NewBIC(input string) (BIC, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(input))
+	if normalized == "" {
+		return EmptyBIC, nil
+	}
+
+	if len(normalized) != 8 && len(normalized) != 11 {
+		return EmptyBIC, fault.New(
+			"BIC must have 8 or 11 characters",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	for i := 0; i < len(normalized); i++ {
+		c := normalized[i]
+		valid := isDigit(c) || isUpperLetter(c)
+		if i == 4 || i == 5 {
+			valid = isUpperLetter(c)
+		}
+		if !valid {
+			return EmptyBIC, fault.New(
+				"BIC must be an institution code, a country code, a location code and an optional branch code",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("input_value", input),
+			)
+		}
+	}
+	return BIC(normalized), nil
+}
+
+// InstitutionCode returns the 4-character code of the institution (e.g., "DEUT").
+func (b BIC) InstitutionCode() string {
+	if b.IsZero() {
+		return ""
+	}
+	return string(b[:4])
+}
+
+// CountryCode returns the ISO 3166 country code of the institution (e.g., "DE").
+func (b BIC) CountryCode() string {
+	if b.IsZero() {
+		return ""
+	}
+	return string(b[4:6])
+}
+
+// LocationCode returns the 2-character location code (e.g., "FF").
+func (b BIC) LocationCode() string {
+	if b.IsZero() {
+		return ""
+	}
+	return string(b[6:8])
+}
+
+// BranchCode returns the 3-character branch code, "XXX" for the primary office.
+// Returns "XXX" for 8-character BICs too, and an empty string for the zero value.
+func (b BIC) BranchCode() string {
+	switch len(b) {
+	case 11:
+		return string(b[8:])
+	case 8:
+		return bicPrimaryOffice
+	default:
+		return ""
+	}
+}
+
+// IsPrimaryOffice returns true if the BIC identifies the primary office of the institution.
+func (b BIC) IsPrimaryOffice() bool {
+	return b.BranchCode() == bicPrimaryOffice
+}
+
+// IsTest returns true for BICs of the SWIFT test and training network, whose location code ends
+// with "0".
+func (b BIC) IsTest() bool {
+	return len(b) >= 8 && b[7] == '0'
+}
+
+// BIC11 returns the BIC with 11 characters, adding "XXX" to 8-character BICs.
+func (b BIC) BIC11() string {
+	if len(b) == 8 {
+		return string(b) + bicPrimaryOffice
+	}
+	return string(b)
+}
+
+// Equals checks if two BICs identify the same office, so "DEUTDEFF" equals "DEUTDEFFXXX".
+func (b BIC) Equals(other BIC) bool {
+	return b.BIC11() == other.BIC11()
+}
+
+// String returns the BIC as stored, with 8 or 11 characters.
+func (b BIC) String() string {
+	return string(b)
+}
+
+// IsZero returns true if the BIC is the zero value.
+func (b BIC) IsZero() bool {
+	return b == EmptyBIC
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the BIC as a JSON string.
+func (b BIC) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a BIC, with validation.
+func (b *BIC) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "BIC must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	bic, err := NewBIC(s)
+	if err != nil {
+		return err
+	}
+	*b = bic
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the BIC as a string, or nil for the zero value.
+func (b BIC) Value() (driver.Value, error) {
+	if b.IsZero() {
+		return nil, nil
+	}
+	return b.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a BIC, with validation.
+func (b *BIC) Scan(src interface{}) error {
+	if src == nil {
+		*b = EmptyBIC
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for BIC",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	bic, err := NewBIC(s)
+	if err != nil {
+		return err
+	}
+	*b = bic
+	return nil
+}
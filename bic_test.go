@@ -0,0 +1,90 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type BICSuite struct {
+	suite.Suite
+}
+
+func TestBICSuite(t *testing.T) {
+	suite.Run(t, new(BICSuite))
+}
+
+func (s *BICSuite) TestNewBIC() {
+	for input, expected := range map[string]wisp.BIC{
+		"DEUTDEFF":      "DEUTDEFF",
+		" deutdeff500 ": "DEUTDEFF500",
+		"BRASBRRJ":      "BRASBRRJ",
+		"ITAUBRSPXXX":   "ITAUBRSPXXX",
+		"":              wisp.EmptyBIC,
+	} {
+		bic, err := wisp.NewBIC(input)
+		s.Require().NoError(err, input)
+		s.Equal(expected, bic)
+	}
+
+	for _, input := range []string{"DEUTDEF", "DEUTDEFF5", "DEUT1EFF", "DEUTDEFF-00", "DEUT DEFF"} {
+		bic, err := wisp.NewBIC(input)
+		s.Require().Error(err, input)
+		s.True(bic.IsZero())
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	}
+}
+
+func (s *BICSuite) TestParts() {
+	bic, _ := wisp.NewBIC("DEUTDEFF500")
+	s.Equal("DEUT", bic.InstitutionCode())
+	s.Equal("DE", bic.CountryCode())
+	s.Equal("FF", bic.LocationCode())
+	s.Equal("500", bic.BranchCode())
+	s.False(bic.IsPrimaryOffice())
+	s.False(bic.IsTest())
+
+	primary, _ := wisp.NewBIC("DEUTDEFF")
+	s.Equal("XXX", primary.BranchCode())
+	s.True(primary.IsPrimaryOffice())
+	s.Equal("DEUTDEFFXXX", primary.BIC11())
+	s.True(primary.Equals("DEUTDEFFXXX"))
+	s.False(primary.Equals(bic))
+
+	test, _ := wisp.NewBIC("DEUTDEF0")
+	s.True(test.IsTest())
+
+	s.Equal("", wisp.EmptyBIC.InstitutionCode())
+	s.Equal("", wisp.EmptyBIC.BranchCode())
+	s.False(wisp.EmptyBIC.IsPrimaryOffice())
+}
+
+func (s *BICSuite) TestJSON_SQL() {
+	bic, _ := wisp.NewBIC("BRASBRRJ")
+
+	data, err := json.Marshal(bic)
+	s.Require().NoError(err)
+	s.Equal(`"BRASBRRJ"`, string(data))
+
+	var decoded wisp.BIC
+	s.Require().NoError(json.Unmarshal([]byte(`"brasbrrj"`), &decoded))
+	s.Equal(bic, decoded)
+	s.Error(json.Unmarshal([]byte(`"BRAS"`), &decoded))
+
+	v, err := bic.Value()
+	s.Require().NoError(err)
+	s.Equal("BRASBRRJ", v)
+	v, _ = wisp.EmptyBIC.Value()
+	s.Nil(v)
+
+	var scanned wisp.BIC
+	s.Require().NoError(scanned.Scan("BRASBRRJ"))
+	s.Equal(bic, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Error(scanned.Scan(1))
+}
@@ -21,6 +21,30 @@ func SetLegalAge(age int) {
 	}
 }
 
+// defaultMaxAge is the oldest age accepted for a birth date, used to reject typos such as 1800-01-01.
+// It can be configured globally using SetMaxAge; zero disables the check.
+var defaultMaxAge = 150
+
+// minBirthYear is the earliest year accepted for a birth date. Zero disables the check.
+// It can be configured globally using SetMinBirthYear.
+var minBirthYear = 0
+
+// SetMaxAge configures the global maximum age accepted by NewBirthDate and ValidateReasonable.
+// A value of 0 disables the check; negative values are ignored.
+func SetMaxAge(age int) {
+	if age >= 0 {
+		defaultMaxAge = age
+	}
+}
+
+// SetMinBirthYear configures the global earliest year accepted by NewBirthDate (e.g., 1900).
+// A value of 0 disables the check; negative values are ignored.
+func SetMinBirthYear(year int) {
+	if year >= 0 {
+		minBirthYear = year
+	}
+}
+
 // BirthDate represents a person's date of birth.
 // It is a value object that wraps a wisp.Date and ensures the date is not in the future.
 // It provides methods to calculate age and check for legal age.
@@ -39,7 +63,8 @@ type BirthDate struct {
 var ZeroBirthDate BirthDate
 
 // NewBirthDate creates a new BirthDate from a year, month, and day.
-// It returns an error if the date is invalid or in the future.
+// It returns an error if the date is invalid, in the future, earlier than the minimum birth year
+// (see SetMinBirthYear), or older than the maximum age (see SetMaxAge, 150 years by default).
 func NewBirthDate(year int, month time.Month, day int) (BirthDate, error) {
 	d, err := NewDate(year, month, day)
 	if err != nil {
@@ -54,7 +79,27 @@ func NewBirthDate(year int, month time.Month, day int) (BirthDate, error) {
 		)
 	}
 
-	return BirthDate{date: d}, nil
+	if minBirthYear > 0 && d.Year() < minBirthYear {
+		return ZeroBirthDate, fault.New(
+			"birth date is earlier than the minimum allowed year",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_date", d.String()),
+			fault.WithContext("min_year", minBirthYear),
+		)
+	}
+
+	bd := BirthDate{date: d}
+	if age := bd.Age(Today()); defaultMaxAge > 0 && age > defaultMaxAge {
+		return ZeroBirthDate, fault.New(
+			"birth date exceeds the maximum allowed age",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_date", d.String()),
+			fault.WithContext("age", age),
+			fault.WithContext("max_age", defaultMaxAge),
+		)
+	}
+
+	return bd, nil
 }
 
 // ParseBirthDate creates a new BirthDate by parsing a string in YYYY-MM-DD format.
@@ -99,6 +144,46 @@ func (bd BirthDate) IsOfAge(today Date) bool {
 	return bd.Age(today) >= defaultLegalAge
 }
 
+// ValidateReasonable checks that the birth date is plausible for identity verification (KYC) flows
+// as of a given reference date (`today`): the person must have reached the legal age (see SetLegalAge)
+// and must not be older than the maximum age (see SetMaxAge).
+// It returns a DomainViolation error for underage persons and an Invalid error otherwise.
+func (bd BirthDate) ValidateReasonable(today Date) error {
+	if bd.IsZero() {
+		return fault.New("birth date is required", fault.WithCode(fault.Invalid))
+	}
+
+	if bd.date.After(today) {
+		return fault.New(
+			"birth date cannot be after the reference date",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("birth_date", bd.String()),
+			fault.WithContext("reference_date", today.String()),
+		)
+	}
+
+	age := bd.Age(today)
+	if age < defaultLegalAge {
+		return fault.New(
+			"person has not reached the legal age",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("age", age),
+			fault.WithContext("legal_age", defaultLegalAge),
+		)
+	}
+
+	if defaultMaxAge > 0 && age > defaultMaxAge {
+		return fault.New(
+			"birth date exceeds the maximum allowed age",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("age", age),
+			fault.WithContext("max_age", defaultMaxAge),
+		)
+	}
+
+	return nil
+}
+
 // AnniversaryThisYear returns the date of the birthday anniversary for the current year of a given reference date (`today`).
 func (bd BirthDate) AnniversaryThisYear(today Date) Date {
 	if bd.IsZero() {
@@ -117,6 +202,42 @@ func (bd BirthDate) HasAnniversaryPassed(today Date) bool {
 	return today.After(bd.AnniversaryThisYear(today))
 }
 
+// NextAnniversary returns the date of the next birthday on or after a given reference date (`today`).
+// For people born on February 29, the anniversary falls on March 1 in non-leap years.
+func (bd BirthDate) NextAnniversary(today Date) Date {
+	if bd.IsZero() {
+		return ZeroDate
+	}
+
+	anniversary := bd.AnniversaryThisYear(today)
+	if anniversary.Before(today) {
+		anniversary = Date{t: time.Date(today.Year()+1, bd.date.Month(), bd.date.Day(), 0, 0, 0, 0, time.UTC)}
+	}
+	return anniversary
+}
+
+// DaysUntilBirthday returns the number of days from a given reference date (`today`) until the next birthday.
+// It returns 0 on the birthday itself.
+func (bd BirthDate) DaysUntilBirthday(today Date) int {
+	if bd.IsZero() {
+		return 0
+	}
+	return int(bd.NextAnniversary(today).t.Sub(today.t).Hours() / 24)
+}
+
+// AgeInMonths calculates the person's age in completed months as of a given reference date (`today`).
+// This is useful for infants, where age in years is too coarse.
+func (bd BirthDate) AgeInMonths(today Date) int {
+	if bd.IsZero() || today.Before(bd.date) {
+		return 0
+	}
+	months := (today.Year()-bd.date.Year())*12 + int(today.Month()) - int(bd.date.Month())
+	if today.Day() < bd.date.Day() {
+		months--
+	}
+	return months
+}
+
 // String returns the birth date formatted as a YYYY-MM-DD string.
 func (bd BirthDate) String() string {
 	return bd.date.String()
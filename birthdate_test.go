@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/marcelofabianov/fault"
 	wisp "github.com/marcelofabianov/wisp"
 	"github.com/stretchr/testify/suite"
 )
@@ -17,8 +18,10 @@ func TestBirthDateSuite(t *testing.T) {
 }
 
 func (s *BirthDateSuite) TearDownTest() {
-	// Reset legal age to default after tests that change it
+	// Reset global settings to their defaults after tests that change them
 	wisp.SetLegalAge(18)
+	wisp.SetMaxAge(150)
+	wisp.SetMinBirthYear(0)
 }
 
 func (s *BirthDateSuite) TestNewBirthDate() {
@@ -107,3 +110,100 @@ func (s *BirthDateSuite) TestBirthDate_Anniversary() {
 		s.Equal(expectedLeapAnniversary, leapBd.AnniversaryThisYear(leapYear))
 	})
 }
+
+func (s *BirthDateSuite) TestNewBirthDate_Bounds() {
+	s.Run("should reject dates older than the default max age", func() {
+		_, err := wisp.NewBirthDate(1800, time.January, 1)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		s.Equal(150, err.(*fault.Error).Context["max_age"])
+	})
+
+	s.Run("should honor a custom max age", func() {
+		wisp.SetMaxAge(100)
+		_, err := wisp.NewBirthDate(wisp.Today().Year()-101, time.January, 1)
+		s.Error(err)
+
+		wisp.SetMaxAge(0)
+		_, err = wisp.NewBirthDate(1800, time.January, 1)
+		s.NoError(err)
+	})
+
+	s.Run("should honor the minimum birth year", func() {
+		wisp.SetMinBirthYear(1950)
+		_, err := wisp.NewBirthDate(1949, time.December, 31)
+		s.Require().Error(err)
+		s.Equal(1950, err.(*fault.Error).Context["min_year"])
+
+		_, err = wisp.NewBirthDate(1950, time.January, 1)
+		s.NoError(err)
+	})
+}
+
+func (s *BirthDateSuite) TestBirthDate_ValidateReasonable() {
+	today, _ := wisp.NewDate(2025, time.June, 15)
+	adult, _ := wisp.NewBirthDate(1990, time.May, 1)
+	minor, _ := wisp.NewBirthDate(2010, time.May, 1)
+
+	s.NoError(adult.ValidateReasonable(today))
+
+	err := minor.ValidateReasonable(today)
+	s.Require().Error(err)
+	s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+
+	wisp.SetMaxAge(30)
+	err = adult.ValidateReasonable(today)
+	s.Require().Error(err)
+	s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+	s.Error(wisp.ZeroBirthDate.ValidateReasonable(today))
+	past, _ := wisp.NewDate(1980, time.January, 1)
+	s.Error(adult.ValidateReasonable(past))
+}
+
+func (s *BirthDateSuite) TestBirthDate_ClockRelative() {
+	bd, _ := wisp.NewBirthDate(1990, time.October, 20)
+
+	s.Run("NextAnniversary and DaysUntilBirthday", func() {
+		before, _ := wisp.NewDate(2025, time.October, 10)
+		expected, _ := wisp.NewDate(2025, time.October, 20)
+		s.Equal(expected, bd.NextAnniversary(before))
+		s.Equal(10, bd.DaysUntilBirthday(before))
+
+		onTheDay, _ := wisp.NewDate(2025, time.October, 20)
+		s.Equal(onTheDay, bd.NextAnniversary(onTheDay))
+		s.Equal(0, bd.DaysUntilBirthday(onTheDay))
+
+		after, _ := wisp.NewDate(2025, time.October, 21)
+		expected, _ = wisp.NewDate(2026, time.October, 20)
+		s.Equal(expected, bd.NextAnniversary(after))
+		s.Equal(364, bd.DaysUntilBirthday(after))
+
+		s.True(wisp.ZeroBirthDate.NextAnniversary(after).IsZero())
+		s.Equal(0, wisp.ZeroBirthDate.DaysUntilBirthday(after))
+	})
+
+	s.Run("leap day birthdays", func() {
+		leap, _ := wisp.NewBirthDate(2000, time.February, 29)
+		today, _ := wisp.NewDate(2025, time.March, 2)
+		expected, _ := wisp.NewDate(2026, time.March, 1)
+		s.Equal(expected, leap.NextAnniversary(today))
+
+		today, _ = wisp.NewDate(2027, time.December, 1)
+		expected, _ = wisp.NewDate(2028, time.February, 29)
+		s.Equal(expected, leap.NextAnniversary(today))
+	})
+
+	s.Run("AgeInMonths", func() {
+		baby, _ := wisp.NewBirthDate(2024, time.January, 31)
+		today, _ := wisp.NewDate(2025, time.March, 30)
+		s.Equal(13, baby.AgeInMonths(today))
+
+		today, _ = wisp.NewDate(2025, time.March, 31)
+		s.Equal(14, baby.AgeInMonths(today))
+
+		earlier, _ := wisp.NewDate(2023, time.January, 1)
+		s.Equal(0, baby.AgeInMonths(earlier))
+		s.Equal(0, wisp.ZeroBirthDate.AgeInMonths(today))
+	})
+}
@@ -0,0 +1,532 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// BoletoKind identifies the layout of a boleto, defined by FEBRABAN.
+type BoletoKind string
+
+// Defines the two boleto layouts.
+const (
+	// BoletoKindBank is a bank boleto ("boleto bancário"), with a 47-digit line.
+	BoletoKindBank BoletoKind = "bank"
+	// BoletoKindCollection is a collection slip ("arrecadação"), used for utility bills and taxes,
+	// with a 48-digit line starting with 8.
+	BoletoKindCollection BoletoKind = "collection"
+)
+
+// boletoFactorBase is the due date of factor 1000 in the current cycle of the due date factor,
+// which restarted at 1000 after reaching 9999 on 2025-02-21.
+var boletoFactorBase = time.Date(2025, time.February, 22, 0, 0, 0, 0, time.UTC)
+
+// BoletoBarcode is a value object representing the 44-digit barcode of a boleto, the content read
+// by scanners. The check digit of the barcode is validated on creation.
+//
+// The zero value is EmptyBoletoBarcode.
+//
+// Example:
+//   barcode, err := wisp.NewBoletoBarcode("00199138100001234560500940144816060680935031")
+//   barcode.Line().Formatted() // "00190.50095 40144.816069 06809.350314 9 13810000123456"
+type BoletoBarcode string
+
+// EmptyBoletoBarcode represents the zero value for the BoletoBarcode type.
+var EmptyBoletoBarcode BoletoBarcode
+
+// NewBoletoBarcode creates a new BoletoBarcode from 44 digits.
+// Returns EmptyBoletoBarcode for an empty input, or an error if the input does not have 44 digits
+// or its check digit is invalid.
+func NewBoletoBarcode(input string) (BoletoBarcode, error) {
+	digits, err := boletoDigits(input)
+	if err != nil || digits == "" {
+		return EmptyBoletoBarcode, err
+	}
+	if len(digits) != 44 {
+		return EmptyBoletoBarcode, fault.New(
+			"boleto barcode must have 44 digits",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("length", len(digits)),
+		)
+	}
+	return parseBoletoBarcode(digits)
+}
+
+// parseBoletoBarcode validates the check digit of a 44-digit barcode.
+func parseBoletoBarcode(digits string) (BoletoBarcode, error) {
+	var dvIndex int
+	var expected byte
+	if digits[0] == '8' {
+		mod, err := boletoCollectionModulus(digits)
+		if err != nil {
+			return EmptyBoletoBarcode, err
+		}
+		dvIndex = 3
+		expected = mod(digits[:3] + digits[4:])
+	} else {
+		dvIndex = 4
+		expected = boletoBankMod11(digits[:4] + digits[5:])
+	}
+
+	if digits[dvIndex] != expected {
+		return EmptyBoletoBarcode, fault.New(
+			"boleto barcode has an invalid check digit",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", digits),
+		)
+	}
+	return BoletoBarcode(digits), nil
+}
+
+// Kind returns the layout of the boleto, or an empty kind for the zero value.
+func (b BoletoBarcode) Kind() BoletoKind {
+	switch {
+	case b.IsZero():
+		return ""
+	case b[0] == '8':
+		return BoletoKindCollection
+	default:
+		return BoletoKindBank
+	}
+}
+
+// BankCode returns the 3-digit code of the issuing bank (e.g., "001"), or an empty string for
+// collection slips.
+func (b BoletoBarcode) BankCode() string {
+	if b.Kind() != BoletoKindBank {
+		return ""
+	}
+	return string(b[:3])
+}
+
+// DueDate returns the due date of a bank boleto and true, or ZeroDate and false if the boleto has
+// no due date (factor 0000) or is a collection slip, whose layout has no standard due date field.
+//
+// The due date factor is read in its current cycle, which started at 1000 on 2025-02-22. Boletos
+// issued before that date with a due date in the previous cycle are out of scope.
+func (b BoletoBarcode) DueDate() (Date, bool) {
+	if b.Kind() != BoletoKindBank {
+		return ZeroDate, false
+	}
+	factor, _ := strconv.Atoi(string(b[5:9]))
+	if factor < 1000 {
+		return ZeroDate, false
+	}
+	t := boletoFactorBase.AddDate(0, 0, factor-1000)
+	return Date{t: t}, true
+}
+
+// Amount returns the amount in BRL and true, or ZeroMoney and false if the amount is zero (left
+// for the payer to fill in) or, on collection slips, the field holds a reference value instead.
+func (b BoletoBarcode) Amount() (Money, bool) {
+	var field string
+	switch b.Kind() {
+	case BoletoKindBank:
+		field = string(b[9:19])
+	case BoletoKindCollection:
+		if b[2] != '6' && b[2] != '8' {
+			return ZeroMoney, false
+		}
+		field = string(b[4:15])
+	default:
+		return ZeroMoney, false
+	}
+
+	cents, _ := strconv.ParseInt(field, 10, 64)
+	if cents == 0 {
+		return ZeroMoney, false
+	}
+	amount, err := NewMoney(cents, BRL)
+	if err != nil {
+		return ZeroMoney, false
+	}
+	return amount, true
+}
+
+// Line returns the digitable line of the boleto, or EmptyBoletoLine for the zero value.
+func (b BoletoBarcode) Line() BoletoLine {
+	switch b.Kind() {
+	case BoletoKindBank:
+		s := string(b)
+		var line strings.Builder
+		line.Grow(47)
+		for _, field := range []string{s[0:4] + s[19:24], s[24:34], s[34:44]} {
+			line.WriteString(field)
+			line.WriteByte(boletoMod10(field))
+		}
+		line.WriteString(s[4:19])
+		return BoletoLine(line.String())
+	case BoletoKindCollection:
+		mod, err := boletoCollectionModulus(string(b))
+		if err != nil {
+			return EmptyBoletoLine
+		}
+		var line strings.Builder
+		line.Grow(48)
+		for i := 0; i < 44; i += 11 {
+			block := string(b[i : i+11])
+			line.WriteString(block)
+			line.WriteByte(mod(block))
+		}
+		return BoletoLine(line.String())
+	default:
+		return EmptyBoletoLine
+	}
+}
+
+// String returns the 44 digits of the barcode.
+func (b BoletoBarcode) String() string {
+	return string(b)
+}
+
+// IsZero returns true if the BoletoBarcode is the zero value.
+func (b BoletoBarcode) IsZero() bool {
+	return b == EmptyBoletoBarcode
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the BoletoBarcode as a JSON string of 44 digits.
+func (b BoletoBarcode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a BoletoBarcode, with validation.
+func (b *BoletoBarcode) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "BoletoBarcode must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	barcode, err := NewBoletoBarcode(s)
+	if err != nil {
+		return err
+	}
+	*b = barcode
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the BoletoBarcode as a string, or nil for the zero value.
+func (b BoletoBarcode) Value() (driver.Value, error) {
+	if b.IsZero() {
+		return nil, nil
+	}
+	return b.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a BoletoBarcode, with validation.
+func (b *BoletoBarcode) Scan(src interface{}) error {
+	if src == nil {
+		*b = EmptyBoletoBarcode
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for BoletoBarcode",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	barcode, err := NewBoletoBarcode(s)
+	if err != nil {
+		return err
+	}
+	*b = barcode
+	return nil
+}
+
+// BoletoLine is a value object representing the digitable line ("linha digitável") of a boleto,
+// the number typed by the payer: 47 digits for bank boletos and 48 digits for collection slips.
+// Each field check digit (mod 10, or mod 11 on some collection slips) and the barcode check digit
+// are validated on creation, and the line is stored as digits only.
+//
+// The zero value is EmptyBoletoLine.
+//
+// Example:
+//   line, err := wisp.NewBoletoLine("00190.50095 40144.816069 06809.350314 9 13810000123456")
+//   line.BankCode()             // "001"
+//   due, _ := line.DueDate()    // 2026-03-10
+//   amount, _ := line.Amount()  // BRL 1234.56
+//   line.Barcode().String()     // "00199138100001234560500940144816060680935031"
+type BoletoLine string
+
+// EmptyBoletoLine represents the zero value for the BoletoLine type.
+var EmptyBoletoLine BoletoLine
+
+// NewBoletoLine creates a new BoletoLine from a digitable line, ignoring the usual spaces, dots
+// and dashes. A 44-digit barcode is also accepted and converted, since payers often paste either.
+// Returns EmptyBoletoLine for an empty input, or an error if the number of digits is wrong or a
+// check digit is invalid.
+func NewBoletoLine(input string) (BoletoLine, error) {
+	digits, err := boletoDigits(input)
+	if err != nil || digits == "" {
+		return EmptyBoletoLine, err
+	}
+
+	var barcode string
+	switch {
+	case len(digits) == 44:
+		barcode = digits
+	case len(digits) == 47 && digits[0] != '8':
+		for i, field := range []string{digits[0:10], digits[10:21], digits[21:32]} {
+			if err := checkBoletoField(field, i+1, boletoMod10); err != nil {
+				return EmptyBoletoLine, err
+			}
+		}
+		barcode = digits[0:4] + digits[32:47] + digits[4:9] + digits[10:20] + digits[21:31]
+	case len(digits) == 48 && digits[0] == '8':
+		mod, err := boletoCollectionModulus(digits)
+		if err != nil {
+			return EmptyBoletoLine, err
+		}
+		for i := 0; i < 4; i++ {
+			block := digits[i*12 : i*12+12]
+			if err := checkBoletoField(block, i+1, mod); err != nil {
+				return EmptyBoletoLine, err
+			}
+			barcode += block[:11]
+		}
+	default:
+		return EmptyBoletoLine, fault.New(
+			"boleto line must have 47 digits (bank) or 48 digits starting with 8 (collection)",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("length", len(digits)),
+		)
+	}
+
+	parsed, err := parseBoletoBarcode(barcode)
+	if err != nil {
+		return EmptyBoletoLine, err
+	}
+	return parsed.Line(), nil
+}
+
+// checkBoletoField validates the last digit of a line field as its check digit.
+func checkBoletoField(field string, index int, mod func(string) byte) error {
+	last := len(field) - 1
+	if mod(field[:last]) != field[last] {
+		return fault.New(
+			"boleto line has an invalid check digit",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("field", index),
+		)
+	}
+	return nil
+}
+
+// Barcode returns the 44-digit barcode of the boleto, or EmptyBoletoBarcode for the zero value.
+func (l BoletoLine) Barcode() BoletoBarcode {
+	s := string(l)
+	switch len(s) {
+	case 47:
+		return BoletoBarcode(s[0:4] + s[32:47] + s[4:9] + s[10:20] + s[21:31])
+	case 48:
+		return BoletoBarcode(s[0:11] + s[12:23] + s[24:35] + s[36:47])
+	default:
+		return EmptyBoletoBarcode
+	}
+}
+
+// Kind returns the layout of the boleto, or an empty kind for the zero value.
+func (l BoletoLine) Kind() BoletoKind {
+	return l.Barcode().Kind()
+}
+
+// BankCode returns the 3-digit code of the issuing bank, or an empty string for collection slips.
+func (l BoletoLine) BankCode() string {
+	return l.Barcode().BankCode()
+}
+
+// DueDate returns the due date of the boleto and true, or ZeroDate and false if it has none.
+// See BoletoBarcode.DueDate.
+func (l BoletoLine) DueDate() (Date, bool) {
+	return l.Barcode().DueDate()
+}
+
+// Amount returns the amount of the boleto in BRL and true, or ZeroMoney and false if it has none.
+// See BoletoBarcode.Amount.
+func (l BoletoLine) Amount() (Money, bool) {
+	return l.Barcode().Amount()
+}
+
+// String returns the digits of the line.
+func (l BoletoLine) String() string {
+	return string(l)
+}
+
+// IsZero returns true if the BoletoLine is the zero value.
+func (l BoletoLine) IsZero() bool {
+	return l == EmptyBoletoLine
+}
+
+// Formatted returns the line as printed on the boleto:
+// "AAAAA.AAAAA BBBBB.BBBBBB CCCCC.CCCCCC D EEEEEEEEEEEEEE" for bank boletos and
+// "AAAAAAAAAAA-A BBBBBBBBBBB-B CCCCCCCCCCC-C DDDDDDDDDDD-D" for collection slips.
+func (l BoletoLine) Formatted() string {
+	s := string(l)
+	switch len(s) {
+	case 47:
+		return fmt.Sprintf("%s.%s %s.%s %s.%s %s %s", s[0:5], s[5:10], s[10:15], s[15:21], s[21:26], s[26:32], s[32:33], s[33:47])
+	case 48:
+		return fmt.Sprintf("%s-%s %s-%s %s-%s %s-%s", s[0:11], s[11:12], s[12:23], s[23:24], s[24:35], s[35:36], s[36:47], s[47:48])
+	default:
+		return s
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the BoletoLine as a JSON string of digits.
+func (l BoletoLine) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a BoletoLine, with validation.
+func (l *BoletoLine) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "BoletoLine must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	line, err := NewBoletoLine(s)
+	if err != nil {
+		return err
+	}
+	*l = line
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the BoletoLine as a string of digits, or nil for the zero value.
+func (l BoletoLine) Value() (driver.Value, error) {
+	if l.IsZero() {
+		return nil, nil
+	}
+	return l.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a BoletoLine, with validation.
+func (l *BoletoLine) Scan(src interface{}) error {
+	if src == nil {
+		*l = EmptyBoletoLine
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for BoletoLine",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	line, err := NewBoletoLine(s)
+	if err != nil {
+		return err
+	}
+	*l = line
+	return nil
+}
+
+// boletoDigits removes the spaces, dots and dashes of a boleto number, rejecting other characters.
+func boletoDigits(input string) (string, error) {
+	var b strings.Builder
+	b.Grow(len(input))
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		switch {
+		case isDigit(c):
+			b.WriteByte(c)
+		case c == ' ' || c == '.' || c == '-' || c == '\t':
+		default:
+			return "", fault.New(
+				"boleto number must contain only digits",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("input_value", input),
+			)
+		}
+	}
+	return b.String(), nil
+}
+
+// boletoCollectionModulus returns the check digit function of a collection slip, chosen by its
+// third digit: 6 or 7 for mod 10, 8 or 9 for mod 11.
+func boletoCollectionModulus(digits string) (func(string) byte, error) {
+	switch digits[2] {
+	case '6', '7':
+		return boletoMod10, nil
+	case '8', '9':
+		return boletoCollectionMod11, nil
+	default:
+		return nil, fault.New(
+			"boleto collection slip has an invalid value identifier",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("value_identifier", string(digits[2])),
+		)
+	}
+}
+
+// boletoMod10 computes the mod 10 check digit: weights 2 and 1 alternate from the right and the
+// digits of each product are added.
+func boletoMod10(digits string) byte {
+	sum, weight := 0, 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		p := int(digits[i]-'0') * weight
+		sum += p/10 + p%10
+		weight = 3 - weight
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// boletoWeightedSum11 adds the digits with weights 2 to 9 repeating from the right.
+func boletoWeightedSum11(digits string) int {
+	sum, weight := 0, 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+		if weight++; weight > 9 {
+			weight = 2
+		}
+	}
+	return sum
+}
+
+// boletoBankMod11 computes the mod 11 check digit of a bank boleto barcode, where 0, 10 and 11
+// become 1.
+func boletoBankMod11(digits string) byte {
+	dv := 11 - boletoWeightedSum11(digits)%11
+	if dv == 0 || dv >= 10 {
+		return '1'
+	}
+	return byte('0' + dv)
+}
+
+// boletoCollectionMod11 computes the mod 11 check digit of a collection slip, where remainders 0
+// and 1 become 0.
+func boletoCollectionMod11(digits string) byte {
+	r := boletoWeightedSum11(digits) % 11
+	if r <= 1 {
+		return '0'
+	}
+	return byte('0' + 11 - r)
+}
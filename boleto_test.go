@@ -0,0 +1,211 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+const (
+	bankBoletoBarcode       = "00199138100001234560500940144816060680935031"
+	bankBoletoLine          = "00190500954014481606906809350314913810000123456"
+	bankBoletoFormatted     = "00190.50095 40144.816069 06809.350314 9 13810000123456"
+	collectionBarcode       = "83620000001579000740000123456789012345678901"
+	collectionLine          = "836200000013579000740004012345678903123456789015"
+	collectionFormatted     = "83620000001-3 57900074000-4 01234567890-3 12345678901-5"
+	collectionMod11Barcode  = "82850000000899000740000123456789012345678901"
+	collectionMod11Line     = "828500000000899000740004012345678900123456789010"
+	collectionReferenceLine = "817000000007000000740001012345678903123456789015"
+)
+
+type BoletoSuite struct {
+	suite.Suite
+}
+
+func TestBoletoSuite(t *testing.T) {
+	suite.Run(t, new(BoletoSuite))
+}
+
+func (s *BoletoSuite) TestNewBoletoLine() {
+	s.Run("should accept valid lines", func() {
+		testCases := []struct {
+			name     string
+			input    string
+			expected wisp.BoletoLine
+			kind     wisp.BoletoKind
+		}{
+			{name: "formatted bank line", input: bankBoletoFormatted, expected: bankBoletoLine, kind: wisp.BoletoKindBank},
+			{name: "bank line digits", input: bankBoletoLine, expected: bankBoletoLine, kind: wisp.BoletoKindBank},
+			{name: "bank barcode", input: bankBoletoBarcode, expected: bankBoletoLine, kind: wisp.BoletoKindBank},
+			{name: "formatted collection line", input: collectionFormatted, expected: collectionLine, kind: wisp.BoletoKindCollection},
+			{name: "collection line with mod 11", input: collectionMod11Line, expected: collectionMod11Line, kind: wisp.BoletoKindCollection},
+			{name: "collection barcode", input: collectionMod11Barcode, expected: collectionMod11Line, kind: wisp.BoletoKindCollection},
+		}
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				line, err := wisp.NewBoletoLine(tc.input)
+				s.Require().NoError(err)
+				s.Equal(tc.expected, line)
+				s.Equal(tc.kind, line.Kind())
+			})
+		}
+	})
+
+	s.Run("should return the zero value for an empty input", func() {
+		line, err := wisp.NewBoletoLine("")
+		s.Require().NoError(err)
+		s.True(line.IsZero())
+		s.Equal(wisp.BoletoKind(""), line.Kind())
+	})
+
+	s.Run("should reject invalid lines", func() {
+		testCases := []struct {
+			name  string
+			input string
+		}{
+			{name: "letters", input: "00190.5009X 40144.816069 06809.350314 9 13810000123456"},
+			{name: "wrong length", input: "00190.50095 40144.816069"},
+			{name: "47 digits starting with 8", input: "8" + bankBoletoLine[1:]},
+			{name: "48 digits not starting with 8", input: "0" + collectionLine[1:]},
+			{name: "wrong first field check digit", input: "00190500964014481606906809350314913810000123456"},
+			{name: "wrong third field check digit", input: "00190500954014481606906809350315913810000123456"},
+			{name: "wrong general check digit", input: "00190500954014481606906809350314813810000123456"},
+			{name: "wrong collection block check digit", input: "836200000014579000740004012345678903123456789015"},
+			{name: "invalid collection value identifier", input: "835200000013579000740004012345678903123456789015"},
+			{name: "wrong barcode check digit", input: "00198138100001234560500940144816060680935031"},
+		}
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				line, err := wisp.NewBoletoLine(tc.input)
+				s.Require().Error(err)
+				s.True(line.IsZero())
+				s.Equal(fault.Invalid, err.(*fault.Error).Code)
+			})
+		}
+	})
+}
+
+func (s *BoletoSuite) TestNewBoletoBarcode() {
+	barcode, err := wisp.NewBoletoBarcode(bankBoletoBarcode)
+	s.Require().NoError(err)
+	s.Equal(wisp.BoletoLine(bankBoletoLine), barcode.Line())
+
+	barcode, err = wisp.NewBoletoBarcode(collectionBarcode)
+	s.Require().NoError(err)
+	s.Equal(wisp.BoletoLine(collectionLine), barcode.Line())
+
+	_, err = wisp.NewBoletoBarcode(bankBoletoLine)
+	s.Error(err)
+	_, err = wisp.NewBoletoBarcode("83630000001579000740000123456789012345678901")
+	s.Error(err)
+
+	barcode, err = wisp.NewBoletoBarcode("")
+	s.Require().NoError(err)
+	s.True(barcode.IsZero())
+	s.True(barcode.Line().IsZero())
+}
+
+func (s *BoletoSuite) TestBarcodeConversion() {
+	for _, input := range []string{bankBoletoLine, collectionLine, collectionMod11Line, collectionReferenceLine} {
+		line, err := wisp.NewBoletoLine(input)
+		s.Require().NoError(err)
+		s.Equal(line, line.Barcode().Line())
+	}
+	s.Equal(wisp.BoletoBarcode(bankBoletoBarcode), wisp.BoletoLine(bankBoletoLine).Barcode())
+	s.Equal(wisp.BoletoBarcode(collectionBarcode), wisp.BoletoLine(collectionLine).Barcode())
+	s.True(wisp.EmptyBoletoLine.Barcode().IsZero())
+}
+
+func (s *BoletoSuite) TestDueDateAndAmount() {
+	s.Run("bank boleto", func() {
+		line, _ := wisp.NewBoletoLine(bankBoletoLine)
+		s.Equal("001", line.BankCode())
+
+		due, ok := line.DueDate()
+		s.True(ok)
+		expected, _ := wisp.NewDate(2026, time.March, 10)
+		s.Equal(expected, due)
+
+		amount, ok := line.Amount()
+		s.True(ok)
+		s.Equal(int64(123456), amount.Amount())
+		s.Equal(wisp.BRL, amount.Currency())
+	})
+
+	s.Run("bank boleto without due date and amount", func() {
+		line, err := wisp.NewBoletoLine("34191.09008 00012.345674 89012.345677 1 00000000000000")
+		s.Require().NoError(err)
+		s.Equal("341", line.BankCode())
+		_, ok := line.DueDate()
+		s.False(ok)
+		_, ok = line.Amount()
+		s.False(ok)
+	})
+
+	s.Run("collection slip", func() {
+		line, _ := wisp.NewBoletoLine(collectionLine)
+		s.Equal("", line.BankCode())
+		_, ok := line.DueDate()
+		s.False(ok)
+
+		amount, ok := line.Amount()
+		s.True(ok)
+		s.Equal(int64(15790), amount.Amount())
+	})
+
+	s.Run("collection slip with a reference value", func() {
+		line, err := wisp.NewBoletoLine(collectionReferenceLine)
+		s.Require().NoError(err)
+		_, ok := line.Amount()
+		s.False(ok)
+	})
+}
+
+func (s *BoletoSuite) TestFormatted() {
+	s.Equal(bankBoletoFormatted, wisp.BoletoLine(bankBoletoLine).Formatted())
+	s.Equal(collectionFormatted, wisp.BoletoLine(collectionLine).Formatted())
+	s.Equal("", wisp.EmptyBoletoLine.Formatted())
+}
+
+func (s *BoletoSuite) TestJSON_SQL() {
+	line, _ := wisp.NewBoletoLine(bankBoletoFormatted)
+
+	data, err := json.Marshal(line)
+	s.Require().NoError(err)
+	s.Equal(`"`+bankBoletoLine+`"`, string(data))
+
+	var decoded wisp.BoletoLine
+	s.Require().NoError(json.Unmarshal([]byte(`"`+bankBoletoFormatted+`"`), &decoded))
+	s.Equal(line, decoded)
+	s.Error(json.Unmarshal([]byte(`"123"`), &decoded))
+
+	v, err := line.Value()
+	s.Require().NoError(err)
+	s.Equal(bankBoletoLine, v)
+	v, _ = wisp.EmptyBoletoLine.Value()
+	s.Nil(v)
+
+	var scanned wisp.BoletoLine
+	s.Require().NoError(scanned.Scan([]byte(bankBoletoLine)))
+	s.Equal(line, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Error(scanned.Scan(1))
+
+	barcode := line.Barcode()
+	data, err = json.Marshal(barcode)
+	s.Require().NoError(err)
+	var decodedBarcode wisp.BoletoBarcode
+	s.Require().NoError(json.Unmarshal(data, &decodedBarcode))
+	s.Equal(barcode, decodedBarcode)
+
+	var scannedBarcode wisp.BoletoBarcode
+	s.Require().NoError(scannedBarcode.Scan(bankBoletoBarcode))
+	s.Equal(barcode, scannedBarcode)
+	s.Error(scannedBarcode.Scan(1))
+}
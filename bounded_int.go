@@ -0,0 +1,209 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// IntBounds defines the inclusive limits of a BoundedInt. Implementations are usually empty
+// structs, so the bounds become part of the type and are checked at compile time.
+//
+// Example:
+//   type CartQuantityBounds struct{}
+//   func (CartQuantityBounds) Min() int { return 1 }
+//   func (CartQuantityBounds) Max() int { return 99 }
+type IntBounds interface {
+	Min() int
+	Max() int
+}
+
+// BoundedInt is a generic value object ensuring an integer stays within the [Min, Max] range
+// defined by its IntBounds type parameter, such as quantity limits per order or a rating scale.
+// Unlike RangedValue, the bounds are fixed by the type rather than stored with each value.
+//
+// Example:
+//   type CartQuantity = wisp.BoundedInt[CartQuantityBounds]
+//   qty, err := wisp.NewBoundedInt[CartQuantityBounds](3)
+//   qty, err = qty.Add(100) // returns an error: above 99
+type BoundedInt[B IntBounds] struct {
+	value int
+}
+
+// NewBoundedInt creates a new BoundedInt.
+// It returns an error if the value is outside the bounds of B.
+func NewBoundedInt[B IntBounds](value int) (BoundedInt[B], error) {
+	var bounds B
+	if value < bounds.Min() || value > bounds.Max() {
+		return BoundedInt[B]{}, fault.New(
+			"value is outside the allowed range [min, max]",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+			fault.WithContext("min", bounds.Min()),
+			fault.WithContext("max", bounds.Max()),
+		)
+	}
+	return BoundedInt[B]{value: value}, nil
+}
+
+// ParseBoundedInt creates a new BoundedInt from a base-10 string, ignoring surrounding whitespace.
+func ParseBoundedInt[B IntBounds](s string) (BoundedInt[B], error) {
+	i, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return BoundedInt[B]{}, fault.Wrap(err,
+			"value must be a valid integer",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", s),
+		)
+	}
+	return NewBoundedInt[B](i)
+}
+
+// Int returns the underlying integer value.
+func (b BoundedInt[B]) Int() int {
+	return b.value
+}
+
+// Min returns the lower bound of the type.
+func (b BoundedInt[B]) Min() int {
+	var bounds B
+	return bounds.Min()
+}
+
+// Max returns the upper bound of the type.
+func (b BoundedInt[B]) Max() int {
+	var bounds B
+	return bounds.Max()
+}
+
+// Add returns the sum of the value and n, or an error if the result leaves the bounds.
+func (b BoundedInt[B]) Add(n int) (BoundedInt[B], error) {
+	sum, ok := checkedAddInt(b.value, n)
+	if !ok {
+		return BoundedInt[B]{}, errIntOverflow("add", b.value, n)
+	}
+	return NewBoundedInt[B](sum)
+}
+
+// Subtract returns the value minus n, or an error if the result leaves the bounds.
+func (b BoundedInt[B]) Subtract(n int) (BoundedInt[B], error) {
+	diff, ok := checkedSubInt(b.value, n)
+	if !ok {
+		return BoundedInt[B]{}, errIntOverflow("subtract", b.value, n)
+	}
+	return NewBoundedInt[B](diff)
+}
+
+// Multiply returns the value multiplied by n, or an error if the result leaves the bounds.
+func (b BoundedInt[B]) Multiply(n int) (BoundedInt[B], error) {
+	product, ok := checkedMulInt(b.value, n)
+	if !ok {
+		return BoundedInt[B]{}, errIntOverflow("multiply", b.value, n)
+	}
+	return NewBoundedInt[B](product)
+}
+
+// String returns the value as a base-10 string.
+func (b BoundedInt[B]) String() string {
+	return strconv.Itoa(b.value)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the BoundedInt to its integer representation.
+func (b BoundedInt[B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON number into a BoundedInt, with validation.
+func (b *BoundedInt[B]) UnmarshalJSON(data []byte) error {
+	var i int
+	if err := json.Unmarshal(data, &i); err != nil {
+		return fault.Wrap(err, "BoundedInt must be a valid JSON number", fault.WithCode(fault.Invalid))
+	}
+
+	v, err := NewBoundedInt[B](i)
+	if err != nil {
+		return err
+	}
+	*b = v
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the BoundedInt as an int64.
+func (b BoundedInt[B]) Value() (driver.Value, error) {
+	return int64(b.value), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts an int64 from the database and converts it into a BoundedInt, with validation.
+func (b *BoundedInt[B]) Scan(src interface{}) error {
+	if src == nil {
+		*b = BoundedInt[B]{}
+		return nil
+	}
+
+	var i int64
+	switch v := src.(type) {
+	case int64:
+		i = v
+	default:
+		return fault.New("unsupported scan type for BoundedInt", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+
+	v, err := NewBoundedInt[B](int(i))
+	if err != nil {
+		return err
+	}
+	*b = v
+	return nil
+}
+
+// checkedAddInt returns a+b and false if the sum overflows.
+func checkedAddInt(a, b int) (int, bool) {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return 0, false
+	}
+	return sum, true
+}
+
+// checkedSubInt returns a-b and false if the difference overflows.
+func checkedSubInt(a, b int) (int, bool) {
+	if b == math.MinInt {
+		if a >= 0 {
+			return 0, false
+		}
+		return a - b, true
+	}
+	return checkedAddInt(a, -b)
+}
+
+// checkedMulInt returns a*b and false if the product overflows.
+func checkedMulInt(a, b int) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+		return 0, false
+	}
+	return product, true
+}
+
+// errIntOverflow builds the error returned when an integer operation overflows.
+func errIntOverflow(op string, a, b int) error {
+	return fault.New(
+		"integer operation overflows",
+		fault.WithCode(fault.DomainViolation),
+		fault.WithContext("operation", op),
+		fault.WithContext("operand_a", a),
+		fault.WithContext("operand_b", b),
+	)
+}
@@ -0,0 +1,94 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type cartQuantityBounds struct{}
+
+func (cartQuantityBounds) Min() int { return 1 }
+func (cartQuantityBounds) Max() int { return 99 }
+
+type cartQuantity = wisp.BoundedInt[cartQuantityBounds]
+
+type BoundedIntSuite struct {
+	suite.Suite
+}
+
+func TestBoundedIntSuite(t *testing.T) {
+	suite.Run(t, new(BoundedIntSuite))
+}
+
+func (s *BoundedIntSuite) TestNewBoundedInt() {
+	qty, err := wisp.NewBoundedInt[cartQuantityBounds](3)
+	s.Require().NoError(err)
+	s.Equal(3, qty.Int())
+	s.Equal(1, qty.Min())
+	s.Equal(99, qty.Max())
+	s.Equal("3", qty.String())
+
+	_, err = wisp.NewBoundedInt[cartQuantityBounds](0)
+	s.Require().Error(err)
+	s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	_, err = wisp.NewBoundedInt[cartQuantityBounds](100)
+	s.Error(err)
+
+	qty, err = wisp.ParseBoundedInt[cartQuantityBounds]("99")
+	s.Require().NoError(err)
+	s.Equal(99, qty.Int())
+	_, err = wisp.ParseBoundedInt[cartQuantityBounds]("x")
+	s.Error(err)
+}
+
+func (s *BoundedIntSuite) TestArithmetic() {
+	qty, _ := wisp.NewBoundedInt[cartQuantityBounds](10)
+
+	sum, err := qty.Add(89)
+	s.Require().NoError(err)
+	s.Equal(99, sum.Int())
+	_, err = qty.Add(90)
+	s.Error(err)
+
+	diff, err := qty.Subtract(9)
+	s.Require().NoError(err)
+	s.Equal(1, diff.Int())
+	_, err = qty.Subtract(10)
+	s.Error(err)
+
+	product, err := qty.Multiply(9)
+	s.Require().NoError(err)
+	s.Equal(90, product.Int())
+	_, err = qty.Multiply(10)
+	s.Error(err)
+}
+
+func (s *BoundedIntSuite) TestJSONAndSQL() {
+	type order struct {
+		Quantity cartQuantity `json:"quantity"`
+	}
+
+	var o order
+	s.Require().NoError(json.Unmarshal([]byte(`{"quantity": 5}`), &o))
+	s.Equal(5, o.Quantity.Int())
+	s.Error(json.Unmarshal([]byte(`{"quantity": 500}`), &o))
+
+	data, err := json.Marshal(o)
+	s.Require().NoError(err)
+	s.JSONEq(`{"quantity": 5}`, string(data))
+
+	val, err := o.Quantity.Value()
+	s.Require().NoError(err)
+	s.Equal(int64(5), val)
+
+	var scanned cartQuantity
+	s.Require().NoError(scanned.Scan(int64(7)))
+	s.Equal(7, scanned.Int())
+	s.Error(scanned.Scan(int64(0)))
+	s.Error(scanned.Scan("7"))
+}
@@ -3,7 +3,6 @@ package wisp
 import (
 	"database/sql/driver"
 	"encoding/json"
-	"strings"
 	"time"
 
 	"github.com/marcelofabianov/fault"
@@ -24,10 +23,43 @@ import (
 //   isOpen := bh.IsOpen(time.Now()) // Checks if the current time falls within business hours
 type BusinessHours struct {
 	schedule map[DayOfWeek]TimeRange
+	// encoded caches the JSON representation. Since the schedule is immutable,
+	// it is computed once at construction instead of on every MarshalJSON/Value call.
+	encoded []byte
 }
 
 // EmptyBusinessHours represents a business that is always closed.
-var EmptyBusinessHours = BusinessHours{schedule: make(map[DayOfWeek]TimeRange)}
+var EmptyBusinessHours = BusinessHours{schedule: make(map[DayOfWeek]TimeRange), encoded: []byte("{}")}
+
+// businessHoursKeys holds the JSON key of each DayOfWeek, indexed by the day itself.
+var businessHoursKeys = [...]string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+// newBusinessHours builds a BusinessHours from an already validated schedule, precomputing its JSON form.
+func newBusinessHours(schedule map[DayOfWeek]TimeRange) BusinessHours {
+	return BusinessHours{schedule: schedule, encoded: encodeSchedule(schedule)}
+}
+
+// encodeSchedule writes the schedule as a compact JSON object, with days in week order (Sunday first).
+func encodeSchedule(schedule map[DayOfWeek]TimeRange) []byte {
+	buf := getJSONBuffer()
+	defer putJSONBuffer(buf)
+
+	buf.b = append(buf.b, '{')
+	for day := Sunday; day <= Saturday; day++ {
+		timeRange, ok := schedule[day]
+		if !ok {
+			continue
+		}
+		if len(buf.b) > 1 {
+			buf.b = append(buf.b, ',')
+		}
+		buf.b = appendJSONString(buf.b, businessHoursKeys[day])
+		buf.b = append(buf.b, ':')
+		buf.b = timeRange.appendJSON(buf.b)
+	}
+	buf.b = append(buf.b, '}')
+	return buf.bytes()
+}
 
 // NewBusinessHours creates a new BusinessHours object from a schedule map.
 // The schedule maps a DayOfWeek to a TimeRange.
@@ -44,7 +76,34 @@ func NewBusinessHours(schedule map[DayOfWeek]TimeRange) (BusinessHours, error) {
 		}
 		newSchedule[day] = timeRange
 	}
-	return BusinessHours{schedule: newSchedule}, nil
+	return newBusinessHours(newSchedule), nil
+}
+
+// NewBusinessHoursForDays creates a BusinessHours object with the same TimeRange on every day in days,
+// e.g. Monday to Friday from 09:00 to 18:00.
+// It returns an error if days is empty or hours is the zero value.
+func NewBusinessHoursForDays(days DaysOfWeek, hours TimeRange) (BusinessHours, error) {
+	if days.IsZero() {
+		return EmptyBusinessHours, fault.New("business hours require at least one day", fault.WithCode(fault.Invalid))
+	}
+	if hours.IsZero() {
+		return EmptyBusinessHours, fault.New("business hours require a time range", fault.WithCode(fault.Invalid))
+	}
+
+	schedule := make(map[DayOfWeek]TimeRange, days.Len())
+	for _, day := range days.Days() {
+		schedule[day] = hours
+	}
+	return newBusinessHours(schedule), nil
+}
+
+// OpenDays returns the set of days that have a schedule.
+func (bh BusinessHours) OpenDays() DaysOfWeek {
+	var days DaysOfWeek
+	for day := range bh.schedule {
+		days = days.Add(day)
+	}
+	return days
 }
 
 // IsOpen checks if the business is open at a specific time `t`.
@@ -73,14 +132,14 @@ func (bh BusinessHours) IsZero() bool {
 
 // MarshalJSON implements the json.Marshaler interface.
 // It serializes the BusinessHours schedule into a JSON object where keys are lowercase day names (e.g., "monday").
+// The representation is precomputed at construction, so this only copies the cached bytes.
 func (bh BusinessHours) MarshalJSON() ([]byte, error) {
-	stringKeyMap := make(map[string]TimeRange)
-	if bh.schedule != nil {
-		for day, timeRange := range bh.schedule {
-			stringKeyMap[strings.ToLower(day.String())] = timeRange
-		}
+	if bh.encoded == nil {
+		return []byte("{}"), nil
 	}
-	return json.Marshal(stringKeyMap)
+	out := make([]byte, len(bh.encoded))
+	copy(out, bh.encoded)
+	return out, nil
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -111,14 +170,20 @@ func (bh *BusinessHours) UnmarshalJSON(data []byte) error {
 		newSchedule[day] = timeRange
 	}
 
-	*bh = BusinessHours{schedule: newSchedule}
+	*bh = newBusinessHours(newSchedule)
 	return nil
 }
 
 // Value implements the driver.Valuer interface for database storage.
 // It returns the BusinessHours schedule as a JSON byte array.
+// The cached representation is copied, without re-serializing the schedule.
 func (bh BusinessHours) Value() (driver.Value, error) {
-	return bh.MarshalJSON()
+	if bh.encoded == nil {
+		return []byte("{}"), nil
+	}
+	out := make([]byte, len(bh.encoded))
+	copy(out, bh.encoded)
+	return out, nil
 }
 
 // Scan implements the sql.Scanner interface for database retrieval.
@@ -74,6 +74,68 @@ func (s *BusinessHoursSuite) TestBusinessHours_JSON() {
 	})
 }
 
+func (s *BusinessHoursSuite) TestBusinessHours_JSONRepresentation() {
+	s.Run("should write days in week order", func() {
+		start, _ := wisp.ParseTimeOfDay("08:30")
+		end, _ := wisp.ParseTimeOfDay("12:00")
+		morning, _ := wisp.NewTimeRange(start, end)
+
+		bh, err := wisp.NewBusinessHours(map[wisp.DayOfWeek]wisp.TimeRange{
+			wisp.Saturday: morning,
+			wisp.Sunday:   morning,
+			wisp.Monday:   morning,
+		})
+		s.Require().NoError(err)
+
+		data, err := json.Marshal(bh)
+		s.Require().NoError(err)
+		s.Equal(`{"sunday":{"start":"08:30","end":"12:00"},"monday":{"start":"08:30","end":"12:00"},"saturday":{"start":"08:30","end":"12:00"}}`, string(data))
+	})
+
+	s.Run("should keep Value and MarshalJSON in sync after unmarshaling", func() {
+		var bh wisp.BusinessHours
+		s.Require().NoError(json.Unmarshal([]byte(`{"friday":{"start":"09:00","end":"18:00"}}`), &bh))
+
+		data, err := json.Marshal(bh)
+		s.Require().NoError(err)
+		val, err := bh.Value()
+		s.Require().NoError(err)
+		s.Equal(string(data), string(val.([]byte)))
+	})
+
+	s.Run("should not expose the cached representation", func() {
+		data, err := s.bh.MarshalJSON()
+		s.Require().NoError(err)
+		data[0] = 'X'
+
+		again, err := s.bh.MarshalJSON()
+		s.Require().NoError(err)
+		s.Equal(byte('{'), again[0])
+
+		val, err := s.bh.Value()
+		s.Require().NoError(err)
+		val.([]byte)[0] = 'X'
+
+		again, err = s.bh.MarshalJSON()
+		s.Require().NoError(err)
+		s.Equal(byte('{'), again[0])
+		val, err = s.bh.Value()
+		s.Require().NoError(err)
+		s.Equal(byte('{'), val.([]byte)[0])
+	})
+
+	s.Run("should encode empty schedules as an empty object", func() {
+		var zero wisp.BusinessHours
+		data, err := json.Marshal(zero)
+		s.Require().NoError(err)
+		s.Equal("{}", string(data))
+
+		data, err = json.Marshal(wisp.EmptyBusinessHours)
+		s.Require().NoError(err)
+		s.Equal("{}", string(data))
+	})
+}
+
 func (s *BusinessHoursSuite) TestBusinessHours_SQL() {
 	s.Run("should write to and scan from database representation", func() {
 		val, err := s.bh.Value()
@@ -87,3 +149,59 @@ func (s *BusinessHoursSuite) TestBusinessHours_SQL() {
 		s.True(scannedBH.IsOpen(time.Date(2025, 9, 29, 10, 30, 0, 0, time.UTC)))
 	})
 }
+
+func benchmarkBusinessHours() wisp.BusinessHours {
+	start, _ := wisp.ParseTimeOfDay("09:00")
+	end, _ := wisp.ParseTimeOfDay("18:00")
+	tr, _ := wisp.NewTimeRange(start, end)
+	bh, _ := wisp.NewBusinessHours(map[wisp.DayOfWeek]wisp.TimeRange{
+		wisp.Monday: tr, wisp.Tuesday: tr, wisp.Wednesday: tr, wisp.Thursday: tr, wisp.Friday: tr,
+	})
+	return bh
+}
+
+func BenchmarkBusinessHours_Value(b *testing.B) {
+	bh := benchmarkBusinessHours()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := bh.Value(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBusinessHours_RoundTrip(b *testing.B) {
+	bh := benchmarkBusinessHours()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		data, err := json.Marshal(bh)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var out wisp.BusinessHours
+		if err := json.Unmarshal(data, &out); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func (s *BusinessHoursSuite) TestOpenDays() {
+	expected := wisp.Weekdays.Add(wisp.Saturday)
+	s.Equal(expected, s.bh.OpenDays())
+	s.True(wisp.EmptyBusinessHours.OpenDays().IsZero())
+}
+
+func (s *BusinessHoursSuite) TestNewBusinessHoursForDays() {
+	hours := s.schedule[wisp.Monday]
+
+	bh, err := wisp.NewBusinessHoursForDays(wisp.Weekdays, hours)
+	s.Require().NoError(err)
+	s.Equal(wisp.Weekdays, bh.OpenDays())
+	s.True(bh.IsOpen(time.Date(2025, 9, 29, 10, 30, 0, 0, time.UTC)))
+	s.False(bh.IsOpen(time.Date(2025, 10, 4, 10, 30, 0, 0, time.UTC)))
+
+	_, err = wisp.NewBusinessHoursForDays(wisp.EmptyDaysOfWeek, hours)
+	s.Error(err)
+	_, err = wisp.NewBusinessHoursForDays(wisp.Weekdays, wisp.ZeroTimeRange)
+	s.Error(err)
+}
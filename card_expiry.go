@@ -0,0 +1,187 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// CardExpiry is a value object representing the expiration of a payment card, the month and year
+// printed as "MM/YY". A card is valid until the last day of that month, inclusive.
+//
+// Two-digit years are read as 2000 onwards, matching how every issuer prints them today.
+//
+// The zero value is ZeroCardExpiry.
+//
+// Example:
+//   exp, err := wisp.ParseCardExpiry("08/27")
+//   exp.String()             // "08/27"
+//   exp.LastDay()            // 2027-08-31
+//   exp.IsExpired(wisp.Today())
+type CardExpiry struct {
+	month time.Month
+	year  int
+}
+
+// ZeroCardExpiry represents the zero value for the CardExpiry type.
+var ZeroCardExpiry CardExpiry
+
+// NewCardExpiry creates a new CardExpiry. A year below 100 is read as 2000 onwards (27 is 2027).
+// Returns an error if the month is not between 1 and 12 or the year is not between 2000 and 2099.
+func NewCardExpiry(month time.Month, year int) (CardExpiry, error) {
+	if month < time.January || month > time.December {
+		return ZeroCardExpiry, fault.New(
+			"card expiry month must be between 1 and 12",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("month", int(month)),
+		)
+	}
+	if year >= 0 && year < 100 {
+		year += 2000
+	}
+	if year < 2000 || year > 2099 {
+		return ZeroCardExpiry, fault.New(
+			"card expiry year must be between 2000 and 2099",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("year", year),
+		)
+	}
+	return CardExpiry{month: month, year: year}, nil
+}
+
+// ParseCardExpiry creates a CardExpiry from the "MM/YY" or "MM/YYYY" format.
+// Returns ZeroCardExpiry for an empty input, or an error if the format or values are invalid.
+func ParseCardExpiry(value string) (CardExpiry, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return ZeroCardExpiry, nil
+	}
+
+	mm, yy, ok := strings.Cut(trimmed, "/")
+	mm, yy = strings.TrimSpace(mm), strings.TrimSpace(yy)
+	if !ok || len(mm) != 2 || (len(yy) != 2 && len(yy) != 4) || !isASCIIDigits(mm) || !isASCIIDigits(yy) {
+		return ZeroCardExpiry, fault.New(
+			"card expiry must be in MM/YY or MM/YYYY format",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+		)
+	}
+
+	month, _ := strconv.Atoi(mm)
+	year, _ := strconv.Atoi(yy)
+	return NewCardExpiry(time.Month(month), year)
+}
+
+// Month returns the expiry month.
+func (e CardExpiry) Month() time.Month {
+	return e.month
+}
+
+// Year returns the expiry year with four digits.
+func (e CardExpiry) Year() int {
+	return e.year
+}
+
+// LastDay returns the last day the card is valid, or ZeroDate for the zero value.
+func (e CardExpiry) LastDay() Date {
+	if e.IsZero() {
+		return ZeroDate
+	}
+	return Date{t: time.Date(e.year, e.month, daysIn(e.month, e.year), 0, 0, 0, 0, time.UTC)}
+}
+
+// IsExpired returns true if the card is no longer valid on the reference date, that is, if ref is
+// after the last day of the expiry month. The zero value is never expired.
+func (e CardExpiry) IsExpired(ref Date) bool {
+	if e.IsZero() {
+		return false
+	}
+	return ref.After(e.LastDay())
+}
+
+// IsZero returns true if the CardExpiry is the zero value.
+func (e CardExpiry) IsZero() bool {
+	return e == ZeroCardExpiry
+}
+
+// String returns the expiry in the "MM/YY" format, or an empty string for the zero value.
+func (e CardExpiry) String() string {
+	if e.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%02d/%02d", int(e.month), e.year%100)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the CardExpiry as a "MM/YY" string, or null for the zero value.
+func (e CardExpiry) MarshalJSON() ([]byte, error) {
+	if e.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(e.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a "MM/YY" or "MM/YYYY" string into a CardExpiry; null results in ZeroCardExpiry.
+func (e *CardExpiry) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*e = ZeroCardExpiry
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "CardExpiry must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	exp, err := ParseCardExpiry(s)
+	if err != nil {
+		return err
+	}
+	*e = exp
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the expiry as a "MM/YY" string, or nil for the zero value.
+func (e CardExpiry) Value() (driver.Value, error) {
+	if e.IsZero() {
+		return nil, nil
+	}
+	return e.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice in the "MM/YY" or "MM/YYYY" format.
+func (e *CardExpiry) Scan(src interface{}) error {
+	if src == nil {
+		*e = ZeroCardExpiry
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for CardExpiry",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	exp, err := ParseCardExpiry(s)
+	if err != nil {
+		return err
+	}
+	*e = exp
+	return nil
+}
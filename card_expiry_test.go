@@ -0,0 +1,150 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type CardExpirySuite struct {
+	suite.Suite
+}
+
+func TestCardExpirySuite(t *testing.T) {
+	suite.Run(t, new(CardExpirySuite))
+}
+
+func (s *CardExpirySuite) TestNewCardExpiry() {
+	s.Run("should read two-digit years as 2000 onwards", func() {
+		exp, err := wisp.NewCardExpiry(time.August, 27)
+		s.Require().NoError(err)
+		s.Equal(time.August, exp.Month())
+		s.Equal(2027, exp.Year())
+		s.Equal("08/27", exp.String())
+	})
+
+	s.Run("should reject invalid months and years", func() {
+		testCases := []struct {
+			month time.Month
+			year  int
+		}{
+			{month: 0, year: 2027},
+			{month: 13, year: 2027},
+			{month: time.August, year: 1999},
+			{month: time.August, year: 2100},
+			{month: time.August, year: -1},
+		}
+		for _, tc := range testCases {
+			_, err := wisp.NewCardExpiry(tc.month, tc.year)
+			s.Require().Error(err)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *CardExpirySuite) TestParseCardExpiry() {
+	s.Run("should parse MM/YY and MM/YYYY", func() {
+		for _, input := range []string{"08/27", "08/2027", " 08 / 27 "} {
+			exp, err := wisp.ParseCardExpiry(input)
+			s.Require().NoError(err, input)
+			s.Equal("08/27", exp.String())
+		}
+	})
+
+	s.Run("should return ZeroCardExpiry for an empty input", func() {
+		exp, err := wisp.ParseCardExpiry("")
+		s.Require().NoError(err)
+		s.True(exp.IsZero())
+	})
+
+	s.Run("should reject invalid formats", func() {
+		for _, input := range []string{"8/27", "0827", "08/027", "aa/27", "13/27", "00/27"} {
+			_, err := wisp.ParseCardExpiry(input)
+			s.Error(err, input)
+		}
+	})
+}
+
+func (s *CardExpirySuite) TestIsExpired() {
+	exp, _ := wisp.ParseCardExpiry("02/28")
+
+	s.Run("should be valid until the last day of the month", func() {
+		s.Equal("2028-02-29", exp.LastDay().String())
+
+		lastDay, _ := wisp.NewDate(2028, time.February, 29)
+		s.False(exp.IsExpired(lastDay))
+
+		before, _ := wisp.NewDate(2027, time.December, 31)
+		s.False(exp.IsExpired(before))
+	})
+
+	s.Run("should be expired from the next month", func() {
+		ref, _ := wisp.NewDate(2028, time.March, 1)
+		s.True(exp.IsExpired(ref))
+	})
+
+	s.Run("should never expire the zero value", func() {
+		ref, _ := wisp.NewDate(2099, time.December, 31)
+		s.False(wisp.ZeroCardExpiry.IsExpired(ref))
+		s.True(wisp.ZeroCardExpiry.LastDay().IsZero())
+	})
+}
+
+func (s *CardExpirySuite) TestJSON() {
+	s.Run("should marshal and unmarshal", func() {
+		exp, _ := wisp.NewCardExpiry(time.August, 2027)
+		data, err := json.Marshal(exp)
+		s.Require().NoError(err)
+		s.Equal(`"08/27"`, string(data))
+
+		var decoded wisp.CardExpiry
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(exp, decoded)
+	})
+
+	s.Run("should handle null", func() {
+		data, err := json.Marshal(wisp.ZeroCardExpiry)
+		s.Require().NoError(err)
+		s.Equal("null", string(data))
+
+		var decoded wisp.CardExpiry
+		s.Require().NoError(json.Unmarshal([]byte("null"), &decoded))
+		s.True(decoded.IsZero())
+	})
+
+	s.Run("should reject invalid JSON", func() {
+		var decoded wisp.CardExpiry
+		s.Error(json.Unmarshal([]byte(`827`), &decoded))
+		s.Error(json.Unmarshal([]byte(`"13/27"`), &decoded))
+	})
+}
+
+func (s *CardExpirySuite) TestSQL() {
+	s.Run("should store and scan", func() {
+		exp, _ := wisp.NewCardExpiry(time.August, 2027)
+		value, err := exp.Value()
+		s.Require().NoError(err)
+		s.Equal("08/27", value)
+
+		var scanned wisp.CardExpiry
+		s.Require().NoError(scanned.Scan([]byte("08/2027")))
+		s.Equal(exp, scanned)
+
+		value, err = wisp.ZeroCardExpiry.Value()
+		s.Require().NoError(err)
+		s.Nil(value)
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+	})
+
+	s.Run("should reject unsupported types", func() {
+		var scanned wisp.CardExpiry
+		s.Error(scanned.Scan(827))
+	})
+}
@@ -0,0 +1,241 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// CardBrand represents a payment card network accepted by Brazilian acquirers.
+//
+// Examples:
+//   - Input: "Master", "MASTERCARD" or " mastercard "
+//   - Stored as: "mastercard"
+type CardBrand string
+
+// Supported card brands.
+const (
+	CardBrandVisa       CardBrand = "visa"
+	CardBrandMastercard CardBrand = "mastercard"
+	CardBrandElo        CardBrand = "elo"
+	CardBrandAmex       CardBrand = "amex"
+	CardBrandHipercard  CardBrand = "hipercard"
+)
+
+// EmptyCardBrand represents the zero value for the CardBrand type.
+var EmptyCardBrand CardBrand
+
+// cardBrandNames holds the valid card brands and their display names.
+var cardBrandNames = map[CardBrand]string{
+	CardBrandVisa:       "Visa",
+	CardBrandMastercard: "Mastercard",
+	CardBrandElo:        "Elo",
+	CardBrandAmex:       "American Express",
+	CardBrandHipercard:  "Hipercard",
+}
+
+// cardBrandAliases maps common alternative spellings to their card brand.
+var cardBrandAliases = map[string]CardBrand{
+	"master":           CardBrandMastercard,
+	"american express": CardBrandAmex,
+	"americanexpress":  CardBrandAmex,
+	"american_express": CardBrandAmex,
+}
+
+// binRange is an inclusive range of card number prefixes with the given number of digits.
+type binRange struct {
+	low, high int
+	digits    int
+	brand     CardBrand
+}
+
+// cardBINRanges lists the known prefixes of each brand. Order matters: the co-branded Elo and
+// Hipercard ranges overlap with the generic Visa and Mastercard ones and must be checked first.
+var cardBINRanges = []binRange{
+	// Elo
+	{401178, 401179, 6, CardBrandElo},
+	{431274, 431274, 6, CardBrandElo},
+	{438935, 438935, 6, CardBrandElo},
+	{451416, 451416, 6, CardBrandElo},
+	{457393, 457393, 6, CardBrandElo},
+	{457631, 457632, 6, CardBrandElo},
+	{504175, 504175, 6, CardBrandElo},
+	{506699, 506778, 6, CardBrandElo},
+	{509000, 509999, 6, CardBrandElo},
+	{627780, 627780, 6, CardBrandElo},
+	{636297, 636297, 6, CardBrandElo},
+	{636368, 636368, 6, CardBrandElo},
+	{650031, 650033, 6, CardBrandElo},
+	{650035, 650051, 6, CardBrandElo},
+	{650405, 650439, 6, CardBrandElo},
+	{650485, 650538, 6, CardBrandElo},
+	{650541, 650598, 6, CardBrandElo},
+	{650700, 650718, 6, CardBrandElo},
+	{650720, 650727, 6, CardBrandElo},
+	{650901, 650978, 6, CardBrandElo},
+	{651652, 651679, 6, CardBrandElo},
+	{655000, 655019, 6, CardBrandElo},
+	{655021, 655058, 6, CardBrandElo},
+	// Hipercard
+	{606282, 606282, 6, CardBrandHipercard},
+	{384100, 384100, 6, CardBrandHipercard},
+	{384140, 384140, 6, CardBrandHipercard},
+	{384160, 384160, 6, CardBrandHipercard},
+	{637095, 637095, 6, CardBrandHipercard},
+	{637568, 637568, 6, CardBrandHipercard},
+	{637599, 637599, 6, CardBrandHipercard},
+	{637609, 637609, 6, CardBrandHipercard},
+	{637612, 637612, 6, CardBrandHipercard},
+	// American Express
+	{34, 34, 2, CardBrandAmex},
+	{37, 37, 2, CardBrandAmex},
+	// Mastercard
+	{51, 55, 2, CardBrandMastercard},
+	{2221, 2720, 4, CardBrandMastercard},
+	// Visa
+	{4, 4, 1, CardBrandVisa},
+}
+
+// NewCardBrand creates a new CardBrand from a string.
+// It normalizes the input to lowercase and accepts common aliases such as "master" and "American Express".
+// Returns an error if the value is not a supported brand.
+func NewCardBrand(input string) (CardBrand, error) {
+	normalized := strings.ToLower(strings.TrimSpace(input))
+	if normalized == "" {
+		return EmptyCardBrand, nil
+	}
+
+	if alias, ok := cardBrandAliases[normalized]; ok {
+		return alias, nil
+	}
+
+	brand := CardBrand(normalized)
+	if !brand.IsValid() {
+		return EmptyCardBrand, fault.New(
+			"unsupported card brand",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_brand", input),
+		)
+	}
+	return brand, nil
+}
+
+// DetectCardBrand identifies the brand of a card from its number (or just its leading digits, the BIN).
+// Spaces and hyphens are ignored. The second return value is false if no known range matches.
+//
+// Example:
+//   brand, ok := wisp.DetectCardBrand("5067 0012 3456 7890") // CardBrandElo, true
+func DetectCardBrand(number string) (CardBrand, bool) {
+	var digits [6]byte
+	n := 0
+	for i := 0; i < len(number) && n < len(digits); i++ {
+		c := number[i]
+		switch {
+		case c >= '0' && c <= '9':
+			digits[n] = c
+			n++
+		case c == ' ' || c == '-':
+		default:
+			return EmptyCardBrand, false
+		}
+	}
+
+	for _, r := range cardBINRanges {
+		if n < r.digits {
+			continue
+		}
+		prefix := 0
+		for _, d := range digits[:r.digits] {
+			prefix = prefix*10 + int(d-'0')
+		}
+		if prefix >= r.low && prefix <= r.high {
+			return r.brand, true
+		}
+	}
+	return EmptyCardBrand, false
+}
+
+// String returns the card brand as a string.
+func (b CardBrand) String() string {
+	return string(b)
+}
+
+// DisplayName returns the brand name as printed on cards (e.g., "Mastercard").
+func (b CardBrand) DisplayName() string {
+	return cardBrandNames[b]
+}
+
+// IsValid checks if the card brand is supported.
+func (b CardBrand) IsValid() bool {
+	_, ok := cardBrandNames[b]
+	return ok
+}
+
+// IsZero returns true if the CardBrand is the zero value.
+func (b CardBrand) IsZero() bool {
+	return b == EmptyCardBrand
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (b CardBrand) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a CardBrand, with validation.
+func (b *CardBrand) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*b = EmptyCardBrand
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "CardBrand must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	brand, err := NewCardBrand(s)
+	if err != nil {
+		return err
+	}
+	*b = brand
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the card brand as a string or nil if it's the zero value.
+func (b CardBrand) Value() (driver.Value, error) {
+	if b.IsZero() {
+		return nil, nil
+	}
+	return b.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values and validates them as a CardBrand.
+func (b *CardBrand) Scan(src interface{}) error {
+	if src == nil {
+		*b = EmptyCardBrand
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New("unsupported scan type for CardBrand", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+
+	brand, err := NewCardBrand(s)
+	if err != nil {
+		return err
+	}
+	*b = brand
+	return nil
+}
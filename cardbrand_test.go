@@ -0,0 +1,106 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type CardBrandSuite struct {
+	suite.Suite
+}
+
+func TestCardBrandSuite(t *testing.T) {
+	suite.Run(t, new(CardBrandSuite))
+}
+
+func (s *CardBrandSuite) TestNewCardBrand() {
+	testCases := []struct {
+		input       string
+		expected    wisp.CardBrand
+		expectError bool
+	}{
+		{input: "VISA", expected: wisp.CardBrandVisa},
+		{input: " mastercard ", expected: wisp.CardBrandMastercard},
+		{input: "Master", expected: wisp.CardBrandMastercard},
+		{input: "American Express", expected: wisp.CardBrandAmex},
+		{input: "elo", expected: wisp.CardBrandElo},
+		{input: "Hipercard", expected: wisp.CardBrandHipercard},
+		{input: "", expected: wisp.EmptyCardBrand},
+		{input: "discover", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.input, func() {
+			brand, err := wisp.NewCardBrand(tc.input)
+			if tc.expectError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.expected, brand)
+		})
+	}
+
+	s.Equal("American Express", wisp.CardBrandAmex.DisplayName())
+}
+
+func (s *CardBrandSuite) TestDetectCardBrand() {
+	testCases := []struct {
+		number   string
+		expected wisp.CardBrand
+		found    bool
+	}{
+		{number: "4111 1111 1111 1111", expected: wisp.CardBrandVisa, found: true},
+		{number: "5555-5555-5555-4444", expected: wisp.CardBrandMastercard, found: true},
+		{number: "2221000000000009", expected: wisp.CardBrandMastercard, found: true},
+		{number: "2720990000000000", expected: wisp.CardBrandMastercard, found: true},
+		{number: "378282246310005", expected: wisp.CardBrandAmex, found: true},
+		{number: "340000000000009", expected: wisp.CardBrandAmex, found: true},
+		{number: "6362970000457013", expected: wisp.CardBrandElo, found: true},
+		{number: "4514160123456789", expected: wisp.CardBrandElo, found: true},
+		{number: "5067001234567890", expected: wisp.CardBrandElo, found: true},
+		{number: "6062825624254001", expected: wisp.CardBrandHipercard, found: true},
+		{number: "411111", expected: wisp.CardBrandVisa, found: true},
+		{number: "6011111111111117", expected: wisp.EmptyCardBrand, found: false},
+		{number: "2720", expected: wisp.CardBrandMastercard, found: true},
+		{number: "2721000000000000", expected: wisp.EmptyCardBrand, found: false},
+		{number: "4111-abcd", expected: wisp.EmptyCardBrand, found: false},
+		{number: "", expected: wisp.EmptyCardBrand, found: false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.number, func() {
+			brand, ok := wisp.DetectCardBrand(tc.number)
+			s.Equal(tc.found, ok)
+			s.Equal(tc.expected, brand)
+		})
+	}
+}
+
+func (s *CardBrandSuite) TestSerialization() {
+	data, err := json.Marshal(wisp.CardBrandElo)
+	s.Require().NoError(err)
+	s.Equal(`"elo"`, string(data))
+
+	var decoded wisp.CardBrand
+	s.Require().NoError(json.Unmarshal([]byte(`"MASTER"`), &decoded))
+	s.Equal(wisp.CardBrandMastercard, decoded)
+	s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.True(decoded.IsZero())
+	s.Require().Error(json.Unmarshal([]byte(`"discover"`), &decoded))
+
+	val, err := wisp.CardBrandVisa.Value()
+	s.Require().NoError(err)
+	s.Equal("visa", val)
+
+	var scanned wisp.CardBrand
+	s.Require().NoError(scanned.Scan([]byte("amex")))
+	s.Equal(wisp.CardBrandAmex, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Require().Error(scanned.Scan(1))
+}
@@ -0,0 +1,223 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// defaultCFOPs returns the descriptions of the most commonly used CFOP codes.
+func defaultCFOPs() map[CFOP]string {
+	return map[CFOP]string{
+		"1102": "Compra para comercialização",
+		"1202": "Devolução de venda de mercadoria adquirida ou recebida de terceiros",
+		"2102": "Compra para comercialização",
+		"2202": "Devolução de venda de mercadoria adquirida ou recebida de terceiros",
+		"5101": "Venda de produção do estabelecimento",
+		"5102": "Venda de mercadoria adquirida ou recebida de terceiros",
+		"5202": "Devolução de compra para comercialização",
+		"5405": "Venda de mercadoria adquirida ou recebida de terceiros em operação com mercadoria sujeita ao regime de substituição tributária, na condição de contribuinte substituído",
+		"5910": "Remessa em bonificação, doação ou brinde",
+		"5915": "Remessa de mercadoria ou bem para conserto ou reparo",
+		"5949": "Outra saída de mercadoria ou prestação de serviço não especificado",
+		"6101": "Venda de produção do estabelecimento",
+		"6102": "Venda de mercadoria adquirida ou recebida de terceiros",
+		"6202": "Devolução de compra para comercialização",
+		"7101": "Venda de produção do estabelecimento",
+		"7102": "Venda de mercadoria adquirida ou recebida de terceiros",
+	}
+}
+
+// registeredCFOPs holds the descriptions of known CFOP codes.
+var registeredCFOPs = defaultCFOPs()
+
+// RegisterCFOP adds the description of a CFOP code to the registry, or overrides the description
+// of a registered one.
+// Returns an error if the code is invalid or the description is empty.
+//
+// Example:
+//   err := wisp.RegisterCFOP("5.933", "Prestação de serviço tributado pelo ISSQN")
+func RegisterCFOP(code string, description string) error {
+	cfop, err := NewCFOP(code)
+	if err != nil {
+		return err
+	}
+	if cfop.IsZero() {
+		return fault.New("CFOP cannot be empty", fault.WithCode(fault.Invalid))
+	}
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return fault.New("CFOP description cannot be empty", fault.WithCode(fault.Invalid), fault.WithContext("cfop", cfop.String()))
+	}
+
+	registeredCFOPs[cfop] = description
+	return nil
+}
+
+// ResetCFOPs restores the registry to the built-in CFOP codes.
+// This is primarily for testing purposes to ensure a clean state.
+func ResetCFOPs() {
+	registeredCFOPs = defaultCFOPs()
+}
+
+// CFOP is a value object representing a Código Fiscal de Operações e Prestações, the 4-digit code
+// that tells the nature of each item of an NF-e. Its first digit gives the direction and scope of
+// the operation:
+//   - 1, 2, 3: inbound, from the same state, another state or abroad
+//   - 5, 6, 7: outbound, to the same state, another state or abroad
+//
+// Codes ending in "00" title the groups of the table and are not valid. Any other code with a
+// valid first digit is accepted; codes in the registry also provide their description.
+//
+// The zero value is EmptyCFOP.
+//
+// Examples:
+//   cfop, err := wisp.NewCFOP("5.102") // "5102"
+//   cfop.IsOutbound()                  // true
+//   cfop.Formatted()                   // "5.102"
+type CFOP string
+
+// EmptyCFOP represents the zero value for the CFOP type.
+var EmptyCFOP CFOP
+
+// NewCFOP creates a new CFOP, ignoring dots, spaces and hyphens.
+// Returns EmptyCFOP for an empty input, or an error if the code does not have 4 digits, its first
+// digit is not 1, 2, 3, 5, 6 or 7, or it is a group title ending in "00".
+func NewCFOP(input string) (CFOP, error) {
+	digits := documentSeparators.Replace(strings.TrimSpace(input))
+	if digits == "" {
+		return EmptyCFOP, nil
+	}
+
+	if len(digits) != 4 || !isASCIIDigits(digits) {
+		return EmptyCFOP, fault.New("CFOP must have 4 digits", fault.WithCode(fault.Invalid), fault.WithContext("input_value", input))
+	}
+	if !strings.ContainsRune("123567", rune(digits[0])) {
+		return EmptyCFOP, fault.New(
+			"CFOP must start with 1, 2, 3, 5, 6 or 7",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	if digits[2:] == "00" {
+		return EmptyCFOP, fault.New(
+			"CFOP group titles cannot be used in operations",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	return CFOP(digits), nil
+}
+
+// IsInbound returns true for inbound operations (purchases, returns received), starting with 1, 2 or 3.
+func (c CFOP) IsInbound() bool {
+	return !c.IsZero() && c[0] <= '3'
+}
+
+// IsOutbound returns true for outbound operations (sales, shipments), starting with 5, 6 or 7.
+func (c CFOP) IsOutbound() bool {
+	return !c.IsZero() && c[0] >= '5'
+}
+
+// IsInterstate returns true for operations with another state, starting with 2 or 6.
+func (c CFOP) IsInterstate() bool {
+	return !c.IsZero() && (c[0] == '2' || c[0] == '6')
+}
+
+// IsForeign returns true for imports and exports, starting with 3 or 7.
+func (c CFOP) IsForeign() bool {
+	return !c.IsZero() && (c[0] == '3' || c[0] == '7')
+}
+
+// Formatted returns the code in the format X.XXX, or an empty string for the zero value.
+func (c CFOP) Formatted() string {
+	if c.IsZero() {
+		return ""
+	}
+	return string(c[:1]) + "." + string(c[1:])
+}
+
+// Description returns the description of a registered code, or an empty string if it is not
+// registered.
+func (c CFOP) Description() string {
+	return registeredCFOPs[c]
+}
+
+// IsRegistered checks if the code is in the registry.
+func (c CFOP) IsRegistered() bool {
+	_, ok := registeredCFOPs[c]
+	return ok
+}
+
+// String returns the code as 4 digits.
+func (c CFOP) String() string {
+	return string(c)
+}
+
+// IsZero returns true if the CFOP is the zero value.
+func (c CFOP) IsZero() bool {
+	return c == EmptyCFOP
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the CFOP as a JSON string of 4 digits.
+func (c CFOP) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a CFOP, with validation.
+func (c *CFOP) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "CFOP must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	cfop, err := NewCFOP(s)
+	if err != nil {
+		return err
+	}
+	*c = cfop
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the CFOP as a string, or nil for the zero value.
+func (c CFOP) Value() (driver.Value, error) {
+	if c.IsZero() {
+		return nil, nil
+	}
+	return c.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a CFOP, with validation.
+func (c *CFOP) Scan(src interface{}) error {
+	if src == nil {
+		*c = EmptyCFOP
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for CFOP",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	cfop, err := NewCFOP(s)
+	if err != nil {
+		return err
+	}
+	*c = cfop
+	return nil
+}
@@ -0,0 +1,142 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type CFOPSuite struct {
+	suite.Suite
+}
+
+func TestCFOPSuite(t *testing.T) {
+	suite.Run(t, new(CFOPSuite))
+}
+
+func (s *CFOPSuite) TearDownTest() {
+	wisp.ResetCFOPs()
+}
+
+func (s *CFOPSuite) TestNewCFOP() {
+	s.Run("should accept formatted and unformatted codes", func() {
+		for _, input := range []string{"5.102", "5102", " 5102 "} {
+			cfop, err := wisp.NewCFOP(input)
+			s.Require().NoError(err, input)
+			s.Equal("5102", cfop.String())
+		}
+	})
+
+	s.Run("should return the zero value for an empty input", func() {
+		cfop, err := wisp.NewCFOP("")
+		s.Require().NoError(err)
+		s.True(cfop.IsZero())
+	})
+
+	s.Run("should reject invalid codes", func() {
+		for _, input := range []string{"510", "51020", "5.1O2", "4102", "8102", "0102", "5100", "5.000"} {
+			_, err := wisp.NewCFOP(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *CFOPSuite) TestDirectionAndScope() {
+	testCases := []struct {
+		code                                   string
+		inbound, outbound, interstate, foreign bool
+	}{
+		{"1102", true, false, false, false},
+		{"2102", true, false, true, false},
+		{"3102", true, false, false, true},
+		{"5102", false, true, false, false},
+		{"6102", false, true, true, false},
+		{"7102", false, true, false, true},
+	}
+	for _, tc := range testCases {
+		cfop, err := wisp.NewCFOP(tc.code)
+		s.Require().NoError(err)
+		s.Equal(tc.inbound, cfop.IsInbound(), tc.code)
+		s.Equal(tc.outbound, cfop.IsOutbound(), tc.code)
+		s.Equal(tc.interstate, cfop.IsInterstate(), tc.code)
+		s.Equal(tc.foreign, cfop.IsForeign(), tc.code)
+	}
+
+	s.False(wisp.EmptyCFOP.IsInbound())
+	s.False(wisp.EmptyCFOP.IsOutbound())
+}
+
+func (s *CFOPSuite) TestFormatted() {
+	cfop, _ := wisp.NewCFOP("6108")
+	s.Equal("6.108", cfop.Formatted())
+	s.Empty(wisp.EmptyCFOP.Formatted())
+}
+
+func (s *CFOPSuite) TestRegistry() {
+	s.Run("should describe built-in codes", func() {
+		cfop, _ := wisp.NewCFOP("5.102")
+		s.True(cfop.IsRegistered())
+		s.Equal("Venda de mercadoria adquirida ou recebida de terceiros", cfop.Description())
+	})
+
+	s.Run("should register and reset descriptions", func() {
+		cfop, _ := wisp.NewCFOP("5933")
+		s.False(cfop.IsRegistered())
+		s.Empty(cfop.Description())
+
+		s.Require().NoError(wisp.RegisterCFOP("5.933", "Prestação de serviço tributado pelo ISSQN"))
+		s.Equal("Prestação de serviço tributado pelo ISSQN", cfop.Description())
+
+		wisp.ResetCFOPs()
+		s.False(cfop.IsRegistered())
+	})
+
+	s.Run("should reject invalid registrations", func() {
+		s.Error(wisp.RegisterCFOP("", "Nada"))
+		s.Error(wisp.RegisterCFOP("4102", "Inválido"))
+		err := wisp.RegisterCFOP("5933", "  ")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *CFOPSuite) TestSerialization() {
+	s.Run("should round-trip through JSON", func() {
+		cfop, _ := wisp.NewCFOP("5.102")
+		data, err := json.Marshal(cfop)
+		s.Require().NoError(err)
+		s.Equal(`"5102"`, string(data))
+
+		var decoded wisp.CFOP
+		s.Require().NoError(json.Unmarshal([]byte(`"6.102"`), &decoded))
+		s.Equal("6102", decoded.String())
+		s.Error(json.Unmarshal([]byte(`"4102"`), &decoded))
+		s.Error(json.Unmarshal([]byte(`5102`), &decoded))
+	})
+
+	s.Run("should store and scan database values", func() {
+		cfop, _ := wisp.NewCFOP("5102")
+		value, err := cfop.Value()
+		s.Require().NoError(err)
+		s.Equal("5102", value)
+
+		value, err = wisp.EmptyCFOP.Value()
+		s.Require().NoError(err)
+		s.Nil(value)
+
+		var scanned wisp.CFOP
+		s.Require().NoError(scanned.Scan([]byte("1102")))
+		s.Equal("1102", scanned.String())
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		err = scanned.Scan(5102)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
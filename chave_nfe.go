@@ -0,0 +1,301 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// chaveNFeLength is the number of characters of an NF-e access key.
+const chaveNFeLength = 44
+
+// Models of fiscal documents identified by a ChaveNFe.
+const (
+	// NFeModel is the model of the NF-e, the invoice for goods between companies.
+	NFeModel = "55"
+	// NFCeModel is the model of the NFC-e, the consumer invoice issued at the point of sale.
+	NFCeModel = "65"
+)
+
+// ChaveNFe is a value object representing the 44-digit access key (chave de acesso) of an NF-e or
+// NFC-e, printed under the barcode of the DANFE and used to query the invoice at the SEFAZ.
+// The key is made of:
+//   - cUF (2): IBGE code of the issuer's state
+//   - AAMM (4): year and month of issue
+//   - CNPJ (14): issuer's CNPJ, or "000" and the CPF for individuals
+//   - mod (2): document model, 55 (NF-e) or 65 (NFC-e)
+//   - serie (3) and nNF (9): series and number of the invoice
+//   - tpEmis (1): emission type (1 normal, others for contingency)
+//   - cNF (8): random code
+//   - cDV (1): modulo 11 check digit
+//
+// The key is stored without spaces. When SetAlphanumericCNPJ is enabled, the CNPJ may have letters,
+// which count as their ASCII code minus 48 in the check digit, as for the CNPJ itself.
+//
+// The zero value is EmptyChaveNFe.
+//
+// Example:
+//   key, err := wisp.NewChaveNFe("3524 0145 5439 1500 0181 5500 1000 0001 2311 2345 6780")
+//   key.UF()            // "SP"
+//   key.InvoiceNumber() // "001-000000123"
+type ChaveNFe string
+
+// EmptyChaveNFe represents the zero value for the ChaveNFe type.
+var EmptyChaveNFe ChaveNFe
+
+// NewChaveNFe creates a new ChaveNFe, ignoring spaces, dots and hyphens and the "NFe" prefix of the
+// Id attribute of the XML.
+// Returns EmptyChaveNFe for an empty input, or an error if the key does not have 44 digits, has an
+// invalid check digit, or any of its components is invalid.
+func NewChaveNFe(input string) (ChaveNFe, error) {
+	key := strings.ToUpper(documentSeparators.Replace(strings.TrimSpace(input)))
+	key = strings.TrimPrefix(key, "NFE")
+	if key == "" {
+		return EmptyChaveNFe, nil
+	}
+
+	if len(key) != chaveNFeLength || !isChaveNFeChars(key) {
+		return EmptyChaveNFe, fault.New("NF-e access key must have 44 digits", fault.WithCode(fault.Invalid), fault.WithContext("input_value", input))
+	}
+	if chaveNFeCheckDigit(key[:43]) != key[43] {
+		return EmptyChaveNFe, fault.New("NF-e access key has an invalid check digit", fault.WithCode(fault.Invalid), fault.WithContext("input_value", input))
+	}
+
+	if _, err := UFFromIBGECode(key[:2]); err != nil {
+		return EmptyChaveNFe, fault.Wrap(err, "NF-e access key has an invalid state code", fault.WithCode(fault.Invalid))
+	}
+	if month, _ := strconv.Atoi(key[4:6]); month < 1 || month > 12 {
+		return EmptyChaveNFe, fault.New("NF-e access key has an invalid month", fault.WithCode(fault.Invalid), fault.WithContext("input_value", input))
+	}
+	if _, err := parseChaveNFeIssuer(key[6:20]); err != nil {
+		return EmptyChaveNFe, fault.Wrap(err, "NF-e access key has an invalid issuer CNPJ or CPF", fault.WithCode(fault.Invalid))
+	}
+	if model := key[20:22]; model != NFeModel && model != NFCeModel {
+		return EmptyChaveNFe, fault.New(
+			"NF-e access key model must be 55 or 65",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("model", model),
+		)
+	}
+	if _, err := chaveNFeInvoiceNumber(key); err != nil {
+		return EmptyChaveNFe, err
+	}
+	return ChaveNFe(key), nil
+}
+
+// isChaveNFeChars reports whether the key has only digits, except for the letters of an
+// alphanumeric CNPJ when they are enabled.
+func isChaveNFeChars(key string) bool {
+	for i := 0; i < len(key); i++ {
+		if isDigit(key[i]) {
+			continue
+		}
+		if !alphanumericCNPJEnabled || i < 6 || i >= 18 || !isUpperLetter(key[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// chaveNFeCheckDigit computes the modulo 11 check digit of the first 43 characters of a key: from
+// the right, they are weighted 2 to 9 cyclically, and the digit is 11 minus the remainder, or 0 when
+// that is 10 or 11.
+func chaveNFeCheckDigit(key string) byte {
+	sum, weight := 0, 2
+	for i := len(key) - 1; i >= 0; i-- {
+		sum += int(key[i]-'0') * weight
+		if weight++; weight > 9 {
+			weight = 2
+		}
+	}
+	dv := 11 - sum%11
+	if dv >= 10 {
+		dv = 0
+	}
+	return byte('0' + dv)
+}
+
+// parseChaveNFeIssuer validates the issuer field of a key, a CNPJ or "000" followed by a CPF.
+func parseChaveNFeIssuer(field string) (TaxID, error) {
+	cnpj, err := NewCNPJ(field)
+	if err == nil {
+		return NewTaxIDFromCNPJ(cnpj), nil
+	}
+	if strings.HasPrefix(field, "000") {
+		if cpf, cpfErr := NewCPF(field[3:]); cpfErr == nil {
+			return NewTaxIDFromCPF(cpf), nil
+		}
+	}
+	return EmptyTaxID, err
+}
+
+// chaveNFeInvoiceNumber returns the series and number of the invoice in a key.
+func chaveNFeInvoiceNumber(key string) (InvoiceNumber, error) {
+	series, _ := strconv.Atoi(key[22:25])
+	number, _ := strconv.ParseInt(key[25:34], 10, 64)
+	return NewNFeNumber(series, number)
+}
+
+// UF returns the state of the issuer.
+func (k ChaveNFe) UF() UF {
+	if k.IsZero() {
+		return EmptyUF
+	}
+	uf, _ := UFFromIBGECode(string(k[:2]))
+	return uf
+}
+
+// IssueMonth returns the first day of the month the invoice was issued in; the key does not hold
+// the day. Returns the zero Date for the zero value.
+func (k ChaveNFe) IssueMonth() Date {
+	if k.IsZero() {
+		return Date{}
+	}
+	year, _ := strconv.Atoi(string(k[2:4]))
+	month, _ := strconv.Atoi(string(k[4:6]))
+	date, _ := NewDate(2000+year, time.Month(month), 1)
+	return date
+}
+
+// Issuer returns the CNPJ of the issuer, or the CPF for invoices issued by individuals.
+func (k ChaveNFe) Issuer() TaxID {
+	if k.IsZero() {
+		return EmptyTaxID
+	}
+	issuer, _ := parseChaveNFeIssuer(string(k[6:20]))
+	return issuer
+}
+
+// CNPJ returns the CNPJ of the issuer, or EmptyCNPJ when the issuer is an individual.
+func (k ChaveNFe) CNPJ() CNPJ {
+	issuer := k.Issuer()
+	if !issuer.IsCNPJ() {
+		return EmptyCNPJ
+	}
+	return CNPJ(issuer)
+}
+
+// Model returns the document model, NFeModel ("55") or NFCeModel ("65").
+func (k ChaveNFe) Model() string {
+	if k.IsZero() {
+		return ""
+	}
+	return string(k[20:22])
+}
+
+// IsNFCe returns true if the key belongs to a consumer invoice (NFC-e, model 65).
+func (k ChaveNFe) IsNFCe() bool {
+	return k.Model() == NFCeModel
+}
+
+// InvoiceNumber returns the series and number of the invoice.
+func (k ChaveNFe) InvoiceNumber() InvoiceNumber {
+	if k.IsZero() {
+		return ZeroInvoiceNumber
+	}
+	number, _ := chaveNFeInvoiceNumber(string(k))
+	return number
+}
+
+// EmissionType returns the emission type (tpEmis): 1 for normal emission, other values for the
+// contingency modes. Returns 0 for the zero value.
+func (k ChaveNFe) EmissionType() int {
+	if k.IsZero() {
+		return 0
+	}
+	return int(k[34] - '0')
+}
+
+// IsContingency returns true if the invoice was issued in a contingency mode.
+func (k ChaveNFe) IsContingency() bool {
+	return !k.IsZero() && k[34] != '1'
+}
+
+// Formatted returns the key in 11 groups of 4 characters separated by spaces, as printed on the
+// DANFE. Returns an empty string for the zero value.
+func (k ChaveNFe) Formatted() string {
+	if k.IsZero() {
+		return ""
+	}
+	groups := make([]string, 0, chaveNFeLength/4)
+	for i := 0; i < chaveNFeLength; i += 4 {
+		groups = append(groups, string(k[i:i+4]))
+	}
+	return strings.Join(groups, " ")
+}
+
+// String returns the key as 44 characters without spaces.
+func (k ChaveNFe) String() string {
+	return string(k)
+}
+
+// IsZero returns true if the ChaveNFe is the zero value.
+func (k ChaveNFe) IsZero() bool {
+	return k == EmptyChaveNFe
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the ChaveNFe as a JSON string of 44 characters.
+func (k ChaveNFe) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a ChaveNFe, with validation.
+func (k *ChaveNFe) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "ChaveNFe must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	key, err := NewChaveNFe(s)
+	if err != nil {
+		return err
+	}
+	*k = key
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the ChaveNFe as a string, or nil for the zero value.
+func (k ChaveNFe) Value() (driver.Value, error) {
+	if k.IsZero() {
+		return nil, nil
+	}
+	return k.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a ChaveNFe, with
+// validation.
+func (k *ChaveNFe) Scan(src interface{}) error {
+	if src == nil {
+		*k = EmptyChaveNFe
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for ChaveNFe",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	key, err := NewChaveNFe(s)
+	if err != nil {
+		return err
+	}
+	*k = key
+	return nil
+}
@@ -0,0 +1,199 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+const (
+	validChaveNFe  = "35240145543915000181550010000001231123456780"
+	validChaveNFCe = "35240145543915000181650010000001249123456786"
+	validChaveCPF  = "43231100052998224725550020000045671123456786"
+)
+
+type ChaveNFeSuite struct {
+	suite.Suite
+}
+
+func TestChaveNFeSuite(t *testing.T) {
+	suite.Run(t, new(ChaveNFeSuite))
+}
+
+func (s *ChaveNFeSuite) TearDownTest() {
+	wisp.SetAlphanumericCNPJ(false)
+}
+
+func (s *ChaveNFeSuite) TestNewChaveNFe() {
+	s.Run("should accept valid keys in any common format", func() {
+		for _, input := range []string{
+			validChaveNFe,
+			"3524 0145 5439 1500 0181 5500 1000 0001 2311 2345 6780",
+			"NFe" + validChaveNFe,
+			"3524.0145.5439.1500.0181.5500.1000.0001.2311.2345.6780",
+		} {
+			key, err := wisp.NewChaveNFe(input)
+			s.Require().NoError(err, input)
+			s.Equal(validChaveNFe, key.String())
+		}
+	})
+
+	s.Run("should return the zero value for an empty input", func() {
+		key, err := wisp.NewChaveNFe("  ")
+		s.Require().NoError(err)
+		s.True(key.IsZero())
+	})
+
+	s.Run("should reject malformed keys and invalid check digits", func() {
+		for _, input := range []string{
+			validChaveNFe[:43],
+			validChaveNFe + "0",
+			"3524014554391500018155001000000123112345678A",
+			"35240145543915000181550010000001231123456781",
+		} {
+			_, err := wisp.NewChaveNFe(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+
+	s.Run("should reject keys with invalid components", func() {
+		testCases := map[string]string{
+			"state":  "3424014554391500018155001000000123112345678",
+			"month":  "3524134554391500018155001000000123112345678",
+			"issuer": "3524014554391500018255001000000123112345678",
+			"model":  "3524014554391500018157001000000123112345678",
+			"number": "3524014554391500018155001000000000112345678",
+		}
+		for name, body := range testCases {
+			_, err := wisp.NewChaveNFe(withChaveCheckDigit(body))
+			s.Require().Error(err, name)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code, name)
+		}
+	})
+
+	s.Run("should accept alphanumeric CNPJs only when enabled", func() {
+		key := withChaveCheckDigit("35240112ABC34501DE3555001000000123112345678")
+
+		_, err := wisp.NewChaveNFe(key)
+		s.Require().Error(err)
+
+		wisp.SetAlphanumericCNPJ(true)
+		parsed, err := wisp.NewChaveNFe(key)
+		s.Require().NoError(err)
+		s.Equal(wisp.CNPJ("12ABC34501DE35"), parsed.CNPJ())
+	})
+}
+
+func (s *ChaveNFeSuite) TestComponents() {
+	s.Run("should expose the components of an NF-e key", func() {
+		key, err := wisp.NewChaveNFe(validChaveNFe)
+		s.Require().NoError(err)
+
+		s.Equal(wisp.UF("SP"), key.UF())
+		expected, _ := wisp.NewDate(2024, time.January, 1)
+		s.True(key.IssueMonth().Equals(expected))
+		s.Equal(wisp.TaxID("45543915000181"), key.Issuer())
+		s.Equal(wisp.CNPJ("45543915000181"), key.CNPJ())
+		s.Equal(wisp.NFeModel, key.Model())
+		s.False(key.IsNFCe())
+
+		number, _ := wisp.NewNFeNumber(1, 123)
+		s.Equal(number, key.InvoiceNumber())
+		s.Equal(1, key.EmissionType())
+		s.False(key.IsContingency())
+		s.Equal("3524 0145 5439 1500 0181 5500 1000 0001 2311 2345 6780", key.Formatted())
+	})
+
+	s.Run("should identify NFC-e keys issued in contingency", func() {
+		key, err := wisp.NewChaveNFe(validChaveNFCe)
+		s.Require().NoError(err)
+		s.True(key.IsNFCe())
+		s.Equal(9, key.EmissionType())
+		s.True(key.IsContingency())
+	})
+
+	s.Run("should expose the CPF of individual issuers", func() {
+		key, err := wisp.NewChaveNFe(validChaveCPF)
+		s.Require().NoError(err)
+		s.Equal(wisp.UF("RS"), key.UF())
+		s.Equal(2023, key.IssueMonth().Year())
+		s.Equal(time.November, key.IssueMonth().Month())
+		s.True(key.Issuer().IsCPF())
+		s.Equal(wisp.TaxID("52998224725"), key.Issuer())
+		s.True(key.CNPJ().IsZero())
+
+		number, _ := wisp.NewNFeNumber(2, 4567)
+		s.Equal(number, key.InvoiceNumber())
+	})
+
+	s.Run("should return zero components for the zero value", func() {
+		key := wisp.EmptyChaveNFe
+		s.True(key.UF().IsZero())
+		s.True(key.IssueMonth().IsZero())
+		s.True(key.Issuer().IsZero())
+		s.True(key.CNPJ().IsZero())
+		s.Empty(key.Model())
+		s.Equal(wisp.ZeroInvoiceNumber, key.InvoiceNumber())
+		s.Equal(0, key.EmissionType())
+		s.False(key.IsContingency())
+		s.Empty(key.Formatted())
+	})
+}
+
+func (s *ChaveNFeSuite) TestSerialization() {
+	s.Run("should round-trip through JSON", func() {
+		key, _ := wisp.NewChaveNFe(validChaveNFe)
+		data, err := json.Marshal(key)
+		s.Require().NoError(err)
+		s.Equal(`"`+validChaveNFe+`"`, string(data))
+
+		var decoded wisp.ChaveNFe
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(key, decoded)
+		s.Error(json.Unmarshal([]byte(`"123"`), &decoded))
+		s.Error(json.Unmarshal([]byte(`123`), &decoded))
+	})
+
+	s.Run("should store and scan database values", func() {
+		key, _ := wisp.NewChaveNFe(validChaveNFe)
+		value, err := key.Value()
+		s.Require().NoError(err)
+		s.Equal(validChaveNFe, value)
+
+		value, err = wisp.EmptyChaveNFe.Value()
+		s.Require().NoError(err)
+		s.Nil(value)
+
+		var scanned wisp.ChaveNFe
+		s.Require().NoError(scanned.Scan([]byte(validChaveNFCe)))
+		s.Equal(validChaveNFCe, scanned.String())
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		err = scanned.Scan(123)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+// withChaveCheckDigit appends the modulo 11 check digit to the first 43 characters of a key.
+func withChaveCheckDigit(body string) string {
+	sum, weight := 0, 2
+	for i := len(body) - 1; i >= 0; i-- {
+		sum += int(body[i]-'0') * weight
+		if weight++; weight > 9 {
+			weight = 2
+		}
+	}
+	dv := 11 - sum%11
+	if dv >= 10 {
+		dv = 0
+	}
+	return body + string(rune('0'+dv))
+}
@@ -0,0 +1,174 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// CNH represents the registration number of a Brazilian driver's license (Carteira Nacional de
+// Habilitação), the RENACH-issued "Nº Registro" printed on the license.
+// It validates the format and verifies check digits according to the DENATRAN algorithm.
+// The value is stored without formatting (digits only).
+//
+// Examples:
+//   - Input: "0265030646-1" or "02650306461"
+//   - Storage: "02650306461"
+//   - Formatted output: "02650306461"
+//
+// A CNH is considered valid when:
+//   - It contains exactly 11 digits
+//   - It's not a sequence of repeated digits (e.g., "11111111111")
+//   - Both check digits are mathematically correct according to the official algorithm
+//
+// Use DocumentWithExpiry to keep the license together with its expiry date.
+type CNH string
+
+// EmptyCNH represents the zero value for CNH type.
+var EmptyCNH CNH
+
+// parseCNH validates and normalizes a CNH from string or []byte input.
+func parseCNH[T string | []byte](input T) (CNH, error) {
+	if len(input) == 0 {
+		return EmptyCNH, nil
+	}
+
+	var digits [11]byte
+	if extractDigits(input, digits[:]) != 11 {
+		return EmptyCNH, fault.New("CNH must have 11 digits", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
+	}
+
+	allSame := true
+	for i := 1; i < 11; i++ {
+		if digits[i] != digits[0] {
+			allSame = false
+			break
+		}
+	}
+	if allSame {
+		return EmptyCNH, fault.New("invalid CNH sequence of repeated digits", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
+	}
+
+	dv1, dv2 := cnhCheckDigits(digits[:9])
+	if dv1 != int(digits[9]-'0') || dv2 != int(digits[10]-'0') {
+		return EmptyCNH, fault.New("invalid CNH check digits", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
+	}
+
+	return CNH(digits[:]), nil
+}
+
+// cnhCheckDigits calculates both CNH check digits for the first 9 ASCII digits.
+// The first uses weights 9 down to 1 and the second weights 1 up to 9, both modulo 11; when the
+// first remainder is 10 or more, the first digit is 0 and 2 is subtracted from the second.
+// The second digit is negative, and never matches, for numbers the algorithm cannot produce.
+func cnhCheckDigits(digits []byte) (int, int) {
+	sum1, sum2 := 0, 0
+	for i, d := range digits {
+		sum1 += int(d-'0') * (9 - i)
+		sum2 += int(d-'0') * (i + 1)
+	}
+
+	dv1, discount := sum1%11, 0
+	if dv1 >= 10 {
+		dv1, discount = 0, 2
+	}
+	dv2 := sum2 % 11
+	if dv2 >= 10 {
+		return dv1, 0
+	}
+	return dv1, dv2 - discount
+}
+
+// NewCNH creates a new CNH from the given input string.
+// It accepts the number with or without separators and validates it.
+//
+// Examples:
+//   cnh, err := NewCNH("02650306461")  // Valid
+//   cnh, err := NewCNH("")             // Returns EmptyCNH
+//   cnh, err := NewCNH("11111111111")  // Error: repeated digits
+func NewCNH(input string) (CNH, error) {
+	return parseCNH(input)
+}
+
+// String returns the CNH as a string without formatting (digits only).
+func (c CNH) String() string {
+	return string(c)
+}
+
+// IsZero returns true if the CNH is the zero value (EmptyCNH).
+func (c CNH) IsZero() bool {
+	return c == EmptyCNH
+}
+
+// Formatted returns the CNH as printed on the license. The registration number has no
+// punctuation, so it is the same as String.
+func (c CNH) Formatted() string {
+	return c.String()
+}
+
+// Masked returns the CNH with only the last four digits visible ("*******6461"), so it can be
+// logged or displayed under LGPD. Returns an empty string for EmptyCNH.
+func (c CNH) Masked() string {
+	if len(c) != 11 {
+		return ""
+	}
+	return "*******" + string(c[7:])
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the CNH as a JSON string without formatting.
+func (c CNH) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a CNH, performing full validation.
+func (c *CNH) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "CNH must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	cnh, err := NewCNH(s)
+	if err != nil {
+		return err
+	}
+	*c = cnh
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the CNH as a string or nil if zero value.
+func (c CNH) Value() (driver.Value, error) {
+	if c.IsZero() {
+		return nil, nil
+	}
+	return c.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values and validates them as CNH.
+func (c *CNH) Scan(src interface{}) error {
+	if src == nil {
+		*c = EmptyCNH
+		return nil
+	}
+
+	var cnh CNH
+	var err error
+	switch v := src.(type) {
+	case string:
+		cnh, err = parseCNH(v)
+	case []byte:
+		cnh, err = parseCNH(v)
+	default:
+		return fault.New("unsupported scan type for CNH", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+
+	if err != nil {
+		return err
+	}
+	*c = cnh
+	return nil
+}
@@ -0,0 +1,93 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type CNHSuite struct {
+	suite.Suite
+}
+
+func TestCNHSuite(t *testing.T) {
+	suite.Run(t, new(CNHSuite))
+}
+
+func (s *CNHSuite) TestNewCNH() {
+	testCases := []struct {
+		name        string
+		input       string
+		expected    wisp.CNH
+		expectError bool
+	}{
+		{name: "should create a valid CNH", input: "02650306461", expected: "02650306461"},
+		{name: "should create a valid CNH with separators", input: "0265030646-1", expected: "02650306461"},
+		{name: "should create a valid CNH when the first remainder is 10", input: "54321098705", expected: "54321098705"},
+		{name: "should create an empty CNH from an empty string", input: "", expected: wisp.EmptyCNH},
+		{name: "should fail for CNH with invalid length", input: "0265030646", expectError: true},
+		{name: "should fail for CNH with all repeated digits", input: "11111111111", expectError: true},
+		{name: "should fail for CNH with incorrect first check digit", input: "02650306451", expectError: true},
+		{name: "should fail for CNH with incorrect second check digit", input: "02650306460", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cnh, err := wisp.NewCNH(tc.input)
+			if tc.expectError {
+				s.Require().Error(err)
+				s.Equal(wisp.EmptyCNH, cnh)
+				s.Equal(fault.Invalid, err.(*fault.Error).Code)
+			} else {
+				s.Require().NoError(err)
+				s.Equal(tc.expected, cnh)
+			}
+		})
+	}
+}
+
+func (s *CNHSuite) TestCNH_Methods() {
+	cnh, _ := wisp.NewCNH("02650306461")
+	s.Equal("02650306461", cnh.String())
+	s.Equal("02650306461", cnh.Formatted())
+	s.Equal("*******6461", cnh.Masked())
+	s.False(cnh.IsZero())
+	s.True(wisp.EmptyCNH.IsZero())
+	s.Equal("", wisp.EmptyCNH.Masked())
+}
+
+func (s *CNHSuite) TestCNH_JSON() {
+	cnh, _ := wisp.NewCNH("02650306461")
+	data, err := json.Marshal(cnh)
+	s.Require().NoError(err)
+	s.Equal(`"02650306461"`, string(data))
+
+	var decoded wisp.CNH
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(cnh, decoded)
+	s.Error(json.Unmarshal([]byte(`"02650306460"`), &decoded))
+	s.Error(json.Unmarshal([]byte(`123`), &decoded))
+}
+
+func (s *CNHSuite) TestCNH_SQL() {
+	cnh, _ := wisp.NewCNH("02650306461")
+	value, err := cnh.Value()
+	s.Require().NoError(err)
+	s.Equal("02650306461", value)
+
+	value, err = wisp.EmptyCNH.Value()
+	s.Require().NoError(err)
+	s.Nil(value)
+
+	var scanned wisp.CNH
+	s.Require().NoError(scanned.Scan([]byte("02650306461")))
+	s.Equal(cnh, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Error(scanned.Scan("02650306460"))
+	s.Error(scanned.Scan(123))
+}
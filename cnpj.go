@@ -4,7 +4,6 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
-	"strconv"
 
 	"github.com/marcelofabianov/fault"
 )
@@ -12,6 +11,7 @@ import (
 // CNPJ represents a Brazilian legal entity identification number (Cadastro Nacional da Pessoa Jurídica).
 // It validates the format and verifies check digits according to Brazilian government standards.
 // The value is stored without formatting (digits only) but can be displayed with proper formatting.
+// The alphanumeric format (e.g., "12.ABC.345/01DE-35") is accepted once enabled with SetAlphanumericCNPJ.
 //
 // Examples:
 //   - Input: "12.345.678/0001-90" or "12345678000190"
@@ -27,71 +27,103 @@ type CNPJ string
 // EmptyCNPJ represents the zero value for CNPJ type.
 var EmptyCNPJ CNPJ
 
-func parseCNPJ(input string) (CNPJ, error) {
-	if input == "" {
+// alphanumericCNPJEnabled reports whether parseCNPJ accepts the alphanumeric format.
+// It can be configured globally using SetAlphanumericCNPJ.
+var alphanumericCNPJEnabled = false
+
+// SetAlphanumericCNPJ enables or disables the alphanumeric CNPJ format introduced by the
+// Receita Federal in 2026, where the first 12 characters may be digits or uppercase letters
+// and the last 2 remain numeric check digits. Numeric CNPJs are always accepted.
+// It is disabled by default so existing systems keep rejecting letters until they are ready.
+func SetAlphanumericCNPJ(enabled bool) {
+	alphanumericCNPJEnabled = enabled
+}
+
+// cnpjWeights1 and cnpjWeights2 are the official weights for the first and second CNPJ check digits.
+var (
+	cnpjWeights1 = [12]int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	cnpjWeights2 = [13]int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+)
+
+// parseCNPJ validates and normalizes a CNPJ from string or []byte input.
+// Working on both input kinds lets database scans skip the intermediate string conversion.
+func parseCNPJ[T string | []byte](input T) (CNPJ, error) {
+	if len(input) == 0 {
 		return EmptyCNPJ, nil
 	}
 
-	sanitized := nonDigitRegex.ReplaceAllString(input, "")
-
-	if len(sanitized) != 14 {
-		return EmptyCNPJ, fault.New("CNPJ must have 14 digits", fault.WithCode(fault.Invalid), fault.WithContext("input", input))
+	var digits [14]byte
+	if alphanumericCNPJEnabled {
+		if extractCNPJChars(input, digits[:]) != 14 {
+			return EmptyCNPJ, fault.New("CNPJ must have 14 characters", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
+		}
+		if !isDigit(digits[12]) || !isDigit(digits[13]) {
+			return EmptyCNPJ, fault.New("CNPJ check digits must be numeric", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
+		}
+	} else if extractDigits(input, digits[:]) != 14 {
+		return EmptyCNPJ, fault.New("CNPJ must have 14 digits", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
 	}
 
 	// Check for invalid known sequences (e.g., "11111111111111")
 	allSame := true
 	for i := 1; i < 14; i++ {
-		if sanitized[i] != sanitized[0] {
+		if digits[i] != digits[0] {
 			allSame = false
 			break
 		}
 	}
 	if allSame {
-		return EmptyCNPJ, fault.New("invalid CNPJ sequence of repeated digits", fault.WithCode(fault.Invalid), fault.WithContext("input", input))
+		return EmptyCNPJ, fault.New("invalid CNPJ sequence of repeated digits", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
 	}
 
-	// Calculate check digits
-	var d1, d2 int
-	weights1 := []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
-	weights2 := []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
-
-	// First check digit
-	sum1 := 0
-	for i := 0; i < 12; i++ {
-		digit, _ := strconv.Atoi(string(sanitized[i]))
-		sum1 += digit * weights1[i]
+	if cnpjCheckDigit(digits[:12], cnpjWeights1[:]) != int(digits[12]-'0') {
+		return EmptyCNPJ, fault.New("invalid CNPJ check digit 1", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
 	}
-	remainder1 := sum1 % 11
-	if remainder1 < 2 {
-		d1 = 0
-	} else {
-		d1 = 11 - remainder1
+	if cnpjCheckDigit(digits[:13], cnpjWeights2[:]) != int(digits[13]-'0') {
+		return EmptyCNPJ, fault.New("invalid CNPJ check digit 2", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
 	}
 
-	d1Str, _ := strconv.Atoi(string(sanitized[12]))
-	if d1 != d1Str {
-		return EmptyCNPJ, fault.New("invalid CNPJ check digit 1", fault.WithCode(fault.Invalid), fault.WithContext("input", input))
-	}
+	return CNPJ(digits[:]), nil
+}
 
-	// Second check digit
-	sum2 := 0
-	for i := 0; i < 13; i++ {
-		digit, _ := strconv.Atoi(string(sanitized[i]))
-		sum2 += digit * weights2[i]
-	}
-	remainder2 := sum2 % 11
-	if remainder2 < 2 {
-		d2 = 0
-	} else {
-		d2 = 11 - remainder2
+// extractCNPJChars copies the digits and letters of input into dst, uppercasing letters and
+// skipping separators, and returns how many were found (which may exceed len(dst)).
+func extractCNPJChars[T string | []byte](input T, dst []byte) int {
+	n := 0
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if !isDigit(c) && (c < 'A' || c > 'Z') {
+			continue
+		}
+		if n < len(dst) {
+			dst[n] = c
+		}
+		n++
 	}
+	return n
+}
 
-	d2Str, _ := strconv.Atoi(string(sanitized[13]))
-	if d2 != d2Str {
-		return EmptyCNPJ, fault.New("invalid CNPJ check digit 2", fault.WithCode(fault.Invalid), fault.WithContext("input", input))
-	}
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
 
-	return CNPJ(sanitized), nil
+// cnpjCheckDigit calculates the CNPJ check digit for the given ASCII characters using the official
+// modulo 11 algorithm and the matching weights. Each character is worth its ASCII code minus 48,
+// so digits keep their value and letters of the alphanumeric format range from 17 ('A') to 42 ('Z').
+func cnpjCheckDigit(digits []byte, weights []int) int {
+	sum := 0
+	for i, d := range digits {
+		sum += int(d-'0') * weights[i]
+	}
+	remainder := sum % 11
+	if remainder < 2 {
+		return 0
+	}
+	return 11 - remainder
 }
 
 // NewCNPJ creates a new CNPJ from the given input string.
@@ -119,6 +151,46 @@ func (c CNPJ) String() string {
 	return string(c)
 }
 
+// IsAlphanumeric returns true if the CNPJ uses the alphanumeric format, that is,
+// if it contains at least one letter.
+func (c CNPJ) IsAlphanumeric() bool {
+	for i := 0; i < len(c); i++ {
+		if !isDigit(c[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Root returns the 8-character base that identifies the company ("raiz"), shared by
+// its headquarters and all of its branches. Returns an empty string for EmptyCNPJ.
+func (c CNPJ) Root() string {
+	if len(c) != 14 {
+		return ""
+	}
+	return string(c[:8])
+}
+
+// BranchNumber returns the 4-character establishment number ("ordem"), "0001" for the headquarters.
+// Returns an empty string for EmptyCNPJ.
+func (c CNPJ) BranchNumber() string {
+	if len(c) != 14 {
+		return ""
+	}
+	return string(c[8:12])
+}
+
+// IsHeadquarters returns true if the CNPJ belongs to the company headquarters (branch "0001").
+func (c CNPJ) IsHeadquarters() bool {
+	return c.BranchNumber() == "0001"
+}
+
+// SameCompany checks if both CNPJs share the same root, meaning they are establishments
+// (headquarters or branches) of the same company. Empty CNPJs never match.
+func (c CNPJ) SameCompany(other CNPJ) bool {
+	return c.Root() != "" && c.Root() == other.Root()
+}
+
 // IsZero returns true if the CNPJ is the zero value (EmptyCNPJ).
 func (c CNPJ) IsZero() bool {
 	return c == EmptyCNPJ
@@ -137,6 +209,15 @@ func (c CNPJ) Formatted() string {
 	return fmt.Sprintf("%s.%s.%s/%s-%s", c[0:2], c[2:5], c[5:8], c[8:12], c[12:14])
 }
 
+// Masked returns the CNPJ formatted with the root prefix and check digits hidden ("**.345.678/0001-**"),
+// so it can be logged or displayed under LGPD. Returns an empty string for EmptyCNPJ.
+func (c CNPJ) Masked() string {
+	if len(c) != 14 {
+		return ""
+	}
+	return fmt.Sprintf("**.%s.%s/%s-**", c[2:5], c[5:8], c[8:12])
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 // It serializes the CNPJ as a JSON string without formatting.
 func (c CNPJ) MarshalJSON() ([]byte, error) {
@@ -175,17 +256,17 @@ func (c *CNPJ) Scan(src interface{}) error {
 		return nil
 	}
 
-	var s string
+	var cnpj CNPJ
+	var err error
 	switch v := src.(type) {
 	case string:
-		s = v
+		cnpj, err = parseCNPJ(v)
 	case []byte:
-		s = string(v)
+		cnpj, err = parseCNPJ(v)
 	default:
 		return fault.New("unsupported scan type for CNPJ", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
 	}
 
-	cnpj, err := NewCNPJ(s)
 	if err != nil {
 		return err
 	}
@@ -128,5 +128,93 @@ func (s *CNPJSuite) TestCNPJ_DatabaseInterface() {
 			err := scannedCNPJ.Scan("11222333000100")
 			s.Require().Error(err)
 		})
+
+		s.Run("should scan a valid byte slice", func() {
+			var scannedCNPJ wisp.CNPJ
+			err := scannedCNPJ.Scan([]byte(s.validCNPJFormatted))
+			s.Require().NoError(err)
+			s.Equal(cnpj, scannedCNPJ)
+		})
+
+		s.Run("should fail to scan an invalid byte slice", func() {
+			var scannedCNPJ wisp.CNPJ
+			err := scannedCNPJ.Scan([]byte("11222333000100"))
+			s.Require().Error(err)
+		})
+	})
+}
+
+func (s *CNPJSuite) TestCNPJ_Establishments() {
+	hq, err := wisp.NewCNPJ(s.validCNPJFormatted)
+	s.Require().NoError(err)
+	branch, err := wisp.NewCNPJ("45.543.915/0002-62")
+	s.Require().NoError(err)
+	other, err := wisp.NewCNPJ("11.222.333/0001-81")
+	s.Require().NoError(err)
+
+	s.Run("should split root and branch number", func() {
+		s.Equal("45543915", hq.Root())
+		s.Equal("0001", hq.BranchNumber())
+		s.Equal("0002", branch.BranchNumber())
+		s.Equal("", wisp.EmptyCNPJ.Root())
+		s.Equal("", wisp.EmptyCNPJ.BranchNumber())
+	})
+
+	s.Run("should identify the headquarters", func() {
+		s.True(hq.IsHeadquarters())
+		s.False(branch.IsHeadquarters())
+		s.False(wisp.EmptyCNPJ.IsHeadquarters())
+	})
+
+	s.Run("should compare companies by root", func() {
+		s.True(hq.SameCompany(branch))
+		s.False(hq.SameCompany(other))
+		s.False(wisp.EmptyCNPJ.SameCompany(wisp.EmptyCNPJ))
+	})
+}
+
+func (s *CNPJSuite) TestAlphanumericCNPJ() {
+	s.T().Cleanup(func() { wisp.SetAlphanumericCNPJ(false) })
+
+	s.Run("should reject letters while the format is disabled", func() {
+		wisp.SetAlphanumericCNPJ(false)
+		_, err := wisp.NewCNPJ("12.ABC.345/01DE-35")
+		s.Require().Error(err)
+	})
+
+	s.Run("should accept the alphanumeric format when enabled", func() {
+		wisp.SetAlphanumericCNPJ(true)
+		cnpj, err := wisp.NewCNPJ("12.abc.345/01de-35")
+		s.Require().NoError(err)
+		s.Equal("12ABC34501DE35", cnpj.String())
+		s.Equal("12.ABC.345/01DE-35", cnpj.Formatted())
+		s.True(cnpj.IsAlphanumeric())
+	})
+
+	s.Run("should keep accepting numeric CNPJs when enabled", func() {
+		wisp.SetAlphanumericCNPJ(true)
+		cnpj, err := wisp.NewCNPJ(s.validCNPJFormatted)
+		s.Require().NoError(err)
+		s.False(cnpj.IsAlphanumeric())
+	})
+
+	s.Run("should validate alphanumeric check digits", func() {
+		wisp.SetAlphanumericCNPJ(true)
+		_, err := wisp.NewCNPJ("12.ABC.345/01DE-36")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+		_, err = wisp.NewCNPJ("12.ABC.345/01DE-3A")
+		s.Require().Error(err)
+
+		_, err = wisp.NewCNPJ("12.ABC.345/01D-35")
+		s.Require().Error(err)
+	})
+
+	s.Run("should scan alphanumeric CNPJs", func() {
+		wisp.SetAlphanumericCNPJ(true)
+		var cnpj wisp.CNPJ
+		s.Require().NoError(cnpj.Scan([]byte("12ABC34501DE35")))
+		s.True(cnpj.IsAlphanumeric())
 	})
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"image/color"
+	"math"
 	"strconv"
 	"strings"
 
@@ -13,62 +14,71 @@ import (
 
 // Color is a value object representing a color, parsed from a hex string.
 // It stores the color as an `image/color.RGBA` value, ensuring it is always valid.
-// It supports both 3-digit (#F00) and 6-digit (#FF0000) hex formats.
+// It supports 3-digit (#F00), 4-digit (#F00C), 6-digit (#FF0000) and 8-digit (#FF0000CC) hex formats,
+// where the 4th and 8th digit forms carry an alpha channel.
 //
-// The zero value is ZeroColor, which is transparent black.
+// The zero value is ZeroColor, which represents the absence of a color. Every parsed color,
+// including opaque black (#000000) and fully transparent black (#00000000), is distinct from it.
 //
 // Example:
 //   c, err := ParseColor("#FF0000")
 //   r, g, b, a := c.RGBA() // 255, 0, 0, 255
 //   hex := c.Hex() // "#ff0000"
 type Color struct {
-	rgba color.RGBA
+	rgba  color.RGBA
+	valid bool
 }
 
-// ZeroColor represents the zero value for the Color type (transparent black).
+// ZeroColor represents the zero value for the Color type (no color).
 var ZeroColor = Color{}
 
-// ParseColor creates a new Color object from a hex string (e.g., "#FF0000" or "#F00").
-// It validates the format and returns an error if the hex code is invalid.
+// NewColorRGBA creates a new Color from its red, green, blue and alpha components.
+func NewColorRGBA(r, g, b, a uint8) Color {
+	return Color{rgba: color.RGBA{R: r, G: g, B: b, A: a}, valid: true}
+}
+
+// ParseColor creates a new Color object from a hex string (e.g., "#FF0000", "#F00" or "#FF000080")
+// or a standard CSS color name (e.g., "rebeccapurple" or "transparent"), ignoring case.
+// It validates the format and returns an error if the hex code or name is invalid.
+// Colors without an alpha component are fully opaque.
 func ParseColor(hex string) (Color, error) {
 	s := strings.ToLower(strings.TrimSpace(hex))
 
 	if !strings.HasPrefix(s, "#") {
-		return ZeroColor, fault.New("hex color must start with '#'", fault.WithCode(fault.Invalid))
+		if named, ok := lookupNamedColor(s); ok {
+			return named, nil
+		}
+		return ZeroColor, fault.New(
+			"color must be a hex code starting with '#' or a CSS color name",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", hex),
+		)
 	}
 
 	s = strings.TrimPrefix(s, "#")
 
-	var r, g, b uint8
-	var a uint8 = 255 // Default alpha is fully opaque
-
-	var err error
 	switch len(s) {
-	case 3: // #RGB format
-		r, err = parseHexComponent(s[0:1] + s[0:1])
-		if err == nil {
-			g, err = parseHexComponent(s[1:2] + s[1:2])
-		}
-		if err == nil {
-			b, err = parseHexComponent(s[2:3] + s[2:3])
-		}
-	case 6: // #RRGGBB format
-		r, err = parseHexComponent(s[0:2])
-		if err == nil {
-			g, err = parseHexComponent(s[2:4])
-		}
-		if err == nil {
-			b, err = parseHexComponent(s[4:6])
+	case 3, 4: // #RGB and #RGBA formats
+		expanded := make([]byte, 0, len(s)*2)
+		for i := 0; i < len(s); i++ {
+			expanded = append(expanded, s[i], s[i])
 		}
+		s = string(expanded)
+	case 6, 8: // #RRGGBB and #RRGGBBAA formats
 	default:
-		return ZeroColor, fault.New("hex color must have 3 or 6 characters after '#'", fault.WithCode(fault.Invalid))
+		return ZeroColor, fault.New("hex color must have 3, 4, 6 or 8 characters after '#'", fault.WithCode(fault.Invalid))
 	}
 
-	if err != nil {
-		return ZeroColor, fault.Wrap(err, "invalid hex value in color code", fault.WithCode(fault.Invalid))
+	components := [4]uint8{3: 255} // Default alpha is fully opaque
+	for i := 0; i < len(s)/2; i++ {
+		component, err := parseHexComponent(s[i*2 : i*2+2])
+		if err != nil {
+			return ZeroColor, fault.Wrap(err, "invalid hex value in color code", fault.WithCode(fault.Invalid))
+		}
+		components[i] = component
 	}
 
-	return Color{rgba: color.RGBA{R: r, G: g, B: b, A: a}}, nil
+	return NewColorRGBA(components[0], components[1], components[2], components[3]), nil
 }
 
 // parseHexComponent converts a two-character hex string into a uint8.
@@ -82,17 +92,273 @@ func (c Color) RGBA() (r, g, b, a uint8) {
 	return c.rgba.R, c.rgba.G, c.rgba.B, c.rgba.A
 }
 
-// Hex returns the 6-digit hex string representation of the color (e.g., "#ff0000").
+// Alpha returns the alpha component of the color (0 is transparent, 255 is opaque).
+func (c Color) Alpha() uint8 {
+	return c.rgba.A
+}
+
+// IsOpaque returns true if the color has no transparency.
+func (c Color) IsOpaque() bool {
+	return c.valid && c.rgba.A == 255
+}
+
+// WithAlpha returns a copy of the color with the given alpha component.
+func (c Color) WithAlpha(a uint8) Color {
+	return NewColorRGBA(c.rgba.R, c.rgba.G, c.rgba.B, a)
+}
+
+// Hex returns the hex string representation of the color, using the 6-digit form
+// for opaque colors (e.g., "#ff0000") and the 8-digit form otherwise (e.g., "#ff000080").
 func (c Color) Hex() string {
 	if c.IsZero() {
 		return ""
 	}
-	return fmt.Sprintf("#%02x%02x%02x", c.rgba.R, c.rgba.G, c.rgba.B)
+	if c.rgba.A == 255 {
+		return fmt.Sprintf("#%02x%02x%02x", c.rgba.R, c.rgba.G, c.rgba.B)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", c.rgba.R, c.rgba.G, c.rgba.B, c.rgba.A)
 }
 
-// IsZero returns true if the Color is the zero value (transparent black).
+// IsZero returns true if the Color is the zero value (no color).
 func (c Color) IsZero() bool {
-	return c.rgba.R == 0 && c.rgba.G == 0 && c.rgba.B == 0 && c.rgba.A == 0
+	return c == ZeroColor
+}
+
+// ToHSL converts the color to the HSL color space, returning the hue in degrees [0, 360)
+// and the saturation and lightness as fractions in [0, 1]. The alpha component is ignored.
+func (c Color) ToHSL() (h, s, l float64) {
+	r, g, b := float64(c.rgba.R)/255, float64(c.rgba.G)/255, float64(c.rgba.B)/255
+	maxC, minC := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	delta := maxC - minC
+
+	l = (maxC + minC) / 2
+	if delta == 0 {
+		return 0, 0, l
+	}
+
+	s = delta / (1 - math.Abs(2*l-1))
+	return colorHue(r, g, b, maxC, delta), s, l
+}
+
+// ToHSV converts the color to the HSV color space, returning the hue in degrees [0, 360)
+// and the saturation and value as fractions in [0, 1]. The alpha component is ignored.
+func (c Color) ToHSV() (h, s, v float64) {
+	r, g, b := float64(c.rgba.R)/255, float64(c.rgba.G)/255, float64(c.rgba.B)/255
+	maxC, minC := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	delta := maxC - minC
+
+	if delta == 0 {
+		return 0, 0, maxC
+	}
+	return colorHue(r, g, b, maxC, delta), delta / maxC, maxC
+}
+
+// colorHue computes the hue in degrees shared by the HSL and HSV conversions.
+func colorHue(r, g, b, maxC, delta float64) float64 {
+	var h float64
+	switch maxC {
+	case r:
+		h = math.Mod((g-b)/delta, 6)
+	case g:
+		h = (b-r)/delta + 2
+	default:
+		h = (r-g)/delta + 4
+	}
+
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+// NewColorFromHSL creates a new opaque Color from HSL components.
+// The hue is given in degrees and wrapped into [0, 360); saturation and lightness must be in [0, 1].
+//
+// Example:
+//   c, err := NewColorFromHSL(210, 0.5, 0.4) // "#336699"
+func NewColorFromHSL(h, s, l float64) (Color, error) {
+	if err := validateColorFraction("saturation", s); err != nil {
+		return ZeroColor, err
+	}
+	if err := validateColorFraction("lightness", l); err != nil {
+		return ZeroColor, err
+	}
+
+	chroma := (1 - math.Abs(2*l-1)) * s
+	return colorFromChroma(h, chroma, l-chroma/2), nil
+}
+
+// NewColorFromHSV creates a new opaque Color from HSV components.
+// The hue is given in degrees and wrapped into [0, 360); saturation and value must be in [0, 1].
+func NewColorFromHSV(h, s, v float64) (Color, error) {
+	if err := validateColorFraction("saturation", s); err != nil {
+		return ZeroColor, err
+	}
+	if err := validateColorFraction("value", v); err != nil {
+		return ZeroColor, err
+	}
+
+	chroma := v * s
+	return colorFromChroma(h, chroma, v-chroma), nil
+}
+
+// validateColorFraction checks that a color space component is within [0, 1].
+func validateColorFraction(component string, value float64) error {
+	if math.IsNaN(value) || value < 0 || value > 1 {
+		return fault.New(
+			"color component must be between 0 and 1",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("component", component),
+			fault.WithContext("input_value", value),
+		)
+	}
+	return nil
+}
+
+// colorFromChroma builds an opaque Color from a hue, chroma and the lightness match offset.
+func colorFromChroma(h, chroma, offset float64) Color {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+
+	x := chroma * (1 - math.Abs(math.Mod(h/60, 2)-1))
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = chroma, x, 0
+	case h < 120:
+		r, g, b = x, chroma, 0
+	case h < 180:
+		r, g, b = 0, chroma, x
+	case h < 240:
+		r, g, b = 0, x, chroma
+	case h < 300:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+
+	toComponent := func(v float64) uint8 {
+		return uint8(math.Round(math.Min(math.Max(v+offset, 0), 1) * 255))
+	}
+	return NewColorRGBA(toComponent(r), toComponent(g), toComponent(b), 255)
+}
+
+// Lighten returns a copy of the color with its HSL lightness increased by the given amount,
+// clamped to white (e.g., 10% turns a lightness of 40% into 50%). The alpha component is preserved.
+func (c Color) Lighten(amount Percentage) Color {
+	return c.adjustHSL(0, amount.Float64())
+}
+
+// Darken returns a copy of the color with its HSL lightness decreased by the given amount,
+// clamped to black. The alpha component is preserved.
+func (c Color) Darken(amount Percentage) Color {
+	return c.adjustHSL(0, -amount.Float64())
+}
+
+// Saturate returns a copy of the color with its HSL saturation increased by the given amount,
+// clamped to the fully saturated color. The alpha component is preserved.
+func (c Color) Saturate(amount Percentage) Color {
+	return c.adjustHSL(amount.Float64(), 0)
+}
+
+// Desaturate returns a copy of the color with its HSL saturation decreased by the given amount,
+// clamped to gray. The alpha component is preserved.
+func (c Color) Desaturate(amount Percentage) Color {
+	return c.adjustHSL(-amount.Float64(), 0)
+}
+
+// adjustHSL shifts the saturation and lightness of the color, keeping its hue and alpha.
+func (c Color) adjustHSL(deltaS, deltaL float64) Color {
+	if c.IsZero() {
+		return ZeroColor
+	}
+
+	clamp := func(v float64) float64 { return math.Min(math.Max(v, 0), 1) }
+
+	h, s, l := c.ToHSL()
+	adjusted, _ := NewColorFromHSL(h, clamp(s+deltaS), clamp(l+deltaL))
+	return adjusted.WithAlpha(c.rgba.A)
+}
+
+// WCAGLevel identifies a WCAG 2.1 contrast conformance level for text.
+type WCAGLevel string
+
+// WCAG 2.1 conformance levels for normal and large text (at least 18pt, or 14pt bold).
+const (
+	WCAGLevelAA       WCAGLevel = "AA"
+	WCAGLevelAALarge  WCAGLevel = "AA-large"
+	WCAGLevelAAA      WCAGLevel = "AAA"
+	WCAGLevelAAALarge WCAGLevel = "AAA-large"
+)
+
+// wcagMinContrast maps each WCAG level to the minimum contrast ratio it requires.
+var wcagMinContrast = map[WCAGLevel]float64{
+	WCAGLevelAA:       4.5,
+	WCAGLevelAALarge:  3,
+	WCAGLevelAAA:      7,
+	WCAGLevelAAALarge: 4.5,
+}
+
+// MinContrast returns the minimum contrast ratio required by the level, or 0 if the level is unknown.
+func (l WCAGLevel) MinContrast() float64 {
+	return wcagMinContrast[l]
+}
+
+// RelativeLuminance returns the WCAG 2.1 relative luminance of the color, from 0 (black) to 1 (white).
+// The alpha component is ignored.
+func (c Color) RelativeLuminance() float64 {
+	linear := func(component uint8) float64 {
+		v := float64(component) / 255
+		if v <= 0.03928 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	return 0.2126*linear(c.rgba.R) + 0.7152*linear(c.rgba.G) + 0.0722*linear(c.rgba.B)
+}
+
+// ContrastRatio returns the WCAG 2.1 contrast ratio between two colors, from 1 to 21.
+// The alpha component of both colors is ignored; use IsReadableOn for translucent foregrounds.
+func (c Color) ContrastRatio(other Color) float64 {
+	l1, l2 := c.RelativeLuminance(), other.RelativeLuminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// IsReadableOn checks if the color, used as text, meets the WCAG 2.1 contrast level on the background.
+// A translucent foreground is first blended over the background, which is treated as opaque.
+// Returns false for an unknown level or if either color is the zero value.
+//
+// Example:
+//   text, _ := ParseColor("#767676")
+//   text.IsReadableOn(white, WCAGLevelAA)  // true (4.54:1)
+//   text.IsReadableOn(white, WCAGLevelAAA) // false
+func (c Color) IsReadableOn(background Color, level WCAGLevel) bool {
+	minContrast := level.MinContrast()
+	if minContrast == 0 || c.IsZero() || background.IsZero() {
+		return false
+	}
+	return c.blendOver(background).ContrastRatio(background) >= minContrast
+}
+
+// blendOver composites the color over an opaque background using its alpha component.
+func (c Color) blendOver(background Color) Color {
+	alpha := float64(c.rgba.A) / 255
+	blend := func(fg, bg uint8) uint8 {
+		return uint8(math.Round(float64(fg)*alpha + float64(bg)*(1-alpha)))
+	}
+	return NewColorRGBA(
+		blend(c.rgba.R, background.rgba.R),
+		blend(c.rgba.G, background.rgba.G),
+		blend(c.rgba.B, background.rgba.B),
+		255,
+	)
 }
 
 // String returns the hex string representation of the color.
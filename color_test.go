@@ -1,6 +1,7 @@
 package wisp_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -39,6 +40,29 @@ func (s *ColorSuite) TestParseColor() {
 		s.Equal("#00ff00", c.Hex())
 	})
 
+	s.Run("should parse an 8-digit hex color with alpha", func() {
+		c, err := wisp.ParseColor("#FF000080")
+		s.Require().NoError(err)
+		r, g, b, a := c.RGBA()
+		s.Equal([]uint8{255, 0, 0, 128}, []uint8{r, g, b, a})
+		s.Equal("#ff000080", c.Hex())
+		s.False(c.IsOpaque())
+	})
+
+	s.Run("should parse a 4-digit hex color with alpha", func() {
+		c, err := wisp.ParseColor("#f0c8")
+		s.Require().NoError(err)
+		s.Equal("#ff00cc88", c.Hex())
+		s.Equal(uint8(0x88), c.Alpha())
+	})
+
+	s.Run("should use the 6-digit form for opaque 8-digit input", func() {
+		c, err := wisp.ParseColor("#336699ff")
+		s.Require().NoError(err)
+		s.Equal("#336699", c.Hex())
+		s.True(c.IsOpaque())
+	})
+
 	s.Run("should fail for invalid formats", func() {
 		_, err := wisp.ParseColor("ff0000") // Missing '#'
 		s.Require().Error(err)
@@ -46,6 +70,9 @@ func (s *ColorSuite) TestParseColor() {
 		_, err = wisp.ParseColor("#12345") // Invalid length
 		s.Require().Error(err)
 
+		_, err = wisp.ParseColor("#123456789") // Invalid length
+		s.Require().Error(err)
+
 		_, err = wisp.ParseColor("#gg0000") // Invalid hex characters
 		s.Require().Error(err)
 	})
@@ -70,5 +97,224 @@ func (s *ColorSuite) TestColor_Methods() {
 	s.Run("IsZero", func() {
 		s.False(c.IsZero())
 		s.True(wisp.ZeroColor.IsZero())
+
+		black, err := wisp.ParseColor("#000")
+		s.Require().NoError(err)
+		s.False(black.IsZero())
+		s.Equal("#000000", black.Hex())
+
+		transparent, err := wisp.ParseColor("#00000000")
+		s.Require().NoError(err)
+		s.False(transparent.IsZero())
+		s.Equal("#00000000", transparent.Hex())
+	})
+
+	s.Run("WithAlpha", func() {
+		translucent := c.WithAlpha(0x40)
+		s.Equal("#33669940", translucent.Hex())
+		s.Equal("#336699", c.Hex())
+		s.Equal(c, translucent.WithAlpha(0xff))
+		s.False(wisp.ZeroColor.WithAlpha(0).IsZero())
+	})
+
+	s.Run("NewColorRGBA", func() {
+		s.Equal(c, wisp.NewColorRGBA(0x33, 0x66, 0x99, 0xff))
+	})
+}
+
+func (s *ColorSuite) TestColor_JSONAndSQL() {
+	c, _ := wisp.ParseColor("#33669980")
+
+	data, err := json.Marshal(c)
+	s.Require().NoError(err)
+	s.Equal(`"#33669980"`, string(data))
+
+	var decoded wisp.Color
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(c, decoded)
+
+	val, err := c.Value()
+	s.Require().NoError(err)
+	s.Equal("#33669980", val)
+
+	var scanned wisp.Color
+	s.Require().NoError(scanned.Scan([]byte("#000000")))
+	s.False(scanned.IsZero())
+	s.True(scanned.IsOpaque())
+
+	val, err = wisp.ZeroColor.Value()
+	s.Require().NoError(err)
+	s.Nil(val)
+}
+
+func (s *ColorSuite) TestColor_HSL() {
+	s.Run("should convert to HSL", func() {
+		c, _ := wisp.ParseColor("#336699")
+		h, sat, l := c.ToHSL()
+		s.InDelta(210.0, h, 0.01)
+		s.InDelta(0.5, sat, 0.01)
+		s.InDelta(0.4, l, 0.01)
+
+		h, sat, l = wisp.NewColorRGBA(128, 128, 128, 255).ToHSL()
+		s.Equal(0.0, h)
+		s.Equal(0.0, sat)
+		s.InDelta(0.502, l, 0.001)
+	})
+
+	s.Run("should convert from HSL", func() {
+		testCases := []struct {
+			h, s, l  float64
+			expected string
+		}{
+			{h: 0, s: 1, l: 0.5, expected: "#ff0000"},
+			{h: 120, s: 1, l: 0.5, expected: "#00ff00"},
+			{h: 240, s: 1, l: 0.5, expected: "#0000ff"},
+			{h: 210, s: 0.5, l: 0.4, expected: "#336699"},
+			{h: -120, s: 1, l: 0.5, expected: "#0000ff"},
+			{h: 0, s: 0, l: 1, expected: "#ffffff"},
+		}
+
+		for _, tc := range testCases {
+			c, err := wisp.NewColorFromHSL(tc.h, tc.s, tc.l)
+			s.Require().NoError(err)
+			s.Equal(tc.expected, c.Hex())
+		}
+
+		_, err := wisp.NewColorFromHSL(0, 1.5, 0.5)
+		s.Error(err)
+		_, err = wisp.NewColorFromHSL(0, 0.5, -0.1)
+		s.Error(err)
+	})
+
+	s.Run("should round-trip through HSL", func() {
+		for _, hex := range []string{"#336699", "#ffcc00", "#8a2be2", "#0a0a0a"} {
+			c, _ := wisp.ParseColor(hex)
+			back, err := wisp.NewColorFromHSL(c.ToHSL())
+			s.Require().NoError(err)
+			s.Equal(c, back, hex)
+		}
+	})
+}
+
+func (s *ColorSuite) TestColor_HSV() {
+	c, _ := wisp.ParseColor("#336699")
+	h, sat, v := c.ToHSV()
+	s.InDelta(210.0, h, 0.01)
+	s.InDelta(0.667, sat, 0.001)
+	s.InDelta(0.6, v, 0.001)
+
+	back, err := wisp.NewColorFromHSV(h, sat, v)
+	s.Require().NoError(err)
+	s.Equal(c, back)
+
+	_, err = wisp.NewColorFromHSV(0, 0.5, 2)
+	s.Error(err)
+}
+
+func (s *ColorSuite) TestColor_Manipulation() {
+	c, _ := wisp.ParseColor("#336699")
+	tenPercent, _ := wisp.NewPercentageFromFloat(0.1)
+
+	s.Run("Lighten and Darken", func() {
+		s.Equal("#4080bf", c.Lighten(tenPercent).Hex())
+		s.Equal("#264d73", c.Darken(tenPercent).Hex())
+
+		full, _ := wisp.NewPercentageFromFloat(1)
+		s.Equal("#ffffff", c.Lighten(full).Hex())
+		s.Equal("#000000", c.Darken(full).Hex())
+	})
+
+	s.Run("Saturate and Desaturate", func() {
+		s.Equal("#2966a3", c.Saturate(tenPercent).Hex())
+		s.Equal("#3d668f", c.Desaturate(tenPercent).Hex())
+	})
+
+	s.Run("should preserve alpha", func() {
+		translucent := c.WithAlpha(0x80)
+		s.Equal(uint8(0x80), translucent.Lighten(tenPercent).Alpha())
+	})
+
+	s.Run("should keep the zero value", func() {
+		s.True(wisp.ZeroColor.Lighten(tenPercent).IsZero())
+	})
+}
+
+func (s *ColorSuite) TestColor_Names() {
+	s.Run("should parse CSS color names", func() {
+		c, err := wisp.ParseColor("RebeccaPurple")
+		s.Require().NoError(err)
+		s.Equal("#663399", c.Hex())
+
+		c, err = wisp.ParseColor(" transparent ")
+		s.Require().NoError(err)
+		s.Equal("#00000000", c.Hex())
+
+		_, err = wisp.ParseColor("notacolor")
+		s.Error(err)
+	})
+
+	s.Run("Name", func() {
+		c, _ := wisp.ParseColor("#663399")
+		name, ok := c.Name()
+		s.True(ok)
+		s.Equal("rebeccapurple", name)
+
+		c, _ = wisp.ParseColor("grey")
+		name, ok = c.Name()
+		s.True(ok)
+		s.Equal("gray", name)
+
+		c, _ = wisp.ParseColor("#663398")
+		_, ok = c.Name()
+		s.False(ok)
+
+		_, ok = wisp.NewColorRGBA(0x66, 0x33, 0x99, 0x80).Name()
+		s.False(ok)
+	})
+
+	s.Run("NearestName", func() {
+		c, _ := wisp.ParseColor("#fe0101")
+		s.Equal("red", c.NearestName())
+
+		c, _ = wisp.ParseColor("#653298")
+		s.Equal("rebeccapurple", c.NearestName())
+
+		s.Equal("", wisp.ZeroColor.NearestName())
+	})
+}
+
+func (s *ColorSuite) TestColor_Contrast() {
+	white, _ := wisp.ParseColor("white")
+	black, _ := wisp.ParseColor("black")
+	gray, _ := wisp.ParseColor("#767676")
+
+	s.Run("RelativeLuminance", func() {
+		s.InDelta(1.0, white.RelativeLuminance(), 1e-9)
+		s.InDelta(0.0, black.RelativeLuminance(), 1e-9)
+	})
+
+	s.Run("ContrastRatio", func() {
+		s.InDelta(21.0, black.ContrastRatio(white), 1e-9)
+		s.InDelta(21.0, white.ContrastRatio(black), 1e-9)
+		s.InDelta(1.0, gray.ContrastRatio(gray), 1e-9)
+		s.InDelta(4.54, gray.ContrastRatio(white), 0.01)
+	})
+
+	s.Run("IsReadableOn", func() {
+		s.True(gray.IsReadableOn(white, wisp.WCAGLevelAA))
+		s.False(gray.IsReadableOn(white, wisp.WCAGLevelAAA))
+		s.True(gray.IsReadableOn(white, wisp.WCAGLevelAAALarge))
+
+		lightGray, _ := wisp.ParseColor("#949494")
+		s.False(lightGray.IsReadableOn(white, wisp.WCAGLevelAA))
+		s.True(lightGray.IsReadableOn(white, wisp.WCAGLevelAALarge))
+
+		s.False(black.IsReadableOn(white, wisp.WCAGLevel("AAAA")))
+		s.False(wisp.ZeroColor.IsReadableOn(white, wisp.WCAGLevelAA))
+	})
+
+	s.Run("should blend translucent foregrounds over the background", func() {
+		s.True(black.IsReadableOn(white, wisp.WCAGLevelAAA))
+		s.False(black.WithAlpha(0x40).IsReadableOn(white, wisp.WCAGLevelAA))
 	})
 }
@@ -0,0 +1,181 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/marcelofabianov/fault"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// maxCompanyNameLength is the longest legal name accepted by the CNPJ registry of the Receita Federal.
+const maxCompanyNameLength = 150
+
+// legalFormSuffixes maps the spellings of the legal form abbreviations that end company names to
+// their canonical form.
+var legalFormSuffixes = map[string]string{
+	"LTDA": "LTDA", "LTDA.": "LTDA",
+	"S.A.": "S.A.", "S.A": "S.A.", "S/A": "S.A.", "SA": "S.A.",
+	"ME": "ME", "EPP": "EPP", "MEI": "MEI", "EIRELI": "EIRELI", "SLU": "SLU",
+}
+
+// CompanyName is a value object representing the legal name of a Brazilian company (Razão Social),
+// as registered with the Receita Federal, for supplier and customer registries.
+//
+// The name is trimmed, inner whitespace is collapsed, and the legal form abbreviations at its end
+// are written in their canonical form ("ltda" becomes "LTDA", "s/a" becomes "S.A."); the rest of the
+// name keeps its casing. Equals ignores case, accents and punctuation, so the same company typed
+// differently in two systems is recognized.
+//
+// The zero value is EmptyCompanyName.
+//
+// Example:
+//   name, err := wisp.NewCompanyName("  Padaria   Silva ltda - me ")
+//   name.String() // "Padaria Silva LTDA - ME"
+//   other, _ := wisp.NewCompanyName("PADARIA SILVA LTDA ME")
+//   name.Equals(other) // true
+type CompanyName string
+
+// EmptyCompanyName represents the zero value for the CompanyName type.
+var EmptyCompanyName CompanyName
+
+// NewCompanyName creates a new CompanyName, normalizing whitespace and legal form suffixes.
+// Returns EmptyCompanyName for an empty input, or an error if the name has control characters or
+// more than 150 characters.
+func NewCompanyName(input string) (CompanyName, error) {
+	words := strings.Fields(input)
+	for i := len(words) - 1; i >= 0; i-- {
+		if words[i] == "-" {
+			continue
+		}
+		canonical, ok := legalFormSuffixes[strings.ToUpper(words[i])]
+		if !ok {
+			break
+		}
+		words[i] = canonical
+	}
+
+	name, err := validateBusinessName(strings.Join(words, " "), maxCompanyNameLength, "company name")
+	if err != nil {
+		return EmptyCompanyName, err
+	}
+	return CompanyName(name), nil
+}
+
+// validateBusinessName checks a normalized company or trade name against the registry limits.
+func validateBusinessName(name string, maxLength int, label string) (string, error) {
+	if strings.ContainsFunc(name, unicode.IsControl) || !utf8.ValidString(name) {
+		return "", fault.New(
+			label+" cannot contain control characters",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", name),
+		)
+	}
+	if length := utf8.RuneCountInString(name); length > maxLength {
+		return "", fault.New(
+			fmt.Sprintf("%s cannot be longer than %d characters", label, maxLength),
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("length", length),
+			fault.WithContext("max_length", maxLength),
+		)
+	}
+	return name, nil
+}
+
+// businessNameKey returns the form used to compare names: uppercase letters and digits only,
+// without accents.
+func businessNameKey(name string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	unaccented, _, err := transform.String(t, name)
+	if err != nil {
+		unaccented = name
+	}
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return -1
+	}, unaccented)
+}
+
+// String returns the normalized company name.
+func (c CompanyName) String() string {
+	return string(c)
+}
+
+// IsZero returns true if the CompanyName is the zero value.
+func (c CompanyName) IsZero() bool {
+	return c == EmptyCompanyName
+}
+
+// Equals checks if two company names are the same, ignoring case, accents, spaces and punctuation,
+// so "Acme S.A." equals "ACME SA".
+func (c CompanyName) Equals(other CompanyName) bool {
+	return businessNameKey(string(c)) == businessNameKey(string(other))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the CompanyName as a JSON string.
+func (c CompanyName) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a CompanyName, with normalization and validation.
+func (c *CompanyName) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "CompanyName must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	name, err := NewCompanyName(s)
+	if err != nil {
+		return err
+	}
+	*c = name
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the CompanyName as a string, or nil for the zero value.
+func (c CompanyName) Value() (driver.Value, error) {
+	if c.IsZero() {
+		return nil, nil
+	}
+	return c.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a CompanyName.
+func (c *CompanyName) Scan(src interface{}) error {
+	if src == nil {
+		*c = EmptyCompanyName
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for CompanyName",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	name, err := NewCompanyName(s)
+	if err != nil {
+		return err
+	}
+	*c = name
+	return nil
+}
@@ -0,0 +1,98 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type CompanyNameSuite struct {
+	suite.Suite
+}
+
+func TestCompanyNameSuite(t *testing.T) {
+	suite.Run(t, new(CompanyNameSuite))
+}
+
+func (s *CompanyNameSuite) TestNewCompanyName() {
+	s.Run("should normalize whitespace and legal form suffixes", func() {
+		testCases := []struct {
+			input    string
+			expected wisp.CompanyName
+		}{
+			{input: "  Padaria   Silva ltda - me ", expected: "Padaria Silva LTDA - ME"},
+			{input: "Acme Indústria s/a", expected: "Acme Indústria S.A."},
+			{input: "Acme sa", expected: "Acme S.A."},
+			{input: "Comércio Souza Ltda. EPP", expected: "Comércio Souza LTDA EPP"},
+			{input: "Me Leva Transportes Eireli", expected: "Me Leva Transportes EIRELI"},
+			{input: "Banco do Brasil S.A", expected: "Banco do Brasil S.A."},
+		}
+		for _, tc := range testCases {
+			name, err := wisp.NewCompanyName(tc.input)
+			s.Require().NoError(err, tc.input)
+			s.Equal(tc.expected, name)
+		}
+	})
+
+	s.Run("should return EmptyCompanyName for an empty input", func() {
+		name, err := wisp.NewCompanyName("   ")
+		s.Require().NoError(err)
+		s.True(name.IsZero())
+	})
+
+	s.Run("should accept names up to 150 characters", func() {
+		_, err := wisp.NewCompanyName(strings.Repeat("á", 150))
+		s.NoError(err)
+	})
+
+	s.Run("should reject invalid names", func() {
+		for _, input := range []string{strings.Repeat("A", 151), "Acme\x00 LTDA"} {
+			_, err := wisp.NewCompanyName(input)
+			s.Require().Error(err)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *CompanyNameSuite) TestEquals() {
+	a, _ := wisp.NewCompanyName("Acme Indústria S.A.")
+	b, _ := wisp.NewCompanyName("ACME INDUSTRIA SA")
+	c, _ := wisp.NewCompanyName("Acme Industria LTDA")
+	s.True(a.Equals(b))
+	s.False(a.Equals(c))
+}
+
+func (s *CompanyNameSuite) TestJSON() {
+	name, _ := wisp.NewCompanyName("Acme S.A.")
+	data, err := json.Marshal(name)
+	s.Require().NoError(err)
+	s.Equal(`"Acme S.A."`, string(data))
+
+	var decoded wisp.CompanyName
+	s.Require().NoError(json.Unmarshal([]byte(`" acme  ltda "`), &decoded))
+	s.Equal(wisp.CompanyName("acme LTDA"), decoded)
+	s.Error(json.Unmarshal([]byte(`1`), &decoded))
+}
+
+func (s *CompanyNameSuite) TestSQL() {
+	name, _ := wisp.NewCompanyName("Acme S.A.")
+	value, err := name.Value()
+	s.Require().NoError(err)
+	s.Equal("Acme S.A.", value)
+
+	value, err = wisp.EmptyCompanyName.Value()
+	s.Require().NoError(err)
+	s.Nil(value)
+
+	var scanned wisp.CompanyName
+	s.Require().NoError(scanned.Scan([]byte("Acme S.A.")))
+	s.Equal(name, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Error(scanned.Scan(1))
+}
@@ -0,0 +1,297 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// ContactChannel identifies the channel through which a person prefers to be reached.
+type ContactChannel string
+
+// Supported contact channels. SMS and WhatsApp are delivered to the contact's phone.
+const (
+	ContactChannelEmail    ContactChannel = "email"
+	ContactChannelPhone    ContactChannel = "phone"
+	ContactChannelSMS      ContactChannel = "sms"
+	ContactChannelWhatsApp ContactChannel = "whatsapp"
+)
+
+// EmptyContactChannel represents the zero value for the ContactChannel type.
+var EmptyContactChannel ContactChannel
+
+// NewContactChannel creates a new ContactChannel from a string, ignoring case.
+// Returns an error if the channel is not supported.
+func NewContactChannel(input string) (ContactChannel, error) {
+	channel := ContactChannel(strings.ToLower(strings.TrimSpace(input)))
+	if !channel.IsValid() {
+		return EmptyContactChannel, fault.New(
+			"invalid contact channel",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	return channel, nil
+}
+
+// IsValid checks if the channel is one of the supported channels.
+func (c ContactChannel) IsValid() bool {
+	switch c {
+	case ContactChannelEmail, ContactChannelPhone, ContactChannelSMS, ContactChannelWhatsApp:
+		return true
+	}
+	return false
+}
+
+// UsesPhone returns true if the channel is delivered to a phone number.
+func (c ContactChannel) UsesPhone() bool {
+	return c == ContactChannelPhone || c == ContactChannelSMS || c == ContactChannelWhatsApp
+}
+
+// String returns the channel name.
+func (c ContactChannel) String() string {
+	return string(c)
+}
+
+// IsZero returns true if the ContactChannel is the zero value.
+func (c ContactChannel) IsZero() bool {
+	return c == EmptyContactChannel
+}
+
+// Contact is a composite value object aggregating a Phone and an Email, a preferred
+// channel and per-channel opt-in flags, as typically stored in customer records.
+//
+// At least one of phone or email must be present, and the preferred channel must be
+// reachable with the data provided (e.g., "whatsapp" requires a phone).
+//
+// Example:
+//   contact, _ := wisp.NewContact(phone, email, wisp.ContactChannelWhatsApp)
+//   contact = contact.WithPhoneOptIn(true)
+//   contact.CanContactVia(wisp.ContactChannelWhatsApp) // true
+type Contact struct {
+	phone      Phone
+	email      Email
+	preferred  ContactChannel
+	phoneOptIn bool
+	emailOptIn bool
+}
+
+// ZeroContact represents the zero value for the Contact type.
+var ZeroContact Contact
+
+// NewContact creates a new Contact. If preferred is empty, it defaults to email when
+// available, otherwise phone. Opt-in flags start disabled.
+// Returns an error if no channel is present or the preferred channel is not reachable.
+func NewContact(phone Phone, email Email, preferred ContactChannel) (Contact, error) {
+	if phone.IsZero() && email.IsEmpty() {
+		return ZeroContact, fault.New(
+			"contact must have at least a phone or an email",
+			fault.WithCode(fault.Invalid),
+		)
+	}
+
+	if preferred.IsZero() {
+		preferred = ContactChannelEmail
+		if email.IsEmpty() {
+			preferred = ContactChannelPhone
+		}
+	}
+
+	if !preferred.IsValid() {
+		return ZeroContact, fault.New(
+			"invalid contact channel",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("preferred_channel", preferred.String()),
+		)
+	}
+
+	if (preferred.UsesPhone() && phone.IsZero()) || (preferred == ContactChannelEmail && email.IsEmpty()) {
+		return ZeroContact, fault.New(
+			"preferred contact channel has no corresponding contact data",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("preferred_channel", preferred.String()),
+		)
+	}
+
+	return Contact{phone: phone, email: email, preferred: preferred}, nil
+}
+
+// Phone returns the contact phone, which may be empty.
+func (c Contact) Phone() Phone {
+	return c.phone
+}
+
+// Email returns the contact email, which may be empty.
+func (c Contact) Email() Email {
+	return c.email
+}
+
+// Preferred returns the preferred contact channel.
+func (c Contact) Preferred() ContactChannel {
+	return c.preferred
+}
+
+// HasPhone returns true if the contact has a phone.
+func (c Contact) HasPhone() bool {
+	return !c.phone.IsZero()
+}
+
+// HasEmail returns true if the contact has an email.
+func (c Contact) HasEmail() bool {
+	return !c.email.IsEmpty()
+}
+
+// PhoneOptIn returns true if the person agreed to be contacted through phone channels.
+func (c Contact) PhoneOptIn() bool {
+	return c.phoneOptIn
+}
+
+// EmailOptIn returns true if the person agreed to be contacted by email.
+func (c Contact) EmailOptIn() bool {
+	return c.emailOptIn
+}
+
+// WithPhoneOptIn returns a copy of the Contact with the phone opt-in flag set.
+// Opting in is ignored when the contact has no phone.
+func (c Contact) WithPhoneOptIn(optIn bool) Contact {
+	c.phoneOptIn = optIn && c.HasPhone()
+	return c
+}
+
+// WithEmailOptIn returns a copy of the Contact with the email opt-in flag set.
+// Opting in is ignored when the contact has no email.
+func (c Contact) WithEmailOptIn(optIn bool) Contact {
+	c.emailOptIn = optIn && c.HasEmail()
+	return c
+}
+
+// WithPreferred returns a copy of the Contact with a new preferred channel.
+// Returns an error if the channel is invalid or not reachable.
+func (c Contact) WithPreferred(preferred ContactChannel) (Contact, error) {
+	updated, err := NewContact(c.phone, c.email, preferred)
+	if err != nil {
+		return ZeroContact, err
+	}
+	updated.phoneOptIn = c.phoneOptIn
+	updated.emailOptIn = c.emailOptIn
+	return updated, nil
+}
+
+// CanContactVia returns true if the channel has contact data and the person opted in to it.
+func (c Contact) CanContactVia(channel ContactChannel) bool {
+	switch {
+	case channel == ContactChannelEmail:
+		return c.HasEmail() && c.emailOptIn
+	case channel.UsesPhone():
+		return c.HasPhone() && c.phoneOptIn
+	}
+	return false
+}
+
+// IsZero returns true if the Contact is the zero value.
+func (c Contact) IsZero() bool {
+	return c == ZeroContact
+}
+
+// String returns the contact data for the preferred channel.
+func (c Contact) String() string {
+	if c.preferred == ContactChannelEmail {
+		return c.email.String()
+	}
+	return c.phone.String()
+}
+
+// Masked implements the Masker interface, returning the masked contact data for the preferred
+// channel, so Redact does not expose the phone or email.
+func (c Contact) Masked() string {
+	if c.preferred == ContactChannelEmail {
+		return c.email.Masked()
+	}
+	return c.phone.Masked()
+}
+
+type contactJSON struct {
+	Phone      Phone          `json:"phone,omitempty"`
+	Email      Email          `json:"email,omitempty"`
+	Preferred  ContactChannel `json:"preferred_channel"`
+	PhoneOptIn bool           `json:"phone_opt_in"`
+	EmailOptIn bool           `json:"email_opt_in"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the Contact to a single JSON object.
+func (c Contact) MarshalJSON() ([]byte, error) {
+	if c.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(contactJSON{
+		Phone:      c.phone,
+		Email:      c.email,
+		Preferred:  c.preferred,
+		PhoneOptIn: c.phoneOptIn,
+		EmailOptIn: c.emailOptIn,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object or null into a Contact, with validation.
+func (c *Contact) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*c = ZeroContact
+		return nil
+	}
+
+	var dto contactJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for Contact", fault.WithCode(fault.Invalid))
+	}
+
+	contact, err := NewContact(dto.Phone, dto.Email, dto.Preferred)
+	if err != nil {
+		return err
+	}
+	*c = contact.WithPhoneOptIn(dto.PhoneOptIn).WithEmailOptIn(dto.EmailOptIn)
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the Contact as a JSON string or nil if it's the zero value.
+func (c Contact) Value() (driver.Value, error) {
+	if c.IsZero() {
+		return nil, nil
+	}
+
+	data, err := c.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err, "failed to marshal contact for database storage", fault.WithCode(fault.Internal))
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as a Contact.
+func (c *Contact) Scan(src interface{}) error {
+	if src == nil {
+		*c = ZeroContact
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for Contact",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return c.UnmarshalJSON(data)
+}
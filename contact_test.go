@@ -0,0 +1,138 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type ContactSuite struct {
+	suite.Suite
+	phone wisp.Phone
+	email wisp.Email
+}
+
+func TestContactSuite(t *testing.T) {
+	suite.Run(t, new(ContactSuite))
+}
+
+func (s *ContactSuite) SetupTest() {
+	s.phone, _ = wisp.NewPhone("5562982870053")
+	s.email = wisp.MustNewEmail("cliente@example.com")
+}
+
+func (s *ContactSuite) TestNewContactChannel() {
+	channel, err := wisp.NewContactChannel(" WhatsApp ")
+	s.Require().NoError(err)
+	s.Equal(wisp.ContactChannelWhatsApp, channel)
+	s.True(channel.UsesPhone())
+	s.False(wisp.ContactChannelEmail.UsesPhone())
+
+	_, err = wisp.NewContactChannel("telegram")
+	s.Error(err)
+}
+
+func (s *ContactSuite) TestNewContact() {
+	s.Run("should default preferred channel to email", func() {
+		contact, err := wisp.NewContact(s.phone, s.email, wisp.EmptyContactChannel)
+		s.Require().NoError(err)
+		s.Equal(wisp.ContactChannelEmail, contact.Preferred())
+		s.Equal("cliente@example.com", contact.String())
+	})
+
+	s.Run("should default preferred channel to phone without email", func() {
+		contact, err := wisp.NewContact(s.phone, wisp.EmptyEmail, wisp.EmptyContactChannel)
+		s.Require().NoError(err)
+		s.Equal(wisp.ContactChannelPhone, contact.Preferred())
+		s.False(contact.HasEmail())
+	})
+
+	s.Run("should require at least one channel", func() {
+		_, err := wisp.NewContact(wisp.EmptyPhone, wisp.EmptyEmail, wisp.EmptyContactChannel)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+
+	s.Run("should reject unreachable preferred channel", func() {
+		_, err := wisp.NewContact(wisp.EmptyPhone, s.email, wisp.ContactChannelWhatsApp)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+
+	s.Run("should reject unknown preferred channel", func() {
+		_, err := wisp.NewContact(s.phone, s.email, wisp.ContactChannel("fax"))
+		s.Error(err)
+	})
+}
+
+func (s *ContactSuite) TestOptIn() {
+	contact, err := wisp.NewContact(s.phone, wisp.EmptyEmail, wisp.ContactChannelSMS)
+	s.Require().NoError(err)
+	s.False(contact.CanContactVia(wisp.ContactChannelSMS))
+
+	contact = contact.WithPhoneOptIn(true).WithEmailOptIn(true)
+	s.True(contact.PhoneOptIn())
+	s.False(contact.EmailOptIn())
+	s.True(contact.CanContactVia(wisp.ContactChannelSMS))
+	s.True(contact.CanContactVia(wisp.ContactChannelWhatsApp))
+	s.False(contact.CanContactVia(wisp.ContactChannelEmail))
+
+	updated, err := contact.WithPreferred(wisp.ContactChannelPhone)
+	s.Require().NoError(err)
+	s.True(updated.PhoneOptIn())
+	s.Equal(wisp.ContactChannelPhone, updated.Preferred())
+
+	_, err = contact.WithPreferred(wisp.ContactChannelEmail)
+	s.Error(err)
+}
+
+func (s *ContactSuite) TestJSON() {
+	contact, err := wisp.NewContact(s.phone, s.email, wisp.ContactChannelWhatsApp)
+	s.Require().NoError(err)
+	contact = contact.WithPhoneOptIn(true)
+
+	data, err := json.Marshal(contact)
+	s.Require().NoError(err)
+	s.JSONEq(`{"phone":"5562982870053","email":"cliente@example.com","preferred_channel":"whatsapp","phone_opt_in":true,"email_opt_in":false}`, string(data))
+
+	var decoded wisp.Contact
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(contact, decoded)
+
+	s.Require().NoError(json.Unmarshal([]byte(`{"email":"a@b.com","preferred_channel":"","email_opt_in":true}`), &decoded))
+	s.Equal(wisp.ContactChannelEmail, decoded.Preferred())
+	s.True(decoded.EmailOptIn())
+	s.False(decoded.HasPhone())
+
+	s.Error(json.Unmarshal([]byte(`{"preferred_channel":"email"}`), &decoded))
+	s.Error(json.Unmarshal([]byte(`{"email":"a@b.com","preferred_channel":"sms"}`), &decoded))
+
+	data, err = json.Marshal(wisp.ZeroContact)
+	s.Require().NoError(err)
+	s.Equal("null", string(data))
+}
+
+func (s *ContactSuite) TestSQL() {
+	contact, err := wisp.NewContact(s.phone, s.email, wisp.EmptyContactChannel)
+	s.Require().NoError(err)
+
+	val, err := contact.Value()
+	s.Require().NoError(err)
+
+	var scanned wisp.Contact
+	s.Require().NoError(scanned.Scan(val))
+	s.Equal(contact, scanned)
+
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+
+	val, err = wisp.ZeroContact.Value()
+	s.Require().NoError(err)
+	s.Nil(val)
+
+	s.Error(scanned.Scan(123))
+}
@@ -0,0 +1,153 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// CountryCode represents a country by its ISO 3166-1 alpha-2 code.
+// It is a value object that ensures the code is one of the officially assigned codes, plus "XK"
+// (Kosovo), which is user-assigned but used by banks and tax authorities.
+//
+// Examples:
+//   - Input: "br" or " BR "
+//   - Stored as: "BR"
+type CountryCode string
+
+// Defines commonly used country codes.
+const (
+	CountryBrazil       CountryCode = "BR"
+	CountryUnitedStates CountryCode = "US"
+)
+
+// EmptyCountryCode represents the zero value for the CountryCode type.
+var EmptyCountryCode CountryCode
+
+// validCountryCodes holds the set of all valid ISO 3166-1 alpha-2 codes.
+var validCountryCodes = map[CountryCode]struct{}{
+	"AD": {}, "AE": {}, "AF": {}, "AG": {}, "AI": {}, "AL": {}, "AM": {}, "AO": {}, "AQ": {}, "AR": {},
+	"AS": {}, "AT": {}, "AU": {}, "AW": {}, "AX": {}, "AZ": {}, "BA": {}, "BB": {}, "BD": {}, "BE": {},
+	"BF": {}, "BG": {}, "BH": {}, "BI": {}, "BJ": {}, "BL": {}, "BM": {}, "BN": {}, "BO": {}, "BQ": {},
+	"BR": {}, "BS": {}, "BT": {}, "BV": {}, "BW": {}, "BY": {}, "BZ": {}, "CA": {}, "CC": {}, "CD": {},
+	"CF": {}, "CG": {}, "CH": {}, "CI": {}, "CK": {}, "CL": {}, "CM": {}, "CN": {}, "CO": {}, "CR": {},
+	"CU": {}, "CV": {}, "CW": {}, "CX": {}, "CY": {}, "CZ": {}, "DE": {}, "DJ": {}, "DK": {}, "DM": {},
+	"DO": {}, "DZ": {}, "EC": {}, "EE": {}, "EG": {}, "EH": {}, "ER": {}, "ES": {}, "ET": {}, "FI": {},
+	"FJ": {}, "FK": {}, "FM": {}, "FO": {}, "FR": {}, "GA": {}, "GB": {}, "GD": {}, "GE": {}, "GF": {},
+	"GG": {}, "GH": {}, "GI": {}, "GL": {}, "GM": {}, "GN": {}, "GP": {}, "GQ": {}, "GR": {}, "GS": {},
+	"GT": {}, "GU": {}, "GW": {}, "GY": {}, "HK": {}, "HM": {}, "HN": {}, "HR": {}, "HT": {}, "HU": {},
+	"ID": {}, "IE": {}, "IL": {}, "IM": {}, "IN": {}, "IO": {}, "IQ": {}, "IR": {}, "IS": {}, "IT": {},
+	"JE": {}, "JM": {}, "JO": {}, "JP": {}, "KE": {}, "KG": {}, "KH": {}, "KI": {}, "KM": {}, "KN": {},
+	"KP": {}, "KR": {}, "KW": {}, "KY": {}, "KZ": {}, "LA": {}, "LB": {}, "LC": {}, "LI": {}, "LK": {},
+	"LR": {}, "LS": {}, "LT": {}, "LU": {}, "LV": {}, "LY": {}, "MA": {}, "MC": {}, "MD": {}, "ME": {},
+	"MF": {}, "MG": {}, "MH": {}, "MK": {}, "ML": {}, "MM": {}, "MN": {}, "MO": {}, "MP": {}, "MQ": {},
+	"MR": {}, "MS": {}, "MT": {}, "MU": {}, "MV": {}, "MW": {}, "MX": {}, "MY": {}, "MZ": {}, "NA": {},
+	"NC": {}, "NE": {}, "NF": {}, "NG": {}, "NI": {}, "NL": {}, "NO": {}, "NP": {}, "NR": {}, "NU": {},
+	"NZ": {}, "OM": {}, "PA": {}, "PE": {}, "PF": {}, "PG": {}, "PH": {}, "PK": {}, "PL": {}, "PM": {},
+	"PN": {}, "PR": {}, "PS": {}, "PT": {}, "PW": {}, "PY": {}, "QA": {}, "RE": {}, "RO": {}, "RS": {},
+	"RU": {}, "RW": {}, "SA": {}, "SB": {}, "SC": {}, "SD": {}, "SE": {}, "SG": {}, "SH": {}, "SI": {},
+	"SJ": {}, "SK": {}, "SL": {}, "SM": {}, "SN": {}, "SO": {}, "SR": {}, "SS": {}, "ST": {}, "SV": {},
+	"SX": {}, "SY": {}, "SZ": {}, "TC": {}, "TD": {}, "TF": {}, "TG": {}, "TH": {}, "TJ": {}, "TK": {},
+	"TL": {}, "TM": {}, "TN": {}, "TO": {}, "TR": {}, "TT": {}, "TV": {}, "TW": {}, "TZ": {}, "UA": {},
+	"UG": {}, "UM": {}, "US": {}, "UY": {}, "UZ": {}, "VA": {}, "VC": {}, "VE": {}, "VG": {}, "VI": {},
+	"VN": {}, "VU": {}, "WF": {}, "WS": {}, "XK": {}, "YE": {}, "YT": {}, "ZA": {}, "ZM": {}, "ZW": {},
+}
+
+// NewCountryCode creates a new CountryCode from a string.
+// It normalizes the input to uppercase and validates it against the ISO 3166-1 alpha-2 codes.
+// Returns an error if the code is not a valid country code.
+func NewCountryCode(input string) (CountryCode, error) {
+	code := CountryCode(strings.ToUpper(strings.TrimSpace(input)))
+
+	if code.IsZero() {
+		return EmptyCountryCode, nil
+	}
+
+	if !code.IsValid() {
+		return EmptyCountryCode, fault.New(
+			"invalid country code",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_code", input),
+		)
+	}
+	return code, nil
+}
+
+// String returns the country code as a string.
+func (c CountryCode) String() string {
+	return string(c)
+}
+
+// IsValid checks if the code is an ISO 3166-1 alpha-2 country code.
+func (c CountryCode) IsValid() bool {
+	_, ok := validCountryCodes[c]
+	return ok
+}
+
+// IsZero returns true if the CountryCode is the zero value.
+func (c CountryCode) IsZero() bool {
+	return c == EmptyCountryCode
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (c CountryCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a CountryCode, with validation.
+func (c *CountryCode) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "CountryCode must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	code, err := NewCountryCode(s)
+	if err != nil {
+		return err
+	}
+	*c = code
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the country code as a string, or nil for the zero value.
+func (c CountryCode) Value() (driver.Value, error) {
+	if c.IsZero() {
+		return nil, nil
+	}
+	return c.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a CountryCode, with validation.
+func (c *CountryCode) Scan(src interface{}) error {
+	if src == nil {
+		*c = EmptyCountryCode
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for CountryCode",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	code, err := NewCountryCode(s)
+	if err != nil {
+		return err
+	}
+	*c = code
+	return nil
+}
@@ -0,0 +1,84 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type CountryCodeSuite struct {
+	suite.Suite
+}
+
+func TestCountryCodeSuite(t *testing.T) {
+	suite.Run(t, new(CountryCodeSuite))
+}
+
+func (s *CountryCodeSuite) TestNewCountryCode() {
+	s.Run("should normalize valid codes", func() {
+		testCases := []struct {
+			input    string
+			expected wisp.CountryCode
+		}{
+			{input: "BR", expected: wisp.CountryBrazil},
+			{input: " us ", expected: wisp.CountryUnitedStates},
+			{input: "pt", expected: "PT"},
+			{input: "XK", expected: "XK"},
+		}
+		for _, tc := range testCases {
+			code, err := wisp.NewCountryCode(tc.input)
+			s.Require().NoError(err, tc.input)
+			s.Equal(tc.expected, code)
+			s.True(code.IsValid())
+		}
+	})
+
+	s.Run("should return EmptyCountryCode for an empty input", func() {
+		code, err := wisp.NewCountryCode("  ")
+		s.Require().NoError(err)
+		s.True(code.IsZero())
+	})
+
+	s.Run("should reject unknown codes", func() {
+		for _, input := range []string{"XX", "BRA", "B", "UK"} {
+			_, err := wisp.NewCountryCode(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *CountryCodeSuite) TestJSON() {
+	data, err := json.Marshal(wisp.CountryBrazil)
+	s.Require().NoError(err)
+	s.Equal(`"BR"`, string(data))
+
+	var code wisp.CountryCode
+	s.Require().NoError(json.Unmarshal([]byte(`"de"`), &code))
+	s.Equal(wisp.CountryCode("DE"), code)
+
+	s.Error(json.Unmarshal([]byte(`"XX"`), &code))
+	s.Error(json.Unmarshal([]byte(`1`), &code))
+}
+
+func (s *CountryCodeSuite) TestSQL() {
+	value, err := wisp.CountryBrazil.Value()
+	s.Require().NoError(err)
+	s.Equal("BR", value)
+
+	value, err = wisp.EmptyCountryCode.Value()
+	s.Require().NoError(err)
+	s.Nil(value)
+
+	var code wisp.CountryCode
+	s.Require().NoError(code.Scan([]byte("us")))
+	s.Equal(wisp.CountryUnitedStates, code)
+	s.Require().NoError(code.Scan(nil))
+	s.True(code.IsZero())
+	s.Error(code.Scan("XX"))
+	s.Error(code.Scan(1))
+}
@@ -4,7 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
-	"strconv"
+	"slices"
 
 	"github.com/marcelofabianov/fault"
 )
@@ -27,69 +27,54 @@ type CPF string
 // EmptyCPF represents the zero value for CPF type.
 var EmptyCPF CPF
 
-func parseCPF(input string) (CPF, error) {
-	if input == "" {
+// parseCPF validates and normalizes a CPF from string or []byte input.
+// Working on both input kinds lets database scans skip the intermediate string conversion.
+func parseCPF[T string | []byte](input T) (CPF, error) {
+	if len(input) == 0 {
 		return EmptyCPF, nil
 	}
 
-	sanitized := nonDigitRegex.ReplaceAllString(input, "")
-
-	if len(sanitized) != 11 {
-		return EmptyCPF, fault.New("CPF must have 11 digits", fault.WithCode(fault.Invalid), fault.WithContext("input", input))
+	var digits [11]byte
+	if extractDigits(input, digits[:]) != 11 {
+		return EmptyCPF, fault.New("CPF must have 11 digits", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
 	}
 
 	// Check for invalid known sequences (e.g., "11111111111")
 	allSame := true
 	for i := 1; i < 11; i++ {
-		if sanitized[i] != sanitized[0] {
+		if digits[i] != digits[0] {
 			allSame = false
 			break
 		}
 	}
 	if allSame {
-		return EmptyCPF, fault.New("invalid CPF sequence of repeated digits", fault.WithCode(fault.Invalid), fault.WithContext("input", input))
+		return EmptyCPF, fault.New("invalid CPF sequence of repeated digits", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
 	}
 
-	// Calculate check digits
-	var d1, d2 int
-
-	// First check digit
-	sum1 := 0
-	for i := 0; i < 9; i++ {
-		digit, _ := strconv.Atoi(string(sanitized[i]))
-		sum1 += digit * (10 - i)
+	if cpfCheckDigit(digits[:9]) != int(digits[9]-'0') {
+		return EmptyCPF, fault.New("invalid CPF check digit 1", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
 	}
-	remainder1 := sum1 % 11
-	if remainder1 < 2 {
-		d1 = 0
-	} else {
-		d1 = 11 - remainder1
+	if cpfCheckDigit(digits[:10]) != int(digits[10]-'0') {
+		return EmptyCPF, fault.New("invalid CPF check digit 2", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
 	}
 
-	d1Str, _ := strconv.Atoi(string(sanitized[9]))
-	if d1 != d1Str {
-		return EmptyCPF, fault.New("invalid CPF check digit 1", fault.WithCode(fault.Invalid), fault.WithContext("input", input))
-	}
+	return CPF(digits[:]), nil
+}
 
-	// Second check digit
-	sum2 := 0
-	for i := 0; i < 10; i++ {
-		digit, _ := strconv.Atoi(string(sanitized[i]))
-		sum2 += digit * (11 - i)
+// cpfCheckDigit calculates the CPF check digit for the given ASCII digits using the official
+// modulo 11 algorithm: weights start at len(digits)+1 and decrease down to 2.
+func cpfCheckDigit(digits []byte) int {
+	sum := 0
+	weight := len(digits) + 1
+	for _, d := range digits {
+		sum += int(d-'0') * weight
+		weight--
 	}
-	remainder2 := sum2 % 11
-	if remainder2 < 2 {
-		d2 = 0
-	} else {
-		d2 = 11 - remainder2
+	remainder := sum % 11
+	if remainder < 2 {
+		return 0
 	}
-
-	d2Str, _ := strconv.Atoi(string(sanitized[10]))
-	if d2 != d2Str {
-		return EmptyCPF, fault.New("invalid CPF check digit 2", fault.WithCode(fault.Invalid), fault.WithContext("input", input))
-	}
-
-	return CPF(sanitized), nil
+	return 11 - remainder
 }
 
 // NewCPF creates a new CPF from the given input string.
@@ -135,6 +120,75 @@ func (c CPF) Formatted() string {
 	return fmt.Sprintf("%s.%s.%s-%s", c[0:3], c[3:6], c[6:9], c[9:11])
 }
 
+// Masked returns the CPF formatted with only the middle six digits visible ("***.456.789-**"),
+// the pattern used by Brazilian government portals, so it can be logged or displayed under LGPD.
+// Returns an empty string for EmptyCPF.
+func (c CPF) Masked() string {
+	if len(c) != 11 {
+		return ""
+	}
+	return fmt.Sprintf("***.%s.%s-**", c[3:6], c[6:9])
+}
+
+// FiscalRegion returns the Receita Federal fiscal region that issued the CPF, encoded in its 9th digit.
+// It returns zero for an invalid or empty CPF.
+//
+// Example:
+//   cpf, _ := NewCPF("529.982.247-25")
+//   cpf.FiscalRegion()                 // 7 (ES, RJ)
+//   cpf.FiscalRegion().Includes("SP")  // false
+func (c CPF) FiscalRegion() FiscalRegion {
+	if len(c) != 11 {
+		return 0
+	}
+	if c[8] == '0' {
+		return 10
+	}
+	return FiscalRegion(c[8] - '0')
+}
+
+// FiscalRegion is one of the ten fiscal regions ("Regiões Fiscais") of the Receita Federal,
+// numbered from 1 to 10, each grouping the states where a CPF can be issued.
+type FiscalRegion int
+
+// fiscalRegionStates maps each fiscal region to its states.
+var fiscalRegionStates = map[FiscalRegion][]UF{
+	1:  {"DF", "GO", "MS", "MT", "TO"},
+	2:  {"AC", "AM", "AP", "PA", "RO", "RR"},
+	3:  {"CE", "MA", "PI"},
+	4:  {"AL", "PB", "PE", "RN"},
+	5:  {"BA", "SE"},
+	6:  {"MG"},
+	7:  {"ES", "RJ"},
+	8:  {"SP"},
+	9:  {"PR", "SC"},
+	10: {"RS"},
+}
+
+// States returns the states of the fiscal region, or nil if the region is not valid.
+func (r FiscalRegion) States() []UF {
+	return slices.Clone(fiscalRegionStates[r])
+}
+
+// Includes checks if the state belongs to the fiscal region, for example to compare where
+// a CPF was issued with the address informed by its holder.
+func (r FiscalRegion) Includes(uf UF) bool {
+	return slices.Contains(fiscalRegionStates[r], uf)
+}
+
+// IsZero returns true if the FiscalRegion is the zero value.
+func (r FiscalRegion) IsZero() bool {
+	return r == 0
+}
+
+// String returns the region in the Receita Federal notation (e.g., "8ª Região Fiscal").
+func (r FiscalRegion) String() string {
+	if r.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%dª Região Fiscal", int(r))
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 // It serializes the CPF as a JSON string without formatting.
 func (c CPF) MarshalJSON() ([]byte, error) {
@@ -173,17 +227,17 @@ func (c *CPF) Scan(src interface{}) error {
 		return nil
 	}
 
-	var s string
+	var cpf CPF
+	var err error
 	switch v := src.(type) {
 	case string:
-		s = v
+		cpf, err = parseCPF(v)
 	case []byte:
-		s = string(v)
+		cpf, err = parseCPF(v)
 	default:
 		return fault.New("unsupported scan type for CPF", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
 	}
 
-	cpf, err := NewCPF(s)
 	if err != nil {
 		return err
 	}
@@ -76,6 +76,33 @@ func (s *CPFSuite) TestCPF_Methods() {
 	})
 }
 
+func (s *CPFSuite) TestCPF_FiscalRegion() {
+	s.Run("should read the region from the 9th digit", func() {
+		cpf, err := wisp.NewCPF("529.982.247-25")
+		s.Require().NoError(err)
+
+		region := cpf.FiscalRegion()
+		s.Equal(wisp.FiscalRegion(7), region)
+		s.Equal([]wisp.UF{"ES", "RJ"}, region.States())
+		s.True(region.Includes("RJ"))
+		s.False(region.Includes("SP"))
+		s.Equal("7ª Região Fiscal", region.String())
+	})
+
+	s.Run("should map the digit 0 to the 10th region", func() {
+		cpf, err := wisp.NewCPF("12345678062")
+		s.Require().NoError(err)
+		s.Equal(wisp.FiscalRegion(10), cpf.FiscalRegion())
+		s.Equal([]wisp.UF{"RS"}, cpf.FiscalRegion().States())
+	})
+
+	s.Run("should return zero for an empty CPF", func() {
+		s.True(wisp.EmptyCPF.FiscalRegion().IsZero())
+		s.Nil(wisp.EmptyCPF.FiscalRegion().States())
+		s.Equal("", wisp.EmptyCPF.FiscalRegion().String())
+	})
+}
+
 func (s *CPFSuite) TestCPF_JSONMarshaling() {
 	s.Run("should marshal and unmarshal a valid CPF", func() {
 		cpf, _ := wisp.NewCPF(s.validCPFUnmasked)
@@ -129,5 +156,29 @@ func (s *CPFSuite) TestCPF_DatabaseInterface() {
 			err := scannedCPF.Scan("99988877766")
 			s.Require().Error(err)
 		})
+
+		s.Run("should scan a valid byte slice", func() {
+			var scannedCPF wisp.CPF
+			err := scannedCPF.Scan([]byte(s.validCPFFormatted))
+			s.Require().NoError(err)
+			s.Equal(cpf, scannedCPF)
+		})
+
+		s.Run("should fail to scan an invalid byte slice", func() {
+			var scannedCPF wisp.CPF
+			err := scannedCPF.Scan([]byte("99988877766"))
+			s.Require().Error(err)
+		})
 	})
 }
+
+func BenchmarkCPF_ScanBytes(b *testing.B) {
+	src := []byte("86222616038")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var cpf wisp.CPF
+		if err := cpf.Scan(src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
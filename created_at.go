@@ -18,9 +18,10 @@ import (
 //	myObject.CreatedAt = wisp.NewCreatedAt()
 type CreatedAt time.Time
 
-// NewCreatedAt creates a new CreatedAt timestamp, capturing the current time in UTC.
+// NewCreatedAt creates a new CreatedAt timestamp, capturing the current time in UTC
+// truncated to the configured precision (see SetTimestampPrecision).
 func NewCreatedAt() CreatedAt {
-	return CreatedAt(time.Now().UTC())
+	return CreatedAt(nowTimestamp())
 }
 
 // Time returns the underlying time.Time value.
@@ -0,0 +1,150 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// csosnDescriptions holds the valid CSOSN codes and their descriptions.
+var csosnDescriptions = map[CSOSN]string{
+	"101": "Tributada pelo Simples Nacional com permissão de crédito",
+	"102": "Tributada pelo Simples Nacional sem permissão de crédito",
+	"103": "Isenção do ICMS no Simples Nacional para faixa de receita bruta",
+	"201": "Tributada pelo Simples Nacional com permissão de crédito e com cobrança do ICMS por substituição tributária",
+	"202": "Tributada pelo Simples Nacional sem permissão de crédito e com cobrança do ICMS por substituição tributária",
+	"203": "Isenção do ICMS no Simples Nacional para faixa de receita bruta e com cobrança do ICMS por substituição tributária",
+	"300": "Imune",
+	"400": "Não tributada pelo Simples Nacional",
+	"500": "ICMS cobrado anteriormente por substituição tributária (substituído) ou por antecipação",
+	"900": "Outros",
+}
+
+// CSOSN is a value object representing the Código de Situação da Operação no Simples Nacional,
+// informed instead of the CST in the items of an NF-e issued by companies in the Simples Nacional.
+//
+// The zero value is EmptyCSOSN.
+//
+// Examples:
+//   csosn, err := wisp.NewCSOSN("101")
+//   csosn.AllowsCredit() // true
+type CSOSN string
+
+// EmptyCSOSN represents the zero value for the CSOSN type.
+var EmptyCSOSN CSOSN
+
+// NewCSOSN creates a new CSOSN from its 3 digits.
+// Returns EmptyCSOSN for an empty input, or an error if the code is not in the table.
+func NewCSOSN(input string) (CSOSN, error) {
+	trimmed := strings.TrimSpace(input)
+	if trimmed == "" {
+		return EmptyCSOSN, nil
+	}
+
+	csosn := CSOSN(trimmed)
+	if !csosn.IsValid() {
+		return EmptyCSOSN, fault.New("invalid CSOSN", fault.WithCode(fault.Invalid), fault.WithContext("input_value", input))
+	}
+	return csosn, nil
+}
+
+// Description returns the description of the code in the CSOSN table, or an empty string for the
+// zero value.
+func (c CSOSN) Description() string {
+	return csosnDescriptions[c]
+}
+
+// AllowsCredit returns true if the buyer may take the ICMS credit informed in the NF-e: codes 101
+// and 201.
+func (c CSOSN) AllowsCredit() bool {
+	return c == "101" || c == "201"
+}
+
+// HasTaxSubstitution returns true if the ICMS is charged or was charged before by substitution
+// (substituição tributária): codes 201, 202, 203 and 500.
+func (c CSOSN) HasTaxSubstitution() bool {
+	switch c {
+	case "201", "202", "203", "500":
+		return true
+	}
+	return false
+}
+
+// IsValid checks if the code is in the CSOSN table.
+func (c CSOSN) IsValid() bool {
+	_, ok := csosnDescriptions[c]
+	return ok
+}
+
+// String returns the code as 3 digits.
+func (c CSOSN) String() string {
+	return string(c)
+}
+
+// IsZero returns true if the CSOSN is the zero value.
+func (c CSOSN) IsZero() bool {
+	return c == EmptyCSOSN
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the CSOSN as a JSON string.
+func (c CSOSN) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a CSOSN, with validation.
+func (c *CSOSN) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "CSOSN must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	csosn, err := NewCSOSN(s)
+	if err != nil {
+		return err
+	}
+	*c = csosn
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the CSOSN as a string, or nil for the zero value.
+func (c CSOSN) Value() (driver.Value, error) {
+	if c.IsZero() {
+		return nil, nil
+	}
+	return c.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a CSOSN, with validation.
+func (c *CSOSN) Scan(src interface{}) error {
+	if src == nil {
+		*c = EmptyCSOSN
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for CSOSN",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	csosn, err := NewCSOSN(s)
+	if err != nil {
+		return err
+	}
+	*c = csosn
+	return nil
+}
@@ -0,0 +1,100 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type CSOSNSuite struct {
+	suite.Suite
+}
+
+func TestCSOSNSuite(t *testing.T) {
+	suite.Run(t, new(CSOSNSuite))
+}
+
+func (s *CSOSNSuite) TestNewCSOSN() {
+	s.Run("should accept codes in the table", func() {
+		for _, input := range []string{"101", "102", "103", "201", "202", "203", "300", "400", "500", " 900 "} {
+			csosn, err := wisp.NewCSOSN(input)
+			s.Require().NoError(err, input)
+			s.NotEmpty(csosn.Description())
+		}
+	})
+
+	s.Run("should return the zero value for an empty input", func() {
+		csosn, err := wisp.NewCSOSN("")
+		s.Require().NoError(err)
+		s.True(csosn.IsZero())
+	})
+
+	s.Run("should reject codes outside the table", func() {
+		for _, input := range []string{"100", "104", "00", "60", "1O1"} {
+			_, err := wisp.NewCSOSN(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *CSOSNSuite) TestRules() {
+	testCases := []struct {
+		code                 string
+		credit, substitution bool
+	}{
+		{"101", true, false},
+		{"102", false, false},
+		{"201", true, true},
+		{"202", false, true},
+		{"203", false, true},
+		{"500", false, true},
+		{"900", false, false},
+	}
+	for _, tc := range testCases {
+		csosn, err := wisp.NewCSOSN(tc.code)
+		s.Require().NoError(err)
+		s.Equal(tc.credit, csosn.AllowsCredit(), tc.code)
+		s.Equal(tc.substitution, csosn.HasTaxSubstitution(), tc.code)
+	}
+}
+
+func (s *CSOSNSuite) TestSerialization() {
+	s.Run("should round-trip through JSON", func() {
+		csosn, _ := wisp.NewCSOSN("102")
+		data, err := json.Marshal(csosn)
+		s.Require().NoError(err)
+		s.Equal(`"102"`, string(data))
+
+		var decoded wisp.CSOSN
+		s.Require().NoError(json.Unmarshal([]byte(`"500"`), &decoded))
+		s.Equal("500", decoded.String())
+		s.Error(json.Unmarshal([]byte(`"501"`), &decoded))
+		s.Error(json.Unmarshal([]byte(`500`), &decoded))
+	})
+
+	s.Run("should store and scan database values", func() {
+		csosn, _ := wisp.NewCSOSN("900")
+		value, err := csosn.Value()
+		s.Require().NoError(err)
+		s.Equal("900", value)
+
+		value, err = wisp.EmptyCSOSN.Value()
+		s.Require().NoError(err)
+		s.Nil(value)
+
+		var scanned wisp.CSOSN
+		s.Require().NoError(scanned.Scan([]byte("300")))
+		s.Equal("300", scanned.String())
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		err = scanned.Scan(300)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
@@ -0,0 +1,153 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// cstDescriptions holds the valid ICMS tax situation codes (Tabela B of the CST) and their
+// descriptions.
+var cstDescriptions = map[CST]string{
+	"00": "Tributada integralmente",
+	"02": "Tributação monofásica própria sobre combustíveis",
+	"10": "Tributada e com cobrança do ICMS por substituição tributária",
+	"15": "Tributação monofásica própria e com responsabilidade pela retenção sobre combustíveis",
+	"20": "Com redução de base de cálculo",
+	"30": "Isenta ou não tributada e com cobrança do ICMS por substituição tributária",
+	"40": "Isenta",
+	"41": "Não tributada",
+	"50": "Suspensão",
+	"51": "Diferimento",
+	"53": "Tributação monofásica sobre combustíveis com recolhimento diferido",
+	"60": "ICMS cobrado anteriormente por substituição tributária",
+	"61": "Tributação monofásica sobre combustíveis cobrada anteriormente",
+	"70": "Com redução de base de cálculo e cobrança do ICMS por substituição tributária",
+	"90": "Outras",
+}
+
+// CST is a value object representing the ICMS Código de Situação Tributária informed in the items
+// of an NF-e by companies outside the Simples Nacional, which use CSOSN instead. It holds the
+// 2 digits of the tax situation (Tabela B); the origin of the goods (Tabela A) is a separate field
+// of the NF-e.
+//
+// The zero value is EmptyCST.
+//
+// Examples:
+//   cst, err := wisp.NewCST("60")
+//   cst.Description()        // "ICMS cobrado anteriormente por substituição tributária"
+//   cst.HasTaxSubstitution() // true
+type CST string
+
+// EmptyCST represents the zero value for the CST type.
+var EmptyCST CST
+
+// NewCST creates a new CST from its 2 digits.
+// Returns EmptyCST for an empty input, or an error if the code is not in the table.
+func NewCST(input string) (CST, error) {
+	trimmed := strings.TrimSpace(input)
+	if trimmed == "" {
+		return EmptyCST, nil
+	}
+
+	cst := CST(trimmed)
+	if !cst.IsValid() {
+		return EmptyCST, fault.New("invalid ICMS CST", fault.WithCode(fault.Invalid), fault.WithContext("input_value", input))
+	}
+	return cst, nil
+}
+
+// Description returns the description of the code in the CST table, or an empty string for the
+// zero value.
+func (c CST) Description() string {
+	return cstDescriptions[c]
+}
+
+// HasTaxSubstitution returns true if the ICMS is charged or was charged before by substitution
+// (substituição tributária): codes 10, 30, 60 and 70.
+func (c CST) HasTaxSubstitution() bool {
+	switch c {
+	case "10", "30", "60", "70":
+		return true
+	}
+	return false
+}
+
+// IsValid checks if the code is in the CST table.
+func (c CST) IsValid() bool {
+	_, ok := cstDescriptions[c]
+	return ok
+}
+
+// String returns the code as 2 digits.
+func (c CST) String() string {
+	return string(c)
+}
+
+// IsZero returns true if the CST is the zero value.
+func (c CST) IsZero() bool {
+	return c == EmptyCST
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the CST as a JSON string.
+func (c CST) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a CST, with validation.
+func (c *CST) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "CST must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	cst, err := NewCST(s)
+	if err != nil {
+		return err
+	}
+	*c = cst
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the CST as a string, or nil for the zero value.
+func (c CST) Value() (driver.Value, error) {
+	if c.IsZero() {
+		return nil, nil
+	}
+	return c.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a CST, with validation.
+func (c *CST) Scan(src interface{}) error {
+	if src == nil {
+		*c = EmptyCST
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for CST",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	cst, err := NewCST(s)
+	if err != nil {
+		return err
+	}
+	*c = cst
+	return nil
+}
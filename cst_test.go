@@ -0,0 +1,97 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type CSTSuite struct {
+	suite.Suite
+}
+
+func TestCSTSuite(t *testing.T) {
+	suite.Run(t, new(CSTSuite))
+}
+
+func (s *CSTSuite) TestNewCST() {
+	s.Run("should accept codes in the table", func() {
+		for _, input := range []string{"00", "10", "20", "41", " 60 ", "90", "61"} {
+			cst, err := wisp.NewCST(input)
+			s.Require().NoError(err, input)
+			s.True(cst.IsValid())
+			s.NotEmpty(cst.Description())
+		}
+	})
+
+	s.Run("should return the zero value for an empty input", func() {
+		cst, err := wisp.NewCST("")
+		s.Require().NoError(err)
+		s.True(cst.IsZero())
+	})
+
+	s.Run("should reject codes outside the table", func() {
+		for _, input := range []string{"0", "01", "060", "99", "AB", "101"} {
+			_, err := wisp.NewCST(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *CSTSuite) TestDescriptionAndSubstitution() {
+	cst, _ := wisp.NewCST("60")
+	s.Equal("ICMS cobrado anteriormente por substituição tributária", cst.Description())
+	s.True(cst.HasTaxSubstitution())
+
+	for _, code := range []string{"10", "30", "70"} {
+		cst, _ := wisp.NewCST(code)
+		s.True(cst.HasTaxSubstitution(), code)
+	}
+	for _, code := range []string{"00", "20", "40", "90"} {
+		cst, _ := wisp.NewCST(code)
+		s.False(cst.HasTaxSubstitution(), code)
+	}
+
+	s.Empty(wisp.EmptyCST.Description())
+}
+
+func (s *CSTSuite) TestSerialization() {
+	s.Run("should round-trip through JSON", func() {
+		cst, _ := wisp.NewCST("00")
+		data, err := json.Marshal(cst)
+		s.Require().NoError(err)
+		s.Equal(`"00"`, string(data))
+
+		var decoded wisp.CST
+		s.Require().NoError(json.Unmarshal([]byte(`"41"`), &decoded))
+		s.Equal("41", decoded.String())
+		s.Error(json.Unmarshal([]byte(`"42"`), &decoded))
+		s.Error(json.Unmarshal([]byte(`41`), &decoded))
+	})
+
+	s.Run("should store and scan database values", func() {
+		cst, _ := wisp.NewCST("20")
+		value, err := cst.Value()
+		s.Require().NoError(err)
+		s.Equal("20", value)
+
+		value, err = wisp.EmptyCST.Value()
+		s.Require().NoError(err)
+		s.Nil(value)
+
+		var scanned wisp.CST
+		s.Require().NoError(scanned.Scan([]byte("51")))
+		s.Equal("51", scanned.String())
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		err = scanned.Scan(51)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
@@ -1,9 +1,11 @@
 package wisp
 
 import (
+	"bytes"
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/marcelofabianov/fault"
@@ -13,7 +15,10 @@ import (
 // It ensures that only valid and recognized currency codes are used in the system,
 // preventing errors in financial calculations and data exchange.
 //
-// The type is based on a predefined list of allowed currencies.
+// The type is based on a registry of enabled currencies. BRL, USD and EUR are enabled by default;
+// other ISO 4217 currencies are enabled with RegisterCurrencies, and user-defined currencies
+// (e.g., loyalty points or tokens) with RegisterCurrency. Each registered currency carries its
+// CurrencyInfo metadata, such as the number of minor units.
 //
 // Examples:
 //   - BRL (Brazilian Real)
@@ -31,11 +36,158 @@ const (
 // EmptyCurrency represents the zero value for the Currency type.
 var EmptyCurrency Currency
 
-// validCurrencies holds the set of supported currency codes for validation.
-var validCurrencies = map[Currency]struct{}{
-	BRL: {},
-	USD: {},
-	EUR: {},
+// CurrencyInfo holds the metadata of a registered Currency.
+type CurrencyInfo struct {
+	// Code is the alphabetic currency code (e.g., "JPY").
+	Code Currency
+	// NumericCode is the ISO 4217 numeric code (e.g., "392"), empty for user-defined currencies.
+	NumericCode string
+	// MinorUnits is the number of decimal places of the minor unit (0 for JPY, 2 for BRL, 3 for BHD).
+	MinorUnits int
+	// Symbol is the display symbol (e.g., "R$").
+	Symbol string
+	// Name is the English name of the currency.
+	Name string
+}
+
+// maxCurrencyMinorUnits is the largest exponent whose scale still fits in an int64.
+const maxCurrencyMinorUnits = 18
+
+// currencyCodeRegex validates user-defined currency codes. Codes are limited to 8 characters
+// so they fit the stack buffer used by parseCurrencyBytes.
+var currencyCodeRegex = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,7}$`)
+
+// isoCurrencies is the built-in ISO 4217 table used by RegisterCurrencies.
+var isoCurrencies = map[Currency]CurrencyInfo{
+	"AED": {Code: "AED", NumericCode: "784", MinorUnits: 2, Symbol: "AED", Name: "UAE Dirham"},
+	"ARS": {Code: "ARS", NumericCode: "032", MinorUnits: 2, Symbol: "$", Name: "Argentine Peso"},
+	"AUD": {Code: "AUD", NumericCode: "036", MinorUnits: 2, Symbol: "A$", Name: "Australian Dollar"},
+	"BHD": {Code: "BHD", NumericCode: "048", MinorUnits: 3, Symbol: "BD", Name: "Bahraini Dinar"},
+	"BOB": {Code: "BOB", NumericCode: "068", MinorUnits: 2, Symbol: "Bs", Name: "Boliviano"},
+	"BRL": {Code: "BRL", NumericCode: "986", MinorUnits: 2, Symbol: "R$", Name: "Brazilian Real"},
+	"CAD": {Code: "CAD", NumericCode: "124", MinorUnits: 2, Symbol: "CA$", Name: "Canadian Dollar"},
+	"CHF": {Code: "CHF", NumericCode: "756", MinorUnits: 2, Symbol: "CHF", Name: "Swiss Franc"},
+	"CLF": {Code: "CLF", NumericCode: "990", MinorUnits: 4, Symbol: "UF", Name: "Unidad de Fomento"},
+	"CLP": {Code: "CLP", NumericCode: "152", MinorUnits: 0, Symbol: "$", Name: "Chilean Peso"},
+	"CNY": {Code: "CNY", NumericCode: "156", MinorUnits: 2, Symbol: "¥", Name: "Yuan Renminbi"},
+	"COP": {Code: "COP", NumericCode: "170", MinorUnits: 2, Symbol: "$", Name: "Colombian Peso"},
+	"CZK": {Code: "CZK", NumericCode: "203", MinorUnits: 2, Symbol: "Kč", Name: "Czech Koruna"},
+	"DKK": {Code: "DKK", NumericCode: "208", MinorUnits: 2, Symbol: "kr", Name: "Danish Krone"},
+	"EGP": {Code: "EGP", NumericCode: "818", MinorUnits: 2, Symbol: "E£", Name: "Egyptian Pound"},
+	"EUR": {Code: "EUR", NumericCode: "978", MinorUnits: 2, Symbol: "€", Name: "Euro"},
+	"GBP": {Code: "GBP", NumericCode: "826", MinorUnits: 2, Symbol: "£", Name: "Pound Sterling"},
+	"HKD": {Code: "HKD", NumericCode: "344", MinorUnits: 2, Symbol: "HK$", Name: "Hong Kong Dollar"},
+	"HUF": {Code: "HUF", NumericCode: "348", MinorUnits: 2, Symbol: "Ft", Name: "Forint"},
+	"IDR": {Code: "IDR", NumericCode: "360", MinorUnits: 2, Symbol: "Rp", Name: "Rupiah"},
+	"ILS": {Code: "ILS", NumericCode: "376", MinorUnits: 2, Symbol: "₪", Name: "New Israeli Sheqel"},
+	"INR": {Code: "INR", NumericCode: "356", MinorUnits: 2, Symbol: "₹", Name: "Indian Rupee"},
+	"IQD": {Code: "IQD", NumericCode: "368", MinorUnits: 3, Symbol: "ID", Name: "Iraqi Dinar"},
+	"ISK": {Code: "ISK", NumericCode: "352", MinorUnits: 0, Symbol: "kr", Name: "Iceland Krona"},
+	"JOD": {Code: "JOD", NumericCode: "400", MinorUnits: 3, Symbol: "JD", Name: "Jordanian Dinar"},
+	"JPY": {Code: "JPY", NumericCode: "392", MinorUnits: 0, Symbol: "¥", Name: "Yen"},
+	"KRW": {Code: "KRW", NumericCode: "410", MinorUnits: 0, Symbol: "₩", Name: "Won"},
+	"KWD": {Code: "KWD", NumericCode: "414", MinorUnits: 3, Symbol: "KD", Name: "Kuwaiti Dinar"},
+	"LYD": {Code: "LYD", NumericCode: "434", MinorUnits: 3, Symbol: "LD", Name: "Libyan Dinar"},
+	"MXN": {Code: "MXN", NumericCode: "484", MinorUnits: 2, Symbol: "MX$", Name: "Mexican Peso"},
+	"MYR": {Code: "MYR", NumericCode: "458", MinorUnits: 2, Symbol: "RM", Name: "Malaysian Ringgit"},
+	"NOK": {Code: "NOK", NumericCode: "578", MinorUnits: 2, Symbol: "kr", Name: "Norwegian Krone"},
+	"NZD": {Code: "NZD", NumericCode: "554", MinorUnits: 2, Symbol: "NZ$", Name: "New Zealand Dollar"},
+	"OMR": {Code: "OMR", NumericCode: "512", MinorUnits: 3, Symbol: "RO", Name: "Rial Omani"},
+	"PEN": {Code: "PEN", NumericCode: "604", MinorUnits: 2, Symbol: "S/", Name: "Sol"},
+	"PHP": {Code: "PHP", NumericCode: "608", MinorUnits: 2, Symbol: "₱", Name: "Philippine Peso"},
+	"PLN": {Code: "PLN", NumericCode: "985", MinorUnits: 2, Symbol: "zł", Name: "Zloty"},
+	"PYG": {Code: "PYG", NumericCode: "600", MinorUnits: 0, Symbol: "₲", Name: "Guarani"},
+	"QAR": {Code: "QAR", NumericCode: "634", MinorUnits: 2, Symbol: "QR", Name: "Qatari Rial"},
+	"RUB": {Code: "RUB", NumericCode: "643", MinorUnits: 2, Symbol: "₽", Name: "Russian Ruble"},
+	"SAR": {Code: "SAR", NumericCode: "682", MinorUnits: 2, Symbol: "SR", Name: "Saudi Riyal"},
+	"SEK": {Code: "SEK", NumericCode: "752", MinorUnits: 2, Symbol: "kr", Name: "Swedish Krona"},
+	"SGD": {Code: "SGD", NumericCode: "702", MinorUnits: 2, Symbol: "S$", Name: "Singapore Dollar"},
+	"THB": {Code: "THB", NumericCode: "764", MinorUnits: 2, Symbol: "฿", Name: "Baht"},
+	"TND": {Code: "TND", NumericCode: "788", MinorUnits: 3, Symbol: "DT", Name: "Tunisian Dinar"},
+	"TRY": {Code: "TRY", NumericCode: "949", MinorUnits: 2, Symbol: "₺", Name: "Turkish Lira"},
+	"TWD": {Code: "TWD", NumericCode: "901", MinorUnits: 2, Symbol: "NT$", Name: "New Taiwan Dollar"},
+	"UAH": {Code: "UAH", NumericCode: "980", MinorUnits: 2, Symbol: "₴", Name: "Hryvnia"},
+	"USD": {Code: "USD", NumericCode: "840", MinorUnits: 2, Symbol: "$", Name: "US Dollar"},
+	"UYU": {Code: "UYU", NumericCode: "858", MinorUnits: 2, Symbol: "$U", Name: "Peso Uruguayo"},
+	"VND": {Code: "VND", NumericCode: "704", MinorUnits: 0, Symbol: "₫", Name: "Dong"},
+	"ZAR": {Code: "ZAR", NumericCode: "710", MinorUnits: 2, Symbol: "R", Name: "Rand"},
+}
+
+// defaultCurrencies returns the currencies enabled by default and their metadata.
+func defaultCurrencies() map[Currency]CurrencyInfo {
+	return map[Currency]CurrencyInfo{
+		BRL: isoCurrencies[BRL],
+		USD: isoCurrencies[USD],
+		EUR: isoCurrencies[EUR],
+	}
+}
+
+// validCurrencies holds the registry of enabled currency codes and their metadata.
+var validCurrencies = defaultCurrencies()
+
+// RegisterCurrencies enables ISO 4217 currencies from the built-in table, making them valid
+// for NewCurrency and Money. Codes are trimmed and uppercased.
+// Returns an error, without registering any currency, if a code is not in the built-in table.
+//
+// Example:
+//   err := wisp.RegisterCurrencies("JPY", "BHD")
+func RegisterCurrencies(codes ...string) error {
+	infos := make([]CurrencyInfo, 0, len(codes))
+	for _, code := range codes {
+		info, ok := isoCurrencies[Currency(strings.ToUpper(strings.TrimSpace(code)))]
+		if !ok {
+			return fault.New(
+				"unknown ISO 4217 currency code",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("input_code", code),
+			)
+		}
+		infos = append(infos, info)
+	}
+
+	for _, info := range infos {
+		validCurrencies[info.Code] = info
+	}
+	return nil
+}
+
+// RegisterCurrency registers a user-defined currency, such as a loyalty token, or overrides the
+// metadata of an existing one. The code must have 2 to 8 uppercase letters or digits, starting
+// with a letter, and MinorUnits must be between 0 and 18. The symbol defaults to the code.
+//
+// Example:
+//   err := wisp.RegisterCurrency(wisp.CurrencyInfo{Code: "PTS", MinorUnits: 0, Name: "Loyalty Points"})
+func RegisterCurrency(info CurrencyInfo) error {
+	info.Code = Currency(strings.ToUpper(strings.TrimSpace(string(info.Code))))
+	if !currencyCodeRegex.MatchString(string(info.Code)) {
+		return fault.New(
+			"invalid currency code format",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_code", string(info.Code)),
+		)
+	}
+
+	if info.MinorUnits < 0 || info.MinorUnits > maxCurrencyMinorUnits {
+		return fault.New(
+			"currency minor units must be between 0 and 18",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_code", string(info.Code)),
+			fault.WithContext("minor_units", info.MinorUnits),
+		)
+	}
+
+	if strings.TrimSpace(info.Symbol) == "" {
+		info.Symbol = string(info.Code)
+	}
+
+	validCurrencies[info.Code] = info
+	return nil
+}
+
+// ResetCurrencies restores the registry to the currencies enabled by default (BRL, USD, EUR).
+// This is primarily for testing purposes to ensure a clean state.
+func ResetCurrencies() {
+	validCurrencies = defaultCurrencies()
 }
 
 // NewCurrency creates a new Currency from a string code.
@@ -63,6 +215,36 @@ func NewCurrency(value string) (Currency, error) {
 	return c, nil
 }
 
+// parseCurrencyBytes is the []byte counterpart of NewCurrency used when scanning database rows.
+// Currency codes are short ASCII strings, so they are normalized in a stack buffer
+// instead of going through intermediate string conversions.
+func parseCurrencyBytes(value []byte) (Currency, error) {
+	trimmed := bytes.TrimSpace(value)
+	if len(trimmed) == 0 {
+		return EmptyCurrency, nil
+	}
+
+	var buf [8]byte
+	if len(trimmed) <= len(buf) {
+		code := buf[:len(trimmed)]
+		for i, c := range trimmed {
+			if 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			code[i] = c
+		}
+		if c := Currency(code); c.IsValid() {
+			return c, nil
+		}
+	}
+
+	return EmptyCurrency, fault.New(
+		"invalid currency code",
+		fault.WithCode(fault.Invalid),
+		fault.WithContext("input_code", string(value)),
+	)
+}
+
 // String returns the currency code as a string.
 func (c Currency) String() string {
 	return string(c)
@@ -74,6 +256,36 @@ func (c Currency) IsValid() bool {
 	return ok
 }
 
+// Info returns the metadata of the currency and whether it is registered.
+func (c Currency) Info() (CurrencyInfo, bool) {
+	info, ok := validCurrencies[c]
+	return info, ok
+}
+
+// MinorUnits returns the number of decimal places of the currency's minor unit
+// (e.g., 0 for JPY, 2 for BRL, 3 for BHD). Unregistered currencies default to 2.
+func (c Currency) MinorUnits() int {
+	info, ok := validCurrencies[c]
+	if !ok {
+		return 2
+	}
+	return info.MinorUnits
+}
+
+// Symbol returns the display symbol of the currency (e.g., "R$"), or the code if it is not registered.
+func (c Currency) Symbol() string {
+	info, ok := validCurrencies[c]
+	if !ok {
+		return c.String()
+	}
+	return info.Symbol
+}
+
+// NumericCode returns the ISO 4217 numeric code (e.g., "986"), or an empty string if unknown.
+func (c Currency) NumericCode() string {
+	return validCurrencies[c].NumericCode
+}
+
 // IsZero returns true if the currency is the zero value (EmptyCurrency).
 func (c Currency) IsZero() bool {
 	return c == EmptyCurrency
@@ -128,12 +340,13 @@ func (c *Currency) Scan(src interface{}) error {
 		return nil
 	}
 
-	var s string
+	var curr Currency
+	var err error
 	switch v := src.(type) {
 	case string:
-		s = v
+		curr, err = NewCurrency(v)
 	case []byte:
-		s = string(v)
+		curr, err = parseCurrencyBytes(v)
 	default:
 		return fault.New(
 			"unsupported scan type for Currency",
@@ -142,7 +355,6 @@ func (c *Currency) Scan(src interface{}) error {
 		)
 	}
 
-	curr, err := NewCurrency(s)
 	if err != nil {
 		return err
 	}
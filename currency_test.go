@@ -124,6 +124,10 @@ func (s *CurrencySuite) TestCurrency_DatabaseInterface() {
 			{name: "should scan a valid string", src: "BRL", expected: wisp.BRL},
 			{name: "should scan and normalize a lowercase string", src: "usd", expected: wisp.USD},
 			{name: "should scan a valid byte slice", src: []byte("EUR"), expected: wisp.EUR},
+			{name: "should scan and normalize a byte slice", src: []byte(" brl "), expected: wisp.BRL},
+			{name: "should scan an empty byte slice as EmptyCurrency", src: []byte("  "), expected: wisp.EmptyCurrency},
+			{name: "should fail to scan an invalid byte slice", src: []byte("JPY"), expectError: true},
+			{name: "should fail to scan a long byte slice", src: []byte("BRAZILIAN REAL"), expectError: true},
 			{name: "should scan nil as EmptyCurrency", src: nil, expected: wisp.EmptyCurrency},
 			{name: "should fail to scan an invalid code", src: "JPY", expectError: true},
 			{name: "should fail to scan an incompatible type", src: 123, expectError: true},
@@ -147,3 +151,68 @@ func (s *CurrencySuite) TestCurrency_DatabaseInterface() {
 		}
 	})
 }
+
+func (s *CurrencySuite) TestCurrency_Registry() {
+	s.T().Cleanup(wisp.ResetCurrencies)
+
+	s.Run("should expose metadata for default currencies", func() {
+		info, ok := wisp.BRL.Info()
+		s.True(ok)
+		s.Equal("986", info.NumericCode)
+		s.Equal(2, wisp.BRL.MinorUnits())
+		s.Equal("R$", wisp.BRL.Symbol())
+		s.Equal("840", wisp.USD.NumericCode())
+		s.Equal("€", wisp.EUR.Symbol())
+	})
+
+	s.Run("should enable ISO 4217 currencies", func() {
+		s.Require().NoError(wisp.RegisterCurrencies("jpy", " BHD "))
+
+		jpy, err := wisp.NewCurrency("JPY")
+		s.Require().NoError(err)
+		s.Equal(0, jpy.MinorUnits())
+		s.Equal("392", jpy.NumericCode())
+
+		bhd, err := wisp.NewCurrency("bhd")
+		s.Require().NoError(err)
+		s.Equal(3, bhd.MinorUnits())
+
+		var scanned wisp.Currency
+		s.Require().NoError(scanned.Scan([]byte("jpy")))
+		s.Equal(jpy, scanned)
+	})
+
+	s.Run("should reject unknown ISO codes atomically", func() {
+		err := wisp.RegisterCurrencies("GBP", "XYZ")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		s.False(wisp.Currency("GBP").IsValid())
+	})
+
+	s.Run("should register user-defined currencies", func() {
+		s.Require().NoError(wisp.RegisterCurrency(wisp.CurrencyInfo{Code: "pts", MinorUnits: 0, Name: "Loyalty Points"}))
+
+		pts, err := wisp.NewCurrency("PTS")
+		s.Require().NoError(err)
+		s.Equal("PTS", pts.Symbol())
+		s.Equal("", pts.NumericCode())
+
+		s.Require().NoError(wisp.RegisterCurrency(wisp.CurrencyInfo{Code: "USDT", MinorUnits: 6, Symbol: "₮"}))
+		s.Equal(6, wisp.Currency("USDT").MinorUnits())
+	})
+
+	s.Run("should validate user-defined currencies", func() {
+		s.Error(wisp.RegisterCurrency(wisp.CurrencyInfo{Code: "1AB"}))
+		s.Error(wisp.RegisterCurrency(wisp.CurrencyInfo{Code: "TOOLONGCODE"}))
+		s.Error(wisp.RegisterCurrency(wisp.CurrencyInfo{Code: "NEG", MinorUnits: -1}))
+		s.Error(wisp.RegisterCurrency(wisp.CurrencyInfo{Code: "BIG", MinorUnits: 19}))
+	})
+
+	s.Run("should reset to the default currencies", func() {
+		s.Require().NoError(wisp.RegisterCurrencies("JPY"))
+		wisp.ResetCurrencies()
+		s.False(wisp.Currency("JPY").IsValid())
+		s.Equal(2, wisp.Currency("JPY").MinorUnits())
+		s.True(wisp.BRL.IsValid())
+	})
+}
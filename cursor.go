@@ -0,0 +1,236 @@
+package wisp
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// MinCursorKeyLength is the minimum length, in bytes, of a key used to sign cursors.
+const MinCursorKeyLength = 32
+
+const (
+	// cursorVersion is the first byte of every encoded cursor, so the format can evolve.
+	cursorVersion byte = 1
+	// cursorPayloadLength is the size of the version, the UUID and the timestamp.
+	cursorPayloadLength = 1 + 16 + 8
+	// cursorNoTime marks a cursor without timestamp in the encoded payload.
+	cursorNoTime = math.MinInt64
+)
+
+// cursorKeys holds the keys used to sign and verify cursors; the first one signs.
+var cursorKeys [][]byte
+
+// SetCursorKeys configures the HMAC keys of cursors. The first key signs new cursors, and every key
+// is accepted when parsing, so keys can be rotated without invalidating the pages clients hold.
+// Calling it without arguments removes all keys, after which cursors cannot be encoded or parsed.
+// Returns an error, without changing the keys, if any key is shorter than MinCursorKeyLength.
+//
+// Example:
+//   err := wisp.SetCursorKeys(newKey, oldKey)
+func SetCursorKeys(keys ...[]byte) error {
+	copied := make([][]byte, 0, len(keys))
+	for i, key := range keys {
+		if len(key) < MinCursorKeyLength {
+			return fault.New(
+				"cursor key is too short",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("index", i),
+				fault.WithContext("min_length", MinCursorKeyLength),
+			)
+		}
+		copied = append(copied, append([]byte(nil), key...))
+	}
+	cursorKeys = copied
+	return nil
+}
+
+// Cursor is a value object representing the position of a page in keyset pagination: the ID and
+// the timestamp of the last item returned, as in "WHERE (created_at, id) < ($1, $2)".
+//
+// Clients receive it as an opaque, URL-safe token signed with HMAC-SHA256, so they cannot forge or
+// tamper with a position. The token is not encrypted: the ID and timestamp can be decoded, so do
+// not put secrets in a cursor. Keys are configured with SetCursorKeys.
+//
+// The zero value is ZeroCursor, which means the first page.
+//
+// Example:
+//   cursor, _ := wisp.NewCursor(last.ID, last.CreatedAt.Time())
+//   token, err := cursor.Encode() // "AQGW..." in the next_page field
+//
+//   cursor, err := wisp.ParseCursor(r.URL.Query().Get("cursor"))
+//   rows := query(cursor.ID(), cursor.At())
+type Cursor struct {
+	id UUID
+	at time.Time
+}
+
+// ZeroCursor represents the zero value for the Cursor type, the first page.
+var ZeroCursor Cursor
+
+// NewCursor creates a new Cursor after the item with the given ID and timestamp. The timestamp is
+// kept in UTC with nanosecond precision and may be zero when the pagination uses only the ID.
+// Returns an error if the ID is Nil or the timestamp is outside the years 1678 to 2262.
+func NewCursor(id UUID, at time.Time) (Cursor, error) {
+	if id.IsNil() {
+		return ZeroCursor, fault.New("cursor id cannot be nil", fault.WithCode(fault.Invalid))
+	}
+	if !at.IsZero() {
+		nanos := at.UnixNano()
+		if !time.Unix(0, nanos).Equal(at) {
+			return ZeroCursor, fault.New(
+				"cursor timestamp is out of range",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("at", at.String()),
+			)
+		}
+		at = at.UTC()
+	}
+	return Cursor{id: id, at: at}, nil
+}
+
+// ParseCursor decodes and verifies a token created by Cursor.Encode.
+// Returns ZeroCursor for an empty token, an Invalid error if the token is malformed or its signature
+// does not match any key, or an Internal error if no key is configured.
+func ParseCursor(token string) (Cursor, error) {
+	if token == "" {
+		return ZeroCursor, nil
+	}
+	if len(cursorKeys) == 0 {
+		return ZeroCursor, errCursorKeysNotConfigured()
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(data) != cursorPayloadLength+sha256.Size || data[0] != cursorVersion {
+		return ZeroCursor, fault.New("cursor is malformed", fault.WithCode(fault.Invalid))
+	}
+
+	payload, mac := data[:cursorPayloadLength], data[cursorPayloadLength:]
+	verified := false
+	for _, key := range cursorKeys {
+		if hmac.Equal(mac, signCursor(key, payload)) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return ZeroCursor, fault.New("cursor signature is invalid", fault.WithCode(fault.Invalid))
+	}
+
+	var id UUID
+	copy(id[:], payload[1:17])
+	var at time.Time
+	if nanos := int64(binary.BigEndian.Uint64(payload[17:])); nanos != cursorNoTime {
+		at = time.Unix(0, nanos).UTC()
+	}
+	return NewCursor(id, at)
+}
+
+// signCursor computes the HMAC-SHA256 of the payload.
+func signCursor(key, payload []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// errCursorKeysNotConfigured builds the error returned when cursors are used before SetCursorKeys.
+func errCursorKeysNotConfigured() error {
+	return fault.New("cursor keys are not configured", fault.WithCode(fault.Internal))
+}
+
+// ID returns the ID of the last item of the previous page.
+func (c Cursor) ID() UUID {
+	return c.id
+}
+
+// At returns the timestamp of the last item of the previous page, or the zero time if not set.
+func (c Cursor) At() time.Time {
+	return c.at
+}
+
+// IsZero returns true if the Cursor is the zero value, the first page.
+func (c Cursor) IsZero() bool {
+	return c.id.IsNil() && c.at.IsZero()
+}
+
+// Encode returns the cursor as a signed, URL-safe base64 token, or an empty string for ZeroCursor.
+// Returns an Internal error if no key is configured.
+func (c Cursor) Encode() (string, error) {
+	if c.IsZero() {
+		return "", nil
+	}
+	if len(cursorKeys) == 0 {
+		return "", errCursorKeysNotConfigured()
+	}
+
+	data := make([]byte, cursorPayloadLength, cursorPayloadLength+sha256.Size)
+	data[0] = cursorVersion
+	copy(data[1:17], c.id[:])
+	nanos := int64(cursorNoTime)
+	if !c.at.IsZero() {
+		nanos = c.at.UnixNano()
+	}
+	binary.BigEndian.PutUint64(data[17:], uint64(nanos))
+
+	data = append(data, signCursor(cursorKeys[0], data)...)
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the Cursor as its encoded token, or null for ZeroCursor.
+func (c Cursor) MarshalJSON() ([]byte, error) {
+	if c.IsZero() {
+		return []byte("null"), nil
+	}
+	token, err := c.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(token)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes and verifies a token; null or an empty string results in ZeroCursor.
+func (c *Cursor) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*c = ZeroCursor
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "Cursor must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	cursor, err := ParseCursor(s)
+	if err != nil {
+		return err
+	}
+	*c = cursor
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, for use in query parameters.
+func (c Cursor) MarshalText() ([]byte, error) {
+	token, err := c.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(token), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, for use in query parameters.
+func (c *Cursor) UnmarshalText(text []byte) error {
+	cursor, err := ParseCursor(string(text))
+	if err != nil {
+		return err
+	}
+	*c = cursor
+	return nil
+}
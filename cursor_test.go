@@ -0,0 +1,165 @@
+package wisp_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type CursorSuite struct {
+	suite.Suite
+	key []byte
+	id  wisp.UUID
+	at  time.Time
+}
+
+func TestCursorSuite(t *testing.T) {
+	suite.Run(t, new(CursorSuite))
+}
+
+func (s *CursorSuite) SetupTest() {
+	s.key = bytes.Repeat([]byte("k"), wisp.MinCursorKeyLength)
+	s.Require().NoError(wisp.SetCursorKeys(s.key))
+	s.id = wisp.MustParseUUID("0190a6b2-7c3e-7d4f-8a1b-2c3d4e5f6a7b")
+	s.at = time.Date(2025, time.March, 14, 9, 26, 53, 589793238, time.FixedZone("BRT", -3*3600))
+}
+
+func (s *CursorSuite) TearDownTest() {
+	s.Require().NoError(wisp.SetCursorKeys())
+}
+
+func (s *CursorSuite) TestNewCursor() {
+	cursor, err := wisp.NewCursor(s.id, s.at)
+	s.Require().NoError(err)
+	s.Equal(s.id, cursor.ID())
+	s.True(cursor.At().Equal(s.at))
+	s.Equal(time.UTC, cursor.At().Location())
+	s.False(cursor.IsZero())
+
+	cursor, err = wisp.NewCursor(s.id, time.Time{})
+	s.Require().NoError(err)
+	s.True(cursor.At().IsZero())
+
+	_, err = wisp.NewCursor(wisp.Nil, s.at)
+	s.Require().Error(err)
+	s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+	_, err = wisp.NewCursor(s.id, time.Date(3000, time.January, 1, 0, 0, 0, 0, time.UTC))
+	s.Error(err)
+}
+
+func (s *CursorSuite) TestEncodeAndParse() {
+	s.Run("should round-trip the id and timestamp", func() {
+		for _, at := range []time.Time{s.at, {}} {
+			cursor, _ := wisp.NewCursor(s.id, at)
+			token, err := cursor.Encode()
+			s.Require().NoError(err)
+			s.NotContains(token, "+")
+			s.NotContains(token, "/")
+			s.NotContains(token, "=")
+
+			parsed, err := wisp.ParseCursor(token)
+			s.Require().NoError(err)
+			s.Equal(cursor, parsed)
+		}
+	})
+
+	s.Run("should map the zero cursor to an empty token", func() {
+		token, err := wisp.ZeroCursor.Encode()
+		s.Require().NoError(err)
+		s.Empty(token)
+
+		parsed, err := wisp.ParseCursor("")
+		s.Require().NoError(err)
+		s.True(parsed.IsZero())
+	})
+
+	s.Run("should reject malformed or tampered tokens", func() {
+		cursor, _ := wisp.NewCursor(s.id, s.at)
+		token, _ := cursor.Encode()
+		data, _ := base64.RawURLEncoding.DecodeString(token)
+		data[5] ^= 0xff
+		tampered := base64.RawURLEncoding.EncodeToString(data)
+
+		for _, input := range []string{tampered, "not base64!", token[:20], "AAAA"} {
+			_, err := wisp.ParseCursor(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+
+	s.Run("should reject tokens signed with another key", func() {
+		cursor, _ := wisp.NewCursor(s.id, s.at)
+		token, _ := cursor.Encode()
+
+		s.Require().NoError(wisp.SetCursorKeys(bytes.Repeat([]byte("n"), wisp.MinCursorKeyLength)))
+		_, err := wisp.ParseCursor(token)
+		s.Error(err)
+	})
+}
+
+func (s *CursorSuite) TestKeyRotation() {
+	cursor, _ := wisp.NewCursor(s.id, s.at)
+	oldToken, _ := cursor.Encode()
+
+	newKey := bytes.Repeat([]byte("n"), wisp.MinCursorKeyLength)
+	s.Require().NoError(wisp.SetCursorKeys(newKey, s.key))
+
+	parsed, err := wisp.ParseCursor(oldToken)
+	s.Require().NoError(err)
+	s.Equal(cursor, parsed)
+
+	newToken, _ := cursor.Encode()
+	s.NotEqual(oldToken, newToken)
+
+	s.Error(wisp.SetCursorKeys([]byte("short")))
+	_, err = wisp.ParseCursor(newToken)
+	s.NoError(err, "a failed SetCursorKeys must keep the current keys")
+}
+
+func (s *CursorSuite) TestWithoutKeys() {
+	cursor, _ := wisp.NewCursor(s.id, s.at)
+	token, _ := cursor.Encode()
+	s.Require().NoError(wisp.SetCursorKeys())
+
+	_, err := cursor.Encode()
+	s.Require().Error(err)
+	s.Equal(fault.Internal, err.(*fault.Error).Code)
+
+	_, err = wisp.ParseCursor(token)
+	s.Require().Error(err)
+	s.Equal(fault.Internal, err.(*fault.Error).Code)
+}
+
+func (s *CursorSuite) TestJSON_Text() {
+	cursor, _ := wisp.NewCursor(s.id, s.at)
+	token, _ := cursor.Encode()
+
+	data, err := json.Marshal(cursor)
+	s.Require().NoError(err)
+	s.Equal(`"`+token+`"`, string(data))
+
+	var decoded wisp.Cursor
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(cursor, decoded)
+	s.Error(json.Unmarshal([]byte(`"invalid"`), &decoded))
+	s.Error(json.Unmarshal([]byte(`1`), &decoded))
+
+	data, err = json.Marshal(wisp.ZeroCursor)
+	s.Require().NoError(err)
+	s.Equal("null", string(data))
+	s.Require().NoError(json.Unmarshal([]byte(`""`), &decoded))
+	s.True(decoded.IsZero())
+
+	text, err := cursor.MarshalText()
+	s.Require().NoError(err)
+	s.Require().NoError(decoded.UnmarshalText(text))
+	s.Equal(cursor, decoded)
+}
@@ -0,0 +1,127 @@
+package wisp
+
+import (
+	"crypto/subtle"
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// CVV is a value object representing the card verification value printed on a payment card
+// (CVV, CVC or CID): 3 digits, or 4 on American Express cards.
+//
+// The CVV is sensitive authentication data under PCI DSS and must not be stored after
+// authorization, not even encrypted. String, GoString, Masked and MarshalJSON return "[REDACTED]",
+// the digits are only available through Reveal, and Value always returns an error, so a CVV field
+// cannot be written to the database by accident. There is no opt-in: pass it to the payment
+// gateway and let it go.
+//
+// The zero value is EmptyCVV.
+//
+// Example:
+//   cvv, err := wisp.NewCVV(req.CVV)
+//   if !cvv.ValidFor(pan.Brand()) { ... }
+//   gateway.Authorize(pan.Reveal(), cvv.Reveal(), amount)
+type CVV struct {
+	digits string
+}
+
+// EmptyCVV represents the zero value for the CVV type.
+var EmptyCVV CVV
+
+// NewCVV creates a new CVV, ignoring surrounding spaces.
+// Returns EmptyCVV for an empty input, or an error if the value does not have 3 or 4 digits.
+// The value is never included in the error.
+func NewCVV(input string) (CVV, error) {
+	digits := strings.TrimSpace(input)
+	if digits == "" {
+		return EmptyCVV, nil
+	}
+	if (len(digits) != 3 && len(digits) != 4) || !isASCIIDigits(digits) {
+		return EmptyCVV, fault.New("CVV must have 3 or 4 digits", fault.WithCode(fault.Invalid))
+	}
+	return CVV{digits: digits}, nil
+}
+
+// Reveal returns the digits of the CVV. Callers must not log, return or store them.
+func (c CVV) Reveal() string {
+	return c.digits
+}
+
+// ValidFor checks if the CVV has the length used by the brand: 4 digits for American Express and
+// 3 for the others. Any length is accepted for an unknown brand.
+func (c CVV) ValidFor(brand CardBrand) bool {
+	switch {
+	case c.IsZero():
+		return false
+	case brand == CardBrandAmex:
+		return len(c.digits) == 4
+	case brand.IsValid():
+		return len(c.digits) == 3
+	default:
+		return true
+	}
+}
+
+// Equals compares two CVVs in constant time.
+func (c CVV) Equals(other CVV) bool {
+	return subtle.ConstantTimeCompare([]byte(c.digits), []byte(other.digits)) == 1
+}
+
+// IsZero returns true if the CVV is the zero value.
+func (c CVV) IsZero() bool {
+	return c.digits == ""
+}
+
+// String returns "[REDACTED]", so the CVV is never printed by accident.
+func (c CVV) String() string {
+	return redactedSecret
+}
+
+// GoString returns "[REDACTED]", protecting the CVV from the %#v verb.
+func (c CVV) GoString() string {
+	return redactedSecret
+}
+
+// Masked implements the Masker interface, so Redact hides the CVV as well.
+func (c CVV) Masked() string {
+	return redactedSecret
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It always serializes the CVV as "[REDACTED]".
+func (c CVV) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redactedSecret)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a CVV, with validation; null results in EmptyCVV.
+func (c *CVV) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*c = EmptyCVV
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "CVV must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	cvv, err := NewCVV(s)
+	if err != nil {
+		return err
+	}
+	*c = cvv
+	return nil
+}
+
+// Value implements the driver.Valuer interface only to refuse storage: it returns nil for the zero
+// value and an error otherwise, since PCI DSS forbids storing the CVV.
+func (c CVV) Value() (driver.Value, error) {
+	if c.IsZero() {
+		return nil, nil
+	}
+	return nil, fault.New("CVV must not be stored", fault.WithCode(fault.DomainViolation))
+}
@@ -0,0 +1,113 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type CVVSuite struct {
+	suite.Suite
+}
+
+func TestCVVSuite(t *testing.T) {
+	suite.Run(t, new(CVVSuite))
+}
+
+func (s *CVVSuite) TestNewCVV() {
+	s.Run("should accept 3 or 4 digits", func() {
+		for _, input := range []string{"123", " 1234 ", "007"} {
+			cvv, err := wisp.NewCVV(input)
+			s.Require().NoError(err, input)
+			s.False(cvv.IsZero())
+		}
+	})
+
+	s.Run("should return EmptyCVV for an empty input", func() {
+		cvv, err := wisp.NewCVV("")
+		s.Require().NoError(err)
+		s.True(cvv.IsZero())
+	})
+
+	s.Run("should reject invalid values without exposing them", func() {
+		for _, input := range []string{"12", "12345", "12a", "1 23"} {
+			_, err := wisp.NewCVV(input)
+			s.Require().Error(err, input)
+			faultErr := err.(*fault.Error)
+			s.Equal(fault.Invalid, faultErr.Code)
+			s.Empty(faultErr.Context)
+		}
+	})
+}
+
+func (s *CVVSuite) TestValidFor() {
+	three, _ := wisp.NewCVV("123")
+	four, _ := wisp.NewCVV("1234")
+
+	s.True(three.ValidFor(wisp.CardBrandVisa))
+	s.False(four.ValidFor(wisp.CardBrandMastercard))
+	s.True(four.ValidFor(wisp.CardBrandAmex))
+	s.False(three.ValidFor(wisp.CardBrandAmex))
+	s.True(four.ValidFor(wisp.EmptyCardBrand))
+	s.False(wisp.EmptyCVV.ValidFor(wisp.EmptyCardBrand))
+}
+
+func (s *CVVSuite) TestRedaction() {
+	cvv, _ := wisp.NewCVV("123")
+
+	s.Run("should never print the digits", func() {
+		s.Equal("123", cvv.Reveal())
+		s.Equal("[REDACTED]", cvv.String())
+		s.Equal("[REDACTED]", fmt.Sprintf("%#v", cvv))
+		s.Equal("[REDACTED]", cvv.Masked())
+		s.Equal(map[string]any{"cvv": "[REDACTED]"}, wisp.Redact(map[string]any{"cvv": cvv}))
+	})
+
+	s.Run("should compare in constant time", func() {
+		same, _ := wisp.NewCVV("123")
+		other, _ := wisp.NewCVV("124")
+		s.True(cvv.Equals(same))
+		s.False(cvv.Equals(other))
+	})
+}
+
+func (s *CVVSuite) TestJSON() {
+	s.Run("should marshal as redacted", func() {
+		cvv, _ := wisp.NewCVV("123")
+		data, err := json.Marshal(cvv)
+		s.Require().NoError(err)
+		s.Equal(`"[REDACTED]"`, string(data))
+	})
+
+	s.Run("should unmarshal the digits", func() {
+		var cvv wisp.CVV
+		s.Require().NoError(json.Unmarshal([]byte(`"1234"`), &cvv))
+		s.Equal("1234", cvv.Reveal())
+
+		s.Require().NoError(json.Unmarshal([]byte("null"), &cvv))
+		s.True(cvv.IsZero())
+
+		s.Error(json.Unmarshal([]byte(`"[REDACTED]"`), &cvv))
+		s.Error(json.Unmarshal([]byte(`123`), &cvv))
+	})
+}
+
+func (s *CVVSuite) TestValue() {
+	s.Run("should refuse to store a CVV", func() {
+		cvv, _ := wisp.NewCVV("123")
+		_, err := cvv.Value()
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+
+	s.Run("should store nil for the zero value", func() {
+		value, err := wisp.EmptyCVV.Value()
+		s.Require().NoError(err)
+		s.Nil(value)
+	})
+}
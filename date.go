@@ -58,16 +58,71 @@ func Today() Date {
 
 // ParseDate creates a new Date by parsing a string in YYYY-MM-DD format.
 // Returns an error if the string is not in the required format.
+//
+// Parsing is done by a dedicated YYYY-MM-DD parser instead of time.Parse,
+// which keeps bulk imports (CSV, database rows) cheap. time.Parse only runs on invalid
+// input, to provide the cause of the error.
 func ParseDate(value string) (Date, error) {
-	t, err := time.Parse(iso8601DateFormat, value)
-	if err != nil {
-		return ZeroDate, fault.Wrap(err,
-			"date must be in YYYY-MM-DD format",
-			fault.WithCode(fault.Invalid),
-			fault.WithContext("input", value),
-		)
+	d, ok := parseISODate(value)
+	if !ok {
+		opts := []fault.Option{fault.WithCode(fault.Invalid), fault.WithContext("input", value)}
+		if _, err := time.Parse(iso8601DateFormat, value); err != nil {
+			return ZeroDate, fault.Wrap(err, "date must be in YYYY-MM-DD format", opts...)
+		}
+		return ZeroDate, fault.New("date must be in YYYY-MM-DD format", opts...)
+	}
+	return d, nil
+}
+
+// parseISODate parses a YYYY-MM-DD value without going through time.Parse.
+// It reports false if the layout is wrong or the components do not form a real calendar date.
+func parseISODate[T string | []byte](value T) (Date, bool) {
+	if len(value) != len(iso8601DateFormat) || value[4] != '-' || value[7] != '-' {
+		return ZeroDate, false
+	}
+
+	year, ok := parseDigits(value[0:4])
+	if !ok {
+		return ZeroDate, false
+	}
+	month, ok := parseDigits(value[5:7])
+	if !ok || month < 1 || month > 12 {
+		return ZeroDate, false
+	}
+	day, ok := parseDigits(value[8:10])
+	if !ok || day < 1 || day > daysIn(time.Month(month), year) {
+		return ZeroDate, false
+	}
+
+	return Date{t: time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)}, true
+}
+
+// parseDigits converts a run of ASCII digits into an int, reporting false on any other character.
+func parseDigits[T string | []byte](value T) (int, bool) {
+	n := 0
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		n = n*10 + int(c-'0')
+	}
+	return n, true
+}
+
+// daysIn returns the number of days in the given month of the given year.
+func daysIn(month time.Month, year int) int {
+	switch month {
+	case time.February:
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	default:
+		return 31
 	}
-	return Date{t: t}, nil
 }
 
 // Year returns the year component of the date.
@@ -169,6 +224,7 @@ func (d Date) Value() (driver.Value, error) {
 
 // Scan implements the sql.Scanner interface for database retrieval.
 // It accepts a time.Time from the database and converts it into a Date, ignoring the time part.
+// Drivers that return DATE columns as text (string or []byte in YYYY-MM-DD format) are also supported.
 func (d *Date) Scan(src interface{}) error {
 	if src == nil {
 		*d = ZeroDate
@@ -179,6 +235,22 @@ func (d *Date) Scan(src interface{}) error {
 	case time.Time:
 		*d = Date{t: time.Date(v.Year(), v.Month(), v.Day(), 0, 0, 0, 0, time.UTC)}
 		return nil
+	case string:
+		date, err := ParseDate(v)
+		if err != nil {
+			return err
+		}
+		*d = date
+		return nil
+	case []byte:
+		date, ok := parseISODate(v)
+		if !ok {
+			// Only the failure path converts to a string, to report the same error as ParseDate.
+			_, err := ParseDate(string(v))
+			return err
+		}
+		*d = date
+		return nil
 	default:
 		return fault.New("unsupported scan type for Date", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
 	}
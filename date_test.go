@@ -2,6 +2,7 @@ package wisp_test
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -46,6 +47,30 @@ func (s *DateSuite) TestNewDateAndParse() {
 	s.Run("should fail to parse an invalid date string", func() {
 		_, err := wisp.ParseDate("09-09-2025")
 		s.Require().Error(err)
+
+		var parseErr *time.ParseError
+		s.True(errors.As(err, &parseErr), "should keep the time.Parse cause")
+	})
+
+	s.Run("should validate calendar rules when parsing", func() {
+		leap, err := wisp.ParseDate("2024-02-29")
+		s.Require().NoError(err)
+		s.Equal(29, leap.Day())
+
+		for _, input := range []string{"2025-02-29", "1900-02-29", "2025-04-31", "2025-13-01", "2025-00-10", "2025-01-00"} {
+			_, err := wisp.ParseDate(input)
+			s.Require().Error(err, input)
+			faultErr, ok := err.(*fault.Error)
+			s.Require().True(ok)
+			s.Equal(fault.Invalid, faultErr.Code)
+		}
+	})
+
+	s.Run("should reject malformed layouts", func() {
+		for _, input := range []string{"", "2025-9-09", "2025/09/09", "2025-09-09T00:00:00Z", "20a5-09-09", " 2025-09-09", "+025-09-09"} {
+			_, err := wisp.ParseDate(input)
+			s.Require().Error(err, input)
+		}
 	})
 }
 
@@ -118,6 +143,25 @@ func (s *DateSuite) TestDate_DatabaseInterface() {
 		s.True(d.Equals(scannedDate), "Scan should truncate the time part")
 	})
 
+	s.Run("Scan from text", func() {
+		var fromString wisp.Date
+		s.Require().NoError(fromString.Scan("2025-09-09"))
+		s.True(d.Equals(fromString))
+
+		var fromBytes wisp.Date
+		s.Require().NoError(fromBytes.Scan([]byte("2025-09-09")))
+		s.True(d.Equals(fromBytes))
+
+		var invalid wisp.Date
+		s.Require().Error(invalid.Scan([]byte("2025-02-30")))
+		s.Require().Error(invalid.Scan(12345))
+
+		err := invalid.Scan([]byte("09-09-2025"))
+		s.Require().Error(err)
+		var parseErr *time.ParseError
+		s.True(errors.As(err, &parseErr), "should keep the time.Parse cause")
+	})
+
 	s.Run("should handle nil from database", func() {
 		var scannedDate wisp.Date
 		err := scannedDate.Scan(nil)
@@ -125,3 +169,32 @@ func (s *DateSuite) TestDate_DatabaseInterface() {
 		s.True(scannedDate.IsZero())
 	})
 }
+
+func BenchmarkParseDate(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := wisp.ParseDate("2025-09-09"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTimeParseDate(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := time.Parse("2006-01-02", "2025-09-09"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDate_ScanBytes(b *testing.B) {
+	src := []byte("2025-09-09")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var d wisp.Date
+		if err := d.Scan(src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -12,12 +12,17 @@ import (
 // It is a value object that ensures the start date is not after the end date.
 // Both the start and end dates are of the wisp.Date type.
 //
+// A range may also be open-ended (e.g., a contract active since 2024-01-01 and still ongoing),
+// created with NewOpenEndedDateRange. Its end is unbounded and End returns ZeroDate,
+// so there is no need for sentinel far-future dates.
+//
 // The zero value for DateRange is ZeroDateRange, where both start and end dates are zero.
 //
 // Examples:
 //   start, _ := wisp.NewDate(2025, 1, 1)
 //   end, _ := wisp.NewDate(2025, 1, 31)
 //   dr, err := wisp.NewDateRange(start, end)
+//   ongoing, err := wisp.NewOpenEndedDateRange(start)
 type DateRange struct {
 	start Date
 	end   Date
@@ -40,12 +45,24 @@ func NewDateRange(start, end Date) (DateRange, error) {
 	return DateRange{start: start, end: end}, nil
 }
 
+// NewOpenEndedDateRange creates a new DateRange that starts at start and has no end date.
+// It returns an error if the start date is zero.
+func NewOpenEndedDateRange(start Date) (DateRange, error) {
+	if start.IsZero() {
+		return ZeroDateRange, fault.New(
+			"open-ended date range requires a start date",
+			fault.WithCode(fault.Invalid),
+		)
+	}
+	return DateRange{start: start}, nil
+}
+
 // Start returns the start date of the range.
 func (dr DateRange) Start() Date {
 	return dr.start
 }
 
-// End returns the end date of the range.
+// End returns the end date of the range, or ZeroDate if the range is open-ended.
 func (dr DateRange) End() Date {
 	return dr.end
 }
@@ -60,58 +77,109 @@ func (dr DateRange) Equals(other DateRange) bool {
 	return dr.start.Equals(other.start) && dr.end.Equals(other.end)
 }
 
+// IsOpenEnded returns true if the range has a start date but no end date.
+func (dr DateRange) IsOpenEnded() bool {
+	return !dr.start.IsZero() && dr.end.IsZero()
+}
+
 // Contains checks if a given date is within the date range (inclusive).
+// For an open-ended range, any date on or after the start is contained.
 func (dr DateRange) Contains(d Date) bool {
 	if dr.IsZero() || d.IsZero() {
 		return false
 	}
-	return !d.Before(dr.start) && !d.After(dr.end)
+	return !d.Before(dr.start) && (dr.IsOpenEnded() || !d.After(dr.end))
 }
 
 // Overlaps checks if two date ranges have at least one day in common.
+// Open-ended ranges extend indefinitely into the future.
 func (dr DateRange) Overlaps(other DateRange) bool {
 	if dr.IsZero() || other.IsZero() {
 		return false
 	}
 
-	return !dr.start.After(other.end) && !dr.end.Before(other.start)
+	startsBeforeOtherEnds := other.IsOpenEnded() || !dr.start.After(other.end)
+	endsAfterOtherStarts := dr.IsOpenEnded() || !dr.end.Before(other.start)
+	return startsBeforeOtherEnds && endsAfterOtherStarts
 }
 
 // Days returns the total number of days in the range, inclusive.
 // For example, a range from 2025-01-01 to 2025-01-03 has 3 days.
+// Open-ended ranges have no finite length and return 0.
 func (dr DateRange) Days() int {
-	if dr.IsZero() {
+	if dr.IsZero() || dr.IsOpenEnded() {
 		return 0
 	}
 
 	return int(dr.end.t.Sub(dr.start.t).Hours()/24) + 1
 }
 
-// String returns a formatted string representation of the date range, like "YYYY-MM-DD to YYYY-MM-DD".
+// Duration returns the calendar length of the range as a Period, counting the end date as a full day.
+// For example, a range from 2025-01-01 to 2025-01-31 has a duration of "P1M", and a range from
+// 2024-03-15 to 2025-05-16 has a duration of "P1Y2M2D".
+// Open-ended ranges have no finite length and return ZeroPeriod.
+func (dr DateRange) Duration() Period {
+	if dr.IsZero() || dr.IsOpenEnded() {
+		return ZeroPeriod
+	}
+	return periodBetween(dr.start, dr.end.AddDays(1))
+}
+
+// BusinessDays returns the number of business days in the range, inclusive.
+// A business day is a weekday (Monday to Friday) that is not a holiday in cal.
+// A nil calendar only excludes weekends. Open-ended ranges return 0.
+func (dr DateRange) BusinessDays(cal HolidayCalendar) int {
+	if dr.IsZero() || dr.IsOpenEnded() {
+		return 0
+	}
+
+	count := 0
+	for d := dr.start; !d.After(dr.end); d = d.AddDays(1) {
+		if IsBusinessDay(d, cal) {
+			count++
+		}
+	}
+	return count
+}
+
+// String returns a formatted string representation of the date range, like "YYYY-MM-DD to YYYY-MM-DD",
+// or "YYYY-MM-DD onwards" for an open-ended range.
 func (dr DateRange) String() string {
 	if dr.IsZero() {
 		return ""
 	}
+	if dr.IsOpenEnded() {
+		return fmt.Sprintf("%s onwards", dr.start.String())
+	}
 	return fmt.Sprintf("%s to %s", dr.start.String(), dr.end.String())
 }
 
 // MarshalJSON implements the json.Marshaler interface.
 // It serializes the DateRange into a JSON object with "start" and "end" fields.
+// The end of an open-ended range is serialized as null.
 func (dr DateRange) MarshalJSON() ([]byte, error) {
 	if dr.IsZero() {
 		return json.Marshal(nil)
 	}
-	return json.Marshal(&struct {
-		Start string `json:"start"`
-		End   string `json:"end"`
-	}{
-		Start: dr.start.String(),
-		End:   dr.end.String(),
-	})
+
+	buf := getJSONBuffer()
+	defer putJSONBuffer(buf)
+
+	buf.b = append(buf.b, `{"start":`...)
+	buf.b = appendJSONString(buf.b, dr.start.String())
+	buf.b = append(buf.b, `,"end":`...)
+	if dr.IsOpenEnded() {
+		buf.b = append(buf.b, "null"...)
+	} else {
+		buf.b = appendJSONString(buf.b, dr.end.String())
+	}
+	buf.b = append(buf.b, '}')
+	return buf.bytes(), nil
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
 // It deserializes a JSON object with "start" and "end" fields into a DateRange.
+// An explicit null "end" results in an open-ended range; a missing "end" is an error.
 func (dr *DateRange) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
 		*dr = ZeroDateRange
@@ -119,8 +187,8 @@ func (dr *DateRange) UnmarshalJSON(data []byte) error {
 	}
 
 	dto := &struct {
-		Start string `json:"start"`
-		End   string `json:"end"`
+		Start string          `json:"start"`
+		End   json.RawMessage `json:"end"`
 	}{}
 
 	if err := json.Unmarshal(data, dto); err != nil {
@@ -132,7 +200,21 @@ func (dr *DateRange) UnmarshalJSON(data []byte) error {
 		return fault.Wrap(err, "invalid start date for DateRange", fault.WithCode(fault.Invalid))
 	}
 
-	end, err := ParseDate(dto.End)
+	if string(dto.End) == "null" {
+		dateRange, err := NewOpenEndedDateRange(start)
+		if err != nil {
+			return err
+		}
+		*dr = dateRange
+		return nil
+	}
+
+	var endValue string
+	if err := json.Unmarshal(dto.End, &endValue); err != nil {
+		return fault.Wrap(err, "invalid end date for DateRange", fault.WithCode(fault.Invalid))
+	}
+
+	end, err := ParseDate(endValue)
 	if err != nil {
 		return fault.Wrap(err, "invalid end date for DateRange", fault.WithCode(fault.Invalid))
 	}
@@ -111,3 +111,117 @@ func (s *DateRangeSuite) TestDateRange_JSONMarshaling() {
 		s.Require().Error(err)
 	})
 }
+
+func (s *DateRangeSuite) TestDateRange_OpenEnded() {
+	start, _ := wisp.NewDate(2024, 1, 1)
+	ongoing, err := wisp.NewOpenEndedDateRange(start)
+	s.Require().NoError(err)
+
+	s.Run("constructor", func() {
+		s.True(ongoing.IsOpenEnded())
+		s.False(ongoing.IsZero())
+		s.True(ongoing.End().IsZero())
+		s.Equal("2024-01-01 onwards", ongoing.String())
+		s.Equal(0, ongoing.Days())
+
+		_, err := wisp.NewOpenEndedDateRange(wisp.ZeroDate)
+		s.Require().Error(err)
+
+		bounded, _ := wisp.NewDateRange(start, start)
+		s.False(bounded.IsOpenEnded())
+	})
+
+	s.Run("Contains", func() {
+		farFuture, _ := wisp.NewDate(2999, 12, 31)
+		before, _ := wisp.NewDate(2023, 12, 31)
+		s.True(ongoing.Contains(start))
+		s.True(ongoing.Contains(farFuture))
+		s.False(ongoing.Contains(before))
+		s.False(ongoing.Contains(wisp.ZeroDate))
+	})
+
+	s.Run("Overlaps", func() {
+		d := func(y, m, day int) wisp.Date {
+			date, err := wisp.NewDate(y, time.Month(m), day)
+			s.Require().NoError(err)
+			return date
+		}
+
+		past, _ := wisp.NewDateRange(d(2023, 1, 1), d(2023, 12, 31))
+		touching, _ := wisp.NewDateRange(d(2023, 6, 1), d(2024, 1, 1))
+		future, _ := wisp.NewDateRange(d(2030, 1, 1), d(2030, 1, 31))
+		otherOngoing, _ := wisp.NewOpenEndedDateRange(d(2026, 5, 1))
+
+		s.False(ongoing.Overlaps(past))
+		s.False(past.Overlaps(ongoing))
+		s.True(ongoing.Overlaps(touching))
+		s.True(touching.Overlaps(ongoing))
+		s.True(ongoing.Overlaps(future))
+		s.True(future.Overlaps(ongoing))
+		s.True(ongoing.Overlaps(otherOngoing))
+		s.False(otherOngoing.Overlaps(past))
+	})
+
+	s.Run("JSON", func() {
+		data, err := json.Marshal(ongoing)
+		s.Require().NoError(err)
+		s.JSONEq(`{"start": "2024-01-01", "end": null}`, string(data))
+
+		var decoded wisp.DateRange
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.True(decoded.IsOpenEnded())
+		s.True(ongoing.Equals(decoded))
+
+		s.Error(json.Unmarshal([]byte(`{"start": "2024-01-01"}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`{"start": null, "end": null}`), &decoded))
+	})
+
+	s.Run("SQL", func() {
+		val, err := ongoing.Value()
+		s.Require().NoError(err)
+
+		var scanned wisp.DateRange
+		s.Require().NoError(scanned.Scan(val))
+		s.True(scanned.IsOpenEnded())
+	})
+}
+
+func (s *DateRangeSuite) TestDateRange_Duration() {
+	mustRange := func(y1 int, m1 time.Month, d1 int, y2 int, m2 time.Month, d2 int) wisp.DateRange {
+		start, _ := wisp.NewDate(y1, m1, d1)
+		end, _ := wisp.NewDate(y2, m2, d2)
+		dr, err := wisp.NewDateRange(start, end)
+		s.Require().NoError(err)
+		return dr
+	}
+
+	s.Equal("P1M", mustRange(2025, 1, 1, 2025, 1, 31).Duration().String())
+	s.Equal("P1D", mustRange(2025, 1, 1, 2025, 1, 1).Duration().String())
+	s.Equal("P1Y", mustRange(2024, 1, 1, 2024, 12, 31).Duration().String())
+	s.Equal("P1Y2M2D", mustRange(2024, 3, 15, 2025, 5, 16).Duration().String())
+	s.Equal("P29D", mustRange(2025, 1, 31, 2025, 2, 28).Duration().String())
+
+	start, _ := wisp.NewDate(2024, 1, 1)
+	ongoing, _ := wisp.NewOpenEndedDateRange(start)
+	s.True(ongoing.Duration().IsZero())
+	s.True(wisp.ZeroDateRange.Duration().IsZero())
+}
+
+func (s *DateRangeSuite) TestDateRange_BusinessDays() {
+	start, _ := wisp.NewDate(2025, 12, 22) // Monday
+	end, _ := wisp.NewDate(2026, 1, 4)     // Sunday
+	dr, err := wisp.NewDateRange(start, end)
+	s.Require().NoError(err)
+
+	s.Equal(10, dr.BusinessDays(nil))
+
+	christmas, _ := wisp.NewDate(2025, 12, 25)
+	newYear, _ := wisp.NewDate(2026, 1, 1)
+	saturday, _ := wisp.NewDate(2025, 12, 27)
+	cal := wisp.NewHolidaySet(christmas, newYear, saturday)
+	s.Equal(8, dr.BusinessDays(cal))
+
+	ongoing, _ := wisp.NewOpenEndedDateRange(start)
+	s.Equal(0, ongoing.BusinessDays(cal))
+	s.Equal(0, wisp.ZeroDateRange.BusinessDays(nil))
+}
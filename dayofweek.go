@@ -31,7 +31,7 @@ const (
 	Saturday  DayOfWeek = DayOfWeek(time.Saturday)
 )
 
-// dayOfWeekMap provides a lookup from a lowercase string to a DayOfWeek constant.
+// dayOfWeekMap provides a lookup from a lowercase full or abbreviated name to a DayOfWeek constant.
 var dayOfWeekMap = map[string]DayOfWeek{
 	"sunday":    Sunday,
 	"monday":    Monday,
@@ -40,10 +40,17 @@ var dayOfWeekMap = map[string]DayOfWeek{
 	"thursday":  Thursday,
 	"friday":    Friday,
 	"saturday":  Saturday,
+	"sun":       Sunday,
+	"mon":       Monday,
+	"tue":       Tuesday,
+	"wed":       Wednesday,
+	"thu":       Thursday,
+	"fri":       Friday,
+	"sat":       Saturday,
 }
 
-// ParseDayOfWeek creates a DayOfWeek from a string (e.g., "Monday").
-// The input is case-insensitive.
+// ParseDayOfWeek creates a DayOfWeek from a string (e.g., "Monday" or "Mon").
+// The input is case-insensitive and accepts full or three-letter English names.
 // Returns an error if the string is not a valid day of the week.
 func ParseDayOfWeek(s string) (DayOfWeek, error) {
 	d, ok := dayOfWeekMap[strings.ToLower(strings.TrimSpace(s))]
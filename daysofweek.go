@@ -0,0 +1,217 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// DaysOfWeek is a value object representing a set of days of the week (e.g., Monday to Friday).
+// It is stored as a bitmask where bit n corresponds to the DayOfWeek with value n (Sunday=0),
+// which makes it compact to store and cheap to compare.
+//
+// It is serialized as a JSON array of lowercase day names in ISO order (Monday first),
+// and as an integer bitmask in the database.
+//
+// Examples:
+//   days, err := wisp.ParseDaysOfWeek("mon,wed,fri")
+//   days, err := wisp.ParseDaysOfWeek("mon-fri")
+//   days.Contains(wisp.Wednesday) // true
+type DaysOfWeek uint8
+
+// Predefined sets of days.
+const (
+	EmptyDaysOfWeek DaysOfWeek = 0
+	Weekdays        DaysOfWeek = 1<<Monday | 1<<Tuesday | 1<<Wednesday | 1<<Thursday | 1<<Friday
+	Weekend         DaysOfWeek = 1<<Saturday | 1<<Sunday
+	EveryDay                   = Weekdays | Weekend
+)
+
+// isoWeekOrder lists the days of the week in ISO 8601 order, starting on Monday.
+var isoWeekOrder = [7]DayOfWeek{Monday, Tuesday, Wednesday, Thursday, Friday, Saturday, Sunday}
+
+// NewDaysOfWeek creates a set from the given days. Duplicates are ignored.
+// Returns an error if any day is out of range.
+func NewDaysOfWeek(days ...DayOfWeek) (DaysOfWeek, error) {
+	var set DaysOfWeek
+	for _, d := range days {
+		if d < Sunday || d > Saturday {
+			return EmptyDaysOfWeek, fault.New(
+				"invalid day of week",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("value", int(d)),
+			)
+		}
+		set |= 1 << d
+	}
+	return set, nil
+}
+
+// ParseDaysOfWeek creates a set from a comma-separated list of day names, such as "mon,wed,fri".
+// Full and abbreviated names are accepted (case-insensitive), as well as ranges like "mon-fri".
+// A range wraps around the end of the week, so "fri-mon" is Friday to Monday.
+// An empty string yields EmptyDaysOfWeek.
+func ParseDaysOfWeek(s string) (DaysOfWeek, error) {
+	var set DaysOfWeek
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		from, to, isRange := strings.Cut(part, "-")
+		first, err := ParseDayOfWeek(from)
+		if err != nil {
+			return EmptyDaysOfWeek, err
+		}
+		if !isRange {
+			set |= 1 << first
+			continue
+		}
+
+		last, err := ParseDayOfWeek(to)
+		if err != nil {
+			return EmptyDaysOfWeek, err
+		}
+		for d := first; ; d = (d + 1) % 7 {
+			set |= 1 << d
+			if d == last {
+				break
+			}
+		}
+	}
+	return set, nil
+}
+
+// Contains checks if the day is in the set.
+func (s DaysOfWeek) Contains(d DayOfWeek) bool {
+	if d < Sunday || d > Saturday {
+		return false
+	}
+	return s&(1<<d) != 0
+}
+
+// Add returns a new set that also contains the given days. Out-of-range days are ignored.
+func (s DaysOfWeek) Add(days ...DayOfWeek) DaysOfWeek {
+	for _, d := range days {
+		if d >= Sunday && d <= Saturday {
+			s |= 1 << d
+		}
+	}
+	return s
+}
+
+// Remove returns a new set without the given days.
+func (s DaysOfWeek) Remove(days ...DayOfWeek) DaysOfWeek {
+	for _, d := range days {
+		if d >= Sunday && d <= Saturday {
+			s &^= 1 << d
+		}
+	}
+	return s
+}
+
+// Union returns the days present in either set.
+func (s DaysOfWeek) Union(other DaysOfWeek) DaysOfWeek {
+	return (s | other) & EveryDay
+}
+
+// Intersect returns the days present in both sets.
+func (s DaysOfWeek) Intersect(other DaysOfWeek) DaysOfWeek {
+	return s & other & EveryDay
+}
+
+// Days returns the days in the set in ISO order (Monday first).
+func (s DaysOfWeek) Days() []DayOfWeek {
+	days := make([]DayOfWeek, 0, 7)
+	for _, d := range isoWeekOrder {
+		if s.Contains(d) {
+			days = append(days, d)
+		}
+	}
+	return days
+}
+
+// Len returns the number of days in the set.
+func (s DaysOfWeek) Len() int {
+	return len(s.Days())
+}
+
+// IsZero returns true if the set is empty.
+func (s DaysOfWeek) IsZero() bool {
+	return s&EveryDay == EmptyDaysOfWeek
+}
+
+// String returns the days as a comma-separated list of abbreviated names in ISO order
+// (e.g., "mon,wed,fri"), which ParseDaysOfWeek accepts back.
+func (s DaysOfWeek) String() string {
+	names := make([]string, 0, 7)
+	for _, d := range s.Days() {
+		names = append(names, strings.ToLower(d.String()[:3]))
+	}
+	return strings.Join(names, ",")
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the set as a JSON array of lowercase day names (e.g., ["monday","friday"]).
+func (s DaysOfWeek) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Days())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON array of day names; null results in EmptyDaysOfWeek.
+func (s *DaysOfWeek) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = EmptyDaysOfWeek
+		return nil
+	}
+
+	var days []DayOfWeek
+	if err := json.Unmarshal(data, &days); err != nil {
+		return fault.Wrap(err, "DaysOfWeek must be a JSON array of day names", fault.WithCode(fault.Invalid))
+	}
+
+	set, err := NewDaysOfWeek(days...)
+	if err != nil {
+		return err
+	}
+	*s = set
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the set as an integer bitmask (Sunday=1, Monday=2, Tuesday=4, ...).
+func (s DaysOfWeek) Value() (driver.Value, error) {
+	return int64(s & EveryDay), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts an integer bitmask from the database.
+func (s *DaysOfWeek) Scan(src interface{}) error {
+	if src == nil {
+		*s = EmptyDaysOfWeek
+		return nil
+	}
+
+	var i int64
+	switch v := src.(type) {
+	case int64:
+		i = v
+	default:
+		return fault.New(
+			"unsupported scan type for DaysOfWeek",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	if i < 0 || i > int64(EveryDay) {
+		return fault.New("value out of range for DaysOfWeek", fault.WithCode(fault.Invalid), fault.WithContext("value", i))
+	}
+
+	*s = DaysOfWeek(i)
+	return nil
+}
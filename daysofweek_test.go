@@ -0,0 +1,91 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type DaysOfWeekSuite struct {
+	suite.Suite
+}
+
+func TestDaysOfWeekSuite(t *testing.T) {
+	suite.Run(t, new(DaysOfWeekSuite))
+}
+
+func (s *DaysOfWeekSuite) TestParseDaysOfWeek() {
+	testCases := []struct {
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{input: "mon,wed,fri", expected: "mon,wed,fri"},
+		{input: "Friday, monday , WED", expected: "mon,wed,fri"},
+		{input: "mon-fri", expected: "mon,tue,wed,thu,fri"},
+		{input: "fri-mon", expected: "mon,fri,sat,sun"},
+		{input: "sat-sat,sun", expected: "sat,sun"},
+		{input: "", expected: ""},
+		{input: "mon,funday", wantErr: true},
+		{input: "mon-", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.input, func() {
+			days, err := wisp.ParseDaysOfWeek(tc.input)
+			if tc.wantErr {
+				s.Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.expected, days.String())
+		})
+	}
+}
+
+func (s *DaysOfWeekSuite) TestSetOperations() {
+	days, err := wisp.NewDaysOfWeek(wisp.Monday, wisp.Wednesday, wisp.Monday)
+	s.Require().NoError(err)
+	s.Equal(2, days.Len())
+	s.True(days.Contains(wisp.Monday))
+	s.False(days.Contains(wisp.Tuesday))
+	s.False(days.Contains(wisp.DayOfWeek(9)))
+
+	s.Equal([]wisp.DayOfWeek{wisp.Monday, wisp.Wednesday, wisp.Sunday}, days.Add(wisp.Sunday).Days())
+	s.Equal("wed", days.Remove(wisp.Monday).String())
+	s.Equal(wisp.EveryDay, wisp.Weekdays.Union(wisp.Weekend))
+	s.True(wisp.Weekdays.Intersect(wisp.Weekend).IsZero())
+	s.Equal(7, wisp.EveryDay.Len())
+
+	_, err = wisp.NewDaysOfWeek(wisp.DayOfWeek(7))
+	s.Error(err)
+}
+
+func (s *DaysOfWeekSuite) TestJSONAndSQL() {
+	days, _ := wisp.ParseDaysOfWeek("sun,mon")
+
+	data, err := json.Marshal(days)
+	s.Require().NoError(err)
+	s.Equal(`["monday","sunday"]`, string(data))
+
+	var decoded wisp.DaysOfWeek
+	s.Require().NoError(json.Unmarshal([]byte(`["sunday","mon"]`), &decoded))
+	s.Equal(days, decoded)
+	s.Error(json.Unmarshal([]byte(`"mon"`), &decoded))
+	s.Error(json.Unmarshal([]byte(`["someday"]`), &decoded))
+
+	val, err := days.Value()
+	s.Require().NoError(err)
+	s.Equal(int64(3), val)
+
+	var scanned wisp.DaysOfWeek
+	s.Require().NoError(scanned.Scan(int64(62)))
+	s.Equal(wisp.Weekdays, scanned)
+	s.Error(scanned.Scan(int64(128)))
+	s.Error(scanned.Scan("mon"))
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+}
@@ -23,13 +23,20 @@ const (
 //
 // A Discount is immutable. Operations like applying it to a monetary value return a new result.
 //
+// A discount may also carry coupon rules: an optional validity period and an optional minimum
+// purchase amount, checked with IsApplicable.
+//
 // Examples:
 //   fixed, _ := NewFixedDiscount(NewMoney(1000, BRL)) // R$10.00 discount
 //   percent, _ := NewPercentageDiscount(NewPercentageFromFloat(0.15)) // 15% discount
+//   coupon, _ := percent.WithValidity(blackFriday).WithMinimumPurchase(NewMoney(10000, BRL))
+//   ok := coupon.IsApplicable(Today(), cartTotal)
 type Discount struct {
 	discountType    DiscountType
 	fixedValue      Money
 	percentageValue Percentage
+	validity        DateRange
+	minPurchase     Money
 }
 
 // ZeroDiscount represents the zero value for the Discount type (no discount).
@@ -60,6 +67,64 @@ func NewPercentageDiscount(value Percentage) (Discount, error) {
 	}, nil
 }
 
+// WithValidity returns a copy of the discount that is only applicable within the given date range.
+// An open-ended range restricts only the start date; ZeroDateRange removes the restriction.
+func (d Discount) WithValidity(validity DateRange) Discount {
+	d.validity = validity
+	return d
+}
+
+// WithMinimumPurchase returns a copy of the discount that is only applicable when the purchase
+// amount is at least minimum. ZeroMoney removes the restriction.
+// Returns an error if the minimum is negative, or if its currency differs from a fixed discount's currency.
+func (d Discount) WithMinimumPurchase(minimum Money) (Discount, error) {
+	if minimum.IsNegative() {
+		return ZeroDiscount, fault.New(
+			"minimum purchase cannot be negative",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("minimum_purchase", minimum.String()),
+		)
+	}
+	if !minimum.IsZero() && d.discountType == FixedDiscount && minimum.Currency() != d.fixedValue.Currency() {
+		return ZeroDiscount, fault.New(
+			"minimum purchase must have the same currency as the fixed discount",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("discount_currency", d.fixedValue.Currency()),
+			fault.WithContext("minimum_currency", minimum.Currency()),
+		)
+	}
+	d.minPurchase = minimum
+	return d, nil
+}
+
+// Validity returns the period in which the discount is applicable, or ZeroDateRange if unrestricted.
+func (d Discount) Validity() DateRange {
+	return d.validity
+}
+
+// MinimumPurchase returns the minimum purchase amount, or ZeroMoney if unrestricted.
+func (d Discount) MinimumPurchase() Money {
+	return d.minPurchase
+}
+
+// IsApplicable checks the discount's coupon rules for a purchase of amount on date:
+// the date must fall within the validity period, and the amount must reach the minimum purchase
+// in the same currency. A zero discount is never applicable.
+func (d Discount) IsApplicable(date Date, amount Money) bool {
+	if d.IsZero() {
+		return false
+	}
+	if !d.validity.IsZero() && !d.validity.Contains(date) {
+		return false
+	}
+	if !d.minPurchase.IsZero() {
+		if amount.Currency() != d.minPurchase.Currency() || amount.Amount() < d.minPurchase.Amount() {
+			return false
+		}
+	}
+	return true
+}
+
 // ApplyTo applies the discount to a given Money value and returns the new amount.
 // - For a fixed discount, it subtracts the fixed amount. Currencies must match.
 // - For a percentage discount, it calculates and subtracts the percentage amount.
@@ -119,26 +184,39 @@ func (d Discount) IsZero() bool {
 }
 
 // MarshalJSON implements the json.Marshaler interface.
-// It serializes the Discount into a JSON object with "type" and "value" fields.
+// It serializes the Discount into a JSON object with "type" and "value" fields, plus the
+// optional "validity" and "min_purchase" fields when the discount has coupon rules.
 func (d Discount) MarshalJSON() ([]byte, error) {
 	if d.IsZero() {
 		return json.Marshal(nil)
 	}
 
-	var value any
+	buf := getJSONBuffer()
+	defer putJSONBuffer(buf)
+
+	var err error
+	buf.b = append(buf.b, `{"type":`...)
+	buf.b = appendJSONString(buf.b, string(d.discountType))
+	buf.b = append(buf.b, `,"value":`...)
 	if d.discountType == FixedDiscount {
-		value = d.fixedValue
-	} else {
-		value = d.percentageValue.Float64()
-	}
-
-	return json.Marshal(&struct {
-		Type  DiscountType `json:"type"`
-		Value any          `json:"value"`
-	}{
-		Type:  d.discountType,
-		Value: value,
-	})
+		buf.b = d.fixedValue.appendJSON(buf.b)
+	} else if buf.b, err = appendJSONFloat(buf.b, d.percentageValue.Float64()); err != nil {
+		return nil, err
+	}
+	if !d.validity.IsZero() {
+		validity, err := d.validity.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		buf.b = append(buf.b, `,"validity":`...)
+		buf.b = append(buf.b, validity...)
+	}
+	if !d.minPurchase.IsZero() {
+		buf.b = append(buf.b, `,"min_purchase":`...)
+		buf.b = d.minPurchase.appendJSON(buf.b)
+	}
+	buf.b = append(buf.b, '}')
+	return buf.bytes(), nil
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -150,8 +228,10 @@ func (d *Discount) UnmarshalJSON(data []byte) error {
 	}
 
 	dto := &struct {
-		Type  DiscountType    `json:"type"`
-		Value json.RawMessage `json:"value"`
+		Type        DiscountType    `json:"type"`
+		Value       json.RawMessage `json:"value"`
+		Validity    DateRange       `json:"validity"`
+		MinPurchase *Money          `json:"min_purchase"`
 	}{}
 
 	if err := json.Unmarshal(data, dto); err != nil {
@@ -185,6 +265,14 @@ func (d *Discount) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return err
 	}
+
+	newDiscount = newDiscount.WithValidity(dto.Validity)
+	if dto.MinPurchase != nil {
+		if newDiscount, err = newDiscount.WithMinimumPurchase(*dto.MinPurchase); err != nil {
+			return err
+		}
+	}
+
 	*d = newDiscount
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/marcelofabianov/fault"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/marcelofabianov/wisp"
@@ -105,3 +106,72 @@ func (s *DiscountSuite) TestDiscount_JSON() {
 		s.Equal(d.String(), unmarshaledD.String())
 	})
 }
+
+func (s *DiscountSuite) TestDiscount_CouponRules() {
+	tenPercent, _ := wisp.NewPercentageFromFloat(0.1)
+	base, _ := wisp.NewPercentageDiscount(tenPercent)
+
+	start, _ := wisp.NewDate(2025, 11, 24)
+	end, _ := wisp.NewDate(2025, 11, 30)
+	blackFriday, _ := wisp.NewDateRange(start, end)
+	minimum, _ := wisp.NewMoney(10000, wisp.BRL)
+
+	coupon, err := base.WithValidity(blackFriday).WithMinimumPurchase(minimum)
+	s.Require().NoError(err)
+	s.Equal(blackFriday, coupon.Validity())
+	s.Equal(minimum, coupon.MinimumPurchase())
+
+	inside, _ := wisp.NewDate(2025, 11, 28)
+	outside, _ := wisp.NewDate(2025, 12, 1)
+	enough, _ := wisp.NewMoney(15000, wisp.BRL)
+	tooLittle, _ := wisp.NewMoney(9999, wisp.BRL)
+	otherCurrency, _ := wisp.NewMoney(15000, wisp.USD)
+
+	s.Run("IsApplicable", func() {
+		s.True(coupon.IsApplicable(inside, enough))
+		s.True(coupon.IsApplicable(inside, minimum))
+		s.False(coupon.IsApplicable(outside, enough))
+		s.False(coupon.IsApplicable(inside, tooLittle))
+		s.False(coupon.IsApplicable(inside, otherCurrency))
+		s.True(base.IsApplicable(outside, tooLittle))
+		s.False(wisp.ZeroDiscount.IsApplicable(inside, enough))
+	})
+
+	s.Run("open-ended validity", func() {
+		since, _ := wisp.NewOpenEndedDateRange(start)
+		d := base.WithValidity(since)
+		s.True(d.IsApplicable(outside, tooLittle))
+		s.False(d.IsApplicable(start.AddDays(-1), tooLittle))
+	})
+
+	s.Run("WithMinimumPurchase validation", func() {
+		negative, _ := wisp.NewMoney(-1, wisp.BRL)
+		_, err := base.WithMinimumPurchase(negative)
+		s.Error(err)
+
+		fiveReais, _ := wisp.NewMoney(500, wisp.BRL)
+		fixed, _ := wisp.NewFixedDiscount(fiveReais)
+		usdMinimum, _ := wisp.NewMoney(10000, wisp.USD)
+		_, err = fixed.WithMinimumPurchase(usdMinimum)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+
+	s.Run("JSON", func() {
+		data, err := json.Marshal(coupon)
+		s.Require().NoError(err)
+		s.JSONEq(`{
+			"type":"percentage","value":0.1,
+			"validity":{"start":"2025-11-24","end":"2025-11-30"},
+			"min_purchase":{"amount":10000,"currency":"BRL"}
+		}`, string(data))
+
+		var decoded wisp.Discount
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(coupon, decoded)
+
+		data, err = json.Marshal(base)
+		s.Require().NoError(err)
+		s.JSONEq(`{"type":"percentage","value":0.1}`, string(data))
+	})
+}
@@ -0,0 +1,311 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// StackingMode defines how multiple discounts are combined.
+type StackingMode string
+
+// Defines the supported stacking modes.
+const (
+	StackSequential StackingMode = "sequential" // Each discount applies to the price left by the previous one.
+	StackBestOf     StackingMode = "best_of"    // Only the discount that saves the most is applied.
+	StackCapped     StackingMode = "capped"     // Discounts apply sequentially, limited to a maximum share of the price.
+)
+
+// StackingPolicy describes how a Discounts collection is applied to a price.
+// The zero value applies discounts sequentially.
+//
+// Examples:
+//   policy := wisp.SequentialStacking
+//   thirtyPercent, _ := wisp.NewPercentageFromFloat(0.3)
+//   policy, err := wisp.NewCappedStacking(thirtyPercent) // never more than 30% off
+type StackingPolicy struct {
+	mode        StackingMode
+	maxDiscount Percentage
+}
+
+var (
+	// SequentialStacking applies every discount in order, each on the price left by the previous one.
+	SequentialStacking = StackingPolicy{mode: StackSequential}
+	// BestOfStacking applies only the single discount that saves the most.
+	BestOfStacking = StackingPolicy{mode: StackBestOf}
+)
+
+// NewCappedStacking creates a policy that applies discounts sequentially but never takes more
+// than maxDiscount off the original price. The cap must be between 0% and 100%.
+func NewCappedStacking(maxDiscount Percentage) (StackingPolicy, error) {
+	if maxDiscount.IsNegative() || maxDiscount > HundredPercent {
+		return StackingPolicy{}, fault.New(
+			"discount cap must be between 0% and 100%",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("max_discount", maxDiscount.String()),
+		)
+	}
+	return StackingPolicy{mode: StackCapped, maxDiscount: maxDiscount}, nil
+}
+
+// Mode returns the stacking mode of the policy.
+func (p StackingPolicy) Mode() StackingMode {
+	if p.mode == "" {
+		return StackSequential
+	}
+	return p.mode
+}
+
+// MaxDiscount returns the cap of a capped policy, or ZeroPercentage for other modes.
+func (p StackingPolicy) MaxDiscount() Percentage {
+	return p.maxDiscount
+}
+
+// Discounts is an immutable, ordered collection of discounts (e.g., a coupon, a loyalty discount
+// and a seasonal promotion) that can be applied together under a StackingPolicy.
+// The order matters for sequential application.
+//
+// It is serialized as a JSON array of discounts, both in JSON and in the database.
+//
+// Example:
+//   coupon, _ := wisp.NewFixedDiscount(tenReais)
+//   promo, _ := wisp.NewPercentageDiscount(tenPercent)
+//   ds := wisp.NewDiscounts(promo, coupon)
+//   result, err := ds.Apply(price, wisp.SequentialStacking)
+//   result.Final() // price after both discounts
+type Discounts struct {
+	items []Discount
+}
+
+// EmptyDiscounts represents a collection without any discount.
+var EmptyDiscounts = Discounts{}
+
+// NewDiscounts creates a new Discounts collection, keeping the given order. Zero discounts are ignored.
+func NewDiscounts(discounts ...Discount) Discounts {
+	items := make([]Discount, 0, len(discounts))
+	for _, d := range discounts {
+		if !d.IsZero() {
+			items = append(items, d)
+		}
+	}
+	if len(items) == 0 {
+		return EmptyDiscounts
+	}
+	return Discounts{items: items}
+}
+
+// Items returns a copy of the discounts in the collection.
+func (ds Discounts) Items() []Discount {
+	items := make([]Discount, len(ds.items))
+	copy(items, ds.items)
+	return items
+}
+
+// Len returns the number of discounts in the collection.
+func (ds Discounts) Len() int {
+	return len(ds.items)
+}
+
+// IsEmpty returns true if the collection has no discounts.
+func (ds Discounts) IsEmpty() bool {
+	return len(ds.items) == 0
+}
+
+// AppliedDiscount is a single line of a discount breakdown: the discount and the amount it took off.
+type AppliedDiscount struct {
+	discount Discount
+	amount   Money
+}
+
+// Discount returns the discount that was applied.
+func (a AppliedDiscount) Discount() Discount {
+	return a.discount
+}
+
+// Amount returns how much the discount took off the price.
+func (a AppliedDiscount) Amount() Money {
+	return a.amount
+}
+
+// DiscountResult is the outcome of applying a Discounts collection to a price, with the
+// itemized breakdown suitable for receipts. The sum of the line amounts always equals
+// Original minus Final.
+type DiscountResult struct {
+	original Money
+	final    Money
+	lines    []AppliedDiscount
+}
+
+// Original returns the price before any discount.
+func (r DiscountResult) Original() Money {
+	return r.original
+}
+
+// Final returns the price after the discounts.
+func (r DiscountResult) Final() Money {
+	return r.final
+}
+
+// TotalDiscount returns the total amount taken off the original price.
+func (r DiscountResult) TotalDiscount() Money {
+	return Money{amount: r.original.amount - r.final.amount, currency: r.original.currency}
+}
+
+// Lines returns the discounts that were effectively applied, in order of application.
+func (r DiscountResult) Lines() []AppliedDiscount {
+	lines := make([]AppliedDiscount, len(r.lines))
+	copy(lines, r.lines)
+	return lines
+}
+
+// Apply applies the discounts to m according to the policy and returns the final price with
+// its breakdown. The resulting price is never negative.
+// Returns an error if m is the zero value, the policy mode is unknown, or a fixed discount
+// has a different currency than m.
+func (ds Discounts) Apply(m Money, policy StackingPolicy) (DiscountResult, error) {
+	if m.IsZero() {
+		return DiscountResult{}, fault.New("cannot apply discounts to an empty money value", fault.WithCode(fault.Invalid))
+	}
+
+	switch policy.Mode() {
+	case StackSequential:
+		return ds.applySequential(m)
+	case StackBestOf:
+		return ds.applyBestOf(m)
+	case StackCapped:
+		result, err := ds.applySequential(m)
+		if err != nil {
+			return DiscountResult{}, err
+		}
+		return result.capped(policy.maxDiscount)
+	default:
+		return DiscountResult{}, fault.New(
+			"unknown discount stacking mode",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("mode", policy.mode),
+		)
+	}
+}
+
+// applySequential applies each discount to the price left by the previous one.
+func (ds Discounts) applySequential(m Money) (DiscountResult, error) {
+	result := DiscountResult{original: m, final: m, lines: make([]AppliedDiscount, 0, len(ds.items))}
+	for _, d := range ds.items {
+		next, err := d.ApplyTo(result.final)
+		if err != nil {
+			return DiscountResult{}, err
+		}
+		amount := Money{amount: result.final.amount - next.amount, currency: m.currency}
+		result.lines = append(result.lines, AppliedDiscount{discount: d, amount: amount})
+		result.final = next
+	}
+	return result, nil
+}
+
+// applyBestOf applies only the discount with the largest saving; ties keep the first one.
+func (ds Discounts) applyBestOf(m Money) (DiscountResult, error) {
+	result := DiscountResult{original: m, final: m, lines: []AppliedDiscount{}}
+	for _, d := range ds.items {
+		next, err := d.ApplyTo(m)
+		if err != nil {
+			return DiscountResult{}, err
+		}
+		if next.amount < result.final.amount {
+			amount := Money{amount: m.amount - next.amount, currency: m.currency}
+			result.final = next
+			result.lines = []AppliedDiscount{{discount: d, amount: amount}}
+		}
+	}
+	return result, nil
+}
+
+// capped limits the total discount to maxDiscount of the original price, trimming the
+// breakdown from the last applied discount backwards so the lines still add up.
+func (r DiscountResult) capped(maxDiscount Percentage) (DiscountResult, error) {
+	limit, err := maxDiscount.ApplyToWithMode(r.original, RoundFloor)
+	if err != nil {
+		return DiscountResult{}, err
+	}
+
+	excess := r.original.amount - r.final.amount - limit.amount
+	if excess <= 0 {
+		return r, nil
+	}
+
+	for i := len(r.lines) - 1; i >= 0 && excess > 0; i-- {
+		cut := r.lines[i].amount.amount
+		if cut > excess {
+			cut = excess
+		}
+		r.lines[i].amount.amount -= cut
+		excess -= cut
+	}
+	r.final = Money{amount: r.original.amount - limit.amount, currency: r.original.currency}
+	return r, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the collection as a JSON array of discounts.
+func (ds Discounts) MarshalJSON() ([]byte, error) {
+	if ds.items == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(ds.items)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON array of discounts; null results in EmptyDiscounts.
+func (ds *Discounts) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*ds = EmptyDiscounts
+		return nil
+	}
+
+	var items []Discount
+	if err := json.Unmarshal(data, &items); err != nil {
+		return fault.Wrap(err, "invalid JSON format for Discounts", fault.WithCode(fault.Invalid))
+	}
+
+	*ds = NewDiscounts(items...)
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the collection as a JSON array string.
+func (ds Discounts) Value() (driver.Value, error) {
+	data, err := ds.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal discounts for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing a JSON array of discounts.
+func (ds *Discounts) Scan(src interface{}) error {
+	if src == nil {
+		*ds = EmptyDiscounts
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for Discounts",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return ds.UnmarshalJSON(data)
+}
@@ -0,0 +1,152 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type DiscountsSuite struct {
+	suite.Suite
+}
+
+func TestDiscountsSuite(t *testing.T) {
+	suite.Run(t, new(DiscountsSuite))
+}
+
+func (s *DiscountsSuite) brl(cents int64) wisp.Money {
+	m, err := wisp.NewMoney(cents, wisp.BRL)
+	s.Require().NoError(err)
+	return m
+}
+
+func (s *DiscountsSuite) percent(value float64) wisp.Discount {
+	p, err := wisp.NewPercentageFromFloat(value)
+	s.Require().NoError(err)
+	d, err := wisp.NewPercentageDiscount(p)
+	s.Require().NoError(err)
+	return d
+}
+
+func (s *DiscountsSuite) fixed(cents int64) wisp.Discount {
+	d, err := wisp.NewFixedDiscount(s.brl(cents))
+	s.Require().NoError(err)
+	return d
+}
+
+func (s *DiscountsSuite) lineAmounts(r wisp.DiscountResult) []int64 {
+	amounts := []int64{}
+	for _, l := range r.Lines() {
+		amounts = append(amounts, l.Amount().Amount())
+	}
+	return amounts
+}
+
+func (s *DiscountsSuite) TestNewDiscounts() {
+	ds := wisp.NewDiscounts(s.percent(0.1), wisp.ZeroDiscount, s.fixed(500))
+	s.Equal(2, ds.Len())
+	s.False(ds.IsEmpty())
+	s.True(wisp.NewDiscounts().IsEmpty())
+}
+
+func (s *DiscountsSuite) TestApply() {
+	price := s.brl(10000)
+	ds := wisp.NewDiscounts(s.percent(0.1), s.fixed(2000), s.percent(0.2))
+
+	s.Run("sequential", func() {
+		result, err := ds.Apply(price, wisp.SequentialStacking)
+		s.Require().NoError(err)
+		// 100.00 -10% = 90.00, -20.00 = 70.00, -20% = 56.00
+		s.Equal(int64(5600), result.Final().Amount())
+		s.Equal(int64(4400), result.TotalDiscount().Amount())
+		s.Equal([]int64{1000, 2000, 1400}, s.lineAmounts(result))
+		s.Equal(price, result.Original())
+	})
+
+	s.Run("zero value policy is sequential", func() {
+		result, err := ds.Apply(price, wisp.StackingPolicy{})
+		s.Require().NoError(err)
+		s.Equal(int64(5600), result.Final().Amount())
+	})
+
+	s.Run("best of", func() {
+		result, err := ds.Apply(price, wisp.BestOfStacking)
+		s.Require().NoError(err)
+		s.Equal(int64(8000), result.Final().Amount())
+		s.Require().Len(result.Lines(), 1)
+		s.Equal(s.fixed(2000), result.Lines()[0].Discount())
+	})
+
+	s.Run("capped", func() {
+		maxOff, err := wisp.NewPercentageFromFloat(0.25)
+		s.Require().NoError(err)
+		policy, err := wisp.NewCappedStacking(maxOff)
+		s.Require().NoError(err)
+		s.Equal(wisp.StackCapped, policy.Mode())
+
+		result, err := ds.Apply(price, policy)
+		s.Require().NoError(err)
+		s.Equal(int64(7500), result.Final().Amount())
+		s.Equal([]int64{1000, 1500, 0}, s.lineAmounts(result))
+
+		generous, _ := wisp.NewPercentageFromFloat(0.5)
+		policy, _ = wisp.NewCappedStacking(generous)
+		result, err = ds.Apply(price, policy)
+		s.Require().NoError(err)
+		s.Equal(int64(5600), result.Final().Amount())
+	})
+
+	s.Run("never goes below zero", func() {
+		result, err := wisp.NewDiscounts(s.fixed(8000), s.fixed(8000)).Apply(price, wisp.SequentialStacking)
+		s.Require().NoError(err)
+		s.Equal(int64(0), result.Final().Amount())
+		s.Equal([]int64{8000, 2000}, s.lineAmounts(result))
+	})
+
+	s.Run("empty collection keeps the price", func() {
+		result, err := wisp.EmptyDiscounts.Apply(price, wisp.BestOfStacking)
+		s.Require().NoError(err)
+		s.Equal(price, result.Final())
+		s.Empty(result.Lines())
+	})
+
+	s.Run("errors", func() {
+		_, err := ds.Apply(wisp.ZeroMoney, wisp.SequentialStacking)
+		s.Error(err)
+
+		usd, _ := wisp.NewMoney(10000, wisp.USD)
+		_, err = ds.Apply(usd, wisp.SequentialStacking)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+
+		_, err = wisp.NewCappedStacking(wisp.Percentage(10001))
+		s.Error(err)
+	})
+}
+
+func (s *DiscountsSuite) TestJSONAndSQL() {
+	ds := wisp.NewDiscounts(s.percent(0.1), s.fixed(500))
+
+	data, err := json.Marshal(ds)
+	s.Require().NoError(err)
+	s.JSONEq(`[{"type":"percentage","value":0.1},{"type":"fixed","value":{"amount":500,"currency":"BRL"}}]`, string(data))
+
+	var decoded wisp.Discounts
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(ds.Items(), decoded.Items())
+
+	data, err = json.Marshal(wisp.EmptyDiscounts)
+	s.Require().NoError(err)
+	s.Equal("[]", string(data))
+
+	val, err := ds.Value()
+	s.Require().NoError(err)
+	var scanned wisp.Discounts
+	s.Require().NoError(scanned.Scan(val))
+	s.Equal(ds.Items(), scanned.Items())
+	s.Error(scanned.Scan(1))
+}
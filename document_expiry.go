@@ -0,0 +1,175 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// ExpirableDocument is a constraint matching the document value objects that can be paired with an
+// expiry date, such as CPF, TaxID or an application-defined passport number.
+type ExpirableDocument interface {
+	comparable
+	IsZero() bool
+}
+
+// DocumentWithExpiry is a generic value object pairing an identity document, such as a driver's
+// license (CNH) or a passport, with the last day it is valid. It supports compliance checks on
+// stored credentials, like refusing an expired license or asking for renewal ahead of time.
+//
+// The document is valid through its expiry date, inclusive. When the document implements Masker,
+// Masked hides it the same way, so Redact keeps it out of logs.
+//
+// The zero value is empty: it holds no document and never expires.
+//
+// Example:
+//   expiresOn, _ := wisp.NewDate(2030, time.May, 31)
+//   license, err := wisp.NewDocumentWithExpiry(cnh, expiresOn)
+//   license.IsExpired(wisp.Today())
+//   renewal, _ := wisp.NewPeriod(0, 0, 30)
+//   license.ExpiresWithin(wisp.Today(), renewal) // true from 2030-05-01
+type DocumentWithExpiry[D ExpirableDocument] struct {
+	document  D
+	expiresOn Date
+}
+
+// NewDocumentWithExpiry creates a new DocumentWithExpiry.
+// It returns an error if the document or the expiry date is the zero value.
+func NewDocumentWithExpiry[D ExpirableDocument](document D, expiresOn Date) (DocumentWithExpiry[D], error) {
+	if document.IsZero() {
+		return DocumentWithExpiry[D]{}, fault.New("document cannot be empty", fault.WithCode(fault.Invalid))
+	}
+	if expiresOn.IsZero() {
+		return DocumentWithExpiry[D]{}, fault.New("document expiry date cannot be empty", fault.WithCode(fault.Invalid))
+	}
+	return DocumentWithExpiry[D]{document: document, expiresOn: expiresOn}, nil
+}
+
+// Document returns the document.
+func (d DocumentWithExpiry[D]) Document() D {
+	return d.document
+}
+
+// ExpiresOn returns the last day the document is valid.
+func (d DocumentWithExpiry[D]) ExpiresOn() Date {
+	return d.expiresOn
+}
+
+// IsZero returns true if the DocumentWithExpiry is the empty zero value.
+func (d DocumentWithExpiry[D]) IsZero() bool {
+	return d.expiresOn.IsZero()
+}
+
+// Equals checks if both values hold the same document with the same expiry date.
+func (d DocumentWithExpiry[D]) Equals(other DocumentWithExpiry[D]) bool {
+	return d.document == other.document && d.expiresOn.Equals(other.expiresOn)
+}
+
+// IsExpired returns true if the document is no longer valid on the reference date, that is, if ref
+// is after the expiry date. The zero value never expires.
+func (d DocumentWithExpiry[D]) IsExpired(ref Date) bool {
+	return !d.IsZero() && ref.After(d.expiresOn)
+}
+
+// ExpiresWithin returns true if the document is still valid on the reference date but expires
+// before the window after it ends, such as a passport within six months of its expiry date.
+// The zero value never expires.
+func (d DocumentWithExpiry[D]) ExpiresWithin(ref Date, window Period) bool {
+	if d.IsZero() || d.IsExpired(ref) {
+		return false
+	}
+	return !d.expiresOn.After(window.AddTo(ref))
+}
+
+// Masked implements the Masker interface. It returns the masked document, or "[REDACTED]" if the
+// document does not implement Masker, followed by the expiry date.
+func (d DocumentWithExpiry[D]) Masked() string {
+	if d.IsZero() {
+		return ""
+	}
+	document := redactedSecret
+	if masker, ok := any(d.document).(Masker); ok {
+		document = masker.Masked()
+	}
+	return fmt.Sprintf("%s (expires %s)", document, d.expiresOn)
+}
+
+// documentWithExpiryJSON is the JSON representation of a DocumentWithExpiry.
+type documentWithExpiryJSON[D ExpirableDocument] struct {
+	Document  D    `json:"document"`
+	ExpiresOn Date `json:"expires_on"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the value into a JSON object with "document" and "expires_on" fields, or null if empty.
+func (d DocumentWithExpiry[D]) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(documentWithExpiryJSON[D]{Document: d.document, ExpiresOn: d.expiresOn})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Both "document" and "expires_on" are required.
+func (d *DocumentWithExpiry[D]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = DocumentWithExpiry[D]{}
+		return nil
+	}
+
+	var dto documentWithExpiryJSON[D]
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for DocumentWithExpiry", fault.WithCode(fault.Invalid))
+	}
+
+	parsed, err := NewDocumentWithExpiry(dto.Document, dto.ExpiresOn)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the value as a JSON string or nil if it's empty.
+func (d DocumentWithExpiry[D]) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+
+	data, err := d.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal document with expiry for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as DocumentWithExpiry.
+func (d *DocumentWithExpiry[D]) Scan(src interface{}) error {
+	if src == nil {
+		*d = DocumentWithExpiry[D]{}
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for DocumentWithExpiry",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return d.UnmarshalJSON(data)
+}
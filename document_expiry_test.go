@@ -0,0 +1,173 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+// passportNumber is a document type defined by the application, without masking.
+type passportNumber string
+
+func (p passportNumber) IsZero() bool { return p == "" }
+
+type DocumentWithExpirySuite struct {
+	suite.Suite
+	cpf       wisp.CPF
+	expiresOn wisp.Date
+}
+
+func TestDocumentWithExpirySuite(t *testing.T) {
+	suite.Run(t, new(DocumentWithExpirySuite))
+}
+
+func (s *DocumentWithExpirySuite) SetupTest() {
+	s.cpf, _ = wisp.NewCPF("529.982.247-25")
+	s.expiresOn, _ = wisp.NewDate(2030, time.May, 31)
+}
+
+func (s *DocumentWithExpirySuite) date(year int, month time.Month, day int) wisp.Date {
+	d, err := wisp.NewDate(year, month, day)
+	s.Require().NoError(err)
+	return d
+}
+
+func (s *DocumentWithExpirySuite) TestNewDocumentWithExpiry() {
+	s.Run("should create a document with expiry", func() {
+		doc, err := wisp.NewDocumentWithExpiry(s.cpf, s.expiresOn)
+		s.Require().NoError(err)
+		s.Equal(s.cpf, doc.Document())
+		s.Equal(s.expiresOn, doc.ExpiresOn())
+		s.False(doc.IsZero())
+	})
+
+	s.Run("should reject an empty document or expiry date", func() {
+		_, err := wisp.NewDocumentWithExpiry(wisp.EmptyCPF, s.expiresOn)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+		_, err = wisp.NewDocumentWithExpiry(s.cpf, wisp.ZeroDate)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+
+	s.Run("should compare document and expiry date", func() {
+		a, _ := wisp.NewDocumentWithExpiry(passportNumber("FT123456"), s.expiresOn)
+		b, _ := wisp.NewDocumentWithExpiry(passportNumber("FT123456"), s.expiresOn)
+		c, _ := wisp.NewDocumentWithExpiry(passportNumber("FT123456"), s.expiresOn.AddDays(1))
+		s.True(a.Equals(b))
+		s.False(a.Equals(c))
+	})
+}
+
+func (s *DocumentWithExpirySuite) TestIsExpired() {
+	doc, _ := wisp.NewDocumentWithExpiry(s.cpf, s.expiresOn)
+
+	s.Run("should be valid through the expiry date", func() {
+		s.False(doc.IsExpired(s.date(2030, time.May, 30)))
+		s.False(doc.IsExpired(s.expiresOn))
+	})
+
+	s.Run("should be expired after the expiry date", func() {
+		s.True(doc.IsExpired(s.date(2030, time.June, 1)))
+	})
+
+	s.Run("should never expire the zero value", func() {
+		var zero wisp.DocumentWithExpiry[wisp.CPF]
+		s.True(zero.IsZero())
+		s.False(zero.IsExpired(s.date(2099, time.December, 31)))
+	})
+}
+
+func (s *DocumentWithExpirySuite) TestExpiresWithin() {
+	doc, _ := wisp.NewDocumentWithExpiry(passportNumber("FT123456"), s.expiresOn)
+	sixMonths, _ := wisp.NewPeriod(0, 6, 0)
+
+	s.Run("should report documents expiring within the window", func() {
+		s.True(doc.ExpiresWithin(s.date(2029, time.December, 1), sixMonths))
+		s.True(doc.ExpiresWithin(s.expiresOn, sixMonths))
+		s.True(doc.ExpiresWithin(s.expiresOn, wisp.ZeroPeriod))
+	})
+
+	s.Run("should not report documents expiring after the window", func() {
+		s.False(doc.ExpiresWithin(s.date(2029, time.November, 29), sixMonths))
+	})
+
+	s.Run("should not report documents already expired", func() {
+		s.False(doc.ExpiresWithin(s.date(2030, time.June, 1), sixMonths))
+	})
+}
+
+func (s *DocumentWithExpirySuite) TestMasked() {
+	s.Run("should mask documents implementing Masker", func() {
+		doc, _ := wisp.NewDocumentWithExpiry(s.cpf, s.expiresOn)
+		s.Equal("***.982.247-** (expires 2030-05-31)", doc.Masked())
+
+		redacted := wisp.Redact(map[string]any{"cpf": doc})
+		s.Equal(map[string]any{"cpf": "***.982.247-** (expires 2030-05-31)"}, redacted)
+	})
+
+	s.Run("should redact documents without masking", func() {
+		doc, _ := wisp.NewDocumentWithExpiry(passportNumber("FT123456"), s.expiresOn)
+		s.Equal("[REDACTED] (expires 2030-05-31)", doc.Masked())
+	})
+}
+
+func (s *DocumentWithExpirySuite) TestJSON() {
+	s.Run("should marshal and unmarshal", func() {
+		doc, _ := wisp.NewDocumentWithExpiry(s.cpf, s.expiresOn)
+		data, err := json.Marshal(doc)
+		s.Require().NoError(err)
+		s.JSONEq(`{"document":"52998224725","expires_on":"2030-05-31"}`, string(data))
+
+		var decoded wisp.DocumentWithExpiry[wisp.CPF]
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.True(doc.Equals(decoded))
+	})
+
+	s.Run("should handle null", func() {
+		var zero wisp.DocumentWithExpiry[wisp.CPF]
+		data, err := json.Marshal(zero)
+		s.Require().NoError(err)
+		s.Equal("null", string(data))
+
+		var decoded wisp.DocumentWithExpiry[wisp.CPF]
+		s.Require().NoError(json.Unmarshal([]byte("null"), &decoded))
+		s.True(decoded.IsZero())
+	})
+
+	s.Run("should validate the document and require both fields", func() {
+		var decoded wisp.DocumentWithExpiry[wisp.CPF]
+		s.Error(json.Unmarshal([]byte(`{"document":"11111111111","expires_on":"2030-05-31"}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`{"document":"52998224725"}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`[]`), &decoded))
+	})
+}
+
+func (s *DocumentWithExpirySuite) TestSQL() {
+	s.Run("should store and scan as JSON", func() {
+		doc, _ := wisp.NewDocumentWithExpiry(passportNumber("FT123456"), s.expiresOn)
+		value, err := doc.Value()
+		s.Require().NoError(err)
+
+		var scanned wisp.DocumentWithExpiry[passportNumber]
+		s.Require().NoError(scanned.Scan([]byte(value.(string))))
+		s.True(doc.Equals(scanned))
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+		value, err = scanned.Value()
+		s.Require().NoError(err)
+		s.Nil(value)
+	})
+
+	s.Run("should reject unsupported types", func() {
+		var scanned wisp.DocumentWithExpiry[passportNumber]
+		s.Error(scanned.Scan(42))
+	})
+}
@@ -0,0 +1,140 @@
+package wisp
+
+import (
+	crand "crypto/rand"
+	"io"
+	mrand "math/rand/v2"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// DocumentGeneratorOption configures how GenerateCPF and GenerateCNPJ pick random digits.
+type DocumentGeneratorOption func(*documentGenerator)
+
+// WithRandSource makes the generator draw digits from src instead of crypto/rand,
+// so a seeded source produces the same documents on every run.
+//
+// Example:
+//   src := rand.NewPCG(42, 0) // math/rand/v2
+//   cpf, _ := wisp.GenerateCPF(wisp.WithRandSource(src))
+func WithRandSource(src mrand.Source) DocumentGeneratorOption {
+	return func(g *documentGenerator) {
+		if src != nil {
+			g.rng = mrand.New(src)
+		}
+	}
+}
+
+// documentGenerator produces random ASCII digits, from a seeded source when configured
+// or from crypto/rand otherwise.
+type documentGenerator struct {
+	rng *mrand.Rand
+}
+
+// newDocumentGenerator applies the options over the crypto/rand default.
+func newDocumentGenerator(opts []DocumentGeneratorOption) *documentGenerator {
+	g := &documentGenerator{}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// fill writes uniformly distributed random ASCII digits into dst.
+func (g *documentGenerator) fill(dst []byte) error {
+	if g.rng != nil {
+		for i := range dst {
+			dst[i] = '0' + byte(g.rng.IntN(10))
+		}
+		return nil
+	}
+
+	var buf [16]byte
+	for i := 0; i < len(dst); {
+		if _, err := io.ReadFull(crand.Reader, buf[:]); err != nil {
+			return fault.Wrap(err, "failed to read random digits", fault.WithCode(fault.Internal))
+		}
+		for _, b := range buf {
+			// Rejecting bytes >= 250 keeps every digit equally likely.
+			if b >= 250 || i == len(dst) {
+				continue
+			}
+			dst[i] = '0' + b%10
+			i++
+		}
+	}
+	return nil
+}
+
+// GenerateCPF returns a random CPF with valid check digits, meant for test fixtures and factories.
+// Digits come from crypto/rand unless WithRandSource is given.
+//
+// Example:
+//   cpf, err := wisp.GenerateCPF()
+//   cpf.Formatted() // e.g. "529.982.247-25"
+func GenerateCPF(opts ...DocumentGeneratorOption) (CPF, error) {
+	g := newDocumentGenerator(opts)
+
+	var digits [11]byte
+	for {
+		if err := g.fill(digits[:9]); err != nil {
+			return EmptyCPF, err
+		}
+		if !allSameDigit(digits[:9]) {
+			break
+		}
+	}
+	digits[9] = '0' + byte(cpfCheckDigit(digits[:9]))
+	digits[10] = '0' + byte(cpfCheckDigit(digits[:10]))
+
+	return CPF(digits[:]), nil
+}
+
+// MustGenerateCPF is like GenerateCPF but panics if random digits cannot be read.
+func MustGenerateCPF(opts ...DocumentGeneratorOption) CPF {
+	cpf, err := GenerateCPF(opts...)
+	if err != nil {
+		panic(err)
+	}
+	return cpf
+}
+
+// GenerateCNPJ returns a random CNPJ with valid check digits, meant for test fixtures and factories.
+// The base is random and the branch is always "0001" (headquarters), as in most real documents.
+// Digits come from crypto/rand unless WithRandSource is given.
+//
+// Example:
+//   cnpj, err := wisp.GenerateCNPJ()
+//   cnpj.Formatted() // e.g. "11.222.333/0001-81"
+func GenerateCNPJ(opts ...DocumentGeneratorOption) (CNPJ, error) {
+	g := newDocumentGenerator(opts)
+
+	var digits [14]byte
+	if err := g.fill(digits[:8]); err != nil {
+		return EmptyCNPJ, err
+	}
+	copy(digits[8:12], "0001")
+	digits[12] = '0' + byte(cnpjCheckDigit(digits[:12], cnpjWeights1[:]))
+	digits[13] = '0' + byte(cnpjCheckDigit(digits[:13], cnpjWeights2[:]))
+
+	return CNPJ(digits[:]), nil
+}
+
+// MustGenerateCNPJ is like GenerateCNPJ but panics if random digits cannot be read.
+func MustGenerateCNPJ(opts ...DocumentGeneratorOption) CNPJ {
+	cnpj, err := GenerateCNPJ(opts...)
+	if err != nil {
+		panic(err)
+	}
+	return cnpj
+}
+
+// allSameDigit reports whether every digit is equal, which the CPF rules reject.
+func allSameDigit(digits []byte) bool {
+	for _, d := range digits[1:] {
+		if d != digits[0] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package wisp_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type DocumentGeneratorSuite struct {
+	suite.Suite
+}
+
+func TestDocumentGeneratorSuite(t *testing.T) {
+	suite.Run(t, new(DocumentGeneratorSuite))
+}
+
+func (s *DocumentGeneratorSuite) TestGenerateCPF() {
+	s.Run("should generate valid CPFs", func() {
+		for range 200 {
+			cpf, err := wisp.GenerateCPF()
+			s.Require().NoError(err)
+
+			parsed, err := wisp.NewCPF(cpf.String())
+			s.Require().NoError(err, cpf.String())
+			s.Equal(cpf, parsed)
+		}
+	})
+
+	s.Run("should be deterministic with a seeded source", func() {
+		a := wisp.MustGenerateCPF(wisp.WithRandSource(rand.NewPCG(42, 7)))
+		b := wisp.MustGenerateCPF(wisp.WithRandSource(rand.NewPCG(42, 7)))
+		c := wisp.MustGenerateCPF(wisp.WithRandSource(rand.NewPCG(43, 7)))
+		s.Equal(a, b)
+		s.NotEqual(a, c)
+
+		_, err := wisp.NewCPF(a.String())
+		s.NoError(err)
+	})
+
+	s.Run("should produce a sequence from a shared source", func() {
+		src := rand.NewPCG(1, 2)
+		first := wisp.MustGenerateCPF(wisp.WithRandSource(src))
+		second := wisp.MustGenerateCPF(wisp.WithRandSource(src))
+		s.NotEqual(first, second)
+	})
+}
+
+func (s *DocumentGeneratorSuite) TestGenerateCNPJ() {
+	s.Run("should generate valid headquarters CNPJs", func() {
+		for range 200 {
+			cnpj, err := wisp.GenerateCNPJ()
+			s.Require().NoError(err)
+			s.Equal("0001", cnpj.String()[8:12])
+
+			parsed, err := wisp.NewCNPJ(cnpj.Formatted())
+			s.Require().NoError(err, cnpj.String())
+			s.Equal(cnpj, parsed)
+		}
+	})
+
+	s.Run("should be deterministic with a seeded source", func() {
+		a := wisp.MustGenerateCNPJ(wisp.WithRandSource(rand.NewPCG(42, 7)))
+		b := wisp.MustGenerateCNPJ(wisp.WithRandSource(rand.NewPCG(42, 7)))
+		s.Equal(a, b)
+
+		_, err := wisp.NewCNPJ(a.String())
+		s.NoError(err)
+	})
+}
@@ -29,6 +29,62 @@ type Email string
 // EmptyEmail represents the zero value for the Email type.
 var EmptyEmail Email
 
+// freeEmailDomains holds the domains of free email providers used by IsCorporate.
+// More domains can be added with RegisterFreeEmailDomains.
+var freeEmailDomains = map[string]struct{}{
+	"gmail.com": {}, "googlemail.com": {}, "hotmail.com": {}, "hotmail.com.br": {}, "outlook.com": {},
+	"outlook.com.br": {}, "live.com": {}, "msn.com": {}, "yahoo.com": {}, "yahoo.com.br": {}, "ymail.com": {},
+	"icloud.com": {}, "me.com": {}, "mac.com": {}, "aol.com": {}, "protonmail.com": {}, "proton.me": {},
+	"gmx.com": {}, "zoho.com": {}, "mail.com": {}, "yandex.com": {},
+	"uol.com.br": {}, "bol.com.br": {}, "terra.com.br": {}, "ig.com.br": {},
+}
+
+// RegisterFreeEmailDomains adds domains to the list of free email providers used by IsCorporate.
+// Domains are normalized to lowercase and surrounding whitespace is ignored.
+func RegisterFreeEmailDomains(domains ...string) {
+	for _, d := range domains {
+		normalized := strings.ToLower(strings.TrimSpace(d))
+		if normalized != "" {
+			freeEmailDomains[normalized] = struct{}{}
+		}
+	}
+}
+
+// blockedEmailDomains holds domains rejected by NewEmail and by JSON/text decoding, such as
+// disposable email providers. It is empty by default; see RegisterBlockedEmailDomains.
+var blockedEmailDomains = map[string]struct{}{}
+
+// RegisterBlockedEmailDomains adds domains whose addresses are rejected when an Email is created
+// from user input (NewEmail, UnmarshalJSON and UnmarshalText), such as disposable providers in
+// sign-up flows. Subdomains of a blocked domain are blocked too. Scan is not affected, so
+// addresses already stored remain readable.
+// Domains are normalized to lowercase and surrounding whitespace is ignored.
+func RegisterBlockedEmailDomains(domains ...string) {
+	for _, d := range domains {
+		normalized := strings.ToLower(strings.TrimSpace(d))
+		if normalized != "" {
+			blockedEmailDomains[normalized] = struct{}{}
+		}
+	}
+}
+
+// ClearBlockedEmailDomains removes all blocked email domains.
+func ClearBlockedEmailDomains() {
+	blockedEmailDomains = map[string]struct{}{}
+}
+
+// checkEmailNotBlocked returns a DomainViolation error if the email's domain is blocked.
+func checkEmailNotBlocked(e Email) error {
+	if !e.IsBlocked() {
+		return nil
+	}
+	return fault.New(
+		"email domain is not allowed",
+		fault.WithCode(fault.DomainViolation),
+		fault.WithContext("domain", e.Domain()),
+	)
+}
+
 // parseEmail contains the core logic for validating and normalizing an email string.
 func parseEmail(emailStr string) (Email, error) {
 	trimmedEmail := strings.TrimSpace(emailStr)
@@ -65,11 +121,80 @@ func parseEmail(emailStr string) (Email, error) {
 	return Email(normalizedEmail), nil
 }
 
+// parseEmailBytes validates an email held in a byte slice, as returned by most database drivers.
+// Values already in canonical form (the way Value stores them) are accepted with a single allocation;
+// anything else falls back to the full RFC 5322 parser in parseEmail.
+func parseEmailBytes(b []byte) (Email, error) {
+	if isCanonicalEmail(b) {
+		return Email(b), nil
+	}
+	return parseEmail(string(b))
+}
+
+// isCanonicalEmail reports whether b is a lowercase dot-atom address ("local@domain") within the length limit.
+// Every address accepted here is also accepted unchanged by mail.ParseAddress, so it is a safe shortcut.
+func isCanonicalEmail(b []byte) bool {
+	if len(b) == 0 || len(b) > MaxEmailLength {
+		return false
+	}
+
+	at := -1
+	for i, c := range b {
+		if c == '@' {
+			if at != -1 {
+				return false
+			}
+			at = i
+		}
+	}
+	if at <= 0 || at == len(b)-1 {
+		return false
+	}
+
+	return isDotAtom(b[:at], isLocalAtext) && isDotAtom(b[at+1:], isDomainAtext)
+}
+
+// isDotAtom reports whether b is a sequence of non-empty atoms made of allowed characters, separated by single dots.
+func isDotAtom(b []byte, allowed func(byte) bool) bool {
+	if b[0] == '.' || b[len(b)-1] == '.' {
+		return false
+	}
+	for i, c := range b {
+		if c == '.' {
+			if b[i-1] == '.' {
+				return false
+			}
+			continue
+		}
+		if !allowed(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// isLocalAtext reports whether c is a lowercase atext character allowed in the local part.
+func isLocalAtext(c byte) bool {
+	return isDomainAtext(c) || strings.IndexByte("!#$%&'*+/=?^_`{|}~", c) >= 0
+}
+
+// isDomainAtext reports whether c is allowed in a lowercase domain label.
+func isDomainAtext(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') || c == '-'
+}
+
 // NewEmail creates a new Email from a string.
 // It trims whitespace, validates the format and length, and normalizes the email to lowercase.
-// Returns an error if the email is empty, too long, or has an invalid format.
+// Returns an error if the email is empty, too long, has an invalid format or belongs to a blocked domain.
 func NewEmail(emailStr string) (Email, error) {
-	return parseEmail(emailStr)
+	email, err := parseEmail(emailStr)
+	if err != nil {
+		return EmptyEmail, err
+	}
+	if err := checkEmailNotBlocked(email); err != nil {
+		return EmptyEmail, err
+	}
+	return email, nil
 }
 
 // MustNewEmail is like NewEmail but panics if the email is invalid.
@@ -87,11 +212,84 @@ func (e Email) String() string {
 	return string(e)
 }
 
+// LocalPart returns the part of the address before the "@" (e.g., "john.doe" for "john.doe@example.com").
+func (e Email) LocalPart() string {
+	local, _, _ := strings.Cut(string(e), "@")
+	return local
+}
+
+// Domain returns the part of the address after the "@" (e.g., "example.com" for "john.doe@example.com").
+func (e Email) Domain() string {
+	at := strings.LastIndexByte(string(e), '@')
+	if at < 0 {
+		return ""
+	}
+	return string(e[at+1:])
+}
+
+// SameDomain checks if both addresses belong to the same domain, such as users of the same tenant.
+// Empty emails never share a domain.
+func (e Email) SameDomain(other Email) bool {
+	return !e.IsEmpty() && e.Domain() == other.Domain()
+}
+
+// IsCorporate returns true if the domain is not a free email provider (e.g., gmail.com, hotmail.com, uol.com.br).
+// The list of free providers can be extended with RegisterFreeEmailDomains.
+func (e Email) IsCorporate() bool {
+	if e.IsEmpty() {
+		return false
+	}
+	_, free := freeEmailDomains[e.Domain()]
+	return !free
+}
+
+// IsBlocked returns true if the domain, or one of its parent domains, was registered with
+// RegisterBlockedEmailDomains.
+func (e Email) IsBlocked() bool {
+	if len(blockedEmailDomains) == 0 || e.IsEmpty() {
+		return false
+	}
+	for domain := e.Domain(); domain != ""; {
+		if _, blocked := blockedEmailDomains[domain]; blocked {
+			return true
+		}
+		_, parent, found := strings.Cut(domain, ".")
+		if !found {
+			break
+		}
+		domain = parent
+	}
+	return false
+}
+
+// NormalizeGmailDots returns the canonical form of a Gmail address, where dots in the local part
+// are ignored by the provider ("j.o.hn@gmail.com" delivers to "john@gmail.com"). This helps detect
+// duplicate sign-ups. googlemail.com addresses are mapped to gmail.com; other domains are
+// returned unchanged.
+func (e Email) NormalizeGmailDots() Email {
+	domain := e.Domain()
+	if domain != "gmail.com" && domain != "googlemail.com" {
+		return e
+	}
+	return Email(strings.ReplaceAll(e.LocalPart(), ".", "") + "@gmail.com")
+}
+
 // IsEmpty returns true if the Email is the zero value.
 func (e Email) IsEmpty() bool {
 	return e == EmptyEmail
 }
 
+// Masked returns the email with only the first character of the local part visible
+// ("j***@example.com"), so it can be logged or displayed under LGPD.
+// Returns an empty string for EmptyEmail.
+func (e Email) Masked() string {
+	at := strings.LastIndexByte(string(e), '@')
+	if at < 1 {
+		return ""
+	}
+	return string(e[:1]) + "***" + string(e[at:])
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 // It serializes the Email as a JSON string.
 func (e Email) MarshalJSON() ([]byte, error) {
@@ -110,7 +308,7 @@ func (e *Email) UnmarshalJSON(data []byte) error {
 		)
 	}
 
-	validatedEmail, err := parseEmail(s)
+	validatedEmail, err := NewEmail(s)
 	if err != nil {
 		return err
 	}
@@ -126,7 +324,7 @@ func (e Email) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
 func (e *Email) UnmarshalText(text []byte) error {
-	validatedEmail, err := parseEmail(string(text))
+	validatedEmail, err := NewEmail(string(text))
 	if err != nil {
 		return err
 	}
@@ -151,12 +349,13 @@ func (e *Email) Scan(src interface{}) error {
 		return nil
 	}
 
-	var emailStr string
+	var validatedEmail Email
+	var err error
 	switch sval := src.(type) {
 	case string:
-		emailStr = sval
+		validatedEmail, err = parseEmail(sval)
 	case []byte:
-		emailStr = string(sval)
+		validatedEmail, err = parseEmailBytes(sval)
 	default:
 		return fault.New(
 			"incompatible type for Email scan",
@@ -165,7 +364,6 @@ func (e *Email) Scan(src interface{}) error {
 		)
 	}
 
-	validatedEmail, err := parseEmail(emailStr)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,107 @@
+package wisp
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// EmailDomainResolver performs the DNS lookups used by Email.VerifyDomain.
+// *net.Resolver satisfies it; tests can provide a fake.
+type EmailDomainResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+var (
+	// emailDomainResolver is the resolver used by VerifyDomain.
+	emailDomainResolver EmailDomainResolver = net.DefaultResolver
+	// emailLookupTimeout bounds each VerifyDomain call, on top of any deadline in its context.
+	emailLookupTimeout = 5 * time.Second
+)
+
+// SetEmailDomainResolver configures the resolver used by Email.VerifyDomain.
+// Passing nil restores net.DefaultResolver.
+func SetEmailDomainResolver(resolver EmailDomainResolver) {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	emailDomainResolver = resolver
+}
+
+// SetEmailLookupTimeout configures the maximum duration of Email.VerifyDomain. Non-positive
+// values are ignored.
+func SetEmailLookupTimeout(timeout time.Duration) {
+	if timeout > 0 {
+		emailLookupTimeout = timeout
+	}
+}
+
+// VerifyDomain checks whether the email's domain can receive mail, by looking up its MX records
+// and, when there are none, its A/AAAA records (the implicit MX of RFC 5321).
+// It is never called by NewEmail, so validation stays offline; sign-up flows can call it when a
+// deliverability check is worth a DNS round trip.
+//
+// It returns a DomainViolation error if the domain does not exist, has no mail host, or declares
+// a null MX (RFC 7505), and an InfraError error if the lookup fails or times out.
+//
+// Example:
+//   if err := email.VerifyDomain(ctx); err != nil { ... }
+func (e Email) VerifyDomain(ctx context.Context) error {
+	if e.IsEmpty() {
+		return fault.New("cannot verify the domain of an empty email", fault.WithCode(fault.Invalid))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, emailLookupTimeout)
+	defer cancel()
+
+	domain := e.Domain()
+	records, err := emailDomainResolver.LookupMX(ctx, domain)
+	if err != nil && !isDNSNotFound(err) {
+		return errEmailLookup(err, domain)
+	}
+
+	if len(records) > 0 {
+		if len(records) == 1 && strings.TrimSuffix(records[0].Host, ".") == "" {
+			return errEmailDomainUndeliverable(domain, "domain does not accept email (null MX)")
+		}
+		return nil
+	}
+
+	hosts, err := emailDomainResolver.LookupHost(ctx, domain)
+	if err != nil && !isDNSNotFound(err) {
+		return errEmailLookup(err, domain)
+	}
+	if len(hosts) == 0 {
+		return errEmailDomainUndeliverable(domain, "email domain has no mail server")
+	}
+	return nil
+}
+
+// isDNSNotFound reports whether err means the name has no records, as opposed to a lookup failure.
+func isDNSNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// errEmailLookup builds the error returned when the DNS lookup itself fails.
+func errEmailLookup(err error, domain string) error {
+	return fault.Wrap(err,
+		"failed to look up email domain",
+		fault.WithCode(fault.InfraError),
+		fault.WithContext("domain", domain),
+	)
+}
+
+// errEmailDomainUndeliverable builds the error returned when the domain cannot receive mail.
+func errEmailDomainUndeliverable(domain, message string) error {
+	return fault.New(
+		message,
+		fault.WithCode(fault.DomainViolation),
+		fault.WithContext("domain", domain),
+	)
+}
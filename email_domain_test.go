@@ -0,0 +1,123 @@
+package wisp_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	wisp "github.com/marcelofabianov/wisp"
+)
+
+// fakeResolver answers lookups from fixed tables; unknown names are not found.
+type fakeResolver struct {
+	mx    map[string][]*net.MX
+	hosts map[string][]string
+	err   error
+	block bool
+}
+
+func (r fakeResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	if r.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	if mx, ok := r.mx[name]; ok {
+		return mx, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if hosts, ok := r.hosts[host]; ok {
+		return hosts, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+type EmailDomainSuite struct {
+	suite.Suite
+}
+
+func TestEmailDomainSuite(t *testing.T) {
+	suite.Run(t, new(EmailDomainSuite))
+}
+
+func (s *EmailDomainSuite) SetupTest() {
+	wisp.SetEmailDomainResolver(fakeResolver{
+		mx: map[string][]*net.MX{
+			"example.com":   {{Host: "mx1.example.com.", Pref: 10}},
+			"nomail.com.br": {{Host: ".", Pref: 0}},
+		},
+		hosts: map[string][]string{"implicit.example": {"203.0.113.10"}},
+	})
+}
+
+func (s *EmailDomainSuite) TearDownTest() {
+	wisp.SetEmailDomainResolver(nil)
+	wisp.SetEmailLookupTimeout(5 * time.Second)
+}
+
+func (s *EmailDomainSuite) TestVerifyDomain() {
+	ctx := context.Background()
+
+	s.Run("should accept domains with MX records", func() {
+		s.NoError(wisp.MustNewEmail("ana@example.com").VerifyDomain(ctx))
+	})
+
+	s.Run("should accept domains with only an address record", func() {
+		s.NoError(wisp.MustNewEmail("ana@implicit.example").VerifyDomain(ctx))
+	})
+
+	s.Run("should reject unknown domains", func() {
+		err := wisp.MustNewEmail("ana@unknown.example").VerifyDomain(ctx)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+
+	s.Run("should reject domains with a null MX", func() {
+		err := wisp.MustNewEmail("ana@nomail.com.br").VerifyDomain(ctx)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+
+	s.Run("should fail for an empty email", func() {
+		err := wisp.EmptyEmail.VerifyDomain(ctx)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *EmailDomainSuite) TestVerifyDomain_LookupFailures() {
+	s.Run("should report resolver errors as infrastructure errors", func() {
+		wisp.SetEmailDomainResolver(fakeResolver{err: errors.New("connection refused")})
+		err := wisp.MustNewEmail("ana@example.com").VerifyDomain(context.Background())
+		s.Require().Error(err)
+		s.Equal(fault.InfraError, err.(*fault.Error).Code)
+	})
+
+	s.Run("should time out", func() {
+		wisp.SetEmailDomainResolver(fakeResolver{block: true})
+		wisp.SetEmailLookupTimeout(10 * time.Millisecond)
+
+		start := time.Now()
+		err := wisp.MustNewEmail("ana@example.com").VerifyDomain(context.Background())
+		s.Require().Error(err)
+		s.Equal(fault.InfraError, err.(*fault.Error).Code)
+		s.Less(time.Since(start), time.Second)
+	})
+
+	s.Run("should honor a cancelled context", func() {
+		wisp.SetEmailDomainResolver(fakeResolver{block: true})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		s.Error(wisp.MustNewEmail("ana@example.com").VerifyDomain(ctx))
+	})
+}
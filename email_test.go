@@ -1,6 +1,7 @@
 package wisp_test
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -127,6 +128,87 @@ func (s *EmailSuite) TestEmail_IsEmptyAndString() {
 	})
 }
 
+func (s *EmailSuite) TestEmail_DomainHelpers() {
+	s.Run("should split local part and domain", func() {
+		e := wisp.MustNewEmail("John.Doe+news@Example.com.br")
+		s.Equal("john.doe+news", e.LocalPart())
+		s.Equal("example.com.br", e.Domain())
+
+		s.Equal("", wisp.EmptyEmail.LocalPart())
+		s.Equal("", wisp.EmptyEmail.Domain())
+	})
+
+	s.Run("should compare domains", func() {
+		a := wisp.MustNewEmail("ana@acme.com")
+		b := wisp.MustNewEmail("bruno@ACME.com")
+		c := wisp.MustNewEmail("carla@sub.acme.com")
+
+		s.True(a.SameDomain(b))
+		s.False(a.SameDomain(c))
+		s.False(wisp.EmptyEmail.SameDomain(wisp.EmptyEmail))
+	})
+
+	s.Run("should detect corporate addresses", func() {
+		s.True(wisp.MustNewEmail("ana@acme.com").IsCorporate())
+		s.False(wisp.MustNewEmail("ana@gmail.com").IsCorporate())
+		s.False(wisp.MustNewEmail("ana@uol.com.br").IsCorporate())
+		s.False(wisp.EmptyEmail.IsCorporate())
+	})
+
+	s.Run("should accept registered free providers", func() {
+		e := wisp.MustNewEmail("ana@freemail.example")
+		s.True(e.IsCorporate())
+
+		wisp.RegisterFreeEmailDomains(" FreeMail.example ")
+		s.False(e.IsCorporate())
+	})
+}
+
+func (s *EmailSuite) TestEmail_NormalizeGmailDots() {
+	s.Equal(wisp.Email("johndoe@gmail.com"), wisp.MustNewEmail("John.Doe@gmail.com").NormalizeGmailDots())
+	s.Equal(wisp.Email("johndoe+news@gmail.com"), wisp.MustNewEmail("j.ohn.doe+news@googlemail.com").NormalizeGmailDots())
+	s.Equal(wisp.Email("john.doe@example.com"), wisp.MustNewEmail("john.doe@example.com").NormalizeGmailDots())
+	s.Equal(wisp.EmptyEmail, wisp.EmptyEmail.NormalizeGmailDots())
+}
+
+func (s *EmailSuite) TestEmail_BlockedDomains() {
+	wisp.RegisterBlockedEmailDomains(" Mailinator.com ", "trashmail.example")
+	s.T().Cleanup(wisp.ClearBlockedEmailDomains)
+
+	s.Run("should reject blocked domains and their subdomains", func() {
+		for _, input := range []string{"ana@mailinator.com", "ana@eu.mailinator.com", "ana@TrashMail.example"} {
+			e, err := wisp.NewEmail(input)
+			s.Require().Error(err, input)
+			s.Equal(wisp.EmptyEmail, e)
+			s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+		}
+	})
+
+	s.Run("should accept other domains", func() {
+		e, err := wisp.NewEmail("ana@notmailinator.com")
+		s.Require().NoError(err)
+		s.False(e.IsBlocked())
+	})
+
+	s.Run("should reject blocked domains when decoding input", func() {
+		var e wisp.Email
+		s.Error(json.Unmarshal([]byte(`"ana@mailinator.com"`), &e))
+		s.Error(e.UnmarshalText([]byte("ana@mailinator.com")))
+	})
+
+	s.Run("should still scan stored addresses", func() {
+		var e wisp.Email
+		s.Require().NoError(e.Scan("ana@mailinator.com"))
+		s.True(e.IsBlocked())
+	})
+
+	s.Run("should accept everything after clearing", func() {
+		wisp.ClearBlockedEmailDomains()
+		_, err := wisp.NewEmail("ana@mailinator.com")
+		s.NoError(err)
+	})
+}
+
 func (s *EmailSuite) TestEmail_JSONMarshaling() {
 	s.Run("should correctly marshal and unmarshal valid email", func() {
 		email := wisp.MustNewEmail("user@domain.com")
@@ -213,6 +295,21 @@ func (s *EmailSuite) TestEmail_Scan() {
 			src:      []byte("scan.bytes@example.com"),
 			expected: "scan.bytes@example.com",
 		},
+		{
+			name:     "should scan and normalize a non-canonical byte slice",
+			src:      []byte("  Scan.Bytes@Example.com "),
+			expected: "scan.bytes@example.com",
+		},
+		{
+			name:        "should fail to scan an invalid byte slice",
+			src:         []byte("scan..bytes@example.com"),
+			expectError: true,
+		},
+		{
+			name:        "should fail to scan an empty byte slice",
+			src:         []byte(""),
+			expectError: true,
+		},
 		{
 			name:     "should scan nil into an empty email",
 			src:      nil,
@@ -247,3 +344,14 @@ func (s *EmailSuite) TestEmail_Scan() {
 		})
 	}
 }
+
+func BenchmarkEmail_ScanBytes(b *testing.B) {
+	src := []byte("scan.bytes@example.com")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var email wisp.Email
+		if err := email.Scan(src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
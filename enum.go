@@ -0,0 +1,201 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// Enum is a generic value object for small string enumerations (e.g., a subscription tier,
+// a notification channel) whose allowed values are registered once per type.
+// It handles registration, parsing, validation and JSON/SQL marshaling so each domain
+// enum only needs to declare its underlying type and register its values.
+//
+// Values are trimmed of surrounding whitespace and matched exactly (case-sensitive)
+// against the registry, like Role.
+//
+// Example:
+//   type Tier string
+//
+//   wisp.RegisterEnumValues[Tier]("free", "pro", "enterprise")
+//   tier, err := wisp.NewEnum[Tier]("pro")
+//   isPro := tier.Is("pro")
+type Enum[T ~string] struct {
+	value T
+}
+
+// enumRegistry holds, for each enum type, the set of registered values.
+// The values are of type map[T]struct{} for the corresponding T.
+var enumRegistry = make(map[reflect.Type]any)
+
+// enumValuesFor returns the registered values for the enum type T, or nil if none were registered.
+func enumValuesFor[T ~string]() map[T]struct{} {
+	values, _ := enumRegistry[reflect.TypeFor[T]()].(map[T]struct{})
+	return values
+}
+
+// RegisterEnumValues adds one or more values to the registry of the enum type T.
+// Values are trimmed of whitespace and empty values are ignored.
+// This function should be called at application startup to define all possible values.
+func RegisterEnumValues[T ~string](values ...T) {
+	registered := enumValuesFor[T]()
+	if registered == nil {
+		registered = make(map[T]struct{})
+		enumRegistry[reflect.TypeFor[T]()] = registered
+	}
+
+	for _, v := range values {
+		normalized := T(strings.TrimSpace(string(v)))
+		if normalized != "" {
+			registered[normalized] = struct{}{}
+		}
+	}
+}
+
+// ClearEnumValues removes all registered values for the enum type T.
+// This is primarily for testing purposes to ensure a clean state.
+func ClearEnumValues[T ~string]() {
+	delete(enumRegistry, reflect.TypeFor[T]())
+}
+
+// EnumValues returns the registered values for the enum type T, sorted alphabetically.
+func EnumValues[T ~string]() []T {
+	registered := enumValuesFor[T]()
+	values := make([]T, 0, len(registered))
+	for v := range registered {
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	return values
+}
+
+// NewEnum creates a new Enum of type T from a string.
+// It trims the input and validates it against the registry for T.
+// An empty input returns the zero Enum. Returns an error if the value is not registered.
+func NewEnum[T ~string](value string) (Enum[T], error) {
+	normalized := T(strings.TrimSpace(value))
+	if normalized == "" {
+		return Enum[T]{}, nil
+	}
+
+	e := Enum[T]{value: normalized}
+	if !e.IsValid() {
+		return Enum[T]{}, fault.New(
+			"value is not registered for this enum",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+			fault.WithContext("enum_type", reflect.TypeFor[T]().String()),
+		)
+	}
+	return e, nil
+}
+
+// MustNewEnum is like NewEnum but panics if the value is not registered.
+// It is intended for package-level constants and tests.
+func MustNewEnum[T ~string](value string) Enum[T] {
+	e, err := NewEnum[T](value)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Get returns the underlying value.
+func (e Enum[T]) Get() T {
+	return e.value
+}
+
+// Is checks if the enum holds the given value.
+func (e Enum[T]) Is(value T) bool {
+	return e.value == value
+}
+
+// IsValid checks if the value is in the registry for its type.
+func (e Enum[T]) IsValid() bool {
+	_, ok := enumValuesFor[T]()[e.value]
+	return ok
+}
+
+// IsZero returns true if the Enum holds no value.
+func (e Enum[T]) IsZero() bool {
+	return e.value == ""
+}
+
+// String returns the value as a string.
+func (e Enum[T]) String() string {
+	return string(e.value)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the value as a JSON string, or null if the Enum is zero.
+func (e Enum[T]) MarshalJSON() ([]byte, error) {
+	if e.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(e.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into an Enum, validating it against the registry.
+func (e *Enum[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*e = Enum[T]{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "Enum must be a valid JSON string or null", fault.WithCode(fault.Invalid))
+	}
+
+	parsed, err := NewEnum[T](s)
+	if err != nil {
+		return err
+	}
+	*e = parsed
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the value as a string or nil if the Enum is zero.
+func (e Enum[T]) Value() (driver.Value, error) {
+	if e.IsZero() {
+		return nil, nil
+	}
+	return e.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values and validates them against the registry.
+func (e *Enum[T]) Scan(src interface{}) error {
+	if src == nil {
+		*e = Enum[T]{}
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for Enum",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	parsed, err := NewEnum[T](s)
+	if err != nil {
+		return err
+	}
+	*e = parsed
+	return nil
+}
@@ -0,0 +1,134 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type tier string
+
+type channel string
+
+type EnumSuite struct {
+	suite.Suite
+}
+
+func TestEnumSuite(t *testing.T) {
+	suite.Run(t, new(EnumSuite))
+}
+
+func (s *EnumSuite) SetupTest() {
+	wisp.ClearEnumValues[tier]()
+	wisp.ClearEnumValues[channel]()
+	wisp.RegisterEnumValues[tier]("free", " pro ", "enterprise", "")
+	wisp.RegisterEnumValues[channel]("email", "sms")
+}
+
+func (s *EnumSuite) TestNewEnum() {
+	s.Run("should create an enum from a registered value", func() {
+		e, err := wisp.NewEnum[tier](" pro ")
+		s.Require().NoError(err)
+		s.Equal(tier("pro"), e.Get())
+		s.True(e.Is("pro"))
+		s.True(e.IsValid())
+		s.False(e.IsZero())
+	})
+
+	s.Run("should return the zero enum for empty input", func() {
+		e, err := wisp.NewEnum[tier]("  ")
+		s.Require().NoError(err)
+		s.True(e.IsZero())
+	})
+
+	s.Run("should fail for unregistered or differently cased values", func() {
+		for _, input := range []string{"gold", "PRO"} {
+			_, err := wisp.NewEnum[tier](input)
+			s.Require().Error(err)
+			faultErr, ok := err.(*fault.Error)
+			s.Require().True(ok)
+			s.Equal(fault.Invalid, faultErr.Code)
+		}
+	})
+
+	s.Run("should keep registries separate per type", func() {
+		_, err := wisp.NewEnum[channel]("pro")
+		s.Require().Error(err)
+	})
+
+	s.Run("MustNewEnum should panic on invalid values", func() {
+		s.NotPanics(func() { wisp.MustNewEnum[channel]("sms") })
+		s.Panics(func() { wisp.MustNewEnum[channel]("fax") })
+	})
+}
+
+func (s *EnumSuite) TestEnumValues() {
+	s.Equal([]tier{"enterprise", "free", "pro"}, wisp.EnumValues[tier]())
+
+	wisp.ClearEnumValues[tier]()
+	s.Empty(wisp.EnumValues[tier]())
+}
+
+func (s *EnumSuite) TestEnum_JSON() {
+	type payload struct {
+		Tier wisp.Enum[tier] `json:"tier"`
+	}
+
+	s.Run("should round-trip a registered value", func() {
+		p := payload{Tier: wisp.MustNewEnum[tier]("enterprise")}
+		data, err := json.Marshal(p)
+		s.Require().NoError(err)
+		s.JSONEq(`{"tier":"enterprise"}`, string(data))
+
+		var decoded payload
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(p, decoded)
+	})
+
+	s.Run("should handle null", func() {
+		data, err := json.Marshal(payload{})
+		s.Require().NoError(err)
+		s.JSONEq(`{"tier":null}`, string(data))
+
+		var decoded payload
+		s.Require().NoError(json.Unmarshal([]byte(`{"tier":null}`), &decoded))
+		s.True(decoded.Tier.IsZero())
+	})
+
+	s.Run("should reject invalid values", func() {
+		var decoded payload
+		s.Require().Error(json.Unmarshal([]byte(`{"tier":"gold"}`), &decoded))
+		s.Require().Error(json.Unmarshal([]byte(`{"tier":1}`), &decoded))
+	})
+}
+
+func (s *EnumSuite) TestEnum_SQL() {
+	s.Run("Value", func() {
+		val, err := wisp.MustNewEnum[channel]("email").Value()
+		s.Require().NoError(err)
+		s.Equal("email", val)
+
+		val, err = wisp.Enum[channel]{}.Value()
+		s.Require().NoError(err)
+		s.Nil(val)
+	})
+
+	s.Run("Scan", func() {
+		var e wisp.Enum[channel]
+		s.Require().NoError(e.Scan("sms"))
+		s.Equal(channel("sms"), e.Get())
+
+		s.Require().NoError(e.Scan([]byte("email")))
+		s.Equal(channel("email"), e.Get())
+
+		s.Require().NoError(e.Scan(nil))
+		s.True(e.IsZero())
+
+		s.Require().Error(e.Scan("fax"))
+		s.Require().Error(e.Scan(123))
+	})
+}
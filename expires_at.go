@@ -0,0 +1,122 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// ExpiresAt is a value object that represents the moment something stops being valid,
+// such as the expiry of a product lot, a token or an offer.
+// Like CreatedAt, it is an alias for time.Time and is always stored in UTC.
+//
+// The zero value means "never expires".
+//
+// Example:
+//
+//	lotExpiry := wisp.NewExpiresAt(time.Date(2026, time.March, 31, 23, 59, 59, 0, time.UTC))
+//	tokenExpiry := wisp.NewExpiresAtIn(15 * time.Minute)
+//	if tokenExpiry.IsExpired() { ... }
+type ExpiresAt time.Time
+
+// ZeroExpiresAt represents the zero value for ExpiresAt (no expiry).
+var ZeroExpiresAt ExpiresAt
+
+// NewExpiresAt creates a new ExpiresAt from the given time, converted to UTC.
+func NewExpiresAt(t time.Time) ExpiresAt {
+	return ExpiresAt(t.UTC())
+}
+
+// NewExpiresAtIn creates a new ExpiresAt the given duration from now.
+func NewExpiresAtIn(d time.Duration) ExpiresAt {
+	return ExpiresAt(time.Now().UTC().Add(d))
+}
+
+// Time returns the underlying time.Time value.
+func (e ExpiresAt) Time() time.Time {
+	return time.Time(e)
+}
+
+// IsZero returns true if no expiry is set.
+func (e ExpiresAt) IsZero() bool {
+	return e.Time().IsZero()
+}
+
+// IsExpired checks if the expiry moment has passed. An ExpiresAt without expiry never expires.
+func (e ExpiresAt) IsExpired() bool {
+	return e.IsExpiredAt(time.Now())
+}
+
+// IsExpiredAt checks if the expiry moment is at or before the given time.
+// An ExpiresAt without expiry never expires.
+func (e ExpiresAt) IsExpiredAt(t time.Time) bool {
+	return !e.IsZero() && !t.Before(e.Time())
+}
+
+// Remaining returns the time left until expiry relative to now, or 0 if it has expired.
+func (e ExpiresAt) Remaining() time.Duration {
+	if e.IsZero() {
+		return 0
+	}
+	if d := time.Until(e.Time()); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// RFC3339 returns the timestamp in RFC3339 format (ISO 8601).
+func (e ExpiresAt) RFC3339() string {
+	return e.Time().Format(time.RFC3339Nano)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the timestamp in RFC3339 format, or null if no expiry is set.
+func (e ExpiresAt) MarshalJSON() ([]byte, error) {
+	if e.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(e.RFC3339())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON time string or null into an ExpiresAt timestamp.
+func (e *ExpiresAt) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*e = ZeroExpiresAt
+		return nil
+	}
+
+	var t time.Time
+	if err := json.Unmarshal(data, &t); err != nil {
+		return fault.Wrap(err, "ExpiresAt must be a valid JSON time string or null", fault.WithCode(fault.Invalid))
+	}
+	*e = NewExpiresAt(t)
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the timestamp as a time.Time value, or nil if no expiry is set.
+func (e ExpiresAt) Value() (driver.Value, error) {
+	if e.IsZero() {
+		return nil, nil
+	}
+	return e.Time(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a time.Time or NULL from the database.
+func (e *ExpiresAt) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*e = ZeroExpiresAt
+		return nil
+	case time.Time:
+		*e = NewExpiresAt(v)
+		return nil
+	default:
+		return fault.New("unsupported scan type for ExpiresAt", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+}
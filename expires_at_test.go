@@ -0,0 +1,71 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type ExpiresAtSuite struct {
+	suite.Suite
+}
+
+func TestExpiresAtSuite(t *testing.T) {
+	suite.Run(t, new(ExpiresAtSuite))
+}
+
+func (s *ExpiresAtSuite) TestExpiry() {
+	moment := time.Date(2026, time.March, 31, 12, 0, 0, 0, time.FixedZone("BRT", -3*3600))
+	e := wisp.NewExpiresAt(moment)
+
+	s.Equal(time.UTC, e.Time().Location())
+	s.True(e.Time().Equal(moment))
+	s.False(e.IsExpiredAt(moment.Add(-time.Second)))
+	s.True(e.IsExpiredAt(moment))
+	s.True(e.IsExpired())
+	s.Equal(time.Duration(0), e.Remaining())
+
+	future := wisp.NewExpiresAtIn(time.Hour)
+	s.False(future.IsExpired())
+	s.Greater(future.Remaining(), 59*time.Minute)
+
+	s.True(wisp.ZeroExpiresAt.IsZero())
+	s.False(wisp.ZeroExpiresAt.IsExpiredAt(time.Now()))
+}
+
+func (s *ExpiresAtSuite) TestSerialization() {
+	e := wisp.NewExpiresAt(time.Date(2026, time.March, 31, 23, 59, 59, 0, time.UTC))
+
+	data, err := json.Marshal(e)
+	s.Require().NoError(err)
+	s.Equal(`"2026-03-31T23:59:59Z"`, string(data))
+
+	var decoded wisp.ExpiresAt
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(e, decoded)
+
+	data, err = json.Marshal(wisp.ZeroExpiresAt)
+	s.Require().NoError(err)
+	s.Equal(`null`, string(data))
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(decoded.IsZero())
+	s.Require().Error(json.Unmarshal([]byte(`"tomorrow"`), &decoded))
+
+	val, err := e.Value()
+	s.Require().NoError(err)
+	s.Equal(e.Time(), val)
+
+	var scanned wisp.ExpiresAt
+	s.Require().NoError(scanned.Scan(e.Time()))
+	s.Equal(e, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	nilVal, err := scanned.Value()
+	s.Require().NoError(err)
+	s.Nil(nilVal)
+	s.Require().Error(scanned.Scan("2026-03-31"))
+}
@@ -0,0 +1,152 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// flagKeyRegex validates a feature flag key: lowercase alphanumeric words separated by
+// single hyphens, underscores or dots (e.g., "new-checkout", "billing.pix_enabled").
+var flagKeyRegex = regexp.MustCompile(`^[a-z0-9]+([._-][a-z0-9]+)*$`)
+
+// FlagKey is a value object representing the identifier of a feature flag (e.g., "new-checkout").
+// Keys are slug-like and must be registered in a global registry before they can be used,
+// so typos in flag names are caught when flags are read from configuration or the database.
+// Keys are normalized to lowercase.
+//
+// Example:
+//   wisp.RegisterFlagKeys("new-checkout", "beta.reports")
+//   key, err := wisp.NewFlagKey("New-Checkout") // "new-checkout"
+type FlagKey string
+
+// validFlagKeys holds the global set of registered flag keys.
+var validFlagKeys = make(map[FlagKey]struct{})
+
+// EmptyFlagKey represents the zero value for the FlagKey type.
+var EmptyFlagKey FlagKey
+
+// RegisterFlagKeys adds one or more keys to the global registry of known feature flags.
+// Keys are normalized to lowercase; malformed and empty keys are ignored.
+// This function should be called at application startup to define all known flags.
+func RegisterFlagKeys(keys ...FlagKey) {
+	for _, k := range keys {
+		normalized := FlagKey(strings.ToLower(strings.TrimSpace(string(k))))
+		if flagKeyRegex.MatchString(string(normalized)) {
+			validFlagKeys[normalized] = struct{}{}
+		}
+	}
+}
+
+// ClearRegisteredFlagKeys removes all keys from the global registry.
+// This is primarily for testing purposes to ensure a clean state.
+func ClearRegisteredFlagKeys() {
+	validFlagKeys = make(map[FlagKey]struct{})
+}
+
+// NewFlagKey creates a new FlagKey from a string.
+// It normalizes the input to lowercase, validates its format and checks it against the registry.
+func NewFlagKey(value string) (FlagKey, error) {
+	normalized := FlagKey(strings.ToLower(strings.TrimSpace(value)))
+	if normalized == EmptyFlagKey {
+		return EmptyFlagKey, nil
+	}
+
+	if !flagKeyRegex.MatchString(string(normalized)) {
+		return EmptyFlagKey, fault.New(
+			"flag key must contain only lowercase letters, digits and single '-', '_' or '.' separators",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_key", value),
+		)
+	}
+
+	if !normalized.IsValid() {
+		return EmptyFlagKey, fault.New(
+			"flag key is not registered as a known feature flag",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_key", value),
+		)
+	}
+
+	return normalized, nil
+}
+
+// String returns the flag key as a string.
+func (k FlagKey) String() string {
+	return string(k)
+}
+
+// IsValid checks if the key is in the global registry of known flags.
+func (k FlagKey) IsValid() bool {
+	_, ok := validFlagKeys[k]
+	return ok
+}
+
+// IsZero returns true if the FlagKey is the zero value.
+func (k FlagKey) IsZero() bool {
+	return k == EmptyFlagKey
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (k FlagKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a FlagKey, validating it against the registry.
+func (k *FlagKey) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "FlagKey must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	key, err := NewFlagKey(s)
+	if err != nil {
+		return err
+	}
+	*k = key
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the key as a string or nil if it's the zero value.
+func (k FlagKey) Value() (driver.Value, error) {
+	if k.IsZero() {
+		return nil, nil
+	}
+	return k.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values and validates them as a FlagKey.
+func (k *FlagKey) Scan(src interface{}) error {
+	if src == nil {
+		*k = EmptyFlagKey
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for FlagKey",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	key, err := NewFlagKey(s)
+	if err != nil {
+		return err
+	}
+	*k = key
+	return nil
+}
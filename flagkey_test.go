@@ -0,0 +1,81 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type FlagKeySuite struct {
+	suite.Suite
+}
+
+func TestFlagKeySuite(t *testing.T) {
+	suite.Run(t, new(FlagKeySuite))
+}
+
+func (s *FlagKeySuite) SetupTest() {
+	wisp.ClearRegisteredFlagKeys()
+	wisp.RegisterFlagKeys("new-checkout", "Beta.Reports", "pix_enabled", "bad key")
+}
+
+func (s *FlagKeySuite) TestNewFlagKey() {
+	testCases := []struct {
+		name        string
+		input       string
+		expected    wisp.FlagKey
+		expectError bool
+	}{
+		{name: "registered key", input: "new-checkout", expected: "new-checkout"},
+		{name: "normalizes case and whitespace", input: " BETA.REPORTS ", expected: "beta.reports"},
+		{name: "underscore separator", input: "pix_enabled", expected: "pix_enabled"},
+		{name: "empty input", input: "", expected: wisp.EmptyFlagKey},
+		{name: "unregistered key", input: "dark-mode", expectError: true},
+		{name: "invalid characters", input: "bad key", expectError: true},
+		{name: "leading separator", input: "-checkout", expectError: true},
+		{name: "repeated separators", input: "new--checkout", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			key, err := wisp.NewFlagKey(tc.input)
+			if tc.expectError {
+				s.Require().Error(err)
+				faultErr, ok := err.(*fault.Error)
+				s.Require().True(ok)
+				s.Equal(fault.Invalid, faultErr.Code)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.expected, key)
+		})
+	}
+}
+
+func (s *FlagKeySuite) TestFlagKey_Serialization() {
+	key, _ := wisp.NewFlagKey("new-checkout")
+
+	data, err := json.Marshal(key)
+	s.Require().NoError(err)
+	s.Equal(`"new-checkout"`, string(data))
+
+	var decoded wisp.FlagKey
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(key, decoded)
+	s.Require().Error(json.Unmarshal([]byte(`"dark-mode"`), &decoded))
+
+	val, err := key.Value()
+	s.Require().NoError(err)
+	s.Equal("new-checkout", val)
+
+	var scanned wisp.FlagKey
+	s.Require().NoError(scanned.Scan([]byte("pix_enabled")))
+	s.Equal(wisp.FlagKey("pix_enabled"), scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Require().Error(scanned.Scan(true))
+}
@@ -0,0 +1,290 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// FlagSet is an immutable value object holding the feature flag values of a scope such as a
+// tenant or a user (e.g., {"new-checkout": true, "reports.limit": 50}). Every key must be a
+// registered FlagKey and every value must be a bool, a string or a finite number, so toggles stored
+// next to Preferences are validated on the way in and out of the database.
+//
+// Numbers are stored as float64, matching what encoding/json produces.
+//
+// Example:
+//   wisp.RegisterFlagKeys("new-checkout", "reports.limit")
+//   flags, _ := wisp.NewFlagSet(map[string]any{"new-checkout": true})
+//   flags, _ = flags.Set("reports.limit", 50)
+//   enabled := flags.IsEnabled("new-checkout")  // true
+//   limit, _ := flags.GetInt("reports.limit")   // 50
+type FlagSet struct {
+	values map[FlagKey]any
+}
+
+// EmptyFlagSet represents the zero value for FlagSet (no flags set).
+var EmptyFlagSet = FlagSet{}
+
+// NewFlagSet creates a new FlagSet from a map of flag names to values.
+// Returns an error if a key is not a registered FlagKey or a value has an unsupported type.
+func NewFlagSet(values map[string]any) (FlagSet, error) {
+	if len(values) == 0 {
+		return EmptyFlagSet, nil
+	}
+
+	validated := make(map[FlagKey]any, len(values))
+	for k, v := range values {
+		key, err := NewFlagKey(k)
+		if err != nil {
+			return EmptyFlagSet, err
+		}
+		if key.IsZero() {
+			return EmptyFlagSet, fault.New("flag key cannot be empty", fault.WithCode(fault.Invalid))
+		}
+
+		value, err := normalizeFlagValue(key, v)
+		if err != nil {
+			return EmptyFlagSet, err
+		}
+		validated[key] = value
+	}
+
+	return FlagSet{values: validated}, nil
+}
+
+// ParseFlagSet creates a new FlagSet from a JSON object.
+func ParseFlagSet(jsonData []byte) (FlagSet, error) {
+	if len(jsonData) == 0 || string(jsonData) == "null" {
+		return EmptyFlagSet, nil
+	}
+
+	var values map[string]any
+	if err := json.Unmarshal(jsonData, &values); err != nil {
+		return EmptyFlagSet, fault.Wrap(err, "invalid JSON format for FlagSet", fault.WithCode(fault.Invalid))
+	}
+
+	return NewFlagSet(values)
+}
+
+// normalizeFlagValue checks that a flag value is a bool, string or finite number, converting
+// numbers to float64.
+func normalizeFlagValue(key FlagKey, value any) (any, error) {
+	var number float64
+	switch v := value.(type) {
+	case bool, string:
+		return v, nil
+	case float64:
+		number = v
+	case float32:
+		number = float64(v)
+	case int:
+		number = float64(v)
+	case int8:
+		number = float64(v)
+	case int16:
+		number = float64(v)
+	case int32:
+		number = float64(v)
+	case int64:
+		number = float64(v)
+	case uint:
+		number = float64(v)
+	case uint8:
+		number = float64(v)
+	case uint16:
+		number = float64(v)
+	case uint32:
+		number = float64(v)
+	case uint64:
+		number = float64(v)
+	default:
+		return nil, fault.New(
+			"flag value must be a bool, string or number",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("flag_key", key.String()),
+			fault.WithContext("received_type", fmt.Sprintf("%T", value)),
+		)
+	}
+
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return nil, fault.New(
+			"flag value must be a finite number",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("flag_key", key.String()),
+		)
+	}
+	return number, nil
+}
+
+// Get returns the raw value of a flag. The second return value is false if the flag is not set.
+func (fs FlagSet) Get(key FlagKey) (any, bool) {
+	v, ok := fs.values[key]
+	return v, ok
+}
+
+// Has checks if the flag is set.
+func (fs FlagSet) Has(key FlagKey) bool {
+	_, ok := fs.values[key]
+	return ok
+}
+
+// GetBool returns the value of a boolean flag.
+// The second return value is false if the flag is not set or is not a bool.
+func (fs FlagSet) GetBool(key FlagKey) (bool, bool) {
+	v, ok := fs.values[key].(bool)
+	return v, ok
+}
+
+// IsEnabled returns true only if the flag is set to the boolean true.
+func (fs FlagSet) IsEnabled(key FlagKey) bool {
+	v, _ := fs.GetBool(key)
+	return v
+}
+
+// GetString returns the value of a string flag.
+// The second return value is false if the flag is not set or is not a string.
+func (fs FlagSet) GetString(key FlagKey) (string, bool) {
+	v, ok := fs.values[key].(string)
+	return v, ok
+}
+
+// GetFloat returns the value of a numeric flag.
+// The second return value is false if the flag is not set or is not a number.
+func (fs FlagSet) GetFloat(key FlagKey) (float64, bool) {
+	v, ok := fs.values[key].(float64)
+	return v, ok
+}
+
+// GetInt returns the value of a numeric flag as an int.
+// The second return value is false if the flag is not set, is not a number or is not a whole number.
+func (fs FlagSet) GetInt(key FlagKey) (int, bool) {
+	v, ok := fs.GetFloat(key)
+	// -math.MinInt is 2^63 (or 2^31), the first float64 beyond the int range; math.MaxInt is
+	// not representable and rounds up to it.
+	if !ok || v != math.Trunc(v) || v >= -math.MinInt || v < math.MinInt {
+		return 0, false
+	}
+	return int(v), true
+}
+
+// Set adds or updates a flag, returning a new FlagSet.
+// Returns an error if the key is not registered or the value has an unsupported type.
+func (fs FlagSet) Set(key string, value any) (FlagSet, error) {
+	flagKey, err := NewFlagKey(key)
+	if err != nil {
+		return fs, err
+	}
+	if flagKey.IsZero() {
+		return fs, fault.New("flag key cannot be empty", fault.WithCode(fault.Invalid))
+	}
+
+	normalized, err := normalizeFlagValue(flagKey, value)
+	if err != nil {
+		return fs, err
+	}
+
+	values := fs.copyValues(len(fs.values) + 1)
+	values[flagKey] = normalized
+	return FlagSet{values: values}, nil
+}
+
+// Unset removes a flag, returning a new FlagSet.
+func (fs FlagSet) Unset(key FlagKey) FlagSet {
+	if !fs.Has(key) {
+		return fs
+	}
+
+	values := fs.copyValues(len(fs.values))
+	delete(values, key)
+	if len(values) == 0 {
+		return EmptyFlagSet
+	}
+	return FlagSet{values: values}
+}
+
+// Keys returns the keys of all flags that are set, sorted.
+func (fs FlagSet) Keys() []FlagKey {
+	keys := make([]FlagKey, 0, len(fs.values))
+	for k := range fs.values {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// Len returns the number of flags that are set.
+func (fs FlagSet) Len() int {
+	return len(fs.values)
+}
+
+// IsZero returns true if no flags are set.
+func (fs FlagSet) IsZero() bool {
+	return len(fs.values) == 0
+}
+
+func (fs FlagSet) copyValues(capacity int) map[FlagKey]any {
+	values := make(map[FlagKey]any, capacity)
+	for k, v := range fs.values {
+		values[k] = v
+	}
+	return values
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the flags as a JSON object; an empty set becomes {}.
+func (fs FlagSet) MarshalJSON() ([]byte, error) {
+	if fs.IsZero() {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(fs.values)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object into a FlagSet, validating keys and values.
+func (fs *FlagSet) UnmarshalJSON(data []byte) error {
+	flags, err := ParseFlagSet(data)
+	if err != nil {
+		return err
+	}
+	*fs = flags
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the flags as a JSON byte array or nil if no flags are set.
+func (fs FlagSet) Value() (driver.Value, error) {
+	if fs.IsZero() {
+		return nil, nil
+	}
+	return fs.MarshalJSON()
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a JSON object as []byte or string and validates every flag.
+func (fs *FlagSet) Scan(src interface{}) error {
+	if src == nil {
+		*fs = EmptyFlagSet
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fault.New(
+			"unsupported scan type for FlagSet",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return fs.UnmarshalJSON(data)
+}
@@ -0,0 +1,167 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type FlagSetSuite struct {
+	suite.Suite
+}
+
+func TestFlagSetSuite(t *testing.T) {
+	suite.Run(t, new(FlagSetSuite))
+}
+
+func (s *FlagSetSuite) SetupTest() {
+	wisp.ClearRegisteredFlagKeys()
+	wisp.RegisterFlagKeys("new-checkout", "reports.limit", "theme.variant", "ratio")
+}
+
+func (s *FlagSetSuite) TestNewFlagSet() {
+	s.Run("should create a set with typed values", func() {
+		flags, err := wisp.NewFlagSet(map[string]any{
+			"new-checkout":  true,
+			"REPORTS.LIMIT": 50,
+			"theme.variant": "compact",
+			"ratio":         float32(0.5),
+		})
+		s.Require().NoError(err)
+		s.Equal(4, flags.Len())
+
+		s.True(flags.IsEnabled("new-checkout"))
+		limit, ok := flags.GetInt("reports.limit")
+		s.True(ok)
+		s.Equal(50, limit)
+		variant, ok := flags.GetString("theme.variant")
+		s.True(ok)
+		s.Equal("compact", variant)
+		ratio, ok := flags.GetFloat("ratio")
+		s.True(ok)
+		s.Equal(0.5, ratio)
+	})
+
+	s.Run("typed getters should reject mismatched types", func() {
+		flags, _ := wisp.NewFlagSet(map[string]any{"new-checkout": "yes", "ratio": 0.5})
+		_, ok := flags.GetBool("new-checkout")
+		s.False(ok)
+		s.False(flags.IsEnabled("new-checkout"))
+		_, ok = flags.GetInt("ratio")
+		s.False(ok)
+		_, ok = flags.GetString("missing")
+		s.False(ok)
+	})
+
+	s.Run("should fail for unknown keys", func() {
+		_, err := wisp.NewFlagSet(map[string]any{"dark-mode": true})
+		s.Require().Error(err)
+	})
+
+	s.Run("should fail for unsupported values", func() {
+		_, err := wisp.NewFlagSet(map[string]any{"new-checkout": []string{"a"}})
+		s.Require().Error(err)
+	})
+
+	s.Run("should accept every integer type", func() {
+		for _, value := range []any{int8(7), int16(7), int32(7), int64(7), uint(7), uint8(7), uint16(7), uint32(7), uint64(7)} {
+			flags, err := wisp.NewFlagSet(map[string]any{"reports.limit": value})
+			s.Require().NoError(err, "%T", value)
+			limit, ok := flags.GetInt("reports.limit")
+			s.True(ok)
+			s.Equal(7, limit)
+		}
+	})
+
+	s.Run("should fail for non-finite numbers", func() {
+		for _, value := range []any{math.NaN(), math.Inf(1), math.Inf(-1), float32(math.Inf(1))} {
+			_, err := wisp.NewFlagSet(map[string]any{"ratio": value})
+			s.Require().Error(err, value)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+			_, err = wisp.EmptyFlagSet.Set("ratio", value)
+			s.Require().Error(err, value)
+		}
+	})
+
+	s.Run("GetInt should reject values beyond the int range", func() {
+		flags, _ := wisp.NewFlagSet(map[string]any{"reports.limit": math.Pow(2, 63), "ratio": -math.Pow(2, 63)})
+		_, ok := flags.GetInt("reports.limit")
+		s.False(ok)
+		low, ok := flags.GetInt("ratio")
+		s.True(ok)
+		s.Equal(math.MinInt, low)
+	})
+
+	s.Run("should return the keys sorted", func() {
+		flags, _ := wisp.NewFlagSet(map[string]any{"theme.variant": "a", "new-checkout": true, "ratio": 1, "reports.limit": 2})
+		s.Equal([]wisp.FlagKey{"new-checkout", "ratio", "reports.limit", "theme.variant"}, flags.Keys())
+	})
+
+	s.Run("should return the empty set for nil", func() {
+		flags, err := wisp.NewFlagSet(nil)
+		s.Require().NoError(err)
+		s.True(flags.IsZero())
+	})
+}
+
+func (s *FlagSetSuite) TestFlagSet_Immutability() {
+	original, _ := wisp.NewFlagSet(map[string]any{"new-checkout": false})
+
+	updated, err := original.Set("new-checkout", true)
+	s.Require().NoError(err)
+	s.True(updated.IsEnabled("new-checkout"))
+	s.False(original.IsEnabled("new-checkout"))
+
+	_, err = original.Set("dark-mode", true)
+	s.Require().Error(err)
+
+	removed := updated.Unset("new-checkout")
+	s.True(removed.IsZero())
+	s.True(updated.Has("new-checkout"))
+	s.Equal(updated, updated.Unset("ratio"))
+}
+
+func (s *FlagSetSuite) TestFlagSet_Serialization() {
+	flags, _ := wisp.NewFlagSet(map[string]any{"new-checkout": true, "reports.limit": 10})
+
+	s.Run("JSON", func() {
+		data, err := json.Marshal(flags)
+		s.Require().NoError(err)
+		s.JSONEq(`{"new-checkout":true,"reports.limit":10}`, string(data))
+
+		var decoded wisp.FlagSet
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(flags, decoded)
+
+		data, err = json.Marshal(wisp.EmptyFlagSet)
+		s.Require().NoError(err)
+		s.Equal(`{}`, string(data))
+
+		s.Require().Error(json.Unmarshal([]byte(`{"dark-mode":true}`), &decoded))
+		s.Require().Error(json.Unmarshal([]byte(`{"new-checkout":null}`), &decoded))
+	})
+
+	s.Run("SQL", func() {
+		val, err := flags.Value()
+		s.Require().NoError(err)
+		s.IsType([]byte{}, val)
+
+		var scanned wisp.FlagSet
+		s.Require().NoError(scanned.Scan(val))
+		s.Equal(flags, scanned)
+		s.Require().NoError(scanned.Scan(`{"ratio":1.5}`))
+		s.Equal(1, scanned.Len())
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+		nilVal, err := scanned.Value()
+		s.Require().NoError(err)
+		s.Nil(nilVal)
+		s.Require().Error(scanned.Scan(1))
+	})
+}
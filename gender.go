@@ -0,0 +1,155 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// Gender represents the sex of a person as reported to IBGE and eSocial (field "sexo"),
+// which use the codes "M" (masculino) and "F" (feminino).
+//
+// The zero value is GenderUnspecified, used when the information was not provided;
+// it is serialized as null in JSON and NULL in the database.
+//
+// Examples:
+//   - Input: "f", "Feminino" or "female"
+//   - Stored as: "F"
+type Gender string
+
+const (
+	// GenderUnspecified means the information was not provided ("não informado").
+	GenderUnspecified Gender = ""
+	// GenderMale is the "M" code ("masculino").
+	GenderMale Gender = "M"
+	// GenderFemale is the "F" code ("feminino").
+	GenderFemale Gender = "F"
+)
+
+// genderLabels maps each gender code to its official label.
+var genderLabels = map[Gender]string{
+	GenderUnspecified: "Não informado",
+	GenderMale:        "Masculino",
+	GenderFemale:      "Feminino",
+}
+
+// genderAliases maps accepted inputs (lowercase) to their gender code.
+var genderAliases = map[string]Gender{
+	"m": GenderMale, "masculino": GenderMale, "male": GenderMale,
+	"f": GenderFemale, "feminino": GenderFemale, "female": GenderFemale,
+	"": GenderUnspecified, "nao informado": GenderUnspecified, "não informado": GenderUnspecified,
+}
+
+// NewGender creates a new Gender from its code or label, ignoring case.
+// Empty input and "não informado" return GenderUnspecified.
+// Returns an error if the value is not recognized.
+func NewGender(input string) (Gender, error) {
+	g, ok := genderAliases[strings.ToLower(strings.TrimSpace(input))]
+	if !ok {
+		return GenderUnspecified, fault.New(
+			"invalid gender code",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	return g, nil
+}
+
+// Code returns the IBGE/eSocial code ("M" or "F"), or an empty string if unspecified.
+func (g Gender) Code() string {
+	return string(g)
+}
+
+// Label returns the official label (e.g., "Feminino").
+func (g Gender) Label() string {
+	return genderLabels[g]
+}
+
+// String returns the gender code.
+func (g Gender) String() string {
+	return string(g)
+}
+
+// IsValid checks if the gender is one of the known codes, including unspecified.
+func (g Gender) IsValid() bool {
+	_, ok := genderLabels[g]
+	return ok
+}
+
+// IsUnspecified returns true if the gender was not provided.
+func (g Gender) IsUnspecified() bool {
+	return g == GenderUnspecified
+}
+
+// IsZero returns true if the Gender is the zero value (unspecified).
+func (g Gender) IsZero() bool {
+	return g == GenderUnspecified
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the gender code, or null if unspecified.
+func (g Gender) MarshalJSON() ([]byte, error) {
+	if g.IsUnspecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(g.Code())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string (code or label) or null into a Gender.
+func (g *Gender) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*g = GenderUnspecified
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "Gender must be a valid JSON string or null", fault.WithCode(fault.Invalid))
+	}
+
+	gender, err := NewGender(s)
+	if err != nil {
+		return err
+	}
+	*g = gender
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the gender code or nil if unspecified.
+func (g Gender) Value() (driver.Value, error) {
+	if g.IsUnspecified() {
+		return nil, nil
+	}
+	return g.Code(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values and validates them as a Gender.
+func (g *Gender) Scan(src interface{}) error {
+	if src == nil {
+		*g = GenderUnspecified
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New("unsupported scan type for Gender", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+
+	gender, err := NewGender(s)
+	if err != nil {
+		return err
+	}
+	*g = gender
+	return nil
+}
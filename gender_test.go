@@ -0,0 +1,98 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type GenderSuite struct {
+	suite.Suite
+}
+
+func TestGenderSuite(t *testing.T) {
+	suite.Run(t, new(GenderSuite))
+}
+
+func (s *GenderSuite) TestNewGender() {
+	testCases := []struct {
+		input       string
+		expected    wisp.Gender
+		expectError bool
+	}{
+		{input: "M", expected: wisp.GenderMale},
+		{input: " f ", expected: wisp.GenderFemale},
+		{input: "Feminino", expected: wisp.GenderFemale},
+		{input: "male", expected: wisp.GenderMale},
+		{input: "", expected: wisp.GenderUnspecified},
+		{input: "Não informado", expected: wisp.GenderUnspecified},
+		{input: "X", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.input, func() {
+			gender, err := wisp.NewGender(tc.input)
+			if tc.expectError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.expected, gender)
+		})
+	}
+}
+
+func (s *GenderSuite) TestCodeAndLabel() {
+	s.Equal("F", wisp.GenderFemale.Code())
+	s.Equal("Feminino", wisp.GenderFemale.Label())
+	s.Equal("Masculino", wisp.GenderMale.Label())
+	s.Equal("", wisp.GenderUnspecified.Code())
+	s.Equal("Não informado", wisp.GenderUnspecified.Label())
+	s.True(wisp.GenderUnspecified.IsUnspecified())
+	s.True(wisp.GenderUnspecified.IsValid())
+	s.False(wisp.Gender("X").IsValid())
+}
+
+func (s *GenderSuite) TestJSON() {
+	type person struct {
+		Gender wisp.Gender `json:"gender"`
+	}
+
+	data, err := json.Marshal(person{Gender: wisp.GenderFemale})
+	s.Require().NoError(err)
+	s.JSONEq(`{"gender":"F"}`, string(data))
+
+	data, err = json.Marshal(person{})
+	s.Require().NoError(err)
+	s.JSONEq(`{"gender":null}`, string(data))
+
+	var p person
+	s.Require().NoError(json.Unmarshal([]byte(`{"gender":"masculino"}`), &p))
+	s.Equal(wisp.GenderMale, p.Gender)
+
+	s.Error(json.Unmarshal([]byte(`{"gender":"Z"}`), &p))
+	s.Error(json.Unmarshal([]byte(`{"gender":1}`), &p))
+}
+
+func (s *GenderSuite) TestSQL() {
+	val, err := wisp.GenderMale.Value()
+	s.Require().NoError(err)
+	s.Equal("M", val)
+
+	val, err = wisp.GenderUnspecified.Value()
+	s.Require().NoError(err)
+	s.Nil(val)
+
+	var g wisp.Gender
+	s.Require().NoError(g.Scan([]byte("F")))
+	s.Equal(wisp.GenderFemale, g)
+
+	s.Require().NoError(g.Scan(nil))
+	s.True(g.IsUnspecified())
+
+	s.Error(g.Scan("Q"))
+	s.Error(g.Scan(42))
+}
@@ -0,0 +1,321 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// earthRadiusMeters is the mean radius of the Earth (IUGG), used by the haversine formula.
+const earthRadiusMeters = 6371008.8
+
+// GeoPoint is a value object representing a location on Earth as a Latitude and Longitude pair,
+// such as a store or a delivery address. Distances use the haversine formula on a spherical Earth,
+// accurate to about 0.5%, which is enough for delivery radius and "nearest store" checks.
+//
+// It is serialized as {"lat":..., "lng":...} in JSON and as "lat,lng" text in the database.
+// Scan also accepts the PostGIS text forms "POINT(lng lat)" and "SRID=4326;POINT(lng lat)",
+// and WKT returns the former for use with ST_GeomFromText.
+//
+// The zero value is ZeroGeoPoint, which is empty and distinct from the point at (0, 0).
+//
+// Example:
+//   saoPaulo, _ := wisp.NewGeoPoint(-23.55052, -46.633308)
+//   rio, _ := wisp.NewGeoPoint(-22.906847, -43.172897)
+//   d := saoPaulo.DistanceTo(rio)
+//   km, _ := d.In(wisp.Kilometer) // ~361
+type GeoPoint struct {
+	lat   Latitude
+	lng   Longitude
+	valid bool
+}
+
+// ZeroGeoPoint represents the empty GeoPoint.
+var ZeroGeoPoint = GeoPoint{}
+
+// NewGeoPoint creates a new GeoPoint from decimal degrees.
+// It returns an error if the latitude is outside -90..90 or the longitude outside -180..180.
+func NewGeoPoint(lat, lng float64) (GeoPoint, error) {
+	latitude, err := NewLatitude(lat)
+	if err != nil {
+		return ZeroGeoPoint, err
+	}
+	longitude, err := NewLongitude(lng)
+	if err != nil {
+		return ZeroGeoPoint, err
+	}
+	return GeoPoint{lat: latitude, lng: longitude, valid: true}, nil
+}
+
+// ParseGeoPoint creates a GeoPoint from "lat,lng" text (e.g., "-23.55052,-46.633308") or from the
+// PostGIS text forms "POINT(lng lat)" and "SRID=4326;POINT(lng lat)". Note that WKT puts the
+// longitude first.
+func ParseGeoPoint(s string) (GeoPoint, error) {
+	text := strings.TrimSpace(s)
+	upper := strings.ToUpper(text)
+
+	var first, second string
+	lngFirst := false
+	if srid, rest, found := strings.Cut(upper, ";"); found {
+		if srid != "SRID=4326" {
+			return ZeroGeoPoint, fault.New(
+				"geo point must use SRID 4326 (WGS 84)",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("input_value", s),
+			)
+		}
+		upper = strings.TrimSpace(rest)
+	}
+
+	if inner, ok := strings.CutPrefix(upper, "POINT"); ok {
+		inner = strings.TrimSpace(inner)
+		if !strings.HasPrefix(inner, "(") || !strings.HasSuffix(inner, ")") {
+			return ZeroGeoPoint, errInvalidGeoPoint(s)
+		}
+		fields := strings.Fields(inner[1 : len(inner)-1])
+		if len(fields) != 2 {
+			return ZeroGeoPoint, errInvalidGeoPoint(s)
+		}
+		first, second, lngFirst = fields[0], fields[1], true
+	} else {
+		var found bool
+		first, second, found = strings.Cut(text, ",")
+		if !found {
+			return ZeroGeoPoint, errInvalidGeoPoint(s)
+		}
+	}
+
+	a, errA := strconv.ParseFloat(strings.TrimSpace(first), 64)
+	b, errB := strconv.ParseFloat(strings.TrimSpace(second), 64)
+	if errA != nil || errB != nil {
+		return ZeroGeoPoint, errInvalidGeoPoint(s)
+	}
+	if lngFirst {
+		return NewGeoPoint(b, a)
+	}
+	return NewGeoPoint(a, b)
+}
+
+// errInvalidGeoPoint builds the error returned for malformed geo point text.
+func errInvalidGeoPoint(input string) error {
+	return fault.New(
+		"geo point must be \"lat,lng\" or \"POINT(lng lat)\"",
+		fault.WithCode(fault.Invalid),
+		fault.WithContext("input_value", input),
+	)
+}
+
+// Latitude returns the latitude of the point.
+func (p GeoPoint) Latitude() Latitude {
+	return p.lat
+}
+
+// Longitude returns the longitude of the point.
+func (p GeoPoint) Longitude() Longitude {
+	return p.lng
+}
+
+// IsZero returns true if the GeoPoint is empty.
+func (p GeoPoint) IsZero() bool {
+	return !p.valid
+}
+
+// Equals checks if two points have exactly the same coordinates.
+func (p GeoPoint) Equals(other GeoPoint) bool {
+	return p == other
+}
+
+// DistanceTo returns the great-circle distance to another point, using the haversine formula.
+// Returns ZeroLength if either point is empty.
+func (p GeoPoint) DistanceTo(other GeoPoint) Length {
+	if !p.valid || !other.valid {
+		return ZeroLength
+	}
+
+	lat1 := degreesToRadians(p.lat.Float64())
+	lat2 := degreesToRadians(other.lat.Float64())
+	dLat := lat2 - lat1
+	dLng := degreesToRadians(other.lng.Float64() - p.lng.Float64())
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	meters := 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+
+	return Length{micrometers: int64(math.Round(meters * micrometersInAMeter))}
+}
+
+// IsWithin checks if the point is at most radius away from center, such as a delivery area.
+func (p GeoPoint) IsWithin(center GeoPoint, radius Length) bool {
+	return p.valid && center.valid && p.DistanceTo(center).micrometers <= radius.micrometers
+}
+
+// degreesToRadians converts an angle from degrees to radians.
+func degreesToRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// String returns the point as "lat,lng" text, or an empty string if it is empty.
+func (p GeoPoint) String() string {
+	if !p.valid {
+		return ""
+	}
+	return formatGeoFloat(p.lat.Float64()) + "," + formatGeoFloat(p.lng.Float64())
+}
+
+// WKT returns the point in the Well-Known Text format used by PostGIS, "POINT(lng lat)",
+// or an empty string if it is empty.
+func (p GeoPoint) WKT() string {
+	if !p.valid {
+		return ""
+	}
+	return "POINT(" + formatGeoFloat(p.lng.Float64()) + " " + formatGeoFloat(p.lat.Float64()) + ")"
+}
+
+// formatGeoFloat formats a coordinate with the fewest digits that represent it exactly.
+func formatGeoFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// geoPointJSON is the JSON representation of a GeoPoint.
+type geoPointJSON struct {
+	Lat *float64 `json:"lat"`
+	Lng *float64 `json:"lng"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the GeoPoint as {"lat":..., "lng":...}, or null if it is empty.
+func (p GeoPoint) MarshalJSON() ([]byte, error) {
+	if !p.valid {
+		return []byte("null"), nil
+	}
+	lat, lng := p.lat.Float64(), p.lng.Float64()
+	return json.Marshal(geoPointJSON{Lat: &lat, Lng: &lng})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Both "lat" and "lng" are required; null results in ZeroGeoPoint.
+func (p *GeoPoint) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*p = ZeroGeoPoint
+		return nil
+	}
+
+	var dto geoPointJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for GeoPoint", fault.WithCode(fault.Invalid))
+	}
+	if dto.Lat == nil || dto.Lng == nil {
+		return fault.New("geo point requires both lat and lng", fault.WithCode(fault.Invalid))
+	}
+
+	point, err := NewGeoPoint(*dto.Lat, *dto.Lng)
+	if err != nil {
+		return err
+	}
+	*p = point
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the GeoPoint as "lat,lng" text, or nil if it is empty.
+func (p GeoPoint) Value() (driver.Value, error) {
+	if !p.valid {
+		return nil, nil
+	}
+	return p.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts "lat,lng" text or the PostGIS text forms, as string or []byte.
+func (p *GeoPoint) Scan(src interface{}) error {
+	if src == nil {
+		*p = ZeroGeoPoint
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for GeoPoint",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	point, err := ParseGeoPoint(s)
+	if err != nil {
+		return err
+	}
+	*p = point
+	return nil
+}
+
+// BoundingBox is a value object representing a rectangular area between a south-west and a
+// north-east corner, such as the visible region of a map. Boxes crossing the antimeridian are
+// supported: the west longitude is then greater than the east one.
+//
+// Example:
+//   sw, _ := wisp.NewGeoPoint(-24.0, -47.0)
+//   ne, _ := wisp.NewGeoPoint(-23.0, -46.0)
+//   box, err := wisp.NewBoundingBox(sw, ne)
+//   box.Contains(saoPaulo) // true
+type BoundingBox struct {
+	southWest GeoPoint
+	northEast GeoPoint
+}
+
+// NewBoundingBox creates a new BoundingBox from its south-west and north-east corners.
+// It returns an error if a corner is empty or the south latitude is greater than the north one.
+func NewBoundingBox(southWest, northEast GeoPoint) (BoundingBox, error) {
+	if southWest.IsZero() || northEast.IsZero() {
+		return BoundingBox{}, fault.New("bounding box corners cannot be empty", fault.WithCode(fault.Invalid))
+	}
+	if southWest.lat > northEast.lat {
+		return BoundingBox{}, fault.New(
+			"bounding box south latitude cannot be greater than the north latitude",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("south", southWest.lat.Float64()),
+			fault.WithContext("north", northEast.lat.Float64()),
+		)
+	}
+	return BoundingBox{southWest: southWest, northEast: northEast}, nil
+}
+
+// SouthWest returns the south-west corner of the box.
+func (b BoundingBox) SouthWest() GeoPoint {
+	return b.southWest
+}
+
+// NorthEast returns the north-east corner of the box.
+func (b BoundingBox) NorthEast() GeoPoint {
+	return b.northEast
+}
+
+// IsZero returns true if the BoundingBox is the zero value.
+func (b BoundingBox) IsZero() bool {
+	return b.southWest.IsZero()
+}
+
+// Contains checks if the point lies inside the box, edges included.
+func (b BoundingBox) Contains(p GeoPoint) bool {
+	if b.IsZero() || p.IsZero() {
+		return false
+	}
+	if p.lat < b.southWest.lat || p.lat > b.northEast.lat {
+		return false
+	}
+	west, east := b.southWest.lng, b.northEast.lng
+	if west <= east {
+		return p.lng >= west && p.lng <= east
+	}
+	return p.lng >= west || p.lng <= east
+}
@@ -0,0 +1,188 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type GeoPointSuite struct {
+	suite.Suite
+	saoPaulo wisp.GeoPoint
+	rio      wisp.GeoPoint
+}
+
+func TestGeoPointSuite(t *testing.T) {
+	suite.Run(t, new(GeoPointSuite))
+}
+
+func (s *GeoPointSuite) SetupTest() {
+	var err error
+	s.saoPaulo, err = wisp.NewGeoPoint(-23.55052, -46.633308)
+	s.Require().NoError(err)
+	s.rio, err = wisp.NewGeoPoint(-22.906847, -43.172897)
+	s.Require().NoError(err)
+}
+
+func (s *GeoPointSuite) TestNewGeoPoint() {
+	s.Equal(wisp.Latitude(-23.55052), s.saoPaulo.Latitude())
+	s.Equal(wisp.Longitude(-46.633308), s.saoPaulo.Longitude())
+	s.False(s.saoPaulo.IsZero())
+
+	nullIsland, err := wisp.NewGeoPoint(0, 0)
+	s.Require().NoError(err)
+	s.False(nullIsland.IsZero(), "(0, 0) is a valid point")
+	s.True(wisp.ZeroGeoPoint.IsZero())
+
+	for _, tc := range [][2]float64{{91, 0}, {-91, 0}, {0, 181}, {0, -181}} {
+		p, err := wisp.NewGeoPoint(tc[0], tc[1])
+		s.Require().Error(err)
+		s.True(p.IsZero())
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	}
+}
+
+func (s *GeoPointSuite) TestParseGeoPoint() {
+	testCases := []struct {
+		input string
+		lat   float64
+		lng   float64
+	}{
+		{input: "-23.55052,-46.633308", lat: -23.55052, lng: -46.633308},
+		{input: " -23.55052 , -46.633308 ", lat: -23.55052, lng: -46.633308},
+		{input: "POINT(-46.633308 -23.55052)", lat: -23.55052, lng: -46.633308},
+		{input: "point ( -46.633308   -23.55052 )", lat: -23.55052, lng: -46.633308},
+		{input: "SRID=4326;POINT(-46.633308 -23.55052)", lat: -23.55052, lng: -46.633308},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.input, func() {
+			p, err := wisp.ParseGeoPoint(tc.input)
+			s.Require().NoError(err)
+			s.Equal(wisp.Latitude(tc.lat), p.Latitude())
+			s.Equal(wisp.Longitude(tc.lng), p.Longitude())
+		})
+	}
+
+	s.Run("should reject malformed text", func() {
+		inputs := []string{"", "-23.5", "a,b", "POINT(1)", "POINT 1 2", "SRID=3857;POINT(1 2)", "95,10", "POINT(10 95)"}
+		for _, input := range inputs {
+			_, err := wisp.ParseGeoPoint(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *GeoPointSuite) TestDistanceTo() {
+	km, err := s.saoPaulo.DistanceTo(s.rio).In(wisp.Kilometer)
+	s.Require().NoError(err)
+	s.InDelta(361, km, 2)
+
+	s.Equal(s.saoPaulo.DistanceTo(s.rio), s.rio.DistanceTo(s.saoPaulo))
+	s.Equal(wisp.ZeroLength, s.saoPaulo.DistanceTo(s.saoPaulo))
+	s.Equal(wisp.ZeroLength, s.saoPaulo.DistanceTo(wisp.ZeroGeoPoint))
+
+	north, _ := wisp.NewGeoPoint(90, 0)
+	south, _ := wisp.NewGeoPoint(-90, 0)
+	km, _ = north.DistanceTo(south).In(wisp.Kilometer)
+	s.InDelta(20015, km, 1)
+}
+
+func (s *GeoPointSuite) TestIsWithin() {
+	radius, _ := wisp.NewLength(400, wisp.Kilometer)
+	s.True(s.rio.IsWithin(s.saoPaulo, radius))
+
+	radius, _ = wisp.NewLength(300, wisp.Kilometer)
+	s.False(s.rio.IsWithin(s.saoPaulo, radius))
+	s.False(wisp.ZeroGeoPoint.IsWithin(s.saoPaulo, radius))
+}
+
+func (s *GeoPointSuite) TestBoundingBox() {
+	sw, _ := wisp.NewGeoPoint(-24, -47)
+	ne, _ := wisp.NewGeoPoint(-23, -46)
+	box, err := wisp.NewBoundingBox(sw, ne)
+	s.Require().NoError(err)
+
+	s.Equal(sw, box.SouthWest())
+	s.Equal(ne, box.NorthEast())
+	s.True(box.Contains(s.saoPaulo))
+	s.True(box.Contains(sw), "edges are included")
+	s.False(box.Contains(s.rio))
+	s.False(box.Contains(wisp.ZeroGeoPoint))
+
+	s.Run("should support boxes crossing the antimeridian", func() {
+		west, _ := wisp.NewGeoPoint(-20, 170)
+		east, _ := wisp.NewGeoPoint(-10, -170)
+		fiji, _ := wisp.NewBoundingBox(west, east)
+
+		inside, _ := wisp.NewGeoPoint(-15, 179)
+		alsoInside, _ := wisp.NewGeoPoint(-15, -175)
+		outside, _ := wisp.NewGeoPoint(-15, 0)
+		s.True(fiji.Contains(inside))
+		s.True(fiji.Contains(alsoInside))
+		s.False(fiji.Contains(outside))
+	})
+
+	s.Run("should reject invalid corners", func() {
+		_, err := wisp.NewBoundingBox(ne, sw)
+		s.Error(err)
+		_, err = wisp.NewBoundingBox(wisp.ZeroGeoPoint, ne)
+		s.Error(err)
+		s.True(wisp.BoundingBox{}.IsZero())
+		s.False(wisp.BoundingBox{}.Contains(s.saoPaulo))
+	})
+}
+
+func (s *GeoPointSuite) TestFormatting() {
+	s.Equal("-23.55052,-46.633308", s.saoPaulo.String())
+	s.Equal("POINT(-46.633308 -23.55052)", s.saoPaulo.WKT())
+	s.Equal("", wisp.ZeroGeoPoint.String())
+	s.Equal("", wisp.ZeroGeoPoint.WKT())
+}
+
+func (s *GeoPointSuite) TestJSON() {
+	data, err := json.Marshal(s.saoPaulo)
+	s.Require().NoError(err)
+	s.JSONEq(`{"lat":-23.55052,"lng":-46.633308}`, string(data))
+
+	data, err = json.Marshal(wisp.ZeroGeoPoint)
+	s.Require().NoError(err)
+	s.Equal("null", string(data))
+
+	var decoded wisp.GeoPoint
+	s.Require().NoError(json.Unmarshal([]byte(`{"lat":-23.55052,"lng":-46.633308}`), &decoded))
+	s.True(s.saoPaulo.Equals(decoded))
+	s.Require().NoError(json.Unmarshal([]byte(`{"lat":0,"lng":0}`), &decoded))
+	s.False(decoded.IsZero())
+	s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.True(decoded.IsZero())
+
+	s.Error(json.Unmarshal([]byte(`{"lat":-23.5}`), &decoded))
+	s.Error(json.Unmarshal([]byte(`{"lat":100,"lng":0}`), &decoded))
+	s.Error(json.Unmarshal([]byte(`[1,2]`), &decoded))
+}
+
+func (s *GeoPointSuite) TestSQL() {
+	v, err := s.saoPaulo.Value()
+	s.Require().NoError(err)
+	s.Equal("-23.55052,-46.633308", v)
+	v, err = wisp.ZeroGeoPoint.Value()
+	s.Require().NoError(err)
+	s.Nil(v)
+
+	var scanned wisp.GeoPoint
+	s.Require().NoError(scanned.Scan("-23.55052,-46.633308"))
+	s.Equal(s.saoPaulo, scanned)
+	s.Require().NoError(scanned.Scan([]byte("SRID=4326;POINT(-43.172897 -22.906847)")))
+	s.Equal(s.rio, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Error(scanned.Scan("invalid"))
+	err = scanned.Scan(1.5)
+	s.Require().Error(err)
+	s.Equal(fault.Invalid, err.(*fault.Error).Code)
+}
@@ -6,6 +6,7 @@ require (
 	github.com/google/uuid v1.6.0
 	github.com/marcelofabianov/fault v1.5.0
 	github.com/stretchr/testify v1.11.1
+	golang.org/x/crypto v0.33.0
 	golang.org/x/text v0.29.0
 )
 
@@ -17,7 +18,6 @@ require (
 	github.com/go-playground/validator/v10 v10.27.0 // indirect
 	github.com/leodido/go-urn v1.4.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	golang.org/x/crypto v0.33.0 // indirect
 	golang.org/x/net v0.34.0 // indirect
 	golang.org/x/sys v0.30.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
@@ -0,0 +1,216 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// GTINKind is the barcode format of a GTIN, given by its length.
+type GTINKind string
+
+// Defines the GTIN formats.
+const (
+	GTINKindEAN8   GTINKind = "ean8"
+	GTINKindUPCA   GTINKind = "upca"
+	GTINKindEAN13  GTINKind = "ean13"
+	GTINKindGTIN14 GTINKind = "gtin14"
+)
+
+// gtinKinds maps the number of digits of a GTIN to its format.
+var gtinKinds = map[int]GTINKind{
+	8:  GTINKindEAN8,
+	12: GTINKindUPCA,
+	13: GTINKindEAN13,
+	14: GTINKindGTIN14,
+}
+
+// GTIN is a value object representing a Global Trade Item Number, the number under the barcode
+// of a product: EAN-8, UPC-A (12 digits), EAN-13 or GTIN-14 (logistic units such as boxes).
+// It is stored as digits only, with the length it was given, after validating the GS1 check digit.
+//
+// The same product may be written with different lengths ("036000291452" as UPC-A and
+// "0036000291452" as EAN-13); use Equals or GTIN14 to compare them.
+//
+// The zero value is EmptyGTIN.
+//
+// Example:
+//   gtin, err := wisp.NewGTIN("7 891000 315507")
+//   gtin.Kind()          // GTINKindEAN13
+//   gtin.CountryPrefix() // "789" (GS1 Brasil)
+type GTIN string
+
+// EmptyGTIN represents the zero value for the GTIN type.
+var EmptyGTIN GTIN
+
+// NewGTIN creates a new GTIN, ignoring spaces and hyphens.
+// Returns EmptyGTIN for an empty input, or an error if the number does not have 8, 12, 13 or 14
+// digits, is all zeros or has an invalid check digit.
+func NewGTIN(input string) (GTIN, error) {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(input))
+	if digits == "" {
+		return EmptyGTIN, nil
+	}
+
+	if _, ok := gtinKinds[len(digits)]; !ok || !isASCIIDigits(digits) {
+		return EmptyGTIN, fault.New(
+			"GTIN must have 8, 12, 13 or 14 digits",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	if strings.Trim(digits, "0") == "" {
+		return EmptyGTIN, fault.New("GTIN cannot be all zeros", fault.WithCode(fault.Invalid), fault.WithContext("input_value", input))
+	}
+	if gtinCheckDigit(digits[:len(digits)-1]) != digits[len(digits)-1] {
+		return EmptyGTIN, fault.New(
+			"GTIN has an invalid check digit",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	return GTIN(digits), nil
+}
+
+// gtinCheckDigit computes the GS1 check digit of the digits before it: from the right, digits are
+// weighted 3 and 1 alternately, and the check digit completes the sum to a multiple of 10.
+func gtinCheckDigit(digits string) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// Kind returns the format of the GTIN, or an empty kind for the zero value.
+func (g GTIN) Kind() GTINKind {
+	return gtinKinds[len(g)]
+}
+
+// GTIN14 returns the GTIN padded with leading zeros to 14 digits, the form used to compare and
+// store GTINs of different lengths. Returns an empty string for the zero value.
+func (g GTIN) GTIN14() string {
+	if g.IsZero() {
+		return ""
+	}
+	return strings.Repeat("0", 14-len(g)) + string(g)
+}
+
+// CountryPrefix returns the 3-digit GS1 prefix of the GTIN, which identifies the GS1 member
+// organisation that assigned the company prefix (e.g., "789" and "790" for Brazil), not the
+// country where the product was made. UPC-A numbers have prefixes from "000" to "139".
+// Returns an empty string for the zero value.
+func (g GTIN) CountryPrefix() string {
+	if g.Kind() == GTINKindEAN8 {
+		return string(g[:3])
+	}
+	if g.IsZero() {
+		return ""
+	}
+	return g.GTIN14()[1:4]
+}
+
+// PackagingIndicator returns the first digit of a GTIN-14, which tells the packaging level of a
+// logistic unit (1 to 8), 9 for variable-measure units, or 0 for other formats.
+func (g GTIN) PackagingIndicator() int {
+	if g.Kind() != GTINKindGTIN14 {
+		return 0
+	}
+	return int(g[0] - '0')
+}
+
+// IsRestrictedCirculation returns true for numbers reserved for use inside a company or region,
+// such as in-store codes and variable-weight items: prefixes 020 to 029, 040 to 049 and 200 to
+// 299, or EAN-8 numbers starting with 0 or 2. They are not unique worldwide.
+func (g GTIN) IsRestrictedCirculation() bool {
+	if g.IsZero() {
+		return false
+	}
+	if g.Kind() == GTINKindEAN8 {
+		return g[0] == '0' || g[0] == '2'
+	}
+	prefix := g.CountryPrefix()
+	return prefix[0] == '2' || (prefix[0] == '0' && (prefix[1] == '2' || prefix[1] == '4'))
+}
+
+// Equals checks if two GTINs identify the same item, regardless of their length.
+func (g GTIN) Equals(other GTIN) bool {
+	return g.GTIN14() == other.GTIN14()
+}
+
+// String returns the GTIN as digits.
+func (g GTIN) String() string {
+	return string(g)
+}
+
+// IsZero returns true if the GTIN is the zero value.
+func (g GTIN) IsZero() bool {
+	return g == EmptyGTIN
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the GTIN as a JSON string.
+func (g GTIN) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a GTIN, with validation.
+func (g *GTIN) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "GTIN must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	gtin, err := NewGTIN(s)
+	if err != nil {
+		return err
+	}
+	*g = gtin
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the GTIN as a string, or nil for the zero value.
+func (g GTIN) Value() (driver.Value, error) {
+	if g.IsZero() {
+		return nil, nil
+	}
+	return g.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a GTIN, with validation.
+func (g *GTIN) Scan(src interface{}) error {
+	if src == nil {
+		*g = EmptyGTIN
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for GTIN",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	gtin, err := NewGTIN(s)
+	if err != nil {
+		return err
+	}
+	*g = gtin
+	return nil
+}
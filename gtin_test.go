@@ -0,0 +1,131 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type GTINSuite struct {
+	suite.Suite
+}
+
+func TestGTINSuite(t *testing.T) {
+	suite.Run(t, new(GTINSuite))
+}
+
+func (s *GTINSuite) TestNewGTIN() {
+	s.Run("should accept every GTIN format", func() {
+		testCases := []struct {
+			input    string
+			expected wisp.GTIN
+			kind     wisp.GTINKind
+			prefix   string
+		}{
+			{input: "73513537", expected: "73513537", kind: wisp.GTINKindEAN8, prefix: "735"},
+			{input: "0 36000 29145 2", expected: "036000291452", kind: wisp.GTINKindUPCA, prefix: "003"},
+			{input: "7 891000 315507", expected: "7891000315507", kind: wisp.GTINKindEAN13, prefix: "789"},
+			{input: "978-0-306-40615-7", expected: "9780306406157", kind: wisp.GTINKindEAN13, prefix: "978"},
+			{input: "17891000315504", expected: "17891000315504", kind: wisp.GTINKindGTIN14, prefix: "789"},
+		}
+		for _, tc := range testCases {
+			gtin, err := wisp.NewGTIN(tc.input)
+			s.Require().NoError(err, tc.input)
+			s.Equal(tc.expected, gtin)
+			s.Equal(tc.kind, gtin.Kind())
+			s.Equal(tc.prefix, gtin.CountryPrefix())
+		}
+	})
+
+	s.Run("should return EmptyGTIN for an empty input", func() {
+		gtin, err := wisp.NewGTIN("  ")
+		s.Require().NoError(err)
+		s.True(gtin.IsZero())
+		s.Equal(wisp.GTINKind(""), gtin.Kind())
+		s.Equal("", gtin.CountryPrefix())
+		s.Equal("", gtin.GTIN14())
+	})
+
+	s.Run("should reject invalid numbers", func() {
+		for _, input := range []string{"7891000315508", "789100031550", "78910003155077", "789100031550A", "00000000"} {
+			_, err := wisp.NewGTIN(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *GTINSuite) TestAccessors() {
+	s.Run("should pad to GTIN-14 and compare across lengths", func() {
+		upc, _ := wisp.NewGTIN("036000291452")
+		ean, _ := wisp.NewGTIN("0036000291452")
+		s.Equal("00036000291452", upc.GTIN14())
+		s.True(upc.Equals(ean))
+
+		other, _ := wisp.NewGTIN("7891000315507")
+		s.False(upc.Equals(other))
+	})
+
+	s.Run("should return the packaging indicator of GTIN-14", func() {
+		box, _ := wisp.NewGTIN("17891000315504")
+		s.Equal(1, box.PackagingIndicator())
+
+		unit, _ := wisp.NewGTIN("7891000315507")
+		s.Equal(0, unit.PackagingIndicator())
+	})
+
+	s.Run("should detect restricted circulation numbers", func() {
+		testCases := []struct {
+			input      string
+			restricted bool
+		}{
+			{input: "2001234000000", restricted: true},
+			{input: "0201234000006", restricted: true},
+			{input: "20012342", restricted: true},
+			{input: "7891000315507", restricted: false},
+			{input: "036000291452", restricted: false},
+			{input: "73513537", restricted: false},
+		}
+		for _, tc := range testCases {
+			gtin, err := wisp.NewGTIN(tc.input)
+			s.Require().NoError(err, tc.input)
+			s.Equal(tc.restricted, gtin.IsRestrictedCirculation(), tc.input)
+		}
+		s.False(wisp.EmptyGTIN.IsRestrictedCirculation())
+	})
+}
+
+func (s *GTINSuite) TestJSON() {
+	gtin, _ := wisp.NewGTIN("7891000315507")
+	data, err := json.Marshal(gtin)
+	s.Require().NoError(err)
+	s.Equal(`"7891000315507"`, string(data))
+
+	var decoded wisp.GTIN
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(gtin, decoded)
+	s.Error(json.Unmarshal([]byte(`"7891000315508"`), &decoded))
+	s.Error(json.Unmarshal([]byte(`7891000315507`), &decoded))
+}
+
+func (s *GTINSuite) TestSQL() {
+	gtin, _ := wisp.NewGTIN("7891000315507")
+	value, err := gtin.Value()
+	s.Require().NoError(err)
+	s.Equal("7891000315507", value)
+
+	value, err = wisp.EmptyGTIN.Value()
+	s.Require().NoError(err)
+	s.Nil(value)
+
+	var scanned wisp.GTIN
+	s.Require().NoError(scanned.Scan([]byte("7891000315507")))
+	s.Equal(gtin, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Error(scanned.Scan(1))
+}
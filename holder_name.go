@@ -0,0 +1,199 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/marcelofabianov/fault"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// maxHolderNameLength is the longest cardholder name a card carries (ISO/IEC 7813, track 1).
+const maxHolderNameLength = 26
+
+// holderNamePersistence controls whether HolderName.Value writes the name to the database.
+// It can be configured globally using SetHolderNamePersistence.
+var holderNamePersistence = false
+
+// SetHolderNamePersistence enables or disables storing cardholder names in the database.
+// The name is cardholder data under PCI DSS: storing it alongside the card brings the database into
+// PCI scope. It is disabled by default, so HolderName.Value returns an error until the application
+// opts in.
+func SetHolderNamePersistence(enabled bool) {
+	holderNamePersistence = enabled
+}
+
+// HolderName is a value object representing the cardholder name as printed on a payment card:
+// uppercase, without accents, at most 26 characters of letters, spaces, periods, hyphens and
+// apostrophes.
+//
+// String, GoString, Masked and MarshalJSON return the masked form ("J*** D* S****"), and the name
+// is only available through Reveal. Value returns an error unless SetHolderNamePersistence(true)
+// was called; Scan always works, so names stored before can still be read.
+//
+// The zero value is EmptyHolderName.
+//
+// Example:
+//   name, err := wisp.NewHolderName("José da Silva")
+//   name.Reveal()    // "JOSE DA SILVA"
+//   fmt.Println(name) // "J*** D* S****"
+type HolderName struct {
+	name string
+}
+
+// EmptyHolderName represents the zero value for the HolderName type.
+var EmptyHolderName HolderName
+
+// NewHolderName creates a new HolderName. Accents are removed, spaces are collapsed and letters
+// are converted to uppercase.
+// Returns EmptyHolderName for an empty input, or an error if the name has other characters, a
+// single character or more than 26 characters. The name is never included in the error.
+func NewHolderName(input string) (HolderName, error) {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	normalized, _, err := transform.String(t, input)
+	if err != nil {
+		return EmptyHolderName, fault.Wrap(err, "failed to normalize cardholder name", fault.WithCode(fault.Internal))
+	}
+
+	name := strings.ToUpper(strings.Join(strings.Fields(normalized), " "))
+	if name == "" {
+		return EmptyHolderName, nil
+	}
+
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !isUpperLetter(c) && c != ' ' && c != '.' && c != '-' && c != '\'' {
+			return EmptyHolderName, fault.New(
+				"cardholder name must contain only letters, spaces, periods, hyphens and apostrophes",
+				fault.WithCode(fault.Invalid),
+			)
+		}
+	}
+	if len(name) < 2 || len(name) > maxHolderNameLength {
+		return EmptyHolderName, fault.New(
+			"cardholder name must have between 2 and 26 characters",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("length", len(name)),
+		)
+	}
+	return HolderName{name: name}, nil
+}
+
+// Reveal returns the full cardholder name. Callers must not log or return it.
+func (h HolderName) Reveal() string {
+	return h.name
+}
+
+// IsZero returns true if the HolderName is the zero value.
+func (h HolderName) IsZero() bool {
+	return h.name == ""
+}
+
+// Equals checks if two cardholder names are the same.
+func (h HolderName) Equals(other HolderName) bool {
+	return h.name == other.name
+}
+
+// Masked implements the Masker interface. It keeps the first letter of each word and replaces the
+// other letters with asterisks (e.g., "J*** D* S****").
+func (h HolderName) Masked() string {
+	masked := []byte(h.name)
+	for i := range masked {
+		if isUpperLetter(masked[i]) && i > 0 && masked[i-1] != ' ' {
+			masked[i] = '*'
+		}
+	}
+	return string(masked)
+}
+
+// String returns the masked cardholder name, so it is never printed by accident.
+func (h HolderName) String() string {
+	return h.Masked()
+}
+
+// GoString returns the masked cardholder name, protecting it from the %#v verb.
+func (h HolderName) GoString() string {
+	return h.Masked()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the HolderName in its masked form, or null for the zero value.
+func (h HolderName) MarshalJSON() ([]byte, error) {
+	if h.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(h.Masked())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a full cardholder name from a JSON string, with validation; null results in
+// EmptyHolderName. A masked name is rejected.
+func (h *HolderName) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*h = EmptyHolderName
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "HolderName must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	name, err := NewHolderName(s)
+	if err != nil {
+		return err
+	}
+	*h = name
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the full name, or nil for the zero value. Returns an error if persistence was not
+// enabled with SetHolderNamePersistence.
+func (h HolderName) Value() (driver.Value, error) {
+	if h.IsZero() {
+		return nil, nil
+	}
+	if !holderNamePersistence {
+		return nil, fault.New(
+			"cardholder name persistence is disabled",
+			fault.WithCode(fault.DomainViolation),
+		)
+	}
+	return h.name, nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a HolderName, with validation.
+func (h *HolderName) Scan(src interface{}) error {
+	if src == nil {
+		*h = EmptyHolderName
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for HolderName",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	name, err := NewHolderName(s)
+	if err != nil {
+		return err
+	}
+	*h = name
+	return nil
+}
@@ -0,0 +1,127 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type HolderNameSuite struct {
+	suite.Suite
+}
+
+func TestHolderNameSuite(t *testing.T) {
+	suite.Run(t, new(HolderNameSuite))
+}
+
+func (s *HolderNameSuite) TearDownTest() {
+	wisp.SetHolderNamePersistence(false)
+}
+
+func (s *HolderNameSuite) TestNewHolderName() {
+	s.Run("should normalize names as printed on cards", func() {
+		testCases := []struct {
+			input    string
+			expected string
+		}{
+			{input: "José da Silva", expected: "JOSE DA SILVA"},
+			{input: "  maria   conceição  ", expected: "MARIA CONCEICAO"},
+			{input: "J. O'Neil-Smith", expected: "J. O'NEIL-SMITH"},
+		}
+		for _, tc := range testCases {
+			name, err := wisp.NewHolderName(tc.input)
+			s.Require().NoError(err, tc.input)
+			s.Equal(tc.expected, name.Reveal())
+		}
+	})
+
+	s.Run("should return EmptyHolderName for an empty input", func() {
+		name, err := wisp.NewHolderName("   ")
+		s.Require().NoError(err)
+		s.True(name.IsZero())
+	})
+
+	s.Run("should reject invalid names without exposing them", func() {
+		for _, input := range []string{"A", "JOSE 2", "J*** S****", strings.Repeat("A", 27)} {
+			_, err := wisp.NewHolderName(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+			s.NotContains(fmt.Sprint(err.(*fault.Error).Context), input)
+		}
+	})
+}
+
+func (s *HolderNameSuite) TestMasking() {
+	name, _ := wisp.NewHolderName("José da Silva")
+
+	s.Equal("J*** D* S****", name.Masked())
+	s.Equal("J*** D* S****", name.String())
+	s.Equal("J*** D* S****", fmt.Sprintf("%#v", name))
+	s.Equal(map[string]any{"holder": "J*** D* S****"}, wisp.Redact(map[string]any{"holder": name}))
+	s.Equal("", wisp.EmptyHolderName.Masked())
+}
+
+func (s *HolderNameSuite) TestJSON() {
+	s.Run("should marshal the masked name", func() {
+		name, _ := wisp.NewHolderName("José da Silva")
+		data, err := json.Marshal(name)
+		s.Require().NoError(err)
+		s.Equal(`"J*** D* S****"`, string(data))
+
+		data, err = json.Marshal(wisp.EmptyHolderName)
+		s.Require().NoError(err)
+		s.Equal("null", string(data))
+	})
+
+	s.Run("should unmarshal the full name", func() {
+		var name wisp.HolderName
+		s.Require().NoError(json.Unmarshal([]byte(`"jose da silva"`), &name))
+		s.Equal("JOSE DA SILVA", name.Reveal())
+
+		s.Require().NoError(json.Unmarshal([]byte("null"), &name))
+		s.True(name.IsZero())
+
+		s.Error(json.Unmarshal([]byte(`"J*** D* S****"`), &name))
+		s.Error(json.Unmarshal([]byte(`42`), &name))
+	})
+}
+
+func (s *HolderNameSuite) TestSQL() {
+	name, _ := wisp.NewHolderName("José da Silva")
+
+	s.Run("should refuse to store the name by default", func() {
+		_, err := name.Value()
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+
+	s.Run("should store the name after opting in", func() {
+		wisp.SetHolderNamePersistence(true)
+		value, err := name.Value()
+		s.Require().NoError(err)
+		s.Equal("JOSE DA SILVA", value)
+	})
+
+	s.Run("should store nil for the zero value", func() {
+		value, err := wisp.EmptyHolderName.Value()
+		s.Require().NoError(err)
+		s.Nil(value)
+	})
+
+	s.Run("should scan stored names", func() {
+		var scanned wisp.HolderName
+		s.Require().NoError(scanned.Scan([]byte("JOSE DA SILVA")))
+		s.True(name.Equals(scanned))
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		s.Error(scanned.Scan(42))
+	})
+}
@@ -0,0 +1,51 @@
+package wisp
+
+// HolidayCalendar reports whether a given date is a holiday.
+// It is the extension point used by business-day calculations such as DateRange.BusinessDays,
+// allowing applications to plug in national, regional or company-specific calendars.
+type HolidayCalendar interface {
+	IsHoliday(d Date) bool
+}
+
+// HolidaySet is a simple HolidayCalendar backed by a fixed set of dates.
+// It is suitable for calendars loaded from configuration or a database.
+//
+// Examples:
+//   christmas, _ := wisp.NewDate(2025, 12, 25)
+//   cal := wisp.NewHolidaySet(christmas)
+//   cal.IsHoliday(christmas) // true
+type HolidaySet struct {
+	dates map[int64]struct{}
+}
+
+// NewHolidaySet creates a HolidaySet containing the given dates. Zero dates are ignored.
+func NewHolidaySet(dates ...Date) HolidaySet {
+	set := HolidaySet{dates: make(map[int64]struct{}, len(dates))}
+	for _, d := range dates {
+		if d.IsZero() {
+			continue
+		}
+		set.dates[d.t.Unix()] = struct{}{}
+	}
+	return set
+}
+
+// IsHoliday implements HolidayCalendar.
+func (h HolidaySet) IsHoliday(d Date) bool {
+	_, ok := h.dates[d.t.Unix()]
+	return ok
+}
+
+// Len returns the number of holidays in the set.
+func (h HolidaySet) Len() int {
+	return len(h.dates)
+}
+
+// IsBusinessDay returns true if d is a weekday (Monday to Friday) and, when cal is not nil,
+// is not a holiday in the calendar.
+func IsBusinessDay(d Date, cal HolidayCalendar) bool {
+	if d.IsZero() || DayOfWeek(d.t.Weekday()).IsWeekend() {
+		return false
+	}
+	return cal == nil || !cal.IsHoliday(d)
+}
@@ -0,0 +1,197 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// HTTPStatusClass is the class of an HTTP status code, given by its first digit.
+type HTTPStatusClass int
+
+// Defines the HTTP status classes of RFC 9110.
+const (
+	HTTPStatusInformational HTTPStatusClass = 1 // 1xx
+	HTTPStatusSuccess       HTTPStatusClass = 2 // 2xx
+	HTTPStatusRedirection   HTTPStatusClass = 3 // 3xx
+	HTTPStatusClientError   HTTPStatusClass = 4 // 4xx
+	HTTPStatusServerError   HTTPStatusClass = 5 // 5xx
+)
+
+// String returns the class in the "2xx" notation, or an empty string for an unknown class.
+func (c HTTPStatusClass) String() string {
+	if c < HTTPStatusInformational || c > HTTPStatusServerError {
+		return ""
+	}
+	return strconv.Itoa(int(c)) + "xx"
+}
+
+// retryableHTTPStatuses are the status codes worth retrying: timeouts, rate limiting and
+// transient server failures.
+var retryableHTTPStatuses = map[HTTPStatusCode]struct{}{
+	http.StatusRequestTimeout:      {},
+	http.StatusTooEarly:            {},
+	http.StatusTooManyRequests:     {},
+	http.StatusInternalServerError: {},
+	http.StatusBadGateway:          {},
+	http.StatusServiceUnavailable:  {},
+	http.StatusGatewayTimeout:      {},
+}
+
+// HTTPStatusCode is a value object representing an HTTP response status code (100-599), such as
+// the result of a webhook delivery or an outbound integration call stored in an integration log.
+//
+// The zero value is ZeroHTTPStatusCode and means no response was received.
+//
+// Example:
+//   status, err := wisp.NewHTTPStatusCode(resp.StatusCode)
+//   if status.IsRetryable() { ... }
+type HTTPStatusCode int
+
+// ZeroHTTPStatusCode represents the zero value for HTTPStatusCode.
+var ZeroHTTPStatusCode HTTPStatusCode
+
+// NewHTTPStatusCode creates a new HTTPStatusCode.
+// It returns an error if the value is not between 100 and 599.
+func NewHTTPStatusCode(value int) (HTTPStatusCode, error) {
+	if value < 100 || value > 599 {
+		return ZeroHTTPStatusCode, fault.New(
+			"http status code must be between 100 and 599",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+		)
+	}
+	return HTTPStatusCode(value), nil
+}
+
+// Int returns the status code as an int.
+func (s HTTPStatusCode) Int() int {
+	return int(s)
+}
+
+// IsZero returns true if the HTTPStatusCode is the zero value.
+func (s HTTPStatusCode) IsZero() bool {
+	return s == ZeroHTTPStatusCode
+}
+
+// Class returns the class of the status code, or 0 for the zero value.
+func (s HTTPStatusCode) Class() HTTPStatusClass {
+	return HTTPStatusClass(s / 100)
+}
+
+// IsInformational returns true for 1xx status codes.
+func (s HTTPStatusCode) IsInformational() bool {
+	return s.Class() == HTTPStatusInformational
+}
+
+// IsSuccess returns true for 2xx status codes.
+func (s HTTPStatusCode) IsSuccess() bool {
+	return s.Class() == HTTPStatusSuccess
+}
+
+// IsRedirection returns true for 3xx status codes.
+func (s HTTPStatusCode) IsRedirection() bool {
+	return s.Class() == HTTPStatusRedirection
+}
+
+// IsClientError returns true for 4xx status codes.
+func (s HTTPStatusCode) IsClientError() bool {
+	return s.Class() == HTTPStatusClientError
+}
+
+// IsServerError returns true for 5xx status codes.
+func (s HTTPStatusCode) IsServerError() bool {
+	return s.Class() == HTTPStatusServerError
+}
+
+// IsError returns true for 4xx and 5xx status codes.
+func (s HTTPStatusCode) IsError() bool {
+	return s.IsClientError() || s.IsServerError()
+}
+
+// IsRetryable returns true if the request may succeed when repeated later: 408, 425, 429, 500,
+// 502, 503 and 504. Other errors, such as 400 or 501, will fail again.
+func (s HTTPStatusCode) IsRetryable() bool {
+	_, ok := retryableHTTPStatuses[s]
+	return ok
+}
+
+// Text returns the standard reason phrase (e.g., "Not Found"), or an empty string if unknown.
+func (s HTTPStatusCode) Text() string {
+	return http.StatusText(int(s))
+}
+
+// String returns the status code as a string (e.g., "404"), or an empty string for the zero value.
+func (s HTTPStatusCode) String() string {
+	if s.IsZero() {
+		return ""
+	}
+	return strconv.Itoa(int(s))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the HTTPStatusCode as a JSON number, or null for the zero value.
+func (s HTTPStatusCode) MarshalJSON() ([]byte, error) {
+	if s.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(int(s))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON number into an HTTPStatusCode, with validation; null results in ZeroHTTPStatusCode.
+func (s *HTTPStatusCode) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = ZeroHTTPStatusCode
+		return nil
+	}
+
+	var i int
+	if err := json.Unmarshal(data, &i); err != nil {
+		return fault.Wrap(err, "HTTPStatusCode must be a valid JSON number", fault.WithCode(fault.Invalid))
+	}
+
+	status, err := NewHTTPStatusCode(i)
+	if err != nil {
+		return err
+	}
+	*s = status
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the HTTPStatusCode as an int64, or nil for the zero value.
+func (s HTTPStatusCode) Value() (driver.Value, error) {
+	if s.IsZero() {
+		return nil, nil
+	}
+	return int64(s), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts an int64 from the database and converts it into an HTTPStatusCode, with validation.
+func (s *HTTPStatusCode) Scan(src interface{}) error {
+	if src == nil {
+		*s = ZeroHTTPStatusCode
+		return nil
+	}
+
+	var i int64
+	switch v := src.(type) {
+	case int64:
+		i = v
+	default:
+		return fault.New("unsupported scan type for HTTPStatusCode", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+
+	status, err := NewHTTPStatusCode(int(i))
+	if err != nil {
+		return err
+	}
+	*s = status
+	return nil
+}
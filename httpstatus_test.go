@@ -0,0 +1,134 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type HTTPStatusCodeSuite struct {
+	suite.Suite
+}
+
+func TestHTTPStatusCodeSuite(t *testing.T) {
+	suite.Run(t, new(HTTPStatusCodeSuite))
+}
+
+func (s *HTTPStatusCodeSuite) TestNewHTTPStatusCode() {
+	testCases := []struct {
+		name        string
+		input       int
+		expectError bool
+	}{
+		{name: "should create the lowest status code", input: 100},
+		{name: "should create a success status code", input: 200},
+		{name: "should create the highest status code", input: 599},
+		{name: "should fail for zero", input: 0, expectError: true},
+		{name: "should fail below the range", input: 99, expectError: true},
+		{name: "should fail above the range", input: 600, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			status, err := wisp.NewHTTPStatusCode(tc.input)
+			if tc.expectError {
+				s.Require().Error(err)
+				s.Equal(fault.Invalid, err.(*fault.Error).Code)
+				s.True(status.IsZero())
+			} else {
+				s.Require().NoError(err)
+				s.Equal(tc.input, status.Int())
+			}
+		})
+	}
+}
+
+func (s *HTTPStatusCodeSuite) TestClasses() {
+	testCases := []struct {
+		code      wisp.HTTPStatusCode
+		class     wisp.HTTPStatusClass
+		success   bool
+		error     bool
+		retryable bool
+	}{
+		{code: 101, class: wisp.HTTPStatusInformational},
+		{code: 204, class: wisp.HTTPStatusSuccess, success: true},
+		{code: 301, class: wisp.HTTPStatusRedirection},
+		{code: 400, class: wisp.HTTPStatusClientError, error: true},
+		{code: 408, class: wisp.HTTPStatusClientError, error: true, retryable: true},
+		{code: 429, class: wisp.HTTPStatusClientError, error: true, retryable: true},
+		{code: 500, class: wisp.HTTPStatusServerError, error: true, retryable: true},
+		{code: 501, class: wisp.HTTPStatusServerError, error: true},
+		{code: 503, class: wisp.HTTPStatusServerError, error: true, retryable: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.code.String(), func() {
+			s.Equal(tc.class, tc.code.Class())
+			s.Equal(tc.success, tc.code.IsSuccess())
+			s.Equal(tc.error, tc.code.IsError())
+			s.Equal(tc.retryable, tc.code.IsRetryable())
+		})
+	}
+
+	s.True(wisp.HTTPStatusCode(100).IsInformational())
+	s.True(wisp.HTTPStatusCode(302).IsRedirection())
+	s.True(wisp.HTTPStatusCode(404).IsClientError())
+	s.False(wisp.HTTPStatusCode(404).IsServerError())
+	s.True(wisp.HTTPStatusCode(502).IsServerError())
+
+	s.Equal("2xx", wisp.HTTPStatusSuccess.String())
+	s.Equal("", wisp.HTTPStatusClass(0).String())
+	s.Equal(wisp.HTTPStatusClass(0), wisp.ZeroHTTPStatusCode.Class())
+	s.False(wisp.ZeroHTTPStatusCode.IsError())
+}
+
+func (s *HTTPStatusCodeSuite) TestText() {
+	s.Equal("Not Found", wisp.HTTPStatusCode(404).Text())
+	s.Equal("", wisp.HTTPStatusCode(599).Text())
+	s.Equal("404", wisp.HTTPStatusCode(404).String())
+	s.Equal("", wisp.ZeroHTTPStatusCode.String())
+}
+
+func (s *HTTPStatusCodeSuite) TestJSON_SQL() {
+	status, _ := wisp.NewHTTPStatusCode(201)
+
+	s.Run("JSON", func() {
+		data, err := json.Marshal(status)
+		s.Require().NoError(err)
+		s.Equal(`201`, string(data))
+
+		data, err = json.Marshal(wisp.ZeroHTTPStatusCode)
+		s.Require().NoError(err)
+		s.Equal(`null`, string(data))
+
+		var decoded wisp.HTTPStatusCode
+		s.Require().NoError(json.Unmarshal([]byte(`201`), &decoded))
+		s.Equal(status, decoded)
+		s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+		s.True(decoded.IsZero())
+		s.Error(json.Unmarshal([]byte(`700`), &decoded))
+		s.Error(json.Unmarshal([]byte(`"201"`), &decoded))
+	})
+
+	s.Run("SQL", func() {
+		v, err := status.Value()
+		s.Require().NoError(err)
+		s.Equal(int64(201), v)
+		v, err = wisp.ZeroHTTPStatusCode.Value()
+		s.Require().NoError(err)
+		s.Nil(v)
+
+		var scanned wisp.HTTPStatusCode
+		s.Require().NoError(scanned.Scan(int64(503)))
+		s.Equal(wisp.HTTPStatusCode(503), scanned)
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+		s.Error(scanned.Scan(int64(42)))
+		s.Error(scanned.Scan("201"))
+	})
+}
@@ -0,0 +1,245 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// ibanLengths holds the IBAN length of each country in the IBAN registry (ISO 13616).
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BR": 29,
+	"BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DK": 18, "DO": 28, "EE": 20, "EG": 29,
+	"ES": 24, "FI": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27, "GT": 28,
+	"HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27, "JO": 30, "KW": 30, "KZ": 20,
+	"LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25, "MC": 27, "MD": 24, "ME": 22,
+	"MK": 19, "MR": 27, "MT": 31, "MU": 30, "NL": 18, "NO": 15, "PK": 24, "PL": 28, "PS": 29, "PT": 25,
+	"QA": 29, "RO": 24, "RS": 22, "SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27,
+	"ST": 25, "SV": 28, "TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20,
+}
+
+// RegisterIBANCountry adds a country to the IBAN length table, or changes the length of one, for
+// countries that join the IBAN registry after this release.
+// Returns an error if the country is not two letters or the length is not between 15 and 34.
+func RegisterIBANCountry(country string, length int) error {
+	code := strings.ToUpper(strings.TrimSpace(country))
+	if len(code) != 2 || !isUpperLetter(code[0]) || !isUpperLetter(code[1]) {
+		return fault.New(
+			"IBAN country must be a two-letter code",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("country", country),
+		)
+	}
+	if length < 15 || length > 34 {
+		return fault.New(
+			"IBAN length must be between 15 and 34",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("length", length),
+		)
+	}
+	ibanLengths[code] = length
+	return nil
+}
+
+// IBAN is a value object representing an International Bank Account Number (ISO 13616), used to
+// receive international transfers. It is stored in the electronic format, uppercase and without
+// spaces, after validating the country, the length for that country and the mod-97 check digits.
+//
+// The zero value is EmptyIBAN.
+//
+// Example:
+//   iban, err := wisp.NewIBAN("de89 3704 0044 0532 0130 00")
+//   iban.String()      // "DE89370400440532013000"
+//   iban.Formatted()   // "DE89 3704 0044 0532 0130 00"
+//   iban.CountryCode() // "DE"
+type IBAN string
+
+// EmptyIBAN represents the zero value for the IBAN type.
+var EmptyIBAN IBAN
+
+// NewIBAN creates a new IBAN, ignoring spaces and case.
+// Returns EmptyIBAN for an empty input, or an error if the IBAN has invalid characters, an unknown
+// country, the wrong length for its country or invalid check digits.
+func NewIBAN(input string) (IBAN, error) {
+	normalized := strings.ToUpper(strings.Join(strings.Fields(input), ""))
+	if normalized == "" {
+		return EmptyIBAN, nil
+	}
+
+	for i := 0; i < len(normalized); i++ {
+		c := normalized[i]
+		valid := isDigit(c) || isUpperLetter(c)
+		if i < 2 {
+			valid = isUpperLetter(c)
+		} else if i < 4 {
+			valid = isDigit(c)
+		}
+		if !valid {
+			return EmptyIBAN, fault.New(
+				"IBAN must be a country code, two check digits and letters or digits",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("input_value", input),
+			)
+		}
+	}
+	if len(normalized) < 4 {
+		return EmptyIBAN, fault.New("IBAN is too short", fault.WithCode(fault.Invalid), fault.WithContext("input_value", input))
+	}
+
+	country := normalized[:2]
+	length, ok := ibanLengths[country]
+	if !ok {
+		return EmptyIBAN, fault.New(
+			"IBAN country is not supported",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("country", country),
+		)
+	}
+	if len(normalized) != length {
+		return EmptyIBAN, fault.New(
+			"IBAN has the wrong length for its country",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("country", country),
+			fault.WithContext("length", len(normalized)),
+			fault.WithContext("expected_length", length),
+		)
+	}
+
+	if ibanMod97(normalized[4:]+normalized[:4]) != 1 {
+		return EmptyIBAN, fault.New(
+			"IBAN has invalid check digits",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	return IBAN(normalized), nil
+}
+
+// ibanMod97 computes the remainder of the number obtained by replacing letters with 10 to 35.
+func ibanMod97(s string) int {
+	r := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isDigit(c) {
+			r = (r*10 + int(c-'0')) % 97
+		} else {
+			r = (r*100 + int(c-'A') + 10) % 97
+		}
+	}
+	return r
+}
+
+// isUpperLetter reports whether c is an ASCII uppercase letter.
+func isUpperLetter(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+// CountryCode returns the ISO 3166 country code of the IBAN (e.g., "DE").
+func (i IBAN) CountryCode() string {
+	if i.IsZero() {
+		return ""
+	}
+	return string(i[:2])
+}
+
+// CheckDigits returns the two check digits of the IBAN.
+func (i IBAN) CheckDigits() string {
+	if i.IsZero() {
+		return ""
+	}
+	return string(i[2:4])
+}
+
+// BBAN returns the Basic Bank Account Number, the national part of the IBAN after the check digits.
+func (i IBAN) BBAN() string {
+	if i.IsZero() {
+		return ""
+	}
+	return string(i[4:])
+}
+
+// String returns the IBAN in the electronic format, without spaces.
+func (i IBAN) String() string {
+	return string(i)
+}
+
+// IsZero returns true if the IBAN is the zero value.
+func (i IBAN) IsZero() bool {
+	return i == EmptyIBAN
+}
+
+// Formatted returns the IBAN in the print format, in groups of 4 characters separated by spaces.
+func (i IBAN) Formatted() string {
+	var b strings.Builder
+	b.Grow(len(i) + len(i)/4)
+	for n := 0; n < len(i); n++ {
+		if n > 0 && n%4 == 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteByte(i[n])
+	}
+	return b.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the IBAN as a JSON string in the electronic format.
+func (i IBAN) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into an IBAN, with validation.
+func (i *IBAN) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "IBAN must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	iban, err := NewIBAN(s)
+	if err != nil {
+		return err
+	}
+	*i = iban
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the IBAN as a string in the electronic format, or nil for the zero value.
+func (i IBAN) Value() (driver.Value, error) {
+	if i.IsZero() {
+		return nil, nil
+	}
+	return i.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into an IBAN, with validation.
+func (i *IBAN) Scan(src interface{}) error {
+	if src == nil {
+		*i = EmptyIBAN
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for IBAN",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	iban, err := NewIBAN(s)
+	if err != nil {
+		return err
+	}
+	*i = iban
+	return nil
+}
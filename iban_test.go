@@ -0,0 +1,113 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type IBANSuite struct {
+	suite.Suite
+}
+
+func TestIBANSuite(t *testing.T) {
+	suite.Run(t, new(IBANSuite))
+}
+
+func (s *IBANSuite) TestNewIBAN() {
+	s.Run("should accept valid IBANs", func() {
+		testCases := []struct {
+			input    string
+			expected wisp.IBAN
+		}{
+			{input: "DE89370400440532013000", expected: "DE89370400440532013000"},
+			{input: "de89 3704 0044 0532 0130 00", expected: "DE89370400440532013000"},
+			{input: "GB82 WEST 1234 5698 7654 32", expected: "GB82WEST12345698765432"},
+			{input: "FR14 2004 1010 0505 0001 3M02 606", expected: "FR1420041010050500013M02606"},
+			{input: "BR18 0036 0305 0000 1000 9795 493C 1", expected: "BR1800360305000010009795493C1"},
+			{input: "NO9386011117947", expected: "NO9386011117947"},
+		}
+		for _, tc := range testCases {
+			iban, err := wisp.NewIBAN(tc.input)
+			s.Require().NoError(err, tc.input)
+			s.Equal(tc.expected, iban)
+		}
+	})
+
+	s.Run("should return the zero value for an empty input", func() {
+		iban, err := wisp.NewIBAN("  ")
+		s.Require().NoError(err)
+		s.True(iban.IsZero())
+	})
+
+	s.Run("should reject invalid IBANs", func() {
+		testCases := []struct {
+			name  string
+			input string
+		}{
+			{name: "wrong check digits", input: "DE88370400440532013000"},
+			{name: "wrong length", input: "DE8937040044053201300"},
+			{name: "unknown country", input: "US64SVBKUS6S3300958879"},
+			{name: "digits in country", input: "1E89370400440532013000"},
+			{name: "letters in check digits", input: "DEAB370400440532013000"},
+			{name: "symbols", input: "DE89-3704-0044-0532-0130-00"},
+			{name: "too short", input: "DE8"},
+		}
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				iban, err := wisp.NewIBAN(tc.input)
+				s.Require().Error(err)
+				s.True(iban.IsZero())
+				s.Equal(fault.Invalid, err.(*fault.Error).Code)
+			})
+		}
+	})
+}
+
+func (s *IBANSuite) TestRegisterIBANCountry() {
+	s.Require().NoError(wisp.RegisterIBANCountry("zz", 18))
+	s.Error(wisp.RegisterIBANCountry("Z1", 18))
+	s.Error(wisp.RegisterIBANCountry("ZY", 40))
+}
+
+func (s *IBANSuite) TestParts() {
+	iban, _ := wisp.NewIBAN("GB82WEST12345698765432")
+	s.Equal("GB", iban.CountryCode())
+	s.Equal("82", iban.CheckDigits())
+	s.Equal("WEST12345698765432", iban.BBAN())
+	s.Equal("GB82 WEST 1234 5698 7654 32", iban.Formatted())
+
+	s.Equal("", wisp.EmptyIBAN.CountryCode())
+	s.Equal("", wisp.EmptyIBAN.BBAN())
+	s.Equal("", wisp.EmptyIBAN.Formatted())
+}
+
+func (s *IBANSuite) TestJSON_SQL() {
+	iban, _ := wisp.NewIBAN("DE89370400440532013000")
+
+	data, err := json.Marshal(iban)
+	s.Require().NoError(err)
+	s.Equal(`"DE89370400440532013000"`, string(data))
+
+	var decoded wisp.IBAN
+	s.Require().NoError(json.Unmarshal([]byte(`"DE89 3704 0044 0532 0130 00"`), &decoded))
+	s.Equal(iban, decoded)
+	s.Error(json.Unmarshal([]byte(`"DE00"`), &decoded))
+
+	v, err := iban.Value()
+	s.Require().NoError(err)
+	s.Equal("DE89370400440532013000", v)
+	v, _ = wisp.EmptyIBAN.Value()
+	s.Nil(v)
+
+	var scanned wisp.IBAN
+	s.Require().NoError(scanned.Scan([]byte("DE89370400440532013000")))
+	s.Equal(iban, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Error(scanned.Scan(1))
+}
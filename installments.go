@@ -0,0 +1,209 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// InstallmentLimit holds the installment rules of a payment method.
+type InstallmentLimit struct {
+	// Max is the maximum number of installments accepted.
+	Max int
+	// InterestFree is the maximum number of installments offered without interest ("sem juros").
+	InterestFree int
+}
+
+// installmentLimits holds the global installment rules by payment method.
+var installmentLimits = make(map[PaymentMethod]InstallmentLimit)
+
+// RegisterInstallmentLimit sets the installment rules of a payment method, replacing any previous rules.
+// Max must be at least 1; InterestFree is clamped to the [1, Max] range.
+// This function should be called at application startup.
+//
+// Example:
+//   wisp.RegisterInstallmentLimit(wisp.PaymentMethodCreditCard, wisp.InstallmentLimit{Max: 12, InterestFree: 3})
+func RegisterInstallmentLimit(method PaymentMethod, limit InstallmentLimit) {
+	if method.IsZero() || limit.Max < 1 {
+		return
+	}
+	if limit.InterestFree < 1 {
+		limit.InterestFree = 1
+	}
+	if limit.InterestFree > limit.Max {
+		limit.InterestFree = limit.Max
+	}
+	installmentLimits[method] = limit
+}
+
+// ClearInstallmentLimits removes all registered installment rules.
+// This is primarily for testing purposes to ensure a clean state.
+func ClearInstallmentLimits() {
+	installmentLimits = make(map[PaymentMethod]InstallmentLimit)
+}
+
+// InstallmentLimitFor returns the installment rules registered for a payment method.
+// The second return value is false if no rules were registered.
+func InstallmentLimitFor(method PaymentMethod) (InstallmentLimit, bool) {
+	limit, ok := installmentLimits[method]
+	return limit, ok
+}
+
+// Installments is a value object representing the number of installments a payment is split into.
+// A single installment means a payment in full ("à vista").
+//
+// Counts above one are only accepted for payment methods that support installments and have
+// their limits registered with RegisterInstallmentLimit.
+//
+// Example:
+//   wisp.RegisterInstallmentLimit(wisp.PaymentMethodCreditCard, wisp.InstallmentLimit{Max: 12, InterestFree: 3})
+//   n, err := wisp.NewInstallments(3, wisp.PaymentMethodCreditCard)
+//   n.Label(wisp.PaymentMethodCreditCard) // "3x sem juros"
+type Installments int
+
+// SingleInstallment represents a payment in full.
+const SingleInstallment Installments = 1
+
+// NewInstallments creates a new Installments count for the given payment method.
+// It returns an error if the count is less than 1, or if it is greater than 1 and the method
+// does not support installments or the count exceeds the registered maximum.
+func NewInstallments(count int, method PaymentMethod) (Installments, error) {
+	if count < 1 {
+		return 0, fault.New(
+			"installments must be at least 1",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("count", count),
+		)
+	}
+	if count == 1 {
+		return SingleInstallment, nil
+	}
+
+	if !method.SupportsInstallments() {
+		return 0, fault.New(
+			"payment method does not support installments",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("payment_method", method.String()),
+			fault.WithContext("count", count),
+		)
+	}
+
+	limit, ok := InstallmentLimitFor(method)
+	if !ok || count > limit.Max {
+		return 0, fault.New(
+			"installments exceed the maximum allowed for the payment method",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("payment_method", method.String()),
+			fault.WithContext("count", count),
+			fault.WithContext("max", limit.Max),
+		)
+	}
+
+	return Installments(count), nil
+}
+
+// Int returns the number of installments.
+func (i Installments) Int() int {
+	return int(i)
+}
+
+// IsSingle returns true if the payment is made in full.
+func (i Installments) IsSingle() bool {
+	return i == SingleInstallment
+}
+
+// IsZero returns true if the Installments is the zero value.
+func (i Installments) IsZero() bool {
+	return i == 0
+}
+
+// IsInterestFree checks if the count is within the interest-free limit of the payment method.
+// A single installment is always interest free.
+func (i Installments) IsInterestFree(method PaymentMethod) bool {
+	if i <= SingleInstallment {
+		return true
+	}
+	limit, ok := InstallmentLimitFor(method)
+	return ok && i.Int() <= limit.InterestFree
+}
+
+// Label returns the checkout label for the installments under the payment method:
+// "à vista" for a single installment, otherwise "Nx sem juros" or "Nx com juros".
+func (i Installments) Label(method PaymentMethod) string {
+	if i <= SingleInstallment {
+		return "à vista"
+	}
+	if i.IsInterestFree(method) {
+		return fmt.Sprintf("%dx sem juros", i.Int())
+	}
+	return fmt.Sprintf("%dx com juros", i.Int())
+}
+
+// String returns the number of installments in "Nx" format (e.g., "3x").
+func (i Installments) String() string {
+	return fmt.Sprintf("%dx", i.Int())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the count as a JSON number, or null for the zero value.
+func (i Installments) MarshalJSON() ([]byte, error) {
+	if i.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(i.Int())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON number into Installments. The payment method is not known at this point,
+// so only the lower bound is checked; use NewInstallments to validate against method limits.
+// null results in the zero value.
+func (i *Installments) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*i = 0
+		return nil
+	}
+
+	var n int
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fault.Wrap(err, "Installments must be a valid JSON integer", fault.WithCode(fault.Invalid))
+	}
+	if n < 1 {
+		return fault.New("installments must be at least 1", fault.WithCode(fault.Invalid), fault.WithContext("count", n))
+	}
+	*i = Installments(n)
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the count as an int64 or nil if it's the zero value.
+func (i Installments) Value() (driver.Value, error) {
+	if i.IsZero() {
+		return nil, nil
+	}
+	return int64(i), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts an int64 from the database; like UnmarshalJSON, only the lower bound is checked.
+func (i *Installments) Scan(src interface{}) error {
+	if src == nil {
+		*i = 0
+		return nil
+	}
+
+	var n int64
+	switch v := src.(type) {
+	case int64:
+		n = v
+	default:
+		return fault.New("unsupported scan type for Installments", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+
+	if n < 1 {
+		return fault.New("installments from database must be at least 1", fault.WithCode(fault.Invalid), fault.WithContext("source_value", n))
+	}
+	*i = Installments(n)
+	return nil
+}
@@ -0,0 +1,116 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type InstallmentsSuite struct {
+	suite.Suite
+}
+
+func TestInstallmentsSuite(t *testing.T) {
+	suite.Run(t, new(InstallmentsSuite))
+}
+
+func (s *InstallmentsSuite) SetupTest() {
+	wisp.ResetPaymentMethods()
+	wisp.ClearInstallmentLimits()
+	wisp.RegisterInstallmentLimit(wisp.PaymentMethodCreditCard, wisp.InstallmentLimit{Max: 12, InterestFree: 3})
+}
+
+func (s *InstallmentsSuite) TestRegisterInstallmentLimit() {
+	wisp.RegisterInstallmentLimit(wisp.PaymentMethodBoleto, wisp.InstallmentLimit{Max: 4, InterestFree: 10})
+	limit, ok := wisp.InstallmentLimitFor(wisp.PaymentMethodBoleto)
+	s.True(ok)
+	s.Equal(wisp.InstallmentLimit{Max: 4, InterestFree: 4}, limit)
+
+	wisp.RegisterInstallmentLimit(wisp.PaymentMethodPix, wisp.InstallmentLimit{Max: 0})
+	_, ok = wisp.InstallmentLimitFor(wisp.PaymentMethodPix)
+	s.False(ok)
+}
+
+func (s *InstallmentsSuite) TestNewInstallments() {
+	testCases := []struct {
+		name      string
+		count     int
+		method    wisp.PaymentMethod
+		errorCode fault.Code
+	}{
+		{name: "single installment for any method", count: 1, method: wisp.PaymentMethodPix},
+		{name: "within credit card limit", count: 12, method: wisp.PaymentMethodCreditCard},
+		{name: "zero", count: 0, method: wisp.PaymentMethodCreditCard, errorCode: fault.Invalid},
+		{name: "above credit card limit", count: 13, method: wisp.PaymentMethodCreditCard, errorCode: fault.DomainViolation},
+		{name: "method without installments", count: 2, method: wisp.PaymentMethodPix, errorCode: fault.DomainViolation},
+		{name: "method without registered limit", count: 2, method: wisp.PaymentMethodBoleto, errorCode: fault.DomainViolation},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			n, err := wisp.NewInstallments(tc.count, tc.method)
+			if tc.errorCode != "" {
+				s.Require().Error(err)
+				faultErr, ok := err.(*fault.Error)
+				s.Require().True(ok)
+				s.Equal(tc.errorCode, faultErr.Code)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.count, n.Int())
+		})
+	}
+}
+
+func (s *InstallmentsSuite) TestLabels() {
+	card := wisp.PaymentMethodCreditCard
+
+	single, _ := wisp.NewInstallments(1, card)
+	three, _ := wisp.NewInstallments(3, card)
+	four, _ := wisp.NewInstallments(4, card)
+
+	s.True(single.IsSingle())
+	s.Equal("à vista", single.Label(card))
+	s.True(three.IsInterestFree(card))
+	s.Equal("3x sem juros", three.Label(card))
+	s.False(four.IsInterestFree(card))
+	s.Equal("4x com juros", four.Label(card))
+	s.Equal("4x", four.String())
+}
+
+func (s *InstallmentsSuite) TestSerialization() {
+	n, _ := wisp.NewInstallments(6, wisp.PaymentMethodCreditCard)
+
+	data, err := json.Marshal(n)
+	s.Require().NoError(err)
+	s.Equal(`6`, string(data))
+
+	var decoded wisp.Installments
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(n, decoded)
+	s.Require().Error(json.Unmarshal([]byte(`0`), &decoded))
+	s.Require().Error(json.Unmarshal([]byte(`"6"`), &decoded))
+
+	var zero wisp.Installments
+	data, err = json.Marshal(zero)
+	s.Require().NoError(err)
+	s.Equal(`null`, string(data))
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(decoded.IsZero())
+
+	val, err := n.Value()
+	s.Require().NoError(err)
+	s.Equal(int64(6), val)
+
+	var scanned wisp.Installments
+	s.Require().NoError(scanned.Scan(int64(6)))
+	s.Equal(n, scanned)
+	s.Require().Error(scanned.Scan(int64(0)))
+	s.Require().Error(scanned.Scan("6"))
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+}
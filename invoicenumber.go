@@ -0,0 +1,316 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// InvoiceKind identifies the Brazilian fiscal document an InvoiceNumber belongs to.
+type InvoiceKind string
+
+const (
+	// InvoiceKindNFe is the "Nota Fiscal Eletrônica" (goods), with numeric series 0–999
+	// and numbers from 1 to 999.999.999.
+	InvoiceKindNFe InvoiceKind = "nfe"
+	// InvoiceKindNFSe is the "Nota Fiscal de Serviços Eletrônica" (services), with alphanumeric
+	// series of up to 5 characters and numbers of up to 15 digits.
+	InvoiceKindNFSe InvoiceKind = "nfse"
+)
+
+const (
+	maxNFeSeries  = 999
+	maxNFeNumber  = 999999999
+	maxNFSeNumber = 999999999999999
+)
+
+var nfseSeriesRegex = regexp.MustCompile(`^[A-Z0-9]{1,5}$`)
+
+// InvoiceGapValidator decides whether a gap between two invoice numbers of the same series is acceptable.
+// It is called by InvoiceNumber.ValidateFollows only when next is not exactly previous + 1,
+// and should return nil to accept the gap (e.g., the skipped numbers were formally voided, "inutilizados").
+type InvoiceGapValidator func(previous, next InvoiceNumber) error
+
+// invoiceGapValidator is the global hook used by ValidateFollows. When nil, gaps are rejected.
+var invoiceGapValidator InvoiceGapValidator
+
+// SetInvoiceGapValidator configures the global hook used to accept or reject gaps in invoice numbering.
+// Passing nil restores the default behavior of rejecting every gap.
+func SetInvoiceGapValidator(validator InvoiceGapValidator) {
+	invoiceGapValidator = validator
+}
+
+// InvoiceNumber is a value object representing the series and number of a Brazilian fiscal
+// document (NF-e or NFS-e), validated against the ranges allowed by each document.
+//
+// Example:
+//   n, _ := wisp.NewNFeNumber(1, 123)
+//   n.String()          // "001-000000123"
+//   n.FormattedNumber() // "000.000.123"
+//   next, _ := wisp.NewNFeNumber(1, 124)
+//   err := next.ValidateFollows(n) // nil: no gap
+type InvoiceNumber struct {
+	kind   InvoiceKind
+	series string
+	number int64
+}
+
+// ZeroInvoiceNumber represents the zero value for InvoiceNumber.
+var ZeroInvoiceNumber = InvoiceNumber{}
+
+// NewNFeNumber creates a new NF-e InvoiceNumber.
+// The series must be between 0 and 999 and the number between 1 and 999.999.999.
+func NewNFeNumber(series int, number int64) (InvoiceNumber, error) {
+	if series < 0 || series > maxNFeSeries {
+		return ZeroInvoiceNumber, fault.New(
+			"NF-e series must be between 0 and 999",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("series", series),
+		)
+	}
+	if number < 1 || number > maxNFeNumber {
+		return ZeroInvoiceNumber, fault.New(
+			"NF-e number must be between 1 and 999999999",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("number", number),
+		)
+	}
+	return InvoiceNumber{kind: InvoiceKindNFe, series: strconv.Itoa(series), number: number}, nil
+}
+
+// NewNFSeNumber creates a new NFS-e InvoiceNumber.
+// The series is normalized to uppercase and must have 1 to 5 alphanumeric characters;
+// the number must be between 1 and 999.999.999.999.999.
+func NewNFSeNumber(series string, number int64) (InvoiceNumber, error) {
+	series = strings.ToUpper(strings.TrimSpace(series))
+	if !nfseSeriesRegex.MatchString(series) {
+		return ZeroInvoiceNumber, fault.New(
+			"NFS-e series must have 1 to 5 alphanumeric characters",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("series", series),
+		)
+	}
+	if number < 1 || number > maxNFSeNumber {
+		return ZeroInvoiceNumber, fault.New(
+			"NFS-e number must be between 1 and 999999999999999",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("number", number),
+		)
+	}
+	return InvoiceNumber{kind: InvoiceKindNFSe, series: series, number: number}, nil
+}
+
+// newInvoiceNumber dispatches to the constructor of the given kind.
+func newInvoiceNumber(kind InvoiceKind, series string, number int64) (InvoiceNumber, error) {
+	switch kind {
+	case InvoiceKindNFe:
+		s, err := strconv.Atoi(strings.TrimSpace(series))
+		if err != nil {
+			return ZeroInvoiceNumber, fault.Wrap(err, "NF-e series must be numeric",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("series", series),
+			)
+		}
+		return NewNFeNumber(s, number)
+	case InvoiceKindNFSe:
+		return NewNFSeNumber(series, number)
+	default:
+		return ZeroInvoiceNumber, fault.New(
+			"invalid invoice kind",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("kind", string(kind)),
+		)
+	}
+}
+
+// Kind returns the fiscal document kind.
+func (n InvoiceNumber) Kind() InvoiceKind {
+	return n.kind
+}
+
+// Series returns the series, without padding (e.g., "1" for NF-e series 001).
+func (n InvoiceNumber) Series() string {
+	return n.series
+}
+
+// Number returns the invoice number.
+func (n InvoiceNumber) Number() int64 {
+	return n.number
+}
+
+// IsZero returns true if the InvoiceNumber is the zero value.
+func (n InvoiceNumber) IsZero() bool {
+	return n == ZeroInvoiceNumber
+}
+
+// SameSeries checks if both numbers belong to the same kind and series.
+func (n InvoiceNumber) SameSeries(other InvoiceNumber) bool {
+	return n.kind == other.kind && n.series == other.series
+}
+
+// Compare compares two invoice numbers by kind, series and number, returning -1, 0 or +1.
+func (n InvoiceNumber) Compare(other InvoiceNumber) int {
+	if c := strings.Compare(string(n.kind), string(other.kind)); c != 0 {
+		return c
+	}
+	if c := strings.Compare(n.paddedSeries(), other.paddedSeries()); c != 0 {
+		return c
+	}
+	switch {
+	case n.number < other.number:
+		return -1
+	case n.number > other.number:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ValidateFollows checks that n is the next number after previous in the same series.
+// It returns a fault.DomainViolation error if the series differ or n does not come after previous.
+// If there is a gap, the hook configured with SetInvoiceGapValidator decides; without a hook the gap is rejected.
+func (n InvoiceNumber) ValidateFollows(previous InvoiceNumber) error {
+	if !n.SameSeries(previous) {
+		return fault.New(
+			"invoice numbers belong to different series",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("previous", previous.String()),
+			fault.WithContext("next", n.String()),
+		)
+	}
+	if n.number <= previous.number {
+		return fault.New(
+			"invoice number must be greater than the previous one",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("previous", previous.String()),
+			fault.WithContext("next", n.String()),
+		)
+	}
+	if n.number == previous.number+1 {
+		return nil
+	}
+
+	if invoiceGapValidator != nil {
+		return invoiceGapValidator(previous, n)
+	}
+	return fault.New(
+		"gap in invoice numbering",
+		fault.WithCode(fault.DomainViolation),
+		fault.WithContext("previous", previous.String()),
+		fault.WithContext("next", n.String()),
+		fault.WithContext("missing", n.number-previous.number-1),
+	)
+}
+
+// paddedSeries returns the series as printed on the document: NF-e series are zero-padded to 3 digits.
+func (n InvoiceNumber) paddedSeries() string {
+	if n.kind == InvoiceKindNFe {
+		s, _ := strconv.Atoi(n.series)
+		return fmt.Sprintf("%03d", s)
+	}
+	return n.series
+}
+
+// FormattedNumber returns the number as printed on the document.
+// NF-e numbers are zero-padded to 9 digits and grouped by dots (e.g., "000.000.123");
+// NFS-e numbers are returned without padding.
+func (n InvoiceNumber) FormattedNumber() string {
+	if n.kind != InvoiceKindNFe {
+		return strconv.FormatInt(n.number, 10)
+	}
+	s := fmt.Sprintf("%09d", n.number)
+	return s[0:3] + "." + s[3:6] + "." + s[6:9]
+}
+
+// String returns the invoice number in "SERIES-NUMBER" format.
+// NF-e numbers are zero-padded (e.g., "001-000000123"), NFS-e numbers are not (e.g., "A1-123").
+func (n InvoiceNumber) String() string {
+	if n.IsZero() {
+		return ""
+	}
+	if n.kind == InvoiceKindNFe {
+		return fmt.Sprintf("%s-%09d", n.paddedSeries(), n.number)
+	}
+	return fmt.Sprintf("%s-%d", n.series, n.number)
+}
+
+// invoiceNumberJSON is the JSON representation of an InvoiceNumber.
+type invoiceNumberJSON struct {
+	Kind   InvoiceKind `json:"kind"`
+	Series string      `json:"series"`
+	Number int64       `json:"number"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the InvoiceNumber to a JSON object with "kind", "series" and "number" fields.
+func (n InvoiceNumber) MarshalJSON() ([]byte, error) {
+	if n.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(invoiceNumberJSON{Kind: n.kind, Series: n.series, Number: n.number})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object into an InvoiceNumber, with validation.
+func (n *InvoiceNumber) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = ZeroInvoiceNumber
+		return nil
+	}
+
+	var dto invoiceNumberJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for InvoiceNumber", fault.WithCode(fault.Invalid))
+	}
+
+	parsed, err := newInvoiceNumber(dto.Kind, dto.Series, dto.Number)
+	if err != nil {
+		return err
+	}
+	*n = parsed
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the InvoiceNumber as a JSON string or nil if it's the zero value.
+func (n InvoiceNumber) Value() (driver.Value, error) {
+	if n.IsZero() {
+		return nil, nil
+	}
+
+	data, err := n.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err, "failed to marshal invoice number for database storage", fault.WithCode(fault.Internal))
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as an InvoiceNumber.
+func (n *InvoiceNumber) Scan(src interface{}) error {
+	if src == nil {
+		*n = ZeroInvoiceNumber
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for InvoiceNumber",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return n.UnmarshalJSON(data)
+}
@@ -0,0 +1,145 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type InvoiceNumberSuite struct {
+	suite.Suite
+}
+
+func TestInvoiceNumberSuite(t *testing.T) {
+	suite.Run(t, new(InvoiceNumberSuite))
+}
+
+func (s *InvoiceNumberSuite) TearDownTest() {
+	wisp.SetInvoiceGapValidator(nil)
+}
+
+func (s *InvoiceNumberSuite) TestNewNFeNumber() {
+	n, err := wisp.NewNFeNumber(1, 123)
+	s.Require().NoError(err)
+	s.Equal(wisp.InvoiceKindNFe, n.Kind())
+	s.Equal("1", n.Series())
+	s.Equal(int64(123), n.Number())
+	s.Equal("001-000000123", n.String())
+	s.Equal("000.000.123", n.FormattedNumber())
+
+	_, err = wisp.NewNFeNumber(0, 999999999)
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		series int
+		number int64
+	}{{-1, 1}, {1000, 1}, {1, 0}, {1, 1000000000}} {
+		_, err := wisp.NewNFeNumber(tc.series, tc.number)
+		s.Require().Error(err, "%+v", tc)
+	}
+}
+
+func (s *InvoiceNumberSuite) TestNewNFSeNumber() {
+	n, err := wisp.NewNFSeNumber(" a1 ", 123)
+	s.Require().NoError(err)
+	s.Equal(wisp.InvoiceKindNFSe, n.Kind())
+	s.Equal("A1", n.Series())
+	s.Equal("A1-123", n.String())
+	s.Equal("123", n.FormattedNumber())
+
+	_, err = wisp.NewNFSeNumber("ABCDEF", 1)
+	s.Require().Error(err)
+	_, err = wisp.NewNFSeNumber("A-1", 1)
+	s.Require().Error(err)
+	_, err = wisp.NewNFSeNumber("A1", 1000000000000000)
+	s.Require().Error(err)
+}
+
+func (s *InvoiceNumberSuite) TestCompare() {
+	a, _ := wisp.NewNFeNumber(1, 10)
+	b, _ := wisp.NewNFeNumber(1, 11)
+	c, _ := wisp.NewNFeNumber(2, 1)
+	d, _ := wisp.NewNFeNumber(10, 1)
+
+	s.Equal(-1, a.Compare(b))
+	s.Equal(1, b.Compare(a))
+	s.Equal(0, a.Compare(a))
+	s.Equal(-1, b.Compare(c))
+	s.Equal(-1, c.Compare(d), "series must compare numerically")
+	s.True(a.SameSeries(b))
+	s.False(a.SameSeries(c))
+}
+
+func (s *InvoiceNumberSuite) TestValidateFollows() {
+	prev, _ := wisp.NewNFeNumber(1, 10)
+	next, _ := wisp.NewNFeNumber(1, 11)
+	gap, _ := wisp.NewNFeNumber(1, 15)
+	other, _ := wisp.NewNFeNumber(2, 11)
+
+	s.Require().NoError(next.ValidateFollows(prev))
+
+	for _, tc := range []struct {
+		name string
+		n    wisp.InvoiceNumber
+	}{{"gap", gap}, {"same number", prev}, {"different series", other}} {
+		s.Run(tc.name, func() {
+			err := tc.n.ValidateFollows(prev)
+			s.Require().Error(err)
+			faultErr, ok := err.(*fault.Error)
+			s.Require().True(ok)
+			s.Equal(fault.DomainViolation, faultErr.Code)
+		})
+	}
+
+	s.Run("hook can accept voided ranges", func() {
+		var called bool
+		wisp.SetInvoiceGapValidator(func(previous, next wisp.InvoiceNumber) error {
+			called = true
+			s.Equal(prev, previous)
+			s.Equal(gap, next)
+			return nil
+		})
+		s.Require().NoError(gap.ValidateFollows(prev))
+		s.True(called)
+
+		s.Require().Error(prev.ValidateFollows(gap), "hook is only consulted for gaps")
+	})
+}
+
+func (s *InvoiceNumberSuite) TestSerialization() {
+	nfe, _ := wisp.NewNFeNumber(1, 123)
+	nfse, _ := wisp.NewNFSeNumber("A1", 9)
+
+	for _, n := range []wisp.InvoiceNumber{nfe, nfse} {
+		data, err := json.Marshal(n)
+		s.Require().NoError(err)
+
+		var decoded wisp.InvoiceNumber
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(n, decoded)
+
+		val, err := n.Value()
+		s.Require().NoError(err)
+		var scanned wisp.InvoiceNumber
+		s.Require().NoError(scanned.Scan(val))
+		s.Equal(n, scanned)
+	}
+
+	data, _ := json.Marshal(nfe)
+	s.JSONEq(`{"kind":"nfe","series":"1","number":123}`, string(data))
+
+	var decoded wisp.InvoiceNumber
+	s.Require().Error(json.Unmarshal([]byte(`{"kind":"cte","series":"1","number":1}`), &decoded))
+	s.Require().Error(json.Unmarshal([]byte(`{"kind":"nfe","series":"X","number":1}`), &decoded))
+	s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.True(decoded.IsZero())
+
+	var scanned wisp.InvoiceNumber
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Require().Error(scanned.Scan(1))
+}
@@ -0,0 +1,87 @@
+package wisp
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"sync"
+)
+
+// maxPooledJSONBufferSize caps the capacity of buffers returned to the pool,
+// so an occasional large payload does not pin memory forever.
+const maxPooledJSONBufferSize = 4096
+
+// jsonBuffer is a reusable byte buffer for MarshalJSON implementations that write JSON objects by hand.
+type jsonBuffer struct {
+	b []byte
+}
+
+// jsonBufferPool recycles jsonBuffers across MarshalJSON calls to reduce GC pressure in high-RPS JSON APIs.
+var jsonBufferPool = sync.Pool{
+	New: func() any {
+		return &jsonBuffer{b: make([]byte, 0, 128)}
+	},
+}
+
+// getJSONBuffer returns an empty buffer from the pool.
+func getJSONBuffer() *jsonBuffer {
+	buf := jsonBufferPool.Get().(*jsonBuffer)
+	buf.b = buf.b[:0]
+	return buf
+}
+
+// putJSONBuffer returns a buffer to the pool, dropping it if it grew too large.
+func putJSONBuffer(buf *jsonBuffer) {
+	if cap(buf.b) > maxPooledJSONBufferSize {
+		return
+	}
+	jsonBufferPool.Put(buf)
+}
+
+// bytes returns a copy of the buffer contents that is safe to hand to the caller
+// after the buffer goes back to the pool.
+func (buf *jsonBuffer) bytes() []byte {
+	out := make([]byte, len(buf.b))
+	copy(out, buf.b)
+	return out
+}
+
+// appendJSONString appends s as a JSON string, producing the same output as encoding/json.
+// Plain ASCII strings (the common case for codes and dates) are written directly;
+// anything that needs escaping goes through encoding/json.
+func appendJSONString(dst []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < 0x20 || c >= 0x80 || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+			quoted, _ := json.Marshal(s)
+			return append(dst, quoted...)
+		}
+	}
+	dst = append(dst, '"')
+	dst = append(dst, s...)
+	return append(dst, '"')
+}
+
+// appendJSONFloat appends f using the same formatting rules as encoding/json.
+// It returns an error for NaN and infinite values, which have no JSON representation.
+func appendJSONFloat(dst []byte, f float64) ([]byte, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return dst, &json.UnsupportedValueError{Str: strconv.FormatFloat(f, 'g', -1, 64)}
+	}
+
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	dst = strconv.AppendFloat(dst, f, format, -1, 64)
+
+	if format == 'e' {
+		// Clean up e-09 to e-9, as encoding/json does.
+		n := len(dst)
+		if n >= 4 && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+			dst[n-2] = dst[n-1]
+			dst = dst[:n-1]
+		}
+	}
+	return dst, nil
+}
@@ -0,0 +1,116 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	wisp "github.com/marcelofabianov/wisp"
+)
+
+type JSONBufferSuite struct {
+	suite.Suite
+}
+
+func TestJSONBufferSuite(t *testing.T) {
+	suite.Run(t, new(JSONBufferSuite))
+}
+
+// valueUnitDTO mirrors the DTO previously used by Length and Weight, to check byte-for-byte parity.
+type valueUnitDTO struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+func (s *JSONBufferSuite) TestPooledMarshal_MatchesEncodingJSON() {
+	s.Run("Length", func() {
+		for _, v := range []float64{0, 0.000001, 1.5, 3.14159, 1234567.891, 9e15} {
+			l, err := wisp.NewLength(v, wisp.Meter)
+			s.Require().NoError(err)
+			meters, _ := l.In(wisp.Meter)
+
+			expected, err := json.Marshal(valueUnitDTO{Value: meters, Unit: "m"})
+			s.Require().NoError(err)
+
+			data, err := json.Marshal(l)
+			s.Require().NoError(err)
+			s.Equal(string(expected), string(data))
+		}
+	})
+
+	s.Run("Weight", func() {
+		for _, v := range []float64{0, 0.001, 2.5, 72.125, 1e9} {
+			w, err := wisp.NewWeight(v, wisp.Kilogram)
+			s.Require().NoError(err)
+			kg, _ := w.In(wisp.Kilogram)
+
+			expected, err := json.Marshal(valueUnitDTO{Value: kg, Unit: "kg"})
+			s.Require().NoError(err)
+
+			data, err := json.Marshal(w)
+			s.Require().NoError(err)
+			s.Equal(string(expected), string(data))
+		}
+	})
+
+	s.Run("Money", func() {
+		m, _ := wisp.NewMoney(-12345, wisp.EUR)
+		data, err := json.Marshal(m)
+		s.Require().NoError(err)
+		s.Equal(`{"amount":-12345,"currency":"EUR"}`, string(data))
+	})
+
+	s.Run("Discount", func() {
+		price, _ := wisp.NewMoney(500, wisp.BRL)
+		fixed, _ := wisp.NewFixedDiscount(price)
+		data, err := json.Marshal(fixed)
+		s.Require().NoError(err)
+		s.Equal(`{"type":"fixed","value":{"amount":500,"currency":"BRL"}}`, string(data))
+
+		p, _ := wisp.NewPercentageFromFloat(0.125)
+		percent, _ := wisp.NewPercentageDiscount(p)
+		data, err = json.Marshal(percent)
+		s.Require().NoError(err)
+		s.Equal(`{"type":"percentage","value":0.125}`, string(data))
+	})
+
+	s.Run("DateRange", func() {
+		start, _ := wisp.NewDate(2025, time.January, 1)
+		end, _ := wisp.NewDate(2025, time.January, 31)
+		dr, _ := wisp.NewDateRange(start, end)
+		data, err := json.Marshal(dr)
+		s.Require().NoError(err)
+		s.Equal(`{"start":"2025-01-01","end":"2025-01-31"}`, string(data))
+	})
+
+	s.Run("returned slices are not shared between calls", func() {
+		a, _ := wisp.NewMoney(1, wisp.BRL)
+		b, _ := wisp.NewMoney(2, wisp.USD)
+		first, _ := a.MarshalJSON()
+		_, _ = b.MarshalJSON()
+		s.Equal(`{"amount":1,"currency":"BRL"}`, string(first))
+	})
+}
+
+func BenchmarkMoney_MarshalJSON(b *testing.B) {
+	m, _ := wisp.NewMoney(123456, wisp.BRL)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.MarshalJSON(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDiscount_MarshalJSON(b *testing.B) {
+	price, _ := wisp.NewMoney(500, wisp.BRL)
+	d, _ := wisp.NewFixedDiscount(price)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := d.MarshalJSON(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -18,9 +18,9 @@ import (
 type Latitude float64
 
 // NewLatitude creates a new Latitude.
-// It returns an error if the value is outside the valid range of -90 to +90.
+// It returns an error if the value is outside the valid range of -90 to +90, or is NaN.
 func NewLatitude(value float64) (Latitude, error) {
-	if value < -90.0 || value > 90.0 {
+	if !(value >= -90.0 && value <= 90.0) {
 		return 0, fault.New(
 			"latitude must be between -90 and 90",
 			fault.WithCode(fault.Invalid),
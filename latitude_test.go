@@ -2,6 +2,7 @@ package wisp_test
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -29,6 +30,7 @@ func (s *LatitudeSuite) TestNewLatitude() {
 		{name: "should create a valid latitude at upper bound", input: 90.0},
 		{name: "should fail for latitude below lower bound", input: -90.1, expectError: true},
 		{name: "should fail for latitude above upper bound", input: 90.1, expectError: true},
+		{name: "should fail for NaN", input: math.NaN(), expectError: true},
 	}
 
 	for _, tc := range testCases {
@@ -131,13 +131,19 @@ func (l Length) String() string {
 // It serializes the Length to a JSON object with its value in meters.
 func (l Length) MarshalJSON() ([]byte, error) {
 	m, _ := l.In(Meter)
-	return json.Marshal(&struct {
-		Value float64    `json:"value"`
-		Unit  LengthUnit `json:"unit"`
-	}{
-		Value: m,
-		Unit:  Meter,
-	})
+
+	buf := getJSONBuffer()
+	defer putJSONBuffer(buf)
+
+	var err error
+	buf.b = append(buf.b, `{"value":`...)
+	if buf.b, err = appendJSONFloat(buf.b, m); err != nil {
+		return nil, err
+	}
+	buf.b = append(buf.b, `,"unit":`...)
+	buf.b = appendJSONString(buf.b, string(Meter))
+	buf.b = append(buf.b, '}')
+	return buf.bytes(), nil
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
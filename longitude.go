@@ -18,9 +18,9 @@ import (
 type Longitude float64
 
 // NewLongitude creates a new Longitude.
-// It returns an error if the value is outside the valid range of -180 to +180.
+// It returns an error if the value is outside the valid range of -180 to +180, or is NaN.
 func NewLongitude(value float64) (Longitude, error) {
-	if value < -180.0 || value > 180.0 {
+	if !(value >= -180.0 && value <= 180.0) {
 		return 0, fault.New(
 			"longitude must be between -180 and 180",
 			fault.WithCode(fault.Invalid),
@@ -2,6 +2,7 @@ package wisp_test
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -29,6 +30,7 @@ func (s *LongitudeSuite) TestNewLongitude() {
 		{name: "should create a valid longitude at upper bound", input: 180.0},
 		{name: "should fail for longitude below lower bound", input: -180.1, expectError: true},
 		{name: "should fail for longitude above upper bound", input: 180.1, expectError: true},
+		{name: "should fail for NaN", input: math.NaN(), expectError: true},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,374 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// defaultLotNumberPattern follows the GS1 batch/lot Application Identifier (10):
+// up to 20 uppercase alphanumeric characters, with '-', '/' or '.' allowed after the first one.
+const defaultLotNumberPattern = `^[A-Z0-9][A-Z0-9./-]{0,19}$`
+
+// lotNumberDateGroup is the name of the regular expression group holding the manufacturing date.
+const lotNumberDateGroup = "date"
+
+// lotNumberFormat is the global format used to validate lot numbers.
+var lotNumberFormat = struct {
+	pattern    *regexp.Regexp
+	dateLayout string
+}{pattern: regexp.MustCompile(defaultLotNumberPattern)}
+
+// SetLotNumberFormat configures the global format used to validate lot numbers.
+// The pattern is a regular expression matched against the normalized (uppercase) lot number.
+// When dateLayout is not empty, the pattern must contain a named group "date" whose match
+// is parsed with that time layout to obtain the manufacturing date (see LotNumber.ManufacturingDate).
+//
+// Example:
+//   // "L250131-0042": manufactured on 2025-01-31, sequence 0042
+//   err := wisp.SetLotNumberFormat(`^L(?P<date>\d{6})-\d{4}$`, "060102")
+func SetLotNumberFormat(pattern, dateLayout string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return fault.Wrap(err, "invalid lot number pattern", fault.WithCode(fault.Invalid), fault.WithContext("pattern", pattern))
+	}
+
+	if dateLayout != "" && re.SubexpIndex(lotNumberDateGroup) < 0 {
+		return fault.New(
+			"lot number pattern must have a named group 'date' when a date layout is given",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("pattern", pattern),
+		)
+	}
+
+	lotNumberFormat.pattern = re
+	lotNumberFormat.dateLayout = dateLayout
+	return nil
+}
+
+// ResetLotNumberFormat restores the default GS1-compatible lot number format.
+// This is primarily for testing purposes to ensure a clean state.
+func ResetLotNumberFormat() {
+	lotNumberFormat.pattern = regexp.MustCompile(defaultLotNumberPattern)
+	lotNumberFormat.dateLayout = ""
+}
+
+// LotNumber is a value object representing a production lot or batch number, used for
+// traceability in pharma and food supply chains. It is normalized to uppercase and validated
+// against the global format configured with SetLotNumberFormat.
+//
+// Example:
+//   lot, err := wisp.NewLotNumber("ab-1234")      // "AB-1234"
+//   batch, err := lot.WithExpiry(expiresAt)        // pairs the lot with its expiry
+type LotNumber string
+
+// EmptyLotNumber represents the zero value for LotNumber.
+var EmptyLotNumber LotNumber
+
+// NewLotNumber creates a new LotNumber, normalizing it to uppercase and validating its format.
+// When the configured format embeds a manufacturing date, the date must be a valid calendar date.
+// Returns EmptyLotNumber for an empty input.
+func NewLotNumber(value string) (LotNumber, error) {
+	normalized := LotNumber(strings.ToUpper(strings.TrimSpace(value)))
+	if normalized == EmptyLotNumber {
+		return EmptyLotNumber, nil
+	}
+
+	if !lotNumberFormat.pattern.MatchString(string(normalized)) {
+		return EmptyLotNumber, fault.New(
+			"lot number does not match the configured format",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+			fault.WithContext("pattern", lotNumberFormat.pattern.String()),
+		)
+	}
+
+	if lotNumberFormat.dateLayout != "" {
+		if _, ok := normalized.ManufacturingDate(); !ok {
+			return EmptyLotNumber, fault.New(
+				"lot number has an invalid manufacturing date",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("input_value", value),
+				fault.WithContext("date_layout", lotNumberFormat.dateLayout),
+			)
+		}
+	}
+
+	return normalized, nil
+}
+
+// String returns the lot number as a string.
+func (l LotNumber) String() string {
+	return string(l)
+}
+
+// IsZero returns true if the LotNumber is the zero value.
+func (l LotNumber) IsZero() bool {
+	return l == EmptyLotNumber
+}
+
+// ManufacturingDate extracts the manufacturing date embedded in the lot number, using the format
+// currently configured with SetLotNumberFormat (a Lot keeps the date it was created with).
+// The second return value is false if the configured format has no date or the date cannot be parsed.
+func (l LotNumber) ManufacturingDate() (Date, bool) {
+	if lotNumberFormat.dateLayout == "" {
+		return ZeroDate, false
+	}
+
+	match := lotNumberFormat.pattern.FindStringSubmatch(string(l))
+	idx := lotNumberFormat.pattern.SubexpIndex(lotNumberDateGroup)
+	if match == nil || idx < 0 || idx >= len(match) {
+		return ZeroDate, false
+	}
+
+	t, err := time.Parse(lotNumberFormat.dateLayout, match[idx])
+	if err != nil {
+		return ZeroDate, false
+	}
+	return Date{t: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}, true
+}
+
+// WithExpiry pairs the lot number with its expiry.
+// Returns an error if the expiry is not set or, when the manufacturing date is known, is not after it.
+func (l LotNumber) WithExpiry(expiresAt ExpiresAt) (Lot, error) {
+	return NewLot(l, expiresAt)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (l LotNumber) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a LotNumber, with validation.
+func (l *LotNumber) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "LotNumber must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	lot, err := NewLotNumber(s)
+	if err != nil {
+		return err
+	}
+	*l = lot
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the lot number as a string or nil if it's the zero value.
+func (l LotNumber) Value() (driver.Value, error) {
+	if l.IsZero() {
+		return nil, nil
+	}
+	return l.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values and validates them as a LotNumber.
+func (l *LotNumber) Scan(src interface{}) error {
+	if src == nil {
+		*l = EmptyLotNumber
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for LotNumber",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	lot, err := NewLotNumber(s)
+	if err != nil {
+		return err
+	}
+	*l = lot
+	return nil
+}
+
+// Lot is a value object pairing a LotNumber with its expiry, the unit of traceability
+// printed on pharma and food packaging.
+//
+// Example:
+//   number, _ := wisp.NewLotNumber("AB-1234")
+//   lot, _ := wisp.NewLot(number, wisp.NewExpiresAt(expiry))
+//   lot.IsExpiredAt(time.Now())
+type Lot struct {
+	number       LotNumber
+	expiresAt    ExpiresAt
+	manufactured Date
+}
+
+// ZeroLot represents the zero value for Lot.
+var ZeroLot = Lot{}
+
+// NewLot creates a new Lot from a lot number and its expiry. The manufacturing date embedded in
+// the lot number is extracted once here, so the Lot keeps it if the lot number format changes.
+// Returns an error if the number or expiry is missing or, when the lot number embeds a
+// manufacturing date, the expiry is not after it.
+func NewLot(number LotNumber, expiresAt ExpiresAt) (Lot, error) {
+	manufactured, _ := number.ManufacturingDate()
+	return newLot(number, expiresAt, manufactured)
+}
+
+// newLot validates and builds a Lot with a known manufacturing date, which may be ZeroDate.
+func newLot(number LotNumber, expiresAt ExpiresAt, manufactured Date) (Lot, error) {
+	if number.IsZero() {
+		return ZeroLot, fault.New("lot number is required", fault.WithCode(fault.Invalid))
+	}
+	if expiresAt.IsZero() {
+		return ZeroLot, fault.New(
+			"lot expiry is required",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("lot_number", number.String()),
+		)
+	}
+
+	if !manufactured.IsZero() && !expiresAt.Time().After(manufactured.t) {
+		return ZeroLot, fault.New(
+			"lot expiry must be after its manufacturing date",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("lot_number", number.String()),
+			fault.WithContext("manufacturing_date", manufactured.String()),
+			fault.WithContext("expires_at", expiresAt.RFC3339()),
+		)
+	}
+
+	return Lot{number: number, expiresAt: expiresAt, manufactured: manufactured}, nil
+}
+
+// Number returns the lot number.
+func (l Lot) Number() LotNumber {
+	return l.number
+}
+
+// ExpiresAt returns the lot expiry.
+func (l Lot) ExpiresAt() ExpiresAt {
+	return l.expiresAt
+}
+
+// ManufacturingDate returns the manufacturing date embedded in the lot number when the lot was
+// created, if any.
+func (l Lot) ManufacturingDate() (Date, bool) {
+	return l.manufactured, !l.manufactured.IsZero()
+}
+
+// IsExpired checks if the lot has expired.
+func (l Lot) IsExpired() bool {
+	return l.expiresAt.IsExpired()
+}
+
+// IsExpiredAt checks if the lot is expired at the given time.
+func (l Lot) IsExpiredAt(t time.Time) bool {
+	return l.expiresAt.IsExpiredAt(t)
+}
+
+// IsZero returns true if the Lot is the zero value.
+func (l Lot) IsZero() bool {
+	return l == ZeroLot
+}
+
+// String returns the lot in "number (exp. YYYY-MM-DD)" format.
+func (l Lot) String() string {
+	if l.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%s (exp. %s)", l.number, l.expiresAt.Time().Format(iso8601DateFormat))
+}
+
+// lotJSON is the JSON representation of a Lot.
+type lotJSON struct {
+	Number            LotNumber `json:"number"`
+	ExpiresAt         ExpiresAt `json:"expires_at"`
+	ManufacturingDate Date      `json:"manufacturing_date"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the Lot to a JSON object with "number", "expires_at" and "manufacturing_date"
+// fields, or null for the zero value.
+func (l Lot) MarshalJSON() ([]byte, error) {
+	if l.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(lotJSON{Number: l.number, ExpiresAt: l.expiresAt, ManufacturingDate: l.manufactured})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object into a Lot, with validation; null results in ZeroLot.
+// Without a "manufacturing_date", the date is extracted from the lot number as NewLot does.
+func (l *Lot) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*l = ZeroLot
+		return nil
+	}
+
+	var dto lotJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for Lot", fault.WithCode(fault.Invalid))
+	}
+
+	var lot Lot
+	var err error
+	if dto.ManufacturingDate.IsZero() {
+		lot, err = NewLot(dto.Number, dto.ExpiresAt)
+	} else {
+		lot, err = newLot(dto.Number, dto.ExpiresAt, dto.ManufacturingDate)
+	}
+	if err != nil {
+		return err
+	}
+	*l = lot
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the Lot as a JSON string or nil if it's the zero value.
+func (l Lot) Value() (driver.Value, error) {
+	if l.IsZero() {
+		return nil, nil
+	}
+
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err, "failed to marshal lot for database storage", fault.WithCode(fault.Internal))
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as a Lot.
+func (l *Lot) Scan(src interface{}) error {
+	if src == nil {
+		*l = ZeroLot
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for Lot",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return l.UnmarshalJSON(data)
+}
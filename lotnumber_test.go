@@ -0,0 +1,195 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type LotNumberSuite struct {
+	suite.Suite
+}
+
+func TestLotNumberSuite(t *testing.T) {
+	suite.Run(t, new(LotNumberSuite))
+}
+
+func (s *LotNumberSuite) SetupTest() {
+	wisp.ResetLotNumberFormat()
+}
+
+func (s *LotNumberSuite) TearDownTest() {
+	wisp.ResetLotNumberFormat()
+}
+
+func (s *LotNumberSuite) TestNewLotNumber_DefaultFormat() {
+	testCases := []struct {
+		name        string
+		input       string
+		expected    wisp.LotNumber
+		expectError bool
+	}{
+		{name: "alphanumeric", input: "AB1234", expected: "AB1234"},
+		{name: "normalizes case", input: " ab-12/3.4 ", expected: "AB-12/3.4"},
+		{name: "max length", input: "12345678901234567890", expected: "12345678901234567890"},
+		{name: "too long", input: "123456789012345678901", expectError: true},
+		{name: "leading separator", input: "-AB12", expectError: true},
+		{name: "invalid characters", input: "AB 12", expectError: true},
+		{name: "empty", input: "  ", expected: wisp.EmptyLotNumber},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			lot, err := wisp.NewLotNumber(tc.input)
+			if tc.expectError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.expected, lot)
+
+			_, ok := lot.ManufacturingDate()
+			s.False(ok)
+		})
+	}
+}
+
+func (s *LotNumberSuite) TestSetLotNumberFormat() {
+	s.Run("should reject invalid patterns", func() {
+		s.Require().Error(wisp.SetLotNumberFormat(`^(`, ""))
+		s.Require().Error(wisp.SetLotNumberFormat(`^L\d{6}$`, "060102"))
+	})
+
+	s.Run("should extract the embedded manufacturing date", func() {
+		s.Require().NoError(wisp.SetLotNumberFormat(`^L(?P<date>\d{6})-\d{4}$`, "060102"))
+
+		lot, err := wisp.NewLotNumber("l250131-0042")
+		s.Require().NoError(err)
+		s.Equal(wisp.LotNumber("L250131-0042"), lot)
+
+		date, ok := lot.ManufacturingDate()
+		s.True(ok)
+		expected, _ := wisp.NewDate(2025, time.January, 31)
+		s.Equal(expected, date)
+	})
+
+	s.Run("should reject invalid embedded dates", func() {
+		s.Require().NoError(wisp.SetLotNumberFormat(`^L(?P<date>\d{6})-\d{4}$`, "060102"))
+
+		_, err := wisp.NewLotNumber("L251332-0001")
+		s.Require().Error(err)
+		_, err = wisp.NewLotNumber("AB1234")
+		s.Require().Error(err)
+	})
+}
+
+func (s *LotNumberSuite) TestLot() {
+	s.Require().NoError(wisp.SetLotNumberFormat(`^L(?P<date>\d{6})-\d{4}$`, "060102"))
+	number, _ := wisp.NewLotNumber("L250131-0042")
+
+	s.Run("should pair a lot number with its expiry", func() {
+		expiry := wisp.NewExpiresAt(time.Date(2026, time.January, 31, 23, 59, 59, 0, time.UTC))
+		lot, err := number.WithExpiry(expiry)
+		s.Require().NoError(err)
+		s.Equal(number, lot.Number())
+		s.Equal(expiry, lot.ExpiresAt())
+		s.False(lot.IsExpiredAt(time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)))
+		s.True(lot.IsExpiredAt(time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)))
+		s.Equal("L250131-0042 (exp. 2026-01-31)", lot.String())
+
+		manufactured, ok := lot.ManufacturingDate()
+		s.True(ok)
+		s.Equal(2025, manufactured.Year())
+	})
+
+	s.Run("should reject an expiry before manufacturing", func() {
+		_, err := wisp.NewLot(number, wisp.NewExpiresAt(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)))
+		s.Require().Error(err)
+		faultErr, ok := err.(*fault.Error)
+		s.Require().True(ok)
+		s.Equal(fault.DomainViolation, faultErr.Code)
+	})
+
+	s.Run("should keep the manufacturing date when the format changes", func() {
+		lot, err := number.WithExpiry(wisp.NewExpiresAt(time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)))
+		s.Require().NoError(err)
+		data, err := json.Marshal(lot)
+		s.Require().NoError(err)
+
+		s.Require().NoError(wisp.SetLotNumberFormat(`^L\d{2}(?P<date>\d{4})-\d{4}$`, "0102"))
+		manufactured, ok := lot.ManufacturingDate()
+		s.True(ok)
+		s.Equal("2025-01-31", manufactured.String())
+
+		var decoded wisp.Lot
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(lot, decoded)
+		s.Require().NoError(wisp.SetLotNumberFormat(`^L(?P<date>\d{6})-\d{4}$`, "060102"))
+	})
+
+	s.Run("should require number and expiry", func() {
+		_, err := wisp.NewLot(number, wisp.ZeroExpiresAt)
+		s.Require().Error(err)
+		_, err = wisp.NewLot(wisp.EmptyLotNumber, wisp.NewExpiresAtIn(time.Hour))
+		s.Require().Error(err)
+	})
+}
+
+func (s *LotNumberSuite) TestSerialization() {
+	number, _ := wisp.NewLotNumber("AB-1234")
+	lot, _ := wisp.NewLot(number, wisp.NewExpiresAt(time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC)))
+
+	s.Run("LotNumber", func() {
+		data, err := json.Marshal(number)
+		s.Require().NoError(err)
+		s.Equal(`"AB-1234"`, string(data))
+
+		var decoded wisp.LotNumber
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(number, decoded)
+		s.Require().Error(json.Unmarshal([]byte(`"A B"`), &decoded))
+
+		var scanned wisp.LotNumber
+		s.Require().NoError(scanned.Scan([]byte("ab-1234")))
+		s.Equal(number, scanned)
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+		s.Require().NoError(scanned.Scan(""))
+		s.True(scanned.IsZero())
+		s.Require().Error(scanned.Scan(12))
+	})
+
+	s.Run("Lot", func() {
+		data, err := json.Marshal(lot)
+		s.Require().NoError(err)
+		s.JSONEq(`{"number":"AB-1234","expires_at":"2026-03-31T00:00:00Z","manufacturing_date":null}`, string(data))
+
+		var decoded wisp.Lot
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(lot, decoded)
+		s.Require().Error(json.Unmarshal([]byte(`{"number":"AB-1234","expires_at":null}`), &decoded))
+
+		s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+		s.True(decoded.IsZero())
+		data, err = json.Marshal(wisp.ZeroLot)
+		s.Require().NoError(err)
+		s.Equal("null", string(data))
+
+		val, err := lot.Value()
+		s.Require().NoError(err)
+
+		var scanned wisp.Lot
+		s.Require().NoError(scanned.Scan(val))
+		s.Equal(lot, scanned)
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+		s.Require().NoError(scanned.Scan("null"))
+		s.True(scanned.IsZero())
+		s.Require().Error(scanned.Scan(1))
+	})
+}
@@ -0,0 +1,201 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// MaritalStatus represents the civil status of a person using the eSocial code table
+// (field "estCiv"): 1 single, 2 married, 3 divorced, 4 separated, 5 widowed.
+//
+// The zero value is MaritalStatusUnspecified, used when the information was not provided;
+// it is serialized as null in JSON and NULL in the database.
+//
+// Example:
+//   status, _ := wisp.ParseMaritalStatus("casado")
+//   status.Code()  // 2
+//   status.Label() // "Casado(a)"
+type MaritalStatus int
+
+const (
+	// MaritalStatusUnspecified means the information was not provided ("não informado").
+	MaritalStatusUnspecified MaritalStatus = 0
+	// MaritalStatusSingle is code 1 ("solteiro").
+	MaritalStatusSingle MaritalStatus = 1
+	// MaritalStatusMarried is code 2 ("casado").
+	MaritalStatusMarried MaritalStatus = 2
+	// MaritalStatusDivorced is code 3 ("divorciado").
+	MaritalStatusDivorced MaritalStatus = 3
+	// MaritalStatusSeparated is code 4 ("separado").
+	MaritalStatusSeparated MaritalStatus = 4
+	// MaritalStatusWidowed is code 5 ("viúvo").
+	MaritalStatusWidowed MaritalStatus = 5
+)
+
+// maritalStatusLabels maps each marital status code to its official label.
+var maritalStatusLabels = map[MaritalStatus]string{
+	MaritalStatusUnspecified: "Não informado",
+	MaritalStatusSingle:      "Solteiro(a)",
+	MaritalStatusMarried:     "Casado(a)",
+	MaritalStatusDivorced:    "Divorciado(a)",
+	MaritalStatusSeparated:   "Separado(a)",
+	MaritalStatusWidowed:     "Viúvo(a)",
+}
+
+// maritalStatusAliases maps accepted labels (lowercase) to their marital status.
+var maritalStatusAliases = map[string]MaritalStatus{
+	"": MaritalStatusUnspecified, "nao informado": MaritalStatusUnspecified, "não informado": MaritalStatusUnspecified,
+	"solteiro": MaritalStatusSingle, "solteira": MaritalStatusSingle, "solteiro(a)": MaritalStatusSingle, "single": MaritalStatusSingle,
+	"casado": MaritalStatusMarried, "casada": MaritalStatusMarried, "casado(a)": MaritalStatusMarried, "married": MaritalStatusMarried,
+	"divorciado": MaritalStatusDivorced, "divorciada": MaritalStatusDivorced, "divorciado(a)": MaritalStatusDivorced, "divorced": MaritalStatusDivorced,
+	"separado": MaritalStatusSeparated, "separada": MaritalStatusSeparated, "separado(a)": MaritalStatusSeparated, "separated": MaritalStatusSeparated,
+	"viúvo": MaritalStatusWidowed, "viúva": MaritalStatusWidowed, "viúvo(a)": MaritalStatusWidowed,
+	"viuvo": MaritalStatusWidowed, "viuva": MaritalStatusWidowed, "viuvo(a)": MaritalStatusWidowed, "widowed": MaritalStatusWidowed,
+}
+
+// NewMaritalStatus creates a new MaritalStatus from its eSocial code.
+// Code 0 returns MaritalStatusUnspecified. Returns an error for unknown codes.
+func NewMaritalStatus(code int) (MaritalStatus, error) {
+	status := MaritalStatus(code)
+	if !status.IsValid() {
+		return MaritalStatusUnspecified, fault.New(
+			"invalid marital status code",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_code", code),
+		)
+	}
+	return status, nil
+}
+
+// ParseMaritalStatus creates a new MaritalStatus from its code ("2") or label ("casada"), ignoring case.
+// Empty input returns MaritalStatusUnspecified.
+func ParseMaritalStatus(input string) (MaritalStatus, error) {
+	normalized := strings.ToLower(strings.TrimSpace(input))
+
+	if code, err := strconv.Atoi(normalized); err == nil {
+		return NewMaritalStatus(code)
+	}
+
+	status, ok := maritalStatusAliases[normalized]
+	if !ok {
+		return MaritalStatusUnspecified, fault.New(
+			"invalid marital status",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	return status, nil
+}
+
+// Code returns the eSocial code (0 when unspecified).
+func (m MaritalStatus) Code() int {
+	return int(m)
+}
+
+// Label returns the official label (e.g., "Casado(a)").
+func (m MaritalStatus) Label() string {
+	return maritalStatusLabels[m]
+}
+
+// String returns the official label.
+func (m MaritalStatus) String() string {
+	return m.Label()
+}
+
+// IsValid checks if the status is one of the known codes, including unspecified.
+func (m MaritalStatus) IsValid() bool {
+	_, ok := maritalStatusLabels[m]
+	return ok
+}
+
+// IsUnspecified returns true if the marital status was not provided.
+func (m MaritalStatus) IsUnspecified() bool {
+	return m == MaritalStatusUnspecified
+}
+
+// IsZero returns true if the MaritalStatus is the zero value (unspecified).
+func (m MaritalStatus) IsZero() bool {
+	return m == MaritalStatusUnspecified
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the eSocial code as a JSON number, or null if unspecified.
+func (m MaritalStatus) MarshalJSON() ([]byte, error) {
+	if m.IsUnspecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(m.Code())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts a JSON number (code), a JSON string (code or label) or null.
+func (m *MaritalStatus) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*m = MaritalStatusUnspecified
+		return nil
+	}
+
+	var code int
+	if err := json.Unmarshal(data, &code); err == nil {
+		status, err := NewMaritalStatus(code)
+		if err != nil {
+			return err
+		}
+		*m = status
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "MaritalStatus must be a JSON number, string or null", fault.WithCode(fault.Invalid))
+	}
+
+	status, err := ParseMaritalStatus(s)
+	if err != nil {
+		return err
+	}
+	*m = status
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the eSocial code as an int64 or nil if unspecified.
+func (m MaritalStatus) Value() (driver.Value, error) {
+	if m.IsUnspecified() {
+		return nil, nil
+	}
+	return int64(m.Code()), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts an int64 code, or a string or []byte code or label.
+func (m *MaritalStatus) Scan(src interface{}) error {
+	var (
+		status MaritalStatus
+		err    error
+	)
+
+	switch v := src.(type) {
+	case nil:
+		status = MaritalStatusUnspecified
+	case int64:
+		status, err = NewMaritalStatus(int(v))
+	case string:
+		status, err = ParseMaritalStatus(v)
+	case []byte:
+		status, err = ParseMaritalStatus(string(v))
+	default:
+		return fault.New("unsupported scan type for MaritalStatus", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+	if err != nil {
+		return err
+	}
+
+	*m = status
+	return nil
+}
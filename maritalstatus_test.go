@@ -0,0 +1,116 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type MaritalStatusSuite struct {
+	suite.Suite
+}
+
+func TestMaritalStatusSuite(t *testing.T) {
+	suite.Run(t, new(MaritalStatusSuite))
+}
+
+func (s *MaritalStatusSuite) TestNewMaritalStatus() {
+	status, err := wisp.NewMaritalStatus(5)
+	s.Require().NoError(err)
+	s.Equal(wisp.MaritalStatusWidowed, status)
+
+	status, err = wisp.NewMaritalStatus(0)
+	s.Require().NoError(err)
+	s.True(status.IsUnspecified())
+
+	_, err = wisp.NewMaritalStatus(6)
+	s.Error(err)
+}
+
+func (s *MaritalStatusSuite) TestParseMaritalStatus() {
+	testCases := []struct {
+		input       string
+		expected    wisp.MaritalStatus
+		expectError bool
+	}{
+		{input: "1", expected: wisp.MaritalStatusSingle},
+		{input: "Casada", expected: wisp.MaritalStatusMarried},
+		{input: "divorciado(a)", expected: wisp.MaritalStatusDivorced},
+		{input: "separado", expected: wisp.MaritalStatusSeparated},
+		{input: "Viúva", expected: wisp.MaritalStatusWidowed},
+		{input: "viuvo", expected: wisp.MaritalStatusWidowed},
+		{input: "", expected: wisp.MaritalStatusUnspecified},
+		{input: "9", expectError: true},
+		{input: "noivo", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.input, func() {
+			status, err := wisp.ParseMaritalStatus(tc.input)
+			if tc.expectError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.expected, status)
+		})
+	}
+}
+
+func (s *MaritalStatusSuite) TestCodeAndLabel() {
+	s.Equal(2, wisp.MaritalStatusMarried.Code())
+	s.Equal("Casado(a)", wisp.MaritalStatusMarried.Label())
+	s.Equal("Viúvo(a)", wisp.MaritalStatusWidowed.String())
+	s.Equal("Não informado", wisp.MaritalStatusUnspecified.Label())
+	s.False(wisp.MaritalStatus(7).IsValid())
+}
+
+func (s *MaritalStatusSuite) TestJSON() {
+	type person struct {
+		MaritalStatus wisp.MaritalStatus `json:"marital_status"`
+	}
+
+	data, err := json.Marshal(person{MaritalStatus: wisp.MaritalStatusSingle})
+	s.Require().NoError(err)
+	s.JSONEq(`{"marital_status":1}`, string(data))
+
+	data, err = json.Marshal(person{})
+	s.Require().NoError(err)
+	s.JSONEq(`{"marital_status":null}`, string(data))
+
+	var p person
+	s.Require().NoError(json.Unmarshal([]byte(`{"marital_status":3}`), &p))
+	s.Equal(wisp.MaritalStatusDivorced, p.MaritalStatus)
+
+	s.Require().NoError(json.Unmarshal([]byte(`{"marital_status":"casado"}`), &p))
+	s.Equal(wisp.MaritalStatusMarried, p.MaritalStatus)
+
+	s.Error(json.Unmarshal([]byte(`{"marital_status":8}`), &p))
+	s.Error(json.Unmarshal([]byte(`{"marital_status":true}`), &p))
+}
+
+func (s *MaritalStatusSuite) TestSQL() {
+	val, err := wisp.MaritalStatusMarried.Value()
+	s.Require().NoError(err)
+	s.Equal(int64(2), val)
+
+	val, err = wisp.MaritalStatusUnspecified.Value()
+	s.Require().NoError(err)
+	s.Nil(val)
+
+	var m wisp.MaritalStatus
+	s.Require().NoError(m.Scan(int64(4)))
+	s.Equal(wisp.MaritalStatusSeparated, m)
+
+	s.Require().NoError(m.Scan([]byte("1")))
+	s.Equal(wisp.MaritalStatusSingle, m)
+
+	s.Require().NoError(m.Scan(nil))
+	s.True(m.IsUnspecified())
+
+	s.Error(m.Scan(int64(10)))
+	s.Error(m.Scan(1.5))
+}
@@ -4,6 +4,9 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 
 	"github.com/marcelofabianov/fault"
 )
@@ -61,6 +64,99 @@ func NewMoney(amountInCents int64, currency Currency) (Money, error) {
 	}, nil
 }
 
+// NewMoneyFromFloat creates a new Money value from a decimal amount, converting it to the
+// smallest unit of the currency and rounding half away from zero.
+// Prefer NewMoneyFromDecimalString when the amount comes from text, to avoid floating-point errors.
+//
+// Returns an error if the currency is invalid or the amount is not finite or out of range.
+//
+// Examples:
+//   money, err := NewMoneyFromFloat(10.5, BRL)  // 1050 centavos
+//   money, err := NewMoneyFromFloat(1050, JPY)  // 1050 yen (JPY has no minor unit)
+func NewMoneyFromFloat(amount float64, currency Currency) (Money, error) {
+	if currency.IsZero() || !currency.IsValid() {
+		return NewMoney(0, currency)
+	}
+
+	scaled := math.Round(amount * float64(pow10Int64(currency.MinorUnits())))
+	if math.IsNaN(scaled) || scaled >= math.MaxInt64 || scaled < math.MinInt64 {
+		return ZeroMoney, fault.New(
+			"money amount is not finite or out of range",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_amount", amount),
+			fault.WithContext("currency", currency.String()),
+		)
+	}
+
+	return NewMoney(int64(scaled), currency)
+}
+
+// NewMoneyFromDecimalString creates a new Money value from a decimal string such as "10.50",
+// "-3" or "1.005", converting it exactly to the smallest unit of the currency.
+// The string may have an optional sign and a '.' decimal separator, and no more decimal places
+// than the currency's minor units.
+//
+// Returns an error if the currency is invalid, the string is malformed, has too many decimal
+// places, or the amount is out of range.
+//
+// Examples:
+//   money, err := NewMoneyFromDecimalString("10.50", BRL) // 1050 centavos
+//   money, err := NewMoneyFromDecimalString("1.005", BHD) // 1005 fils
+//   money, err := NewMoneyFromDecimalString("10.5", JPY)  // Error: JPY has no decimal places
+func NewMoneyFromDecimalString(value string, currency Currency) (Money, error) {
+	if currency.IsZero() || !currency.IsValid() {
+		return NewMoney(0, currency)
+	}
+
+	minorUnits := currency.MinorUnits()
+	invalid := func(message string) (Money, error) {
+		return ZeroMoney, fault.New(
+			message,
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+			fault.WithContext("currency", currency.String()),
+			fault.WithContext("minor_units", minorUnits),
+		)
+	}
+
+	s := strings.TrimSpace(value)
+	negative := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	integerPart, fractionPart, hasFraction := strings.Cut(s, ".")
+	if integerPart == "" || (hasFraction && fractionPart == "") || !isASCIIDigits(integerPart) || !isASCIIDigits(fractionPart) {
+		return invalid("invalid decimal format for money")
+	}
+	if len(fractionPart) > minorUnits {
+		return invalid("money amount has more decimal places than the currency allows")
+	}
+
+	digits := integerPart + fractionPart + strings.Repeat("0", minorUnits-len(fractionPart))
+	magnitude, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil || magnitude > math.MaxInt64+1 || (!negative && magnitude > math.MaxInt64) {
+		return invalid("money amount is out of range")
+	}
+
+	amount := int64(magnitude)
+	if negative {
+		amount = -amount
+	}
+	return NewMoney(amount, currency)
+}
+
+// isASCIIDigits returns true if s contains only the digits 0-9. An empty string is accepted.
+func isASCIIDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 // Amount returns the monetary amount in the smallest currency unit (e.g., cents).
 func (m Money) Amount() int64 {
 	return m.amount
@@ -152,6 +248,29 @@ func (m Money) Multiply(multiplier int64) Money {
 	}
 }
 
+// DivideRounded returns a new Money instance with the amount divided by n, rounding the result
+// to the smallest currency unit with the given mode.
+// Returns an error if n is zero or the rounding mode is unknown.
+//
+// Example:
+//   m, _ := NewMoney(1001, BRL)
+//   half, _ := m.DivideRounded(2, RoundHalfUp)   // BRL 5.01
+//   half, _ = m.DivideRounded(2, RoundHalfEven)  // BRL 5.00
+func (m Money) DivideRounded(n int64, mode RoundingMode) (Money, error) {
+	if n == 0 {
+		return ZeroMoney, fault.New(
+			"cannot divide money by zero",
+			fault.WithCode(fault.Invalid),
+		)
+	}
+
+	amount, err := mulDivRounded(m.amount, 1, n, mode)
+	if err != nil {
+		return ZeroMoney, err
+	}
+	return Money{amount: amount, currency: m.currency}, nil
+}
+
 // Split divides the Money into n parts, distributing any remainder.
 // This is useful for scenarios like splitting a bill among several people.
 // The remainder is distributed one by one to the first parts.
@@ -180,33 +299,186 @@ func (m Money) Split(n int) ([]Money, error) {
 	return parts, nil
 }
 
+// Allocate distributes the Money proportionally to the given ratios without losing any minor unit,
+// e.g. a 3:7 split of commissions. Each part receives its proportional share rounded toward zero,
+// and the remaining minor units are handed out one by one to the first parts with a non-zero ratio,
+// following Fowler's money allocation pattern.
+// Returns an error if no ratio is given, any ratio is negative, or all ratios are zero.
+//
+// Example:
+//   m, _ := NewMoney(5, BRL)
+//   parts, _ := m.Allocate(3, 7) // [BRL 0.02, BRL 0.03]
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	var total int64
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, fault.New(
+				"allocation ratios cannot be negative",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("ratios", ratios),
+			)
+		}
+		if total > math.MaxInt64-int64(ratio) {
+			return nil, fault.New(
+				"allocation ratios are too large",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("ratios", ratios),
+			)
+		}
+		total += int64(ratio)
+	}
+
+	if total == 0 {
+		return nil, fault.New(
+			"allocation requires at least one positive ratio",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("ratios", ratios),
+		)
+	}
+
+	parts := make([]Money, len(ratios))
+	remainder := m.amount
+	for i, ratio := range ratios {
+		share := prorateCumulative(m.amount, int64(ratio), total)
+		parts[i] = Money{amount: share, currency: m.currency}
+		remainder -= share
+	}
+
+	step := int64(1)
+	if remainder < 0 {
+		step = -1
+	}
+	for i := 0; remainder != 0; i++ {
+		if ratios[i] == 0 {
+			continue
+		}
+		parts[i].amount += step
+		remainder -= step
+	}
+
+	return parts, nil
+}
+
+// Prorate returns the share of total that corresponds to the days of used within full.
+// It is useful for subscription upgrades and downgrades in the middle of a billing cycle.
+//
+// Each day of full is mapped to a cumulative share of total, so prorating adjacent,
+// non-overlapping ranges that cover full yields amounts that add up exactly to total.
+// Returns an error if any range is zero or open-ended, or if used is not contained in full.
+//
+// Example:
+//   // R$ 300.00 for a 30-day cycle, 10 days used
+//   amount, err := wisp.Prorate(total, cycle, used) // BRL 100.00
+func Prorate(total Money, full, used DateRange) (Money, error) {
+	if full.IsZero() || used.IsZero() || full.IsOpenEnded() || used.IsOpenEnded() {
+		return ZeroMoney, fault.New(
+			"bounded date ranges are required to prorate money",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("full_range", full.String()),
+			fault.WithContext("used_range", used.String()),
+		)
+	}
+
+	if !full.Contains(used.start) || !full.Contains(used.end) {
+		return ZeroMoney, fault.New(
+			"used date range must be within the full date range",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("full_range", full.String()),
+			fault.WithContext("used_range", used.String()),
+		)
+	}
+
+	fullDays := int64(full.Days())
+	offset := int64(used.start.t.Sub(full.start.t).Hours() / 24)
+	usedDays := int64(used.Days())
+
+	share := prorateCumulative(total.amount, offset+usedDays, fullDays) -
+		prorateCumulative(total.amount, offset, fullDays)
+
+	return Money{amount: share, currency: total.currency}, nil
+}
+
+// prorateCumulative returns amount*days/totalDays truncated toward zero. The product is computed
+// exactly, and the result fits in an int64 since days never exceeds totalDays.
+func prorateCumulative(amount, days, totalDays int64) int64 {
+	mode := RoundFloor
+	if amount < 0 {
+		mode = RoundCeil
+	}
+	share, _ := mulDivRounded(amount, days, totalDays, mode)
+	return share
+}
+
 // IsNegative returns true if the monetary amount is negative.
 func (m Money) IsNegative() bool {
 	return m.amount < 0
 }
 
-// Float64 returns the monetary amount as a float64, converting from cents.
+// Float64 returns the monetary amount as a float64, converting from the currency's minor unit
+// (e.g., 1050 is 10.50 for BRL, 1050 for JPY and 1.050 for BHD).
 // Note: Use with caution, as floating-point arithmetic can lead to precision issues.
 // This is primarily for display or interoperability, not for financial calculations.
 func (m Money) Float64() float64 {
-	return float64(m.amount) / 100.0
+	return float64(m.amount) / float64(pow10Int64(m.currency.MinorUnits()))
 }
 
-// String returns a formatted string representation of the money, like "BRL 10.50".
+// String returns a formatted string representation of the money using the currency's
+// minor units, like "BRL 10.50", "JPY 1050" or "BHD 1.050".
 func (m Money) String() string {
-	return fmt.Sprintf("%s %.2f", m.currency, m.Float64())
+	return fmt.Sprintf("%s %s", m.currency, formatMinorUnits(m.amount, m.currency.MinorUnits()))
+}
+
+// pow10Int64 returns 10 raised to exp, for exp between 0 and 18.
+func pow10Int64(exp int) int64 {
+	result := int64(1)
+	for i := 0; i < exp; i++ {
+		result *= 10
+	}
+	return result
+}
+
+// formatMinorUnits formats an amount in minor units as an exact decimal string with the given
+// number of decimal places, without going through floating-point.
+func formatMinorUnits(amount int64, minorUnits int) string {
+	negative := amount < 0
+	magnitude := uint64(amount)
+	if negative {
+		magnitude = -magnitude
+	}
+
+	digits := strconv.FormatUint(magnitude, 10)
+	if minorUnits > 0 {
+		if len(digits) <= minorUnits {
+			digits = strings.Repeat("0", minorUnits-len(digits)+1) + digits
+		}
+		split := len(digits) - minorUnits
+		digits = digits[:split] + "." + digits[split:]
+	}
+
+	if negative {
+		return "-" + digits
+	}
+	return digits
 }
 
 // MarshalJSON implements the json.Marshaler interface.
 // It serializes Money into a JSON object with "amount" and "currency" fields.
+// The object is written into a pooled buffer to avoid building an intermediate DTO.
 func (m Money) MarshalJSON() ([]byte, error) {
-	return json.Marshal(&struct {
-		Amount   int64    `json:"amount"`
-		Currency Currency `json:"currency"`
-	}{
-		Amount:   m.amount,
-		Currency: m.currency,
-	})
+	buf := getJSONBuffer()
+	defer putJSONBuffer(buf)
+
+	buf.b = m.appendJSON(buf.b)
+	return buf.bytes(), nil
+}
+
+// appendJSON appends the JSON object representation of the Money to dst.
+func (m Money) appendJSON(dst []byte) []byte {
+	dst = append(dst, `{"amount":`...)
+	dst = strconv.AppendInt(dst, m.amount, 10)
+	dst = append(dst, `,"currency":`...)
+	dst = appendJSONString(dst, m.currency.String())
+	return append(dst, '}')
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
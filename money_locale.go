@@ -0,0 +1,276 @@
+package wisp
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// MoneyLocale describes how monetary amounts are written in a locale: the decimal and
+// thousands separators and where the currency symbol goes.
+//
+// The built-in locales are "pt-BR" (R$ 1.234,56), "en-US" ($1,234.56) and "de-DE" (1.234,56 €).
+// Others can be added with RegisterMoneyLocale.
+type MoneyLocale struct {
+	// DecimalSeparator separates the integer part from the minor units (e.g., ",").
+	DecimalSeparator string
+	// ThousandsSeparator groups the integer digits by thousands (e.g., ".").
+	ThousandsSeparator string
+	// SymbolAfter places the currency symbol after the amount instead of before it.
+	SymbolAfter bool
+	// SymbolSpace separates the currency symbol from the amount with a space.
+	SymbolSpace bool
+}
+
+// defaultMoneyLocales returns the built-in money locales.
+func defaultMoneyLocales() map[string]MoneyLocale {
+	return map[string]MoneyLocale{
+		"pt-br": {DecimalSeparator: ",", ThousandsSeparator: ".", SymbolSpace: true},
+		"en-us": {DecimalSeparator: ".", ThousandsSeparator: ","},
+		"de-de": {DecimalSeparator: ",", ThousandsSeparator: ".", SymbolAfter: true, SymbolSpace: true},
+	}
+}
+
+// moneyLocales holds the global registry of money locales, keyed by normalized tag.
+var moneyLocales = defaultMoneyLocales()
+
+// normalizeLocaleTag lowercases a locale tag and accepts "_" as separator (e.g., "pt_BR").
+func normalizeLocaleTag(tag string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(tag)), "_", "-")
+}
+
+// RegisterMoneyLocale adds or replaces a money locale, identified by a tag such as "fr-FR".
+// Locales without a decimal separator, or with equal decimal and thousands separators, are ignored.
+func RegisterMoneyLocale(tag string, locale MoneyLocale) {
+	normalized := normalizeLocaleTag(tag)
+	if normalized == "" || locale.DecimalSeparator == "" || locale.DecimalSeparator == locale.ThousandsSeparator {
+		return
+	}
+	moneyLocales[normalized] = locale
+}
+
+// ResetMoneyLocales restores the registry to the built-in money locales.
+// This is primarily for testing purposes to ensure a clean state.
+func ResetMoneyLocales() {
+	moneyLocales = defaultMoneyLocales()
+}
+
+// lookupMoneyLocale returns the registered locale for a tag or an error if it is unknown.
+func lookupMoneyLocale(tag string) (MoneyLocale, error) {
+	locale, ok := moneyLocales[normalizeLocaleTag(tag)]
+	if !ok {
+		return MoneyLocale{}, fault.New(
+			"unsupported money locale",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("locale", tag),
+		)
+	}
+	return locale, nil
+}
+
+// Format returns the amount formatted for a locale, with the currency symbol, thousands
+// separators and the currency's minor units.
+// Returns an error if the locale is not registered.
+//
+// Examples:
+//   m, _ := NewMoney(123456, BRL)
+//   m.Format("pt-BR") // "R$ 1.234,56"
+//   m.Format("en-US") // "R$1,234.56"
+//   m.Format("de-DE") // "1.234,56 R$"
+func (m Money) Format(localeTag string) (string, error) {
+	locale, err := lookupMoneyLocale(localeTag)
+	if err != nil {
+		return "", err
+	}
+
+	digits := formatMinorUnits(m.amount, m.currency.MinorUnits())
+	negative := strings.HasPrefix(digits, "-")
+	digits = strings.TrimPrefix(digits, "-")
+
+	integerPart, fractionPart, hasFraction := strings.Cut(digits, ".")
+
+	var b strings.Builder
+	for i := 0; i < len(integerPart); i++ {
+		if i > 0 && (len(integerPart)-i)%3 == 0 {
+			b.WriteString(locale.ThousandsSeparator)
+		}
+		b.WriteByte(integerPart[i])
+	}
+	if hasFraction {
+		b.WriteString(locale.DecimalSeparator)
+		b.WriteString(fractionPart)
+	}
+	number := b.String()
+
+	space := ""
+	if locale.SymbolSpace {
+		space = " "
+	}
+
+	var formatted string
+	if locale.SymbolAfter {
+		formatted = number + space + m.currency.Symbol()
+	} else {
+		formatted = m.currency.Symbol() + space + number
+	}
+
+	if negative {
+		return "-" + formatted, nil
+	}
+	return formatted, nil
+}
+
+// ParseMoney parses a human-formatted amount such as "R$ 1.234,56", "$1,234.56" or "-1.234,56 €"
+// into Money of the given currency. The currency symbol or code is optional, and the decimal
+// separator is inferred: when both "." and "," appear, the last one is the decimal separator;
+// when only one appears, it is a thousands separator if it repeats or is followed by exactly three
+// digits (unless the currency has three minor units), and a decimal separator otherwise.
+// Use ParseMoneyInLocale when the locale of the input is known.
+//
+// Returns an error if the amount is malformed, has misplaced thousands separators or more decimal
+// places than the currency allows.
+func ParseMoney(input string, currency Currency) (Money, error) {
+	cleaned, err := cleanMoneyInput(input, currency)
+	if err != nil {
+		return ZeroMoney, err
+	}
+
+	sign, number := splitMoneySign(cleaned)
+
+	decimalSep, thousandsSep := inferMoneySeparators(number, currency.MinorUnits())
+	canonical, ok := canonicalMoneyNumber(number, decimalSep, thousandsSep)
+	if !ok {
+		return ZeroMoney, invalidMoneyInput(input, currency)
+	}
+	return NewMoneyFromDecimalString(sign+canonical, currency)
+}
+
+// ParseMoneyInLocale parses an amount written with the separators of a registered locale,
+// such as "1.234,56" for "pt-BR" or "1,234.56" for "en-US", into Money of the given currency.
+// The currency symbol or code is optional.
+//
+// Returns an error if the locale is unknown or the amount does not follow its format.
+func ParseMoneyInLocale(input string, currency Currency, localeTag string) (Money, error) {
+	locale, err := lookupMoneyLocale(localeTag)
+	if err != nil {
+		return ZeroMoney, err
+	}
+
+	cleaned, err := cleanMoneyInput(input, currency)
+	if err != nil {
+		return ZeroMoney, err
+	}
+
+	sign, number := splitMoneySign(cleaned)
+
+	thousandsSep := strings.TrimSpace(locale.ThousandsSeparator)
+	canonical, ok := canonicalMoneyNumber(number, locale.DecimalSeparator, thousandsSep)
+	if !ok {
+		return ZeroMoney, invalidMoneyInput(input, currency)
+	}
+	return NewMoneyFromDecimalString(sign+canonical, currency)
+}
+
+// invalidMoneyInput builds the error returned for malformed money input.
+func invalidMoneyInput(input string, currency Currency) error {
+	return fault.New(
+		"invalid money format",
+		fault.WithCode(fault.Invalid),
+		fault.WithContext("input_value", input),
+		fault.WithContext("currency", currency.String()),
+	)
+}
+
+// cleanMoneyInput validates the currency and removes its symbol, its code and all whitespace
+// (including non-breaking spaces) from the input.
+func cleanMoneyInput(input string, currency Currency) (string, error) {
+	if currency.IsZero() || !currency.IsValid() {
+		_, err := NewMoney(0, currency)
+		return "", err
+	}
+
+	cleaned := strings.Replace(input, currency.Symbol(), "", 1)
+	// The code is matched case-insensitively on cleaned itself: indexes into an uppercased copy
+	// do not map back, since some runes change length when uppercased.
+	code := currency.String()
+	for i := 0; i+len(code) <= len(cleaned); i++ {
+		if strings.EqualFold(cleaned[i:i+len(code)], code) {
+			cleaned = cleaned[:i] + cleaned[i+len(code):]
+			break
+		}
+	}
+
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, cleaned), nil
+}
+
+// splitMoneySign separates a leading sign from the number.
+func splitMoneySign(value string) (string, string) {
+	if strings.HasPrefix(value, "-") || strings.HasPrefix(value, "+") {
+		return value[:1], value[1:]
+	}
+	return "", value
+}
+
+// inferMoneySeparators guesses the decimal and thousands separators of a number that uses
+// "." and/or ",".
+func inferMoneySeparators(number string, minorUnits int) (decimalSep, thousandsSep string) {
+	lastDot, lastComma := strings.LastIndex(number, "."), strings.LastIndex(number, ",")
+
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		if lastDot > lastComma {
+			return ".", ","
+		}
+		return ",", "."
+	case lastDot < 0 && lastComma < 0:
+		return ".", ","
+	}
+
+	sep, other, last := ".", ",", lastDot
+	if lastComma >= 0 {
+		sep, other, last = ",", ".", lastComma
+	}
+
+	digitsAfter := len(number) - last - 1
+	if strings.Count(number, sep) > 1 || (digitsAfter == 3 && minorUnits != 3) {
+		return other, sep
+	}
+	return sep, other
+}
+
+// canonicalMoneyNumber converts a number written with the given separators into the
+// "1234.56" form, validating that thousands separators split the integer part into groups of three.
+func canonicalMoneyNumber(number, decimalSep, thousandsSep string) (string, bool) {
+	integerPart, fractionPart, hasFraction := strings.Cut(number, decimalSep)
+	if integerPart == "" || (hasFraction && fractionPart == "") || !isASCIIDigits(fractionPart) {
+		return "", false
+	}
+
+	if thousandsSep != "" && strings.Contains(integerPart, thousandsSep) {
+		groups := strings.Split(integerPart, thousandsSep)
+		if len(groups[0]) == 0 || len(groups[0]) > 3 {
+			return "", false
+		}
+		for _, group := range groups[1:] {
+			if len(group) != 3 {
+				return "", false
+			}
+		}
+		integerPart = strings.Join(groups, "")
+	}
+
+	if !isASCIIDigits(integerPart) {
+		return "", false
+	}
+
+	if hasFraction {
+		return integerPart + "." + fractionPart, true
+	}
+	return integerPart, true
+}
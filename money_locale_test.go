@@ -0,0 +1,155 @@
+package wisp_test
+
+import (
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type MoneyLocaleSuite struct {
+	suite.Suite
+}
+
+func TestMoneyLocaleSuite(t *testing.T) {
+	suite.Run(t, new(MoneyLocaleSuite))
+}
+
+func (s *MoneyLocaleSuite) TearDownTest() {
+	wisp.ResetMoneyLocales()
+	wisp.ResetCurrencies()
+}
+
+func (s *MoneyLocaleSuite) TestFormat() {
+	brl, _ := wisp.NewMoney(123456, wisp.BRL)
+	eur, _ := wisp.NewMoney(-123456789, wisp.EUR)
+	usd, _ := wisp.NewMoney(5, wisp.USD)
+
+	testCases := []struct {
+		money    wisp.Money
+		locale   string
+		expected string
+	}{
+		{money: brl, locale: "pt-BR", expected: "R$ 1.234,56"},
+		{money: brl, locale: "en-US", expected: "R$1,234.56"},
+		{money: brl, locale: "de-DE", expected: "1.234,56 R$"},
+		{money: eur, locale: "de_de", expected: "-1.234.567,89 €"},
+		{money: eur, locale: "pt-BR", expected: "-€ 1.234.567,89"},
+		{money: usd, locale: "en-US", expected: "$0.05"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.expected, func() {
+			formatted, err := tc.money.Format(tc.locale)
+			s.Require().NoError(err)
+			s.Equal(tc.expected, formatted)
+		})
+	}
+
+	s.Run("should use the currency minor units", func() {
+		s.Require().NoError(wisp.RegisterCurrencies("JPY"))
+		jpy, _ := wisp.NewMoney(1234567, wisp.Currency("JPY"))
+		formatted, err := jpy.Format("en-US")
+		s.Require().NoError(err)
+		s.Equal("¥1,234,567", formatted)
+	})
+
+	s.Run("should fail for an unknown locale", func() {
+		_, err := brl.Format("xx-XX")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *MoneyLocaleSuite) TestParseMoney() {
+	testCases := []struct {
+		input       string
+		currency    wisp.Currency
+		expected    int64
+		expectError bool
+	}{
+		{input: "R$ 1.234,56", currency: wisp.BRL, expected: 123456},
+		{input: "R$\u00a01.234,56", currency: wisp.BRL, expected: 123456},
+		{input: "-R$ 1.234,56", currency: wisp.BRL, expected: -123456},
+		{input: "$1,234.56", currency: wisp.USD, expected: 123456},
+		{input: "1.234,56 €", currency: wisp.EUR, expected: 123456},
+		{input: "EUR 1.234.567,8", currency: wisp.EUR, expected: 123456780},
+		{input: "1,5", currency: wisp.BRL, expected: 150},
+		{input: "1.5", currency: wisp.USD, expected: 150},
+		{input: "1.234", currency: wisp.BRL, expected: 123400},
+		{input: "1,234", currency: wisp.USD, expected: 123400},
+		{input: "1.234.567", currency: wisp.BRL, expected: 123456700},
+		{input: "10", currency: wisp.BRL, expected: 1000},
+		{input: "12.34.56", currency: wisp.BRL, expectError: true},
+		{input: "1,23,456.00", currency: wisp.USD, expectError: true},
+		{input: "1,234.567", currency: wisp.USD, expectError: true},
+		{input: "R$ abc", currency: wisp.BRL, expectError: true},
+		{input: "", currency: wisp.BRL, expectError: true},
+		{input: "10", currency: wisp.Currency("XYZ"), expectError: true},
+		{input: "brl 10,50", currency: wisp.BRL, expected: 1050},
+		{input: "ɐBRL", currency: wisp.BRL, expectError: true},
+		{input: "ɐɐɐ brl 1", currency: wisp.BRL, expectError: true},
+		{input: "ﬀBRL 10", currency: wisp.BRL, expectError: true},
+		{input: "R$ 10 İ", currency: wisp.BRL, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.input, func() {
+			m, err := wisp.ParseMoney(tc.input, tc.currency)
+			if tc.expectError {
+				s.Require().Error(err)
+				s.Equal(fault.Invalid, err.(*fault.Error).Code)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.expected, m.Amount())
+			s.Equal(tc.currency, m.Currency())
+		})
+	}
+
+	s.Run("should treat a 3-digit group as decimals for 3-decimal currencies", func() {
+		s.Require().NoError(wisp.RegisterCurrencies("BHD"))
+		m, err := wisp.ParseMoney("1.234", wisp.Currency("BHD"))
+		s.Require().NoError(err)
+		s.Equal(int64(1234), m.Amount())
+	})
+
+	s.Run("should round-trip Format output", func() {
+		m, _ := wisp.NewMoney(-98765432, wisp.BRL)
+		for _, locale := range []string{"pt-BR", "en-US", "de-DE"} {
+			formatted, err := m.Format(locale)
+			s.Require().NoError(err)
+			parsed, err := wisp.ParseMoney(formatted, wisp.BRL)
+			s.Require().NoError(err)
+			s.Equal(m, parsed, locale)
+		}
+	})
+}
+
+func (s *MoneyLocaleSuite) TestParseMoneyInLocale() {
+	m, err := wisp.ParseMoneyInLocale("1.234", wisp.BRL, "pt-BR")
+	s.Require().NoError(err)
+	s.Equal(int64(123400), m.Amount())
+
+	_, err = wisp.ParseMoneyInLocale("1.234", wisp.USD, "en-US")
+	s.Require().Error(err)
+
+	m, err = wisp.ParseMoneyInLocale("1,25", wisp.BRL, "pt_br")
+	s.Require().NoError(err)
+	s.Equal(int64(125), m.Amount())
+
+	_, err = wisp.ParseMoneyInLocale("1,25", wisp.BRL, "xx")
+	s.Require().Error(err)
+
+	wisp.RegisterMoneyLocale("fr-FR", wisp.MoneyLocale{DecimalSeparator: ",", ThousandsSeparator: " ", SymbolAfter: true, SymbolSpace: true})
+	eur, _ := wisp.NewMoney(123456, wisp.EUR)
+	formatted, err := eur.Format("fr-FR")
+	s.Require().NoError(err)
+	s.Equal("1 234,56 €", formatted)
+
+	m, err = wisp.ParseMoneyInLocale(formatted, wisp.EUR, "fr-FR")
+	s.Require().NoError(err)
+	s.Equal(eur, m)
+}
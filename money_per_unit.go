@@ -0,0 +1,336 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// RateDimension identifies what a MoneyPerUnit is charged per.
+type RateDimension string
+
+// Defines the supported rate dimensions.
+const (
+	RatePerQuantity RateDimension = "quantity" // Per registered Unit, multiplied by a Quantity.
+	RatePerWeight   RateDimension = "weight"   // Per WeightUnit, multiplied by a Weight.
+	RatePerLength   RateDimension = "length"   // Per LengthUnit, multiplied by a Length.
+	RatePerTime     RateDimension = "time"     // Per second, minute, hour or day, multiplied by a time.Duration.
+)
+
+// Size of one unit in the internal storage unit of Weight (micrograms, to keep pounds and
+// ounces exact) and Length (micrometers).
+var (
+	rateWeightMicrograms = map[WeightUnit]int64{
+		Kilogram: 1_000_000_000,
+		Gram:     1_000_000,
+		Pound:    453_592_370,
+		Ounce:    28_349_520,
+	}
+	rateLengthMicrometers = map[LengthUnit]int64{
+		Meter:      1_000_000,
+		Centimeter: 10_000,
+		Millimeter: 1_000,
+		Kilometer:  1_000_000_000,
+		Inch:       25_400,
+		Foot:       304_800,
+	}
+	rateTimeUnits = map[string]time.Duration{
+		"s":   time.Second,
+		"min": time.Minute,
+		"h":   time.Hour,
+		"d":   24 * time.Hour,
+	}
+)
+
+// MoneyPerUnit is a price expressed per unit of another measure, such as BRL 2.50 per km,
+// BRL 12.00 per kg or BRL 150.00 per hour. Multiplying it only accepts the measure it was
+// created for, so freight and hourly-billing calculations are checked by the type system
+// and the unit conversion is done exactly, with banker's rounding on the final amount.
+//
+// The zero value is ZeroMoneyPerUnit.
+//
+// Example:
+//   price, _ := wisp.NewMoney(250, wisp.BRL)
+//   perKm, _ := wisp.NewMoneyPerLength(price, wisp.Kilometer)
+//   distance, _ := wisp.NewLength(12.4, wisp.Kilometer)
+//   freight, err := perKm.MultiplyLength(distance) // BRL 31.00
+type MoneyPerUnit struct {
+	price     Money
+	dimension RateDimension
+	unit      string
+}
+
+// ZeroMoneyPerUnit represents the zero value for the MoneyPerUnit type.
+var ZeroMoneyPerUnit MoneyPerUnit
+
+// newMoneyPerUnit validates the price shared by every constructor.
+func newMoneyPerUnit(price Money, dimension RateDimension, unit string) (MoneyPerUnit, error) {
+	if price.IsZero() {
+		return ZeroMoneyPerUnit, fault.New("rate price cannot be empty", fault.WithCode(fault.Invalid))
+	}
+	if price.amount < 0 {
+		return ZeroMoneyPerUnit, fault.New(
+			"rate price cannot be negative",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("price", price.String()),
+		)
+	}
+	return MoneyPerUnit{price: price, dimension: dimension, unit: unit}, nil
+}
+
+// NewMoneyPerQuantity creates a rate charged per unit of a registered Unit (e.g., per "BOX").
+func NewMoneyPerQuantity(price Money, unit Unit) (MoneyPerUnit, error) {
+	if !unit.IsValid() {
+		return ZeroMoneyPerUnit, fault.New(
+			"unit is not registered as a valid unit of measure",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("unit", unit),
+		)
+	}
+	return newMoneyPerUnit(price, RatePerQuantity, string(unit))
+}
+
+// NewMoneyPerWeight creates a rate charged per weight unit (e.g., per kg).
+func NewMoneyPerWeight(price Money, unit WeightUnit) (MoneyPerUnit, error) {
+	if _, ok := rateWeightMicrograms[unit]; !ok {
+		return ZeroMoneyPerUnit, fault.New("unsupported weight unit", fault.WithCode(fault.Invalid), fault.WithContext("unit", unit))
+	}
+	return newMoneyPerUnit(price, RatePerWeight, string(unit))
+}
+
+// NewMoneyPerLength creates a rate charged per length unit (e.g., per km).
+func NewMoneyPerLength(price Money, unit LengthUnit) (MoneyPerUnit, error) {
+	if _, ok := rateLengthMicrometers[unit]; !ok {
+		return ZeroMoneyPerUnit, fault.New("unsupported length unit", fault.WithCode(fault.Invalid), fault.WithContext("unit", unit))
+	}
+	return newMoneyPerUnit(price, RatePerLength, string(unit))
+}
+
+// NewMoneyPerDuration creates a rate charged per period of time.
+// The period must be one second, one minute, one hour or one day (e.g., time.Hour for an hourly rate).
+func NewMoneyPerDuration(price Money, per time.Duration) (MoneyPerUnit, error) {
+	for name, d := range rateTimeUnits {
+		if d == per {
+			return newMoneyPerUnit(price, RatePerTime, name)
+		}
+	}
+	return ZeroMoneyPerUnit, fault.New(
+		"rate period must be one second, minute, hour or day",
+		fault.WithCode(fault.Invalid),
+		fault.WithContext("per", per.String()),
+	)
+}
+
+// Price returns the amount charged per unit.
+func (r MoneyPerUnit) Price() Money {
+	return r.price
+}
+
+// Dimension returns what the rate is charged per.
+func (r MoneyPerUnit) Dimension() RateDimension {
+	return r.dimension
+}
+
+// Unit returns the unit the rate is charged per (e.g., "kg", "km", "h" or a registered Unit).
+func (r MoneyPerUnit) Unit() string {
+	return r.unit
+}
+
+// IsZero returns true if the MoneyPerUnit is the zero value.
+func (r MoneyPerUnit) IsZero() bool {
+	return r == ZeroMoneyPerUnit
+}
+
+// MultiplyQuantity returns the price of q. The quantity must be in the unit of the rate.
+func (r MoneyPerUnit) MultiplyQuantity(q Quantity) (Money, error) {
+	if err := r.requireDimension(RatePerQuantity); err != nil {
+		return ZeroMoney, err
+	}
+	if string(q.unit) != r.unit {
+		return ZeroMoney, fault.New(
+			"quantity unit does not match the rate unit",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("rate_unit", r.unit),
+			fault.WithContext("quantity_unit", q.unit),
+		)
+	}
+	return r.multiply(q.value, pow10Int64(q.precision))
+}
+
+// MultiplyWeight returns the price of w, converting it to the unit of the rate.
+// Returns an error if the result overflows.
+func (r MoneyPerUnit) MultiplyWeight(w Weight) (Money, error) {
+	if err := r.requireDimension(RatePerWeight); err != nil {
+		return ZeroMoney, err
+	}
+
+	// Weights are held in milligrams and unit sizes in micrograms: the factor of 1000 between them
+	// is reduced against the unit size first, so kilograms and grams need no scaling at all.
+	unitSize := rateWeightMicrograms[WeightUnit(r.unit)]
+	common := gcdInt64(1000, unitSize)
+	scale := 1000 / common
+	if w.milligrams > math.MaxInt64/scale {
+		return ZeroMoney, fault.New(
+			"weight is too large to be priced",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("milligrams", w.milligrams),
+			fault.WithContext("unit", r.unit),
+		)
+	}
+	return r.multiply(w.milligrams*scale, unitSize/common)
+}
+
+// gcdInt64 returns the greatest common divisor of two positive integers.
+func gcdInt64(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// MultiplyLength returns the price of l, converting it to the unit of the rate.
+func (r MoneyPerUnit) MultiplyLength(l Length) (Money, error) {
+	if err := r.requireDimension(RatePerLength); err != nil {
+		return ZeroMoney, err
+	}
+	return r.multiply(l.micrometers, rateLengthMicrometers[LengthUnit(r.unit)])
+}
+
+// MultiplyDuration returns the price of d, converting it to the period of the rate.
+func (r MoneyPerUnit) MultiplyDuration(d time.Duration) (Money, error) {
+	if err := r.requireDimension(RatePerTime); err != nil {
+		return ZeroMoney, err
+	}
+	return r.multiply(int64(d), int64(rateTimeUnits[r.unit]))
+}
+
+// requireDimension returns an error if the rate is not charged per the given dimension.
+func (r MoneyPerUnit) requireDimension(dimension RateDimension) error {
+	if r.dimension != dimension {
+		return fault.New(
+			"rate cannot be multiplied by this kind of measure",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("rate_dimension", r.dimension),
+			fault.WithContext("measure_dimension", dimension),
+		)
+	}
+	return nil
+}
+
+// multiply returns the price times amount/unitSize, where both are in the same storage unit.
+func (r MoneyPerUnit) multiply(amount, unitSize int64) (Money, error) {
+	total, err := mulDivRounded(r.price.amount, amount, unitSize, RoundHalfEven)
+	if err != nil {
+		return ZeroMoney, err
+	}
+	return Money{amount: total, currency: r.price.currency}, nil
+}
+
+// String returns the rate like "BRL 2.50/km", or an empty string for the zero value.
+func (r MoneyPerUnit) String() string {
+	if r.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s", r.price, r.unit)
+}
+
+// moneyPerUnitJSON is the JSON representation of a MoneyPerUnit.
+type moneyPerUnitJSON struct {
+	Price     Money         `json:"price"`
+	Dimension RateDimension `json:"dimension"`
+	Per       string        `json:"per"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the rate to a JSON object with "price", "dimension" and "per" fields, or null if zero.
+func (r MoneyPerUnit) MarshalJSON() ([]byte, error) {
+	if r.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(moneyPerUnitJSON{Price: r.price, Dimension: r.dimension, Per: r.unit})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object into a MoneyPerUnit, with validation.
+func (r *MoneyPerUnit) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*r = ZeroMoneyPerUnit
+		return nil
+	}
+
+	var dto moneyPerUnitJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for MoneyPerUnit", fault.WithCode(fault.Invalid))
+	}
+
+	var rate MoneyPerUnit
+	var err error
+	switch dto.Dimension {
+	case RatePerQuantity:
+		rate, err = NewMoneyPerQuantity(dto.Price, Unit(dto.Per))
+	case RatePerWeight:
+		rate, err = NewMoneyPerWeight(dto.Price, WeightUnit(dto.Per))
+	case RatePerLength:
+		rate, err = NewMoneyPerLength(dto.Price, LengthUnit(dto.Per))
+	case RatePerTime:
+		per, ok := rateTimeUnits[dto.Per]
+		if !ok {
+			return fault.New("unsupported rate period", fault.WithCode(fault.Invalid), fault.WithContext("per", dto.Per))
+		}
+		rate, err = NewMoneyPerDuration(dto.Price, per)
+	default:
+		return fault.New("unknown rate dimension", fault.WithCode(fault.Invalid), fault.WithContext("dimension", dto.Dimension))
+	}
+	if err != nil {
+		return err
+	}
+	*r = rate
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the rate as a JSON string or nil if it's the zero value.
+func (r MoneyPerUnit) Value() (driver.Value, error) {
+	if r.IsZero() {
+		return nil, nil
+	}
+
+	data, err := r.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal money per unit for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as MoneyPerUnit.
+func (r *MoneyPerUnit) Scan(src interface{}) error {
+	if src == nil {
+		*r = ZeroMoneyPerUnit
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for MoneyPerUnit",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return r.UnmarshalJSON(data)
+}
@@ -0,0 +1,188 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type MoneyPerUnitSuite struct {
+	suite.Suite
+}
+
+func TestMoneyPerUnitSuite(t *testing.T) {
+	suite.Run(t, new(MoneyPerUnitSuite))
+}
+
+func (s *MoneyPerUnitSuite) SetupTest() {
+	wisp.ClearRegisteredUnits()
+	wisp.RegisterUnits(UnitKG, UnitUN)
+}
+
+func (s *MoneyPerUnitSuite) brl(cents int64) wisp.Money {
+	m, err := wisp.NewMoney(cents, wisp.BRL)
+	s.Require().NoError(err)
+	return m
+}
+
+func (s *MoneyPerUnitSuite) TestConstructors() {
+	s.Run("should create rates for each dimension", func() {
+		perKm, err := wisp.NewMoneyPerLength(s.brl(250), wisp.Kilometer)
+		s.Require().NoError(err)
+		s.Equal(wisp.RatePerLength, perKm.Dimension())
+		s.Equal("km", perKm.Unit())
+		s.Equal("BRL 2.50/km", perKm.String())
+
+		perHour, err := wisp.NewMoneyPerDuration(s.brl(15000), time.Hour)
+		s.Require().NoError(err)
+		s.Equal("BRL 150.00/h", perHour.String())
+
+		perBox, err := wisp.NewMoneyPerQuantity(s.brl(1000), UnitUN)
+		s.Require().NoError(err)
+		s.Equal(wisp.RatePerQuantity, perBox.Dimension())
+	})
+
+	s.Run("should reject invalid prices and units", func() {
+		_, err := wisp.NewMoneyPerWeight(wisp.ZeroMoney, wisp.Kilogram)
+		s.Require().Error(err)
+
+		_, err = wisp.NewMoneyPerWeight(s.brl(-100), wisp.Kilogram)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+		_, err = wisp.NewMoneyPerWeight(s.brl(100), wisp.WeightUnit("ton"))
+		s.Require().Error(err)
+
+		_, err = wisp.NewMoneyPerDuration(s.brl(100), 90*time.Minute)
+		s.Require().Error(err)
+
+		_, err = wisp.NewMoneyPerQuantity(s.brl(100), wisp.Unit("BOX"))
+		s.Require().Error(err)
+	})
+}
+
+func (s *MoneyPerUnitSuite) TestMultiply() {
+	s.Run("should price a distance", func() {
+		perKm, _ := wisp.NewMoneyPerLength(s.brl(250), wisp.Kilometer)
+		distance, _ := wisp.NewLength(12.4, wisp.Kilometer)
+		total, err := perKm.MultiplyLength(distance)
+		s.Require().NoError(err)
+		s.Equal(s.brl(3100), total)
+
+		meters, _ := wisp.NewLength(500, wisp.Meter)
+		total, err = perKm.MultiplyLength(meters)
+		s.Require().NoError(err)
+		s.Equal(s.brl(125), total)
+	})
+
+	s.Run("should price a weight converting units exactly", func() {
+		perKg, _ := wisp.NewMoneyPerWeight(s.brl(1200), wisp.Kilogram)
+		w, _ := wisp.NewWeight(1500, wisp.Gram)
+		total, err := perKg.MultiplyWeight(w)
+		s.Require().NoError(err)
+		s.Equal(s.brl(1800), total)
+
+		perLb, _ := wisp.NewMoneyPerWeight(s.brl(1000), wisp.Pound)
+		lb, _ := wisp.NewWeight(2, wisp.Pound)
+		total, err = perLb.MultiplyWeight(lb)
+		s.Require().NoError(err)
+		s.Equal(s.brl(2000), total)
+	})
+
+	s.Run("should price large weights without overflowing", func() {
+		perKg, _ := wisp.NewMoneyPerWeight(s.brl(1), wisp.Kilogram)
+		w, _ := wisp.NewWeight(9e12, wisp.Kilogram)
+		total, err := perKg.MultiplyWeight(w)
+		s.Require().NoError(err)
+		s.Equal(s.brl(9e12), total)
+
+		perOz, _ := wisp.NewMoneyPerWeight(s.brl(1), wisp.Ounce)
+		_, err = perOz.MultiplyWeight(w)
+		s.Require().Error(err)
+	})
+
+	s.Run("should price a duration", func() {
+		perHour, _ := wisp.NewMoneyPerDuration(s.brl(15000), time.Hour)
+		total, err := perHour.MultiplyDuration(90 * time.Minute)
+		s.Require().NoError(err)
+		s.Equal(s.brl(22500), total)
+	})
+
+	s.Run("should price a quantity in the same unit", func() {
+		perKg, _ := wisp.NewMoneyPerQuantity(s.brl(1000), UnitKG)
+		q, _ := wisp.NewQuantityWithPrecision(2.5, UnitKG, 3)
+		total, err := perKg.MultiplyQuantity(q)
+		s.Require().NoError(err)
+		s.Equal(s.brl(2500), total)
+
+		other, _ := wisp.NewQuantity(1, UnitUN)
+		_, err = perKg.MultiplyQuantity(other)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+
+	s.Run("should reject measures of another dimension", func() {
+		perKm, _ := wisp.NewMoneyPerLength(s.brl(250), wisp.Kilometer)
+		_, err := perKm.MultiplyDuration(time.Hour)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+
+		_, err = wisp.ZeroMoneyPerUnit.MultiplyWeight(wisp.ZeroWeight)
+		s.Require().Error(err)
+	})
+}
+
+func (s *MoneyPerUnitSuite) TestJSONAndSQL() {
+	perKg, _ := wisp.NewMoneyPerWeight(s.brl(1200), wisp.Kilogram)
+
+	s.Run("should round trip through JSON", func() {
+		data, err := json.Marshal(perKg)
+		s.Require().NoError(err)
+
+		var decoded wisp.MoneyPerUnit
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(perKg, decoded)
+
+		perHour, _ := wisp.NewMoneyPerDuration(s.brl(15000), time.Hour)
+		data, err = json.Marshal(perHour)
+		s.Require().NoError(err)
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(perHour, decoded)
+	})
+
+	s.Run("should handle null and reject invalid JSON", func() {
+		data, err := json.Marshal(wisp.ZeroMoneyPerUnit)
+		s.Require().NoError(err)
+		s.Equal("null", string(data))
+
+		var decoded wisp.MoneyPerUnit
+		s.Require().NoError(json.Unmarshal([]byte("null"), &decoded))
+		s.True(decoded.IsZero())
+
+		s.Error(json.Unmarshal([]byte(`{"price":{"amount":100,"currency":"BRL"},"dimension":"volume","per":"l"}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`{"price":{"amount":100,"currency":"BRL"},"dimension":"time","per":"week"}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`[]`), &decoded))
+	})
+
+	s.Run("should round trip through the database", func() {
+		val, err := perKg.Value()
+		s.Require().NoError(err)
+
+		var scanned wisp.MoneyPerUnit
+		s.Require().NoError(scanned.Scan(val))
+		s.Equal(perKg, scanned)
+
+		val, err = wisp.ZeroMoneyPerUnit.Value()
+		s.Require().NoError(err)
+		s.Nil(val)
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+		s.Error(scanned.Scan(1))
+	})
+}
@@ -2,7 +2,9 @@ package wisp_test
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/marcelofabianov/fault"
 	"github.com/stretchr/testify/suite"
@@ -151,6 +153,268 @@ func (s *MoneySuite) TestMoney_Split() {
 	})
 }
 
+func (s *MoneySuite) TestMoney_DivideRounded() {
+	testCases := []struct {
+		amount   int64
+		divisor  int64
+		mode     wisp.RoundingMode
+		expected int64
+	}{
+		{amount: 1001, divisor: 2, mode: wisp.RoundHalfEven, expected: 500},
+		{amount: 1003, divisor: 2, mode: wisp.RoundHalfEven, expected: 502},
+		{amount: 1001, divisor: 2, mode: wisp.RoundHalfUp, expected: 501},
+		{amount: 1001, divisor: 2, mode: wisp.RoundHalfDown, expected: 500},
+		{amount: 1001, divisor: 2, mode: wisp.RoundFloor, expected: 500},
+		{amount: 1001, divisor: 2, mode: wisp.RoundCeil, expected: 501},
+		{amount: -1001, divisor: 2, mode: wisp.RoundHalfEven, expected: -500},
+		{amount: -1001, divisor: 2, mode: wisp.RoundHalfUp, expected: -501},
+		{amount: -1001, divisor: 2, mode: wisp.RoundHalfDown, expected: -500},
+		{amount: -1001, divisor: 2, mode: wisp.RoundFloor, expected: -501},
+		{amount: -1001, divisor: 2, mode: wisp.RoundCeil, expected: -500},
+		{amount: 1001, divisor: -2, mode: wisp.RoundFloor, expected: -501},
+		{amount: 2000, divisor: 3, mode: wisp.RoundHalfDown, expected: 667},
+		{amount: 1000, divisor: 3, mode: wisp.RoundCeil, expected: 334},
+		{amount: -1000, divisor: 3, mode: wisp.RoundFloor, expected: -334},
+		{amount: 1000, divisor: 4, mode: wisp.RoundCeil, expected: 250},
+	}
+
+	for _, tc := range testCases {
+		m, _ := wisp.NewMoney(tc.amount, wisp.BRL)
+		result, err := m.DivideRounded(tc.divisor, tc.mode)
+		s.Require().NoError(err)
+		s.Equal(tc.expected, result.Amount(), "%d / %d (%s)", tc.amount, tc.divisor, tc.mode)
+		s.Equal(wisp.BRL, result.Currency())
+	}
+
+	m, _ := wisp.NewMoney(1000, wisp.BRL)
+	_, err := m.DivideRounded(0, wisp.RoundHalfUp)
+	s.Require().Error(err)
+	_, err = m.DivideRounded(2, wisp.RoundingMode(42))
+	s.Require().Error(err)
+	s.Equal(fault.Invalid, err.(*fault.Error).Code)
+}
+
+func (s *MoneySuite) TestMoney_Allocate() {
+	amounts := func(parts []wisp.Money) []int64 {
+		result := make([]int64, len(parts))
+		for i, p := range parts {
+			result[i] = p.Amount()
+		}
+		return result
+	}
+
+	s.Run("should allocate proportionally", func() {
+		m, _ := wisp.NewMoney(10000, wisp.BRL)
+		parts, err := m.Allocate(3, 7)
+		s.Require().NoError(err)
+		s.Equal([]int64{3000, 7000}, amounts(parts))
+		s.Equal(wisp.BRL, parts[0].Currency())
+	})
+
+	s.Run("should distribute the remainder to the first parts", func() {
+		m, _ := wisp.NewMoney(5, wisp.BRL)
+		parts, err := m.Allocate(3, 7)
+		s.Require().NoError(err)
+		s.Equal([]int64{2, 3}, amounts(parts))
+
+		m, _ = wisp.NewMoney(100, wisp.BRL)
+		parts, err = m.Allocate(1, 1, 1)
+		s.Require().NoError(err)
+		s.Equal([]int64{34, 33, 33}, amounts(parts))
+	})
+
+	s.Run("should skip zero ratios when distributing the remainder", func() {
+		m, _ := wisp.NewMoney(101, wisp.BRL)
+		parts, err := m.Allocate(0, 1, 1)
+		s.Require().NoError(err)
+		s.Equal([]int64{0, 51, 50}, amounts(parts))
+	})
+
+	s.Run("should allocate negative amounts", func() {
+		m, _ := wisp.NewMoney(-100, wisp.BRL)
+		parts, err := m.Allocate(1, 1, 1)
+		s.Require().NoError(err)
+		s.Equal([]int64{-34, -33, -33}, amounts(parts))
+	})
+
+	s.Run("should allocate with large ratios", func() {
+		m, _ := wisp.NewMoney(10000, wisp.BRL)
+		parts, err := m.Allocate(1<<40+1, 1<<40+3)
+		s.Require().NoError(err)
+		s.Equal([]int64{5000, 5000}, amounts(parts))
+
+		m, _ = wisp.NewMoney(math.MaxInt64, wisp.BRL)
+		parts, err = m.Allocate(3, 1<<62)
+		s.Require().NoError(err)
+		s.Equal([]int64{6, math.MaxInt64 - 6}, amounts(parts))
+	})
+
+	s.Run("should fail when the ratios overflow", func() {
+		m, _ := wisp.NewMoney(100, wisp.BRL)
+		_, err := m.Allocate(math.MaxInt, math.MaxInt)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+
+	s.Run("should fail for invalid ratios", func() {
+		m, _ := wisp.NewMoney(100, wisp.BRL)
+		_, err := m.Allocate()
+		s.Require().Error(err)
+		_, err = m.Allocate(0, 0)
+		s.Require().Error(err)
+		_, err = m.Allocate(1, -1)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *MoneySuite) TestProrate() {
+	date := func(month time.Month, day int) wisp.Date {
+		d, err := wisp.NewDate(2025, month, day)
+		s.Require().NoError(err)
+		return d
+	}
+	dateRange := func(start, end wisp.Date) wisp.DateRange {
+		dr, err := wisp.NewDateRange(start, end)
+		s.Require().NoError(err)
+		return dr
+	}
+	cycle := dateRange(date(time.June, 1), date(time.June, 30))
+
+	s.Run("should prorate by days", func() {
+		total, _ := wisp.NewMoney(30000, wisp.BRL)
+		amount, err := wisp.Prorate(total, cycle, dateRange(date(time.June, 1), date(time.June, 10)))
+		s.Require().NoError(err)
+		s.Equal(int64(10000), amount.Amount())
+		s.Equal(wisp.BRL, amount.Currency())
+	})
+
+	s.Run("should distribute the remainder exactly across adjacent ranges", func() {
+		total, _ := wisp.NewMoney(10000, wisp.BRL)
+		first, err := wisp.Prorate(total, cycle, dateRange(date(time.June, 1), date(time.June, 7)))
+		s.Require().NoError(err)
+		second, err := wisp.Prorate(total, cycle, dateRange(date(time.June, 8), date(time.June, 19)))
+		s.Require().NoError(err)
+		third, err := wisp.Prorate(total, cycle, dateRange(date(time.June, 20), date(time.June, 30)))
+		s.Require().NoError(err)
+
+		s.Equal(int64(2333), first.Amount())
+		s.Equal(int64(4000), second.Amount())
+		s.Equal(int64(3667), third.Amount())
+		s.Equal(total.Amount(), first.Amount()+second.Amount()+third.Amount())
+	})
+
+	s.Run("should return the total for the full range", func() {
+		total, _ := wisp.NewMoney(-9999, wisp.BRL)
+		amount, err := wisp.Prorate(total, cycle, cycle)
+		s.Require().NoError(err)
+		s.Equal(total, amount)
+	})
+
+	s.Run("should fail when used range is outside the full range", func() {
+		total, _ := wisp.NewMoney(10000, wisp.BRL)
+		_, err := wisp.Prorate(total, cycle, dateRange(date(time.June, 25), date(time.July, 5)))
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+
+	s.Run("should fail for zero ranges", func() {
+		total, _ := wisp.NewMoney(10000, wisp.BRL)
+		_, err := wisp.Prorate(total, wisp.ZeroDateRange, cycle)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *MoneySuite) TestMoney_MinorUnits() {
+	s.Require().NoError(wisp.RegisterCurrencies("JPY", "BHD"))
+	s.T().Cleanup(wisp.ResetCurrencies)
+
+	jpy, bhd := wisp.Currency("JPY"), wisp.Currency("BHD")
+
+	s.Run("should format using the currency minor units", func() {
+		m, _ := wisp.NewMoney(1050, jpy)
+		s.Equal("JPY 1050", m.String())
+		s.Equal(1050.0, m.Float64())
+
+		m, _ = wisp.NewMoney(1050, bhd)
+		s.Equal("BHD 1.050", m.String())
+		s.InDelta(1.05, m.Float64(), 1e-9)
+
+		m, _ = wisp.NewMoney(-5, wisp.BRL)
+		s.Equal("BRL -0.05", m.String())
+
+		m, _ = wisp.NewMoney(-9223372036854775808, wisp.BRL)
+		s.Equal("BRL -92233720368547758.08", m.String())
+	})
+
+	s.Run("NewMoneyFromFloat", func() {
+		m, err := wisp.NewMoneyFromFloat(10.5, wisp.BRL)
+		s.Require().NoError(err)
+		s.Equal(int64(1050), m.Amount())
+
+		m, err = wisp.NewMoneyFromFloat(0.1+0.2, wisp.BRL)
+		s.Require().NoError(err)
+		s.Equal(int64(30), m.Amount())
+
+		m, err = wisp.NewMoneyFromFloat(1050, jpy)
+		s.Require().NoError(err)
+		s.Equal(int64(1050), m.Amount())
+
+		m, err = wisp.NewMoneyFromFloat(1.2345, bhd)
+		s.Require().NoError(err)
+		s.Equal(int64(1235), m.Amount())
+
+		_, err = wisp.NewMoneyFromFloat(math.Inf(1), wisp.BRL)
+		s.Require().Error(err)
+		_, err = wisp.NewMoneyFromFloat(math.NaN(), wisp.BRL)
+		s.Require().Error(err)
+		_, err = wisp.NewMoneyFromFloat(1e30, wisp.BRL)
+		s.Require().Error(err)
+		_, err = wisp.NewMoneyFromFloat(1, wisp.Currency("XYZ"))
+		s.Require().Error(err)
+	})
+
+	s.Run("NewMoneyFromDecimalString", func() {
+		testCases := []struct {
+			input       string
+			currency    wisp.Currency
+			expected    int64
+			expectError bool
+		}{
+			{input: "10.50", currency: wisp.BRL, expected: 1050},
+			{input: "10.5", currency: wisp.BRL, expected: 1050},
+			{input: " -3 ", currency: wisp.BRL, expected: -300},
+			{input: "+0.01", currency: wisp.BRL, expected: 1},
+			{input: "1.005", currency: bhd, expected: 1005},
+			{input: "1050", currency: jpy, expected: 1050},
+			{input: "-92233720368547758.08", currency: wisp.BRL, expected: -9223372036854775808},
+			{input: "10.5", currency: jpy, expectError: true},
+			{input: "1.001", currency: wisp.BRL, expectError: true},
+			{input: "1,50", currency: wisp.BRL, expectError: true},
+			{input: ".5", currency: wisp.BRL, expectError: true},
+			{input: "5.", currency: wisp.BRL, expectError: true},
+			{input: "", currency: wisp.BRL, expectError: true},
+			{input: "92233720368547758.08", currency: wisp.BRL, expectError: true},
+			{input: "1", currency: wisp.Currency("XYZ"), expectError: true},
+		}
+
+		for _, tc := range testCases {
+			s.Run(tc.input, func() {
+				m, err := wisp.NewMoneyFromDecimalString(tc.input, tc.currency)
+				if tc.expectError {
+					s.Require().Error(err)
+					s.Equal(fault.Invalid, err.(*fault.Error).Code)
+					return
+				}
+				s.Require().NoError(err)
+				s.Equal(tc.expected, m.Amount())
+				s.Equal(tc.currency, m.Currency())
+			})
+		}
+	})
+}
+
 func (s *MoneySuite) TestMoney_JSONMarshaling() {
 	s.Run("should marshal and unmarshal correctly", func() {
 		m, _ := wisp.NewMoney(12345, wisp.EUR)
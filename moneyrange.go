@@ -0,0 +1,229 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// MoneyRange represents a band of monetary values between a minimum and a maximum, inclusive.
+// It is a value object that ensures both bounds share the same currency and that min is not
+// greater than max, which makes it suitable for price bands, salary ranges and approval limits.
+//
+// The zero value for MoneyRange is ZeroMoneyRange, where both bounds are ZeroMoney.
+//
+// Examples:
+//   min, _ := wisp.NewMoney(1000, wisp.BRL)
+//   max, _ := wisp.NewMoney(5000, wisp.BRL)
+//   band, err := wisp.NewMoneyRange(min, max)
+//   price, _ := wisp.NewMoney(2500, wisp.BRL)
+//   band.Contains(price) // true
+type MoneyRange struct {
+	min Money
+	max Money
+}
+
+// ZeroMoneyRange represents the zero value for the MoneyRange type.
+var ZeroMoneyRange MoneyRange
+
+// NewMoneyRange creates a new MoneyRange from a minimum and maximum amount.
+// It returns an error if either bound is ZeroMoney, if the currencies differ,
+// or if min is greater than max.
+func NewMoneyRange(min, max Money) (MoneyRange, error) {
+	if min.IsZero() || max.IsZero() {
+		return ZeroMoneyRange, fault.New(
+			"money range requires both min and max",
+			fault.WithCode(fault.Invalid),
+		)
+	}
+
+	if min.currency != max.currency {
+		return ZeroMoneyRange, fault.New(
+			"money range bounds must have the same currency",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("min_currency", min.currency),
+			fault.WithContext("max_currency", max.currency),
+		)
+	}
+
+	if min.amount > max.amount {
+		return ZeroMoneyRange, fault.New(
+			"min amount cannot be greater than max amount",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("min", min.String()),
+			fault.WithContext("max", max.String()),
+		)
+	}
+
+	return MoneyRange{min: min, max: max}, nil
+}
+
+// Min returns the lower bound of the range.
+func (mr MoneyRange) Min() Money {
+	return mr.min
+}
+
+// Max returns the upper bound of the range.
+func (mr MoneyRange) Max() Money {
+	return mr.max
+}
+
+// Currency returns the currency shared by both bounds.
+func (mr MoneyRange) Currency() Currency {
+	return mr.min.currency
+}
+
+// IsZero returns true if the MoneyRange is the zero value.
+func (mr MoneyRange) IsZero() bool {
+	return mr.min.IsZero() && mr.max.IsZero()
+}
+
+// Equals checks if two MoneyRange instances have the same bounds.
+func (mr MoneyRange) Equals(other MoneyRange) bool {
+	return mr.min.Equals(other.min) && mr.max.Equals(other.max)
+}
+
+// Contains checks if m is within the range (inclusive).
+// Money in a different currency is never contained.
+func (mr MoneyRange) Contains(m Money) bool {
+	if mr.IsZero() || m.currency != mr.min.currency {
+		return false
+	}
+	return m.amount >= mr.min.amount && m.amount <= mr.max.amount
+}
+
+// Overlaps checks if two ranges share at least one value.
+// Ranges in different currencies never overlap.
+func (mr MoneyRange) Overlaps(other MoneyRange) bool {
+	if mr.IsZero() || other.IsZero() || mr.min.currency != other.min.currency {
+		return false
+	}
+	return mr.min.amount <= other.max.amount && mr.max.amount >= other.min.amount
+}
+
+// Clamp returns m limited to the bounds of the range: values below min return min,
+// values above max return max, and values inside the range are returned unchanged.
+// It returns an error if the range is zero or if m has a different currency.
+func (mr MoneyRange) Clamp(m Money) (Money, error) {
+	if mr.IsZero() {
+		return ZeroMoney, fault.New(
+			"cannot clamp to an empty money range",
+			fault.WithCode(fault.Invalid),
+		)
+	}
+
+	if m.currency != mr.min.currency {
+		return ZeroMoney, fault.New(
+			"cannot clamp money of a different currency",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("range_currency", mr.min.currency),
+			fault.WithContext("money_currency", m.currency),
+		)
+	}
+
+	switch {
+	case m.amount < mr.min.amount:
+		return mr.min, nil
+	case m.amount > mr.max.amount:
+		return mr.max, nil
+	default:
+		return m, nil
+	}
+}
+
+// String returns a formatted string representation of the range, like "BRL 10.00 to BRL 50.00".
+func (mr MoneyRange) String() string {
+	if mr.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%s to %s", mr.min.String(), mr.max.String())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the MoneyRange into a JSON object with "min" and "max" Money fields.
+func (mr MoneyRange) MarshalJSON() ([]byte, error) {
+	if mr.IsZero() {
+		return json.Marshal(nil)
+	}
+
+	buf := getJSONBuffer()
+	defer putJSONBuffer(buf)
+
+	buf.b = append(buf.b, `{"min":`...)
+	buf.b = mr.min.appendJSON(buf.b)
+	buf.b = append(buf.b, `,"max":`...)
+	buf.b = mr.max.appendJSON(buf.b)
+	buf.b = append(buf.b, '}')
+	return buf.bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object with "min" and "max" fields into a MoneyRange.
+func (mr *MoneyRange) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*mr = ZeroMoneyRange
+		return nil
+	}
+
+	dto := &struct {
+		Min Money `json:"min"`
+		Max Money `json:"max"`
+	}{}
+
+	if err := json.Unmarshal(data, dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for MoneyRange", fault.WithCode(fault.Invalid))
+	}
+
+	moneyRange, err := NewMoneyRange(dto.Min, dto.Max)
+	if err != nil {
+		return err
+	}
+
+	*mr = moneyRange
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the MoneyRange as a JSON string or nil if it's the zero value.
+func (mr MoneyRange) Value() (driver.Value, error) {
+	if mr.IsZero() {
+		return nil, nil
+	}
+
+	data, err := mr.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal money range for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as MoneyRange.
+func (mr *MoneyRange) Scan(src interface{}) error {
+	if src == nil {
+		*mr = ZeroMoneyRange
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for MoneyRange",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return mr.UnmarshalJSON(data)
+}
@@ -0,0 +1,153 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type MoneyRangeSuite struct {
+	suite.Suite
+}
+
+func TestMoneyRangeSuite(t *testing.T) {
+	suite.Run(t, new(MoneyRangeSuite))
+}
+
+func (s *MoneyRangeSuite) brl(cents int64) wisp.Money {
+	m, err := wisp.NewMoney(cents, wisp.BRL)
+	s.Require().NoError(err)
+	return m
+}
+
+func (s *MoneyRangeSuite) TestNewMoneyRange() {
+	s.Run("should create a valid range", func() {
+		mr, err := wisp.NewMoneyRange(s.brl(1000), s.brl(5000))
+		s.Require().NoError(err)
+		s.Equal(s.brl(1000), mr.Min())
+		s.Equal(s.brl(5000), mr.Max())
+		s.Equal(wisp.BRL, mr.Currency())
+		s.Equal("BRL 10.00 to BRL 50.00", mr.String())
+	})
+
+	s.Run("should allow a single-value range", func() {
+		_, err := wisp.NewMoneyRange(s.brl(1000), s.brl(1000))
+		s.NoError(err)
+	})
+
+	s.Run("should fail when min is greater than max", func() {
+		_, err := wisp.NewMoneyRange(s.brl(5000), s.brl(1000))
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+
+	s.Run("should fail with different currencies", func() {
+		usd, _ := wisp.NewMoney(5000, wisp.USD)
+		_, err := wisp.NewMoneyRange(s.brl(1000), usd)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+
+	s.Run("should fail with zero money bounds", func() {
+		_, err := wisp.NewMoneyRange(wisp.ZeroMoney, s.brl(1000))
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *MoneyRangeSuite) TestMoneyRange_Methods() {
+	mr, _ := wisp.NewMoneyRange(s.brl(1000), s.brl(5000))
+	usd, _ := wisp.NewMoney(2000, wisp.USD)
+
+	s.Run("Contains", func() {
+		s.True(mr.Contains(s.brl(1000)))
+		s.True(mr.Contains(s.brl(3000)))
+		s.True(mr.Contains(s.brl(5000)))
+		s.False(mr.Contains(s.brl(999)))
+		s.False(mr.Contains(s.brl(5001)))
+		s.False(mr.Contains(usd))
+		s.False(wisp.ZeroMoneyRange.Contains(s.brl(1000)))
+	})
+
+	s.Run("Overlaps", func() {
+		touching, _ := wisp.NewMoneyRange(s.brl(5000), s.brl(9000))
+		inside, _ := wisp.NewMoneyRange(s.brl(2000), s.brl(3000))
+		apart, _ := wisp.NewMoneyRange(s.brl(6000), s.brl(9000))
+		usdMax, _ := wisp.NewMoney(9000, wisp.USD)
+		usdRange, _ := wisp.NewMoneyRange(usd, usdMax)
+
+		s.True(mr.Overlaps(touching))
+		s.True(touching.Overlaps(mr))
+		s.True(mr.Overlaps(inside))
+		s.False(mr.Overlaps(apart))
+		s.False(mr.Overlaps(usdRange))
+		s.False(mr.Overlaps(wisp.ZeroMoneyRange))
+	})
+
+	s.Run("Clamp", func() {
+		clamped, err := mr.Clamp(s.brl(100))
+		s.Require().NoError(err)
+		s.Equal(s.brl(1000), clamped)
+
+		clamped, err = mr.Clamp(s.brl(9000))
+		s.Require().NoError(err)
+		s.Equal(s.brl(5000), clamped)
+
+		clamped, err = mr.Clamp(s.brl(2500))
+		s.Require().NoError(err)
+		s.Equal(s.brl(2500), clamped)
+
+		_, err = mr.Clamp(usd)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+
+		_, err = wisp.ZeroMoneyRange.Clamp(s.brl(100))
+		s.Error(err)
+	})
+}
+
+func (s *MoneyRangeSuite) TestMoneyRange_JSONAndSQL() {
+	mr, _ := wisp.NewMoneyRange(s.brl(1000), s.brl(5000))
+
+	s.Run("JSON", func() {
+		data, err := json.Marshal(mr)
+		s.Require().NoError(err)
+		s.JSONEq(`{"min":{"amount":1000,"currency":"BRL"},"max":{"amount":5000,"currency":"BRL"}}`, string(data))
+
+		var decoded wisp.MoneyRange
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.True(mr.Equals(decoded))
+
+		s.Require().NoError(json.Unmarshal([]byte("null"), &decoded))
+		s.True(decoded.IsZero())
+
+		data, err = json.Marshal(wisp.ZeroMoneyRange)
+		s.Require().NoError(err)
+		s.Equal("null", string(data))
+
+		s.Error(json.Unmarshal([]byte(`{"min":{"amount":5000,"currency":"BRL"},"max":{"amount":1000,"currency":"BRL"}}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`{"min":{"amount":1000,"currency":"BRL"}}`), &decoded))
+	})
+
+	s.Run("SQL", func() {
+		val, err := mr.Value()
+		s.Require().NoError(err)
+
+		var scanned wisp.MoneyRange
+		s.Require().NoError(scanned.Scan(val))
+		s.True(mr.Equals(scanned))
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		val, err = wisp.ZeroMoneyRange.Value()
+		s.Require().NoError(err)
+		s.Nil(val)
+
+		s.Error(scanned.Scan(123))
+	})
+}
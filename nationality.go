@@ -0,0 +1,126 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// Nationality is a value object representing the citizenship of a person, identified by the
+// ISO 3166-1 alpha-2 code of the country, as collected in KYC onboarding.
+// A person with dual citizenship has one Nationality per country.
+//
+// The zero value is EmptyNationality.
+//
+// Example:
+//   n, err := wisp.NewNationality("br")
+//   n.Country()     // CountryBrazil
+//   n.IsBrazilian() // true
+type Nationality string
+
+// EmptyNationality represents the zero value for the Nationality type.
+var EmptyNationality Nationality
+
+// NewNationality creates a new Nationality from a country code, ignoring surrounding spaces and case.
+// Returns EmptyNationality for an empty input, or an error if the code is not a valid country code.
+func NewNationality(input string) (Nationality, error) {
+	country, err := NewCountryCode(input)
+	if err != nil {
+		return EmptyNationality, err
+	}
+	return NationalityOf(country), nil
+}
+
+// NationalityOf returns the Nationality of a country.
+func NationalityOf(country CountryCode) Nationality {
+	return Nationality(country)
+}
+
+// Country returns the country of citizenship.
+func (n Nationality) Country() CountryCode {
+	return CountryCode(n)
+}
+
+// IsBrazilian returns true for Brazilian citizens.
+func (n Nationality) IsBrazilian() bool {
+	return n.Country() == CountryBrazil
+}
+
+// IsUSCitizen returns true for citizens of the United States, who are US persons under FATCA
+// wherever they live and file a W-9 instead of a W-8BEN.
+func (n Nationality) IsUSCitizen() bool {
+	return n.Country() == CountryUnitedStates
+}
+
+// String returns the country code of the nationality.
+func (n Nationality) String() string {
+	return string(n)
+}
+
+// IsZero returns true if the Nationality is the zero value.
+func (n Nationality) IsZero() bool {
+	return n == EmptyNationality
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the Nationality as its country code.
+func (n Nationality) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a country code into a Nationality, with validation.
+func (n *Nationality) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "Nationality must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	nationality, err := NewNationality(s)
+	if err != nil {
+		return err
+	}
+	*n = nationality
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the country code as a string, or nil for the zero value.
+func (n Nationality) Value() (driver.Value, error) {
+	if n.IsZero() {
+		return nil, nil
+	}
+	return n.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a Nationality, with validation.
+func (n *Nationality) Scan(src interface{}) error {
+	if src == nil {
+		*n = EmptyNationality
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for Nationality",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	nationality, err := NewNationality(s)
+	if err != nil {
+		return err
+	}
+	*n = nationality
+	return nil
+}
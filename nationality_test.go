@@ -0,0 +1,77 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type NationalitySuite struct {
+	suite.Suite
+}
+
+func TestNationalitySuite(t *testing.T) {
+	suite.Run(t, new(NationalitySuite))
+}
+
+func (s *NationalitySuite) TestNewNationality() {
+	s.Run("should create a nationality from a country code", func() {
+		n, err := wisp.NewNationality(" br ")
+		s.Require().NoError(err)
+		s.Equal(wisp.CountryBrazil, n.Country())
+		s.Equal("BR", n.String())
+		s.True(n.IsBrazilian())
+		s.False(n.IsUSCitizen())
+	})
+
+	s.Run("should create a nationality from a CountryCode", func() {
+		n := wisp.NationalityOf(wisp.CountryUnitedStates)
+		s.True(n.IsUSCitizen())
+		s.False(n.IsBrazilian())
+	})
+
+	s.Run("should return EmptyNationality for an empty input", func() {
+		n, err := wisp.NewNationality("")
+		s.Require().NoError(err)
+		s.True(n.IsZero())
+	})
+
+	s.Run("should reject invalid codes", func() {
+		_, err := wisp.NewNationality("XX")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *NationalitySuite) TestJSON() {
+	data, err := json.Marshal(wisp.NationalityOf(wisp.CountryBrazil))
+	s.Require().NoError(err)
+	s.Equal(`"BR"`, string(data))
+
+	var n wisp.Nationality
+	s.Require().NoError(json.Unmarshal([]byte(`"pt"`), &n))
+	s.Equal(wisp.Nationality("PT"), n)
+	s.Error(json.Unmarshal([]byte(`"XX"`), &n))
+	s.Error(json.Unmarshal([]byte(`1`), &n))
+}
+
+func (s *NationalitySuite) TestSQL() {
+	value, err := wisp.NationalityOf(wisp.CountryBrazil).Value()
+	s.Require().NoError(err)
+	s.Equal("BR", value)
+
+	value, err = wisp.EmptyNationality.Value()
+	s.Require().NoError(err)
+	s.Nil(value)
+
+	var n wisp.Nationality
+	s.Require().NoError(n.Scan([]byte("US")))
+	s.True(n.IsUSCitizen())
+	s.Require().NoError(n.Scan(nil))
+	s.True(n.IsZero())
+	s.Error(n.Scan(1))
+}
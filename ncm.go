@@ -0,0 +1,200 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// registeredNCMs holds the descriptions of known NCM codes.
+var registeredNCMs = make(map[NCM]string)
+
+// RegisterNCM adds the description of an NCM code to the registry, or overrides the description of
+// a registered one. The full TIPI table has thousands of codes, so applications register the codes
+// of the products they sell, usually at startup.
+// Returns an error if the code is invalid or the description is empty.
+//
+// Example:
+//   err := wisp.RegisterNCM("0901.21.00", "Café torrado, não descafeinado")
+func RegisterNCM(code string, description string) error {
+	ncm, err := NewNCM(code)
+	if err != nil {
+		return err
+	}
+	if ncm.IsZero() {
+		return fault.New("NCM cannot be empty", fault.WithCode(fault.Invalid))
+	}
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return fault.New("NCM description cannot be empty", fault.WithCode(fault.Invalid), fault.WithContext("ncm", ncm.String()))
+	}
+
+	registeredNCMs[ncm] = description
+	return nil
+}
+
+// ResetNCMs removes all NCM descriptions from the registry.
+// This is primarily for testing purposes to ensure a clean state.
+func ResetNCMs() {
+	registeredNCMs = make(map[NCM]string)
+}
+
+// NCM is a value object representing a code of the Nomenclatura Comum do Mercosul, the 8-digit
+// classification of goods required in every item of an NF-e. Its first 6 digits follow the
+// Harmonized System: chapter (2 digits), heading (4) and subheading (6).
+// Any 8-digit code in chapters 01 to 97 is valid; codes in the registry also provide their
+// description.
+//
+// The zero value is EmptyNCM.
+//
+// Examples:
+//   ncm, err := wisp.NewNCM("0901.21.00") // "09012100"
+//   ncm.Formatted()                       // "0901.21.00"
+//   ncm.Chapter()                         // 9
+type NCM string
+
+// EmptyNCM represents the zero value for the NCM type.
+var EmptyNCM NCM
+
+// NewNCM creates a new NCM, ignoring dots, spaces and hyphens.
+// Returns EmptyNCM for an empty input, or an error if the code does not have 8 digits or its
+// chapter is not between 01 and 97 (chapter 77 is reserved).
+func NewNCM(input string) (NCM, error) {
+	digits := documentSeparators.Replace(strings.TrimSpace(input))
+	if digits == "" {
+		return EmptyNCM, nil
+	}
+
+	if len(digits) != 8 || !isASCIIDigits(digits) {
+		return EmptyNCM, fault.New("NCM must have 8 digits", fault.WithCode(fault.Invalid), fault.WithContext("input_value", input))
+	}
+	if chapter, _ := strconv.Atoi(digits[:2]); chapter < 1 || chapter > 97 || chapter == 77 {
+		return EmptyNCM, fault.New(
+			"NCM chapter must be between 01 and 97",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	return NCM(digits), nil
+}
+
+// Chapter returns the chapter of the code (e.g., 9 for "09012100"), or 0 for the zero value.
+func (n NCM) Chapter() int {
+	if n.IsZero() {
+		return 0
+	}
+	chapter, _ := strconv.Atoi(string(n[:2]))
+	return chapter
+}
+
+// Heading returns the first 4 digits of the code (e.g., "0901"), or an empty string for the zero
+// value.
+func (n NCM) Heading() string {
+	if n.IsZero() {
+		return ""
+	}
+	return string(n[:4])
+}
+
+// Subheading returns the first 6 digits of the code, the Harmonized System subheading
+// (e.g., "090121"), or an empty string for the zero value.
+func (n NCM) Subheading() string {
+	if n.IsZero() {
+		return ""
+	}
+	return string(n[:6])
+}
+
+// Formatted returns the code in the format XXXX.XX.XX, or an empty string for the zero value.
+func (n NCM) Formatted() string {
+	if n.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%s.%s.%s", n[:4], n[4:6], n[6:])
+}
+
+// Description returns the description of a registered code, or an empty string if it is not
+// registered.
+func (n NCM) Description() string {
+	return registeredNCMs[n]
+}
+
+// IsRegistered checks if the code is in the registry.
+func (n NCM) IsRegistered() bool {
+	_, ok := registeredNCMs[n]
+	return ok
+}
+
+// String returns the code as 8 digits.
+func (n NCM) String() string {
+	return string(n)
+}
+
+// IsZero returns true if the NCM is the zero value.
+func (n NCM) IsZero() bool {
+	return n == EmptyNCM
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the NCM as a JSON string of 8 digits.
+func (n NCM) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into an NCM, with validation.
+func (n *NCM) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "NCM must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	ncm, err := NewNCM(s)
+	if err != nil {
+		return err
+	}
+	*n = ncm
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the NCM as a string, or nil for the zero value.
+func (n NCM) Value() (driver.Value, error) {
+	if n.IsZero() {
+		return nil, nil
+	}
+	return n.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into an NCM, with validation.
+func (n *NCM) Scan(src interface{}) error {
+	if src == nil {
+		*n = EmptyNCM
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for NCM",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	ncm, err := NewNCM(s)
+	if err != nil {
+		return err
+	}
+	*n = ncm
+	return nil
+}
@@ -0,0 +1,117 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type NCMSuite struct {
+	suite.Suite
+}
+
+func TestNCMSuite(t *testing.T) {
+	suite.Run(t, new(NCMSuite))
+}
+
+func (s *NCMSuite) TearDownTest() {
+	wisp.ResetNCMs()
+}
+
+func (s *NCMSuite) TestNewNCM() {
+	s.Run("should accept formatted and unformatted codes", func() {
+		for _, input := range []string{"0901.21.00", "09012100", " 0901 21 00 "} {
+			ncm, err := wisp.NewNCM(input)
+			s.Require().NoError(err, input)
+			s.Equal("09012100", ncm.String())
+		}
+	})
+
+	s.Run("should return the zero value for an empty input", func() {
+		ncm, err := wisp.NewNCM("")
+		s.Require().NoError(err)
+		s.True(ncm.IsZero())
+	})
+
+	s.Run("should reject invalid codes", func() {
+		for _, input := range []string{"0901210", "090121000", "0901.21.0A", "00000000", "98010000", "77010000"} {
+			_, err := wisp.NewNCM(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *NCMSuite) TestParts() {
+	ncm, err := wisp.NewNCM("85171300")
+	s.Require().NoError(err)
+
+	s.Equal(85, ncm.Chapter())
+	s.Equal("8517", ncm.Heading())
+	s.Equal("851713", ncm.Subheading())
+	s.Equal("8517.13.00", ncm.Formatted())
+
+	s.Equal(0, wisp.EmptyNCM.Chapter())
+	s.Empty(wisp.EmptyNCM.Heading())
+	s.Empty(wisp.EmptyNCM.Subheading())
+	s.Empty(wisp.EmptyNCM.Formatted())
+}
+
+func (s *NCMSuite) TestRegistry() {
+	ncm, _ := wisp.NewNCM("0901.21.00")
+	s.False(ncm.IsRegistered())
+	s.Empty(ncm.Description())
+
+	s.Require().NoError(wisp.RegisterNCM("0901.21.00", " Café torrado, não descafeinado "))
+	s.True(ncm.IsRegistered())
+	s.Equal("Café torrado, não descafeinado", ncm.Description())
+
+	err := wisp.RegisterNCM("09012100", "")
+	s.Require().Error(err)
+	s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	s.Error(wisp.RegisterNCM("", "Nada"))
+	s.Error(wisp.RegisterNCM("123", "Inválido"))
+
+	wisp.ResetNCMs()
+	s.False(ncm.IsRegistered())
+}
+
+func (s *NCMSuite) TestSerialization() {
+	s.Run("should round-trip through JSON", func() {
+		ncm, _ := wisp.NewNCM("0901.21.00")
+		data, err := json.Marshal(ncm)
+		s.Require().NoError(err)
+		s.Equal(`"09012100"`, string(data))
+
+		var decoded wisp.NCM
+		s.Require().NoError(json.Unmarshal([]byte(`"8517.13.00"`), &decoded))
+		s.Equal("85171300", decoded.String())
+		s.Error(json.Unmarshal([]byte(`"123"`), &decoded))
+		s.Error(json.Unmarshal([]byte(`85171300`), &decoded))
+	})
+
+	s.Run("should store and scan database values", func() {
+		ncm, _ := wisp.NewNCM("0901.21.00")
+		value, err := ncm.Value()
+		s.Require().NoError(err)
+		s.Equal("09012100", value)
+
+		value, err = wisp.EmptyNCM.Value()
+		s.Require().NoError(err)
+		s.Nil(value)
+
+		var scanned wisp.NCM
+		s.Require().NoError(scanned.Scan([]byte("85171300")))
+		s.Equal("85171300", scanned.String())
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		err = scanned.Scan(85171300)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
@@ -0,0 +1,145 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// NonNegativeInt is a value object ensuring an integer is always greater than or equal to zero.
+// This is useful for representing values like stock levels, retry counts, or offsets, where zero
+// is meaningful but negative values are not.
+//
+// The zero value is ZeroNonNegativeInt, which is also a valid value (0).
+//
+// Example:
+//   stock, err := NewNonNegativeInt(0)
+//   stock, err = stock.Add(5)       // 5
+//   _, err = stock.Subtract(10)     // returns an error
+type NonNegativeInt int
+
+// ZeroNonNegativeInt represents the zero value for NonNegativeInt.
+var ZeroNonNegativeInt NonNegativeInt
+
+// NewNonNegativeInt creates a new NonNegativeInt.
+// It returns an error if the value is negative.
+func NewNonNegativeInt(value int) (NonNegativeInt, error) {
+	if value < 0 {
+		return ZeroNonNegativeInt, fault.New(
+			"value must be a non-negative integer",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+		)
+	}
+	return NonNegativeInt(value), nil
+}
+
+// ParseNonNegativeInt creates a new NonNegativeInt from a base-10 string, ignoring surrounding whitespace.
+// It returns an error if the string is not an integer or the value is negative.
+func ParseNonNegativeInt(s string) (NonNegativeInt, error) {
+	i, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return ZeroNonNegativeInt, fault.Wrap(err,
+			"value must be a valid integer",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", s),
+		)
+	}
+	return NewNonNegativeInt(i)
+}
+
+// Add returns the sum of the value and n.
+// It returns an error if the result is negative or overflows.
+func (n NonNegativeInt) Add(value int) (NonNegativeInt, error) {
+	sum, ok := checkedAddInt(int(n), value)
+	if !ok {
+		return ZeroNonNegativeInt, errIntOverflow("add", int(n), value)
+	}
+	return NewNonNegativeInt(sum)
+}
+
+// Subtract returns the value minus the given amount.
+// It returns an error if the result is negative or overflows.
+func (n NonNegativeInt) Subtract(value int) (NonNegativeInt, error) {
+	diff, ok := checkedSubInt(int(n), value)
+	if !ok {
+		return ZeroNonNegativeInt, errIntOverflow("subtract", int(n), value)
+	}
+	return NewNonNegativeInt(diff)
+}
+
+// Multiply returns the value multiplied by the given factor.
+// It returns an error if the result is negative or overflows.
+func (n NonNegativeInt) Multiply(value int) (NonNegativeInt, error) {
+	product, ok := checkedMulInt(int(n), value)
+	if !ok {
+		return ZeroNonNegativeInt, errIntOverflow("multiply", int(n), value)
+	}
+	return NewNonNegativeInt(product)
+}
+
+// Int returns the underlying integer value.
+func (n NonNegativeInt) Int() int {
+	return int(n)
+}
+
+// IsZero returns true if the value is zero.
+func (n NonNegativeInt) IsZero() bool {
+	return n == ZeroNonNegativeInt
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the NonNegativeInt to its integer representation.
+func (n NonNegativeInt) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.Int())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON number into a NonNegativeInt, with validation.
+func (n *NonNegativeInt) UnmarshalJSON(data []byte) error {
+	var i int
+	if err := json.Unmarshal(data, &i); err != nil {
+		return fault.Wrap(err, "NonNegativeInt must be a valid JSON number", fault.WithCode(fault.Invalid))
+	}
+
+	v, err := NewNonNegativeInt(i)
+	if err != nil {
+		return err
+	}
+	*n = v
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the NonNegativeInt as an int64.
+func (n NonNegativeInt) Value() (driver.Value, error) {
+	return int64(n.Int()), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts an int64 from the database and converts it into a NonNegativeInt, with validation.
+func (n *NonNegativeInt) Scan(src interface{}) error {
+	if src == nil {
+		*n = ZeroNonNegativeInt
+		return nil
+	}
+
+	var i int64
+	switch v := src.(type) {
+	case int64:
+		i = v
+	default:
+		return fault.New("unsupported scan type for NonNegativeInt", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+
+	v, err := NewNonNegativeInt(int(i))
+	if err != nil {
+		return err
+	}
+	*n = v
+	return nil
+}
@@ -0,0 +1,91 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type NonNegativeIntSuite struct {
+	suite.Suite
+}
+
+func TestNonNegativeIntSuite(t *testing.T) {
+	suite.Run(t, new(NonNegativeIntSuite))
+}
+
+func (s *NonNegativeIntSuite) TestNewNonNegativeInt() {
+	n, err := wisp.NewNonNegativeInt(0)
+	s.Require().NoError(err)
+	s.True(n.IsZero())
+
+	n, err = wisp.NewNonNegativeInt(7)
+	s.Require().NoError(err)
+	s.Equal(7, n.Int())
+
+	_, err = wisp.NewNonNegativeInt(-1)
+	s.Require().Error(err)
+	s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+	n, err = wisp.ParseNonNegativeInt("0")
+	s.Require().NoError(err)
+	s.True(n.IsZero())
+	_, err = wisp.ParseNonNegativeInt("-3")
+	s.Error(err)
+	_, err = wisp.ParseNonNegativeInt("1.5")
+	s.Error(err)
+}
+
+func (s *NonNegativeIntSuite) TestArithmetic() {
+	n, _ := wisp.NewNonNegativeInt(5)
+
+	sum, err := n.Add(5)
+	s.Require().NoError(err)
+	s.Equal(10, sum.Int())
+
+	diff, err := n.Subtract(5)
+	s.Require().NoError(err)
+	s.True(diff.IsZero())
+
+	_, err = n.Subtract(6)
+	s.Error(err)
+
+	product, err := n.Multiply(0)
+	s.Require().NoError(err)
+	s.True(product.IsZero())
+
+	_, err = n.Multiply(-1)
+	s.Error(err)
+
+	_, err = n.Subtract(math.MinInt)
+	s.Require().Error(err)
+	s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+}
+
+func (s *NonNegativeIntSuite) TestJSONAndSQL() {
+	n, _ := wisp.NewNonNegativeInt(12)
+
+	data, err := json.Marshal(n)
+	s.Require().NoError(err)
+	s.Equal("12", string(data))
+
+	var decoded wisp.NonNegativeInt
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(n, decoded)
+	s.Error(json.Unmarshal([]byte("-1"), &decoded))
+
+	val, err := n.Value()
+	s.Require().NoError(err)
+	s.Equal(int64(12), val)
+
+	var scanned wisp.NonNegativeInt
+	s.Require().NoError(scanned.Scan(int64(0)))
+	s.True(scanned.IsZero())
+	s.Error(scanned.Scan(int64(-1)))
+	s.Error(scanned.Scan("1"))
+}
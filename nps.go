@@ -0,0 +1,183 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// NPSScore is a value object representing a single Net Promoter Score answer,
+// an integer from 0 to 10 to the question "how likely are you to recommend us?".
+//
+// Unlike most types in this package, 0 is a valid score (a strong detractor),
+// so NPSScore has no separate zero value.
+//
+// Example:
+//   score, err := wisp.NewNPSScore(9)
+//   score.Category() // wisp.NPSPromoter
+//
+//   _, err = wisp.NewNPSScore(11) // returns an error
+type NPSScore int
+
+// NPSCategory classifies an NPSScore as detractor, passive or promoter.
+type NPSCategory string
+
+const (
+	// NPSDetractor is the category of scores from 0 to 6.
+	NPSDetractor NPSCategory = "detractor"
+	// NPSPassive is the category of scores 7 and 8.
+	NPSPassive NPSCategory = "passive"
+	// NPSPromoter is the category of scores 9 and 10.
+	NPSPromoter NPSCategory = "promoter"
+)
+
+const (
+	minNPSScore = 0
+	maxNPSScore = 10
+)
+
+// NewNPSScore creates a new NPSScore.
+// It returns an error if the value is outside the 0–10 range.
+func NewNPSScore(value int) (NPSScore, error) {
+	if value < minNPSScore || value > maxNPSScore {
+		return 0, fault.New(
+			"NPS score must be between 0 and 10",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+		)
+	}
+	return NPSScore(value), nil
+}
+
+// Int returns the underlying integer value.
+func (s NPSScore) Int() int {
+	return int(s)
+}
+
+// Category returns the NPS category of the score.
+func (s NPSScore) Category() NPSCategory {
+	switch {
+	case s >= 9:
+		return NPSPromoter
+	case s >= 7:
+		return NPSPassive
+	default:
+		return NPSDetractor
+	}
+}
+
+// IsPromoter returns true if the score is 9 or 10.
+func (s NPSScore) IsPromoter() bool {
+	return s.Category() == NPSPromoter
+}
+
+// IsPassive returns true if the score is 7 or 8.
+func (s NPSScore) IsPassive() bool {
+	return s.Category() == NPSPassive
+}
+
+// IsDetractor returns true if the score is 6 or lower.
+func (s NPSScore) IsDetractor() bool {
+	return s.Category() == NPSDetractor
+}
+
+// String returns the score as a string.
+func (s NPSScore) String() string {
+	return fmt.Sprintf("%d", s.Int())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the score as a JSON number.
+func (s NPSScore) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Int())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON number into an NPSScore, with validation.
+func (s *NPSScore) UnmarshalJSON(data []byte) error {
+	var i int
+	if err := json.Unmarshal(data, &i); err != nil {
+		return fault.Wrap(err, "NPSScore must be a valid JSON integer", fault.WithCode(fault.Invalid))
+	}
+
+	score, err := NewNPSScore(i)
+	if err != nil {
+		return err
+	}
+	*s = score
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the score as an int64.
+func (s NPSScore) Value() (driver.Value, error) {
+	return int64(s.Int()), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts an int64 from the database and converts it into an NPSScore, with validation.
+func (s *NPSScore) Scan(src interface{}) error {
+	if src == nil {
+		return fault.New("NPSScore cannot be null", fault.WithCode(fault.Invalid))
+	}
+
+	var i int64
+	switch v := src.(type) {
+	case int64:
+		i = v
+	default:
+		return fault.New("unsupported scan type for NPSScore", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+
+	if i < minNPSScore || i > maxNPSScore {
+		return fault.New("NPS score from database must be between 0 and 10", fault.WithCode(fault.Invalid), fault.WithContext("source_value", i))
+	}
+
+	*s = NPSScore(i)
+	return nil
+}
+
+// NPSSummary holds the category counts of a set of NPS answers.
+// Use SummarizeNPS to build it and Score to compute the resulting Net Promoter Score.
+type NPSSummary struct {
+	Promoters  int `json:"promoters"`
+	Passives   int `json:"passives"`
+	Detractors int `json:"detractors"`
+}
+
+// SummarizeNPS counts the promoters, passives and detractors among the given scores.
+func SummarizeNPS(scores []NPSScore) NPSSummary {
+	var summary NPSSummary
+	for _, s := range scores {
+		switch s.Category() {
+		case NPSPromoter:
+			summary.Promoters++
+		case NPSPassive:
+			summary.Passives++
+		default:
+			summary.Detractors++
+		}
+	}
+	return summary
+}
+
+// Total returns the number of answers in the summary.
+func (s NPSSummary) Total() int {
+	return s.Promoters + s.Passives + s.Detractors
+}
+
+// Score returns the Net Promoter Score, the percentage of promoters minus the percentage
+// of detractors, ranging from -100 to 100. It returns 0 when there are no answers.
+//
+// Example:
+//   // 6 promoters, 2 passives, 2 detractors
+//   summary.Score() // 40
+func (s NPSSummary) Score() float64 {
+	total := s.Total()
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Promoters-s.Detractors) * 100 / float64(total)
+}
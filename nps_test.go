@@ -0,0 +1,92 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type NPSSuite struct {
+	suite.Suite
+}
+
+func TestNPSSuite(t *testing.T) {
+	suite.Run(t, new(NPSSuite))
+}
+
+func (s *NPSSuite) TestNewNPSScore() {
+	for _, v := range []int{0, 5, 10} {
+		score, err := wisp.NewNPSScore(v)
+		s.Require().NoError(err)
+		s.Equal(v, score.Int())
+	}
+
+	for _, v := range []int{-1, 11} {
+		_, err := wisp.NewNPSScore(v)
+		s.Require().Error(err)
+	}
+}
+
+func (s *NPSSuite) TestNPSScore_Category() {
+	testCases := []struct {
+		score    wisp.NPSScore
+		expected wisp.NPSCategory
+	}{
+		{score: 0, expected: wisp.NPSDetractor},
+		{score: 6, expected: wisp.NPSDetractor},
+		{score: 7, expected: wisp.NPSPassive},
+		{score: 8, expected: wisp.NPSPassive},
+		{score: 9, expected: wisp.NPSPromoter},
+		{score: 10, expected: wisp.NPSPromoter},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.score.String(), func() {
+			s.Equal(tc.expected, tc.score.Category())
+		})
+	}
+
+	s.True(wisp.NPSScore(10).IsPromoter())
+	s.True(wisp.NPSScore(7).IsPassive())
+	s.True(wisp.NPSScore(3).IsDetractor())
+}
+
+func (s *NPSSuite) TestSummarizeNPS() {
+	scores := []wisp.NPSScore{10, 9, 9, 10, 9, 10, 7, 8, 0, 6}
+	summary := wisp.SummarizeNPS(scores)
+
+	s.Equal(wisp.NPSSummary{Promoters: 6, Passives: 2, Detractors: 2}, summary)
+	s.Equal(10, summary.Total())
+	s.InDelta(40.0, summary.Score(), 1e-9)
+
+	s.Equal(-100.0, wisp.SummarizeNPS([]wisp.NPSScore{1, 2}).Score())
+	s.Equal(0.0, wisp.SummarizeNPS(nil).Score())
+}
+
+func (s *NPSSuite) TestNPSScore_Serialization() {
+	score, _ := wisp.NewNPSScore(8)
+
+	data, err := json.Marshal(score)
+	s.Require().NoError(err)
+	s.Equal(`8`, string(data))
+
+	var decoded wisp.NPSScore
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(score, decoded)
+	s.Require().Error(json.Unmarshal([]byte(`12`), &decoded))
+	s.Require().Error(json.Unmarshal([]byte(`8.5`), &decoded))
+
+	val, err := score.Value()
+	s.Require().NoError(err)
+	s.Equal(int64(8), val)
+
+	var scanned wisp.NPSScore
+	s.Require().NoError(scanned.Scan(int64(0)))
+	s.Equal(wisp.NPSScore(0), scanned)
+	s.Require().Error(scanned.Scan(int64(11)))
+	s.Require().Error(scanned.Scan(nil))
+	s.Require().Error(scanned.Scan("9"))
+}
@@ -38,36 +38,118 @@ func NewNullableTime(t time.Time) NullableTime {
 	}
 }
 
+// NewNullableTimeFromDate creates a new NullableTime at midnight UTC of the given Date.
+// A zero Date results in an invalid (null) NullableTime.
+func NewNullableTimeFromDate(d Date) NullableTime {
+	if d.IsZero() {
+		return EmptyNullableTime
+	}
+	return NewNullableTime(d.t)
+}
+
+// NewNullableTimeFromPtr creates a new NullableTime from a *time.Time, as commonly found in DTOs.
+// A nil pointer or a zero time results in an invalid (null) NullableTime.
+func NewNullableTimeFromPtr(t *time.Time) NullableTime {
+	if t == nil {
+		return EmptyNullableTime
+	}
+	return NewNullableTime(*t)
+}
+
+// Ptr returns a pointer to a copy of the time, or nil if the NullableTime is invalid (null).
+func (nt NullableTime) Ptr() *time.Time {
+	if !nt.Valid {
+		return nil
+	}
+	t := nt.Time
+	return &t
+}
+
+// ValueOr returns the time, or def if the NullableTime is invalid (null).
+func (nt NullableTime) ValueOr(def time.Time) time.Time {
+	if !nt.Valid {
+		return def
+	}
+	return nt.Time
+}
+
 // IsZero returns true if the NullableTime is invalid (null).
 // This is an alias for !nt.Valid to provide a consistent IsZero interface.
 func (nt NullableTime) IsZero() bool {
 	return !nt.Valid
 }
 
+// String returns the time formatted as RFC 3339 with nanoseconds, or an empty string if invalid (null).
+func (nt NullableTime) String() string {
+	if !nt.Valid {
+		return ""
+	}
+	return nt.Time.Format(time.RFC3339Nano)
+}
+
+// parseNullableTime parses a strict RFC 3339 timestamp into a valid NullableTime.
+func parseNullableTime(value string) (NullableTime, error) {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return EmptyNullableTime, fault.Wrap(err,
+			"NullableTime must be an RFC 3339 timestamp",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+		)
+	}
+	return NullableTime{Time: t, Valid: true}, nil
+}
+
 // MarshalJSON implements the json.Marshaler interface.
-// It serializes the NullableTime to a JSON time string, or `null` if it is invalid.
+// It serializes the NullableTime to an RFC 3339 JSON string, or `null` if it is invalid.
 func (nt NullableTime) MarshalJSON() ([]byte, error) {
 	if !nt.Valid {
 		return []byte("null"), nil
 	}
-	return json.Marshal(nt.Time)
+	return json.Marshal(nt.String())
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// It deserializes a JSON time string or `null` into a NullableTime.
+// It deserializes an RFC 3339 JSON string or `null` into a NullableTime.
+// Any other JSON value, including an empty string, is rejected.
 func (nt *NullableTime) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
-		nt.Valid = false
+		*nt = EmptyNullableTime
 		return nil
 	}
 
-	var t time.Time
-	if err := json.Unmarshal(data, &t); err != nil {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
 		return fault.Wrap(err, "NullableTime must be a valid JSON time string or null", fault.WithCode(fault.Invalid))
 	}
 
-	nt.Time = t
-	nt.Valid = true
+	parsed, err := parseNullableTime(s)
+	if err != nil {
+		return err
+	}
+	*nt = parsed
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the RFC 3339 representation, or empty text if the NullableTime is invalid (null).
+func (nt NullableTime) MarshalText() ([]byte, error) {
+	return []byte(nt.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text results in an invalid (null) NullableTime; anything else must be RFC 3339.
+func (nt *NullableTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*nt = EmptyNullableTime
+		return nil
+	}
+
+	parsed, err := parseNullableTime(string(text))
+	if err != nil {
+		return err
+	}
+	*nt = parsed
 	return nil
 }
 
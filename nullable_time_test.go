@@ -100,3 +100,73 @@ func (s *NullableTimeSuite) TestNullableTime_DatabaseInterface() {
 		s.False(nt.Valid)
 	})
 }
+
+func (s *NullableTimeSuite) TestNullableTime_Constructors() {
+	s.Run("NewNullableTimeFromDate", func() {
+		d, _ := wisp.NewDate(2025, time.March, 10)
+		nt := wisp.NewNullableTimeFromDate(d)
+		s.True(nt.Valid)
+		s.Equal(time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC), nt.Time)
+
+		s.False(wisp.NewNullableTimeFromDate(wisp.ZeroDate).Valid)
+	})
+
+	s.Run("NewNullableTimeFromPtr and Ptr", func() {
+		t := time.Date(2025, 9, 9, 12, 30, 0, 0, time.UTC)
+		nt := wisp.NewNullableTimeFromPtr(&t)
+		s.True(nt.Valid)
+
+		ptr := nt.Ptr()
+		s.Require().NotNil(ptr)
+		s.Equal(t, *ptr)
+		*ptr = ptr.Add(time.Hour)
+		s.Equal(t, nt.Time)
+
+		s.False(wisp.NewNullableTimeFromPtr(nil).Valid)
+		s.Nil(wisp.EmptyNullableTime.Ptr())
+	})
+
+	s.Run("ValueOr", func() {
+		t := time.Date(2025, 9, 9, 12, 30, 0, 0, time.UTC)
+		def := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		s.Equal(t, wisp.NewNullableTime(t).ValueOr(def))
+		s.Equal(def, wisp.EmptyNullableTime.ValueOr(def))
+	})
+}
+
+func (s *NullableTimeSuite) TestNullableTime_Text() {
+	t := time.Date(2025, 9, 9, 12, 30, 0, 500, time.FixedZone("BRT", -3*3600))
+	nt := wisp.NewNullableTime(t)
+
+	text, err := nt.MarshalText()
+	s.Require().NoError(err)
+	s.Equal("2025-09-09T12:30:00.0000005-03:00", string(text))
+	s.Equal(string(text), nt.String())
+
+	var decoded wisp.NullableTime
+	s.Require().NoError(decoded.UnmarshalText(text))
+	s.True(decoded.Time.Equal(t))
+
+	s.Require().NoError(decoded.UnmarshalText(nil))
+	s.False(decoded.Valid)
+
+	text, err = wisp.EmptyNullableTime.MarshalText()
+	s.Require().NoError(err)
+	s.Empty(text)
+
+	s.Error(decoded.UnmarshalText([]byte("09/09/2025")))
+}
+
+func (s *NullableTimeSuite) TestNullableTime_StrictJSON() {
+	var nt wisp.NullableTime
+	s.Error(json.Unmarshal([]byte(`""`), &nt))
+	s.Error(json.Unmarshal([]byte(`"2025-09-09"`), &nt))
+	s.Error(json.Unmarshal([]byte(`"2025-09-09 12:30:00"`), &nt))
+	s.Error(json.Unmarshal([]byte(`1757421000`), &nt))
+
+	s.Require().NoError(json.Unmarshal([]byte(`"2025-09-09T12:30:00Z"`), &nt))
+	s.True(nt.Valid)
+
+	s.Require().NoError(json.Unmarshal([]byte(`null`), &nt))
+	s.Equal(wisp.EmptyNullableTime, nt)
+}
@@ -0,0 +1,309 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+const maxNumberSequenceWidth = 18
+
+// NumberSequence is a value object describing a series of sequentially numbered documents,
+// formatted as a fixed prefix followed by a zero-padded number (e.g., "REC-000123"),
+// together with the last number issued. It validates that numbers in the series are strictly
+// increasing and reports the gaps between them, as required by fiscal document series audits.
+//
+// All operations are immutable, returning a new NumberSequence instance.
+//
+// Example:
+//   seq, _ := wisp.NewNumberSequence("REC-", 6, 122)
+//   seq, _ = seq.Next()
+//   seq.String() // "REC-000123"
+//   gaps, err := seq.Gaps([]string{"REC-000001", "REC-000002", "REC-000005"})
+//   gaps[0].String() // "3-4"
+type NumberSequence struct {
+	prefix  string
+	width   int
+	current int64
+}
+
+// ZeroNumberSequence represents the zero value for the NumberSequence type.
+var ZeroNumberSequence NumberSequence
+
+// NewNumberSequence creates a new NumberSequence.
+// The width must be between 1 and 18 digits, the prefix cannot end with a digit (it would be
+// ambiguous with the number), and current is the last number issued (0 when none was issued yet).
+func NewNumberSequence(prefix string, width int, current int64) (NumberSequence, error) {
+	if width < 1 || width > maxNumberSequenceWidth {
+		return ZeroNumberSequence, fault.New(
+			"number sequence width must be between 1 and 18",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("width", width),
+		)
+	}
+	if prefix != "" && isDigit(prefix[len(prefix)-1]) {
+		return ZeroNumberSequence, fault.New(
+			"number sequence prefix cannot end with a digit",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("prefix", prefix),
+		)
+	}
+
+	seq := NumberSequence{prefix: prefix, width: width}
+	if current < 0 || current > seq.max() {
+		return ZeroNumberSequence, fault.New(
+			"current number is outside the range allowed by the width",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("current", current),
+			fault.WithContext("width", width),
+		)
+	}
+	seq.current = current
+	return seq, nil
+}
+
+// Prefix returns the fixed prefix of the formatted numbers.
+func (s NumberSequence) Prefix() string {
+	return s.prefix
+}
+
+// Width returns the number of digits of the formatted numbers.
+func (s NumberSequence) Width() int {
+	return s.width
+}
+
+// Current returns the last number issued, or 0 if none was issued yet.
+func (s NumberSequence) Current() int64 {
+	return s.current
+}
+
+// IsZero returns true if the NumberSequence is the zero value.
+func (s NumberSequence) IsZero() bool {
+	return s == ZeroNumberSequence
+}
+
+// max returns the largest number that fits in the width.
+func (s NumberSequence) max() int64 {
+	return pow10Int64(s.width) - 1
+}
+
+// Next returns the sequence advanced to the next number.
+// It returns an error if the width has no room for another number.
+func (s NumberSequence) Next() (NumberSequence, error) {
+	if s.current >= s.max() {
+		return s, fault.New(
+			"number sequence is exhausted",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("current", s.String()),
+		)
+	}
+	s.current++
+	return s, nil
+}
+
+// Format returns n formatted with the prefix and zero padding of the sequence.
+// It returns an error if n is not positive or does not fit in the width.
+func (s NumberSequence) Format(n int64) (string, error) {
+	if n < 1 || n > s.max() {
+		return "", fault.New(
+			"number is outside the range allowed by the sequence",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("number", n),
+			fault.WithContext("width", s.width),
+		)
+	}
+	return s.format(n), nil
+}
+
+// format pads n to the width and adds the prefix, without validation.
+func (s NumberSequence) format(n int64) string {
+	return fmt.Sprintf("%s%0*d", s.prefix, s.width, n)
+}
+
+// Parse extracts the number from a formatted value of the sequence.
+// It returns an error if the prefix or the number of digits does not match, or the number is zero.
+func (s NumberSequence) Parse(value string) (int64, error) {
+	digits, ok := strings.CutPrefix(strings.TrimSpace(value), s.prefix)
+	if !ok || len(digits) != s.width || extractDigits(digits, nil) != s.width {
+		return 0, fault.New(
+			"value does not match the sequence format",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("value", value),
+			fault.WithContext("expected_format", s.format(0)),
+		)
+	}
+	n, _ := strconv.ParseInt(digits, 10, 64)
+	if n == 0 {
+		return 0, fault.New("sequence numbers start at 1", fault.WithCode(fault.Invalid), fault.WithContext("value", value))
+	}
+	return n, nil
+}
+
+// Validate checks that the formatted values belong to the sequence and are strictly increasing.
+// It returns a fault.DomainViolation error at the first value that repeats or goes backwards.
+func (s NumberSequence) Validate(values []string) error {
+	_, err := s.parseIncreasing(values)
+	return err
+}
+
+// parseIncreasing parses the values and checks that they are strictly increasing.
+func (s NumberSequence) parseIncreasing(values []string) ([]int64, error) {
+	numbers := make([]int64, len(values))
+	for i, v := range values {
+		n, err := s.Parse(v)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && n <= numbers[i-1] {
+			return nil, fault.New(
+				"sequence numbers must be strictly increasing",
+				fault.WithCode(fault.DomainViolation),
+				fault.WithContext("previous", values[i-1]),
+				fault.WithContext("next", v),
+			)
+		}
+		numbers[i] = n
+	}
+	return numbers, nil
+}
+
+// Gaps validates the values like Validate and returns the ranges of numbers missing between them,
+// in order. An empty result means the values are contiguous.
+func (s NumberSequence) Gaps(values []string) ([]NumberGap, error) {
+	numbers, err := s.parseIncreasing(values)
+	if err != nil {
+		return nil, err
+	}
+
+	gaps := []NumberGap{}
+	for i := 1; i < len(numbers); i++ {
+		if numbers[i] > numbers[i-1]+1 {
+			gaps = append(gaps, NumberGap{first: numbers[i-1] + 1, last: numbers[i] - 1})
+		}
+	}
+	return gaps, nil
+}
+
+// String returns the last number issued formatted (e.g., "REC-000123"),
+// or an empty string if none was issued yet.
+func (s NumberSequence) String() string {
+	if s.current == 0 {
+		return ""
+	}
+	return s.format(s.current)
+}
+
+// NumberGap is a range of consecutive numbers missing from a sequence, inclusive.
+type NumberGap struct {
+	first int64
+	last  int64
+}
+
+// First returns the first missing number.
+func (g NumberGap) First() int64 {
+	return g.first
+}
+
+// Last returns the last missing number.
+func (g NumberGap) Last() int64 {
+	return g.last
+}
+
+// Count returns how many numbers are missing.
+func (g NumberGap) Count() int64 {
+	return g.last - g.first + 1
+}
+
+// String returns the gap like "3-4", or a single number like "7" when only one is missing.
+func (g NumberGap) String() string {
+	if g.first == g.last {
+		return strconv.FormatInt(g.first, 10)
+	}
+	return fmt.Sprintf("%d-%d", g.first, g.last)
+}
+
+// numberSequenceJSON is the JSON representation of a NumberSequence.
+type numberSequenceJSON struct {
+	Prefix  string `json:"prefix"`
+	Width   int    `json:"width"`
+	Current int64  `json:"current"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the sequence to a JSON object with "prefix", "width" and "current" fields,
+// or null for the zero value.
+func (s NumberSequence) MarshalJSON() ([]byte, error) {
+	if s.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(numberSequenceJSON{Prefix: s.prefix, Width: s.width, Current: s.current})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object into a NumberSequence, with validation; null results in
+// ZeroNumberSequence.
+func (s *NumberSequence) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = ZeroNumberSequence
+		return nil
+	}
+
+	var dto numberSequenceJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for NumberSequence", fault.WithCode(fault.Invalid))
+	}
+
+	seq, err := NewNumberSequence(dto.Prefix, dto.Width, dto.Current)
+	if err != nil {
+		return err
+	}
+	*s = seq
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the sequence as a JSON string or nil if it's the zero value.
+func (s NumberSequence) Value() (driver.Value, error) {
+	if s.IsZero() {
+		return nil, nil
+	}
+
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal number sequence for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as NumberSequence.
+func (s *NumberSequence) Scan(src interface{}) error {
+	if src == nil {
+		*s = ZeroNumberSequence
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for NumberSequence",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return s.UnmarshalJSON(data)
+}
@@ -0,0 +1,176 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type NumberSequenceSuite struct {
+	suite.Suite
+}
+
+func TestNumberSequenceSuite(t *testing.T) {
+	suite.Run(t, new(NumberSequenceSuite))
+}
+
+func (s *NumberSequenceSuite) TestNewNumberSequence() {
+	s.Run("should create a sequence", func() {
+		seq, err := wisp.NewNumberSequence("REC-", 6, 122)
+		s.Require().NoError(err)
+		s.Equal("REC-", seq.Prefix())
+		s.Equal(6, seq.Width())
+		s.Equal(int64(122), seq.Current())
+		s.Equal("REC-000122", seq.String())
+	})
+
+	s.Run("should allow a new sequence without numbers issued", func() {
+		seq, err := wisp.NewNumberSequence("", 3, 0)
+		s.Require().NoError(err)
+		s.Equal("", seq.String())
+	})
+
+	s.Run("should reject invalid configurations", func() {
+		_, err := wisp.NewNumberSequence("REC-", 0, 0)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+		_, err = wisp.NewNumberSequence("REC-", 19, 0)
+		s.Require().Error(err)
+
+		_, err = wisp.NewNumberSequence("2024", 4, 0)
+		s.Require().Error(err)
+
+		_, err = wisp.NewNumberSequence("REC-", 3, 1000)
+		s.Require().Error(err)
+
+		_, err = wisp.NewNumberSequence("REC-", 3, -1)
+		s.Require().Error(err)
+	})
+}
+
+func (s *NumberSequenceSuite) TestNext() {
+	seq, _ := wisp.NewNumberSequence("A", 2, 98)
+
+	next, err := seq.Next()
+	s.Require().NoError(err)
+	s.Equal("A99", next.String())
+	s.Equal(int64(98), seq.Current(), "original should be unchanged")
+
+	_, err = next.Next()
+	s.Require().Error(err)
+	s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+}
+
+func (s *NumberSequenceSuite) TestFormatAndParse() {
+	seq, _ := wisp.NewNumberSequence("REC-", 6, 0)
+
+	formatted, err := seq.Format(42)
+	s.Require().NoError(err)
+	s.Equal("REC-000042", formatted)
+
+	_, err = seq.Format(0)
+	s.Require().Error(err)
+	_, err = seq.Format(1_000_000)
+	s.Require().Error(err)
+
+	n, err := seq.Parse(" REC-000042 ")
+	s.Require().NoError(err)
+	s.Equal(int64(42), n)
+
+	for _, invalid := range []string{"INV-000042", "REC-42", "REC-0000042", "REC-00004a", "REC-000000", ""} {
+		_, err := seq.Parse(invalid)
+		s.Error(err, invalid)
+	}
+}
+
+func (s *NumberSequenceSuite) TestValidateAndGaps() {
+	seq, _ := wisp.NewNumberSequence("REC-", 6, 0)
+
+	s.Run("should accept a contiguous series", func() {
+		values := []string{"REC-000001", "REC-000002", "REC-000003"}
+		s.NoError(seq.Validate(values))
+
+		gaps, err := seq.Gaps(values)
+		s.Require().NoError(err)
+		s.Empty(gaps)
+	})
+
+	s.Run("should report gaps", func() {
+		gaps, err := seq.Gaps([]string{"REC-000001", "REC-000002", "REC-000005", "REC-000007", "REC-000008"})
+		s.Require().NoError(err)
+		s.Require().Len(gaps, 2)
+
+		s.Equal(int64(3), gaps[0].First())
+		s.Equal(int64(4), gaps[0].Last())
+		s.Equal(int64(2), gaps[0].Count())
+		s.Equal("3-4", gaps[0].String())
+		s.Equal("6", gaps[1].String())
+	})
+
+	s.Run("should reject repeated or decreasing numbers", func() {
+		err := seq.Validate([]string{"REC-000001", "REC-000003", "REC-000002"})
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+
+		_, err = seq.Gaps([]string{"REC-000001", "REC-000001"})
+		s.Require().Error(err)
+	})
+
+	s.Run("should reject values outside the sequence", func() {
+		err := seq.Validate([]string{"REC-000001", "INV-000002"})
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *NumberSequenceSuite) TestJSONAndSQL() {
+	seq, _ := wisp.NewNumberSequence("REC-", 6, 122)
+
+	s.Run("should round trip through JSON", func() {
+		data, err := json.Marshal(seq)
+		s.Require().NoError(err)
+		s.JSONEq(`{"prefix":"REC-","width":6,"current":122}`, string(data))
+
+		var decoded wisp.NumberSequence
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(seq, decoded)
+
+		s.Error(json.Unmarshal([]byte(`{"prefix":"REC-","width":0,"current":1}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`"REC-000001"`), &decoded))
+	})
+
+	s.Run("should round trip the zero value through JSON as null", func() {
+		var holder struct {
+			Sequence wisp.NumberSequence `json:"sequence"`
+		}
+		data, err := json.Marshal(holder)
+		s.Require().NoError(err)
+		s.JSONEq(`{"sequence":null}`, string(data))
+
+		holder.Sequence = seq
+		s.Require().NoError(json.Unmarshal(data, &holder))
+		s.True(holder.Sequence.IsZero())
+	})
+
+	s.Run("should round trip through the database", func() {
+		val, err := seq.Value()
+		s.Require().NoError(err)
+
+		var scanned wisp.NumberSequence
+		s.Require().NoError(scanned.Scan(val))
+		s.Equal(seq, scanned)
+
+		val, err = wisp.ZeroNumberSequence.Value()
+		s.Require().NoError(err)
+		s.Nil(val)
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+		s.Error(scanned.Scan(1))
+	})
+}
@@ -0,0 +1,247 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// defaultOrderNumberPadding is the default number of digits of the sequence in an OrderNumber.
+// It can be configured globally using SetOrderNumberPadding.
+var defaultOrderNumberPadding = 6
+
+// SetOrderNumberPadding configures the global number of digits the sequence is zero-padded to
+// when an OrderNumber is formatted (e.g., 6 formats sequence 123 as "000123").
+// The padding must be between 1 and 18.
+func SetOrderNumberPadding(digits int) {
+	if digits > 0 && digits <= 18 {
+		defaultOrderNumberPadding = digits
+	}
+}
+
+// orderNumberRegex matches the "PREFIX-YYYY-SEQUENCE" format.
+var orderNumberRegex = regexp.MustCompile(`^([A-Z]{1,10})-(\d{4})-(\d+)$`)
+
+// orderNumberPrefixRegex validates the prefix of an OrderNumber.
+var orderNumberPrefixRegex = regexp.MustCompile(`^[A-Z]{1,10}$`)
+
+// OrderNumber is a value object representing a user-facing, sequential order identifier
+// composed of a prefix, a year and a zero-padded sequence (e.g., "PED-2025-000123").
+// The sequence usually comes from a per-year database counter.
+//
+// Example:
+//   n, _ := wisp.NewOrderNumber("PED", 2025, 123)
+//   n.String()                                  // "PED-2025-000123"
+//   parsed, _ := wisp.ParseOrderNumber("PED-2025-000123")
+//   parsed.Sequence()                           // 123
+type OrderNumber struct {
+	prefix   string
+	year     int
+	sequence int64
+}
+
+// ZeroOrderNumber represents the zero value for OrderNumber.
+var ZeroOrderNumber = OrderNumber{}
+
+// NewOrderNumber creates a new OrderNumber from its components.
+// The prefix is normalized to uppercase and must have 1 to 10 letters, the year must have
+// four digits and the sequence must be positive.
+func NewOrderNumber(prefix string, year int, sequence int64) (OrderNumber, error) {
+	prefix = strings.ToUpper(strings.TrimSpace(prefix))
+
+	if !orderNumberPrefixRegex.MatchString(prefix) {
+		return ZeroOrderNumber, fault.New(
+			"order number prefix must have 1 to 10 letters",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("prefix", prefix),
+		)
+	}
+	if year < 1000 || year > 9999 {
+		return ZeroOrderNumber, fault.New(
+			"order number year must have four digits",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("year", year),
+		)
+	}
+	if sequence <= 0 {
+		return ZeroOrderNumber, fault.New(
+			"order number sequence must be positive",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("sequence", sequence),
+		)
+	}
+
+	return OrderNumber{prefix: prefix, year: year, sequence: sequence}, nil
+}
+
+// ParseOrderNumber parses a formatted order number (e.g., "PED-2025-000123") back into its components.
+// The sequence must be zero-padded to exactly the configured padding, unless it needs more digits,
+// in which case it must not have leading zeros.
+func ParseOrderNumber(value string) (OrderNumber, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+
+	match := orderNumberRegex.FindStringSubmatch(normalized)
+	if match == nil {
+		return ZeroOrderNumber, fault.New(
+			"order number must be in PREFIX-YYYY-SEQUENCE format",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+		)
+	}
+
+	digits := match[3]
+	if len(digits) < defaultOrderNumberPadding || (len(digits) > defaultOrderNumberPadding && digits[0] == '0') {
+		return ZeroOrderNumber, fault.New(
+			"order number sequence has invalid padding",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+			fault.WithContext("expected_digits", defaultOrderNumberPadding),
+		)
+	}
+
+	year, _ := strconv.Atoi(match[2])
+	sequence, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return ZeroOrderNumber, fault.Wrap(err, "order number sequence is out of range",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+		)
+	}
+
+	return NewOrderNumber(match[1], year, sequence)
+}
+
+// Prefix returns the prefix of the order number (e.g., "PED").
+func (o OrderNumber) Prefix() string {
+	return o.prefix
+}
+
+// Year returns the year of the order number.
+func (o OrderNumber) Year() int {
+	return o.year
+}
+
+// Sequence returns the sequence of the order number.
+func (o OrderNumber) Sequence() int64 {
+	return o.sequence
+}
+
+// Next returns the order number with the following sequence in the same prefix and year.
+// It returns an error if the sequence has reached its maximum value.
+func (o OrderNumber) Next() (OrderNumber, error) {
+	if o.sequence == math.MaxInt64 {
+		return o, fault.New(
+			"order number sequence is exhausted",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("current", o.String()),
+		)
+	}
+	return OrderNumber{prefix: o.prefix, year: o.year, sequence: o.sequence + 1}, nil
+}
+
+// Compare compares two order numbers by prefix, year and sequence, returning -1, 0 or +1.
+func (o OrderNumber) Compare(other OrderNumber) int {
+	switch {
+	case o.prefix != other.prefix:
+		return strings.Compare(o.prefix, other.prefix)
+	case o.year != other.year:
+		if o.year < other.year {
+			return -1
+		}
+		return 1
+	case o.sequence < other.sequence:
+		return -1
+	case o.sequence > other.sequence:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsZero returns true if the OrderNumber is the zero value.
+func (o OrderNumber) IsZero() bool {
+	return o == ZeroOrderNumber
+}
+
+// String returns the formatted order number (e.g., "PED-2025-000123"), or an empty string for the zero value.
+func (o OrderNumber) String() string {
+	if o.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%s-%04d-%0*d", o.prefix, o.year, defaultOrderNumberPadding, o.sequence)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the OrderNumber as its formatted string, or null if it's the zero value.
+func (o OrderNumber) MarshalJSON() ([]byte, error) {
+	if o.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a formatted JSON string into an OrderNumber.
+func (o *OrderNumber) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = ZeroOrderNumber
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "OrderNumber must be a valid JSON string or null", fault.WithCode(fault.Invalid))
+	}
+
+	n, err := ParseOrderNumber(s)
+	if err != nil {
+		return err
+	}
+	*o = n
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the formatted order number or nil if it's the zero value.
+func (o OrderNumber) Value() (driver.Value, error) {
+	if o.IsZero() {
+		return nil, nil
+	}
+	return o.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values and parses them as an OrderNumber.
+func (o *OrderNumber) Scan(src interface{}) error {
+	if src == nil {
+		*o = ZeroOrderNumber
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for OrderNumber",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	n, err := ParseOrderNumber(s)
+	if err != nil {
+		return err
+	}
+	*o = n
+	return nil
+}
@@ -0,0 +1,137 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type OrderNumberSuite struct {
+	suite.Suite
+}
+
+func TestOrderNumberSuite(t *testing.T) {
+	suite.Run(t, new(OrderNumberSuite))
+}
+
+func (s *OrderNumberSuite) SetupTest() {
+	wisp.SetOrderNumberPadding(6)
+}
+
+func (s *OrderNumberSuite) TestNewOrderNumber() {
+	n, err := wisp.NewOrderNumber(" ped ", 2025, 123)
+	s.Require().NoError(err)
+	s.Equal("PED", n.Prefix())
+	s.Equal(2025, n.Year())
+	s.Equal(int64(123), n.Sequence())
+	s.Equal("PED-2025-000123", n.String())
+	next, err := n.Next()
+	s.Require().NoError(err)
+	s.Equal("PED-2025-000124", next.String())
+
+	last, _ := wisp.NewOrderNumber("PED", 2025, math.MaxInt64)
+	_, err = last.Next()
+	s.Require().Error(err)
+	s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+
+	big, _ := wisp.NewOrderNumber("PED", 2025, 1234567)
+	s.Equal("PED-2025-1234567", big.String())
+
+	invalid := []struct {
+		prefix   string
+		year     int
+		sequence int64
+	}{
+		{prefix: "", year: 2025, sequence: 1},
+		{prefix: "PED1", year: 2025, sequence: 1},
+		{prefix: "ABCDEFGHIJK", year: 2025, sequence: 1},
+		{prefix: "PED", year: 25, sequence: 1},
+		{prefix: "PED", year: 2025, sequence: 0},
+	}
+	for _, tc := range invalid {
+		_, err := wisp.NewOrderNumber(tc.prefix, tc.year, tc.sequence)
+		s.Require().Error(err, "%+v", tc)
+	}
+}
+
+func (s *OrderNumberSuite) TestParseOrderNumber() {
+	testCases := []struct {
+		name        string
+		input       string
+		expected    string
+		expectError bool
+	}{
+		{name: "valid", input: "PED-2025-000123", expected: "PED-2025-000123"},
+		{name: "lowercase", input: " ped-2025-000001 ", expected: "PED-2025-000001"},
+		{name: "overflowing padding", input: "PED-2025-1000000", expected: "PED-2025-1000000"},
+		{name: "short padding", input: "PED-2025-123", expectError: true},
+		{name: "extra leading zeros", input: "PED-2025-0000123", expectError: true},
+		{name: "sequence out of range", input: "PED-2025-9223372036854775808", expectError: true},
+		{name: "zero sequence", input: "PED-2025-000000", expectError: true},
+		{name: "missing year", input: "PED-000123", expectError: true},
+		{name: "garbage", input: "order 123", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			n, err := wisp.ParseOrderNumber(tc.input)
+			if tc.expectError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.expected, n.String())
+		})
+	}
+
+	s.Run("should honor the configured padding", func() {
+		wisp.SetOrderNumberPadding(4)
+		n, err := wisp.ParseOrderNumber("PED-2025-0042")
+		s.Require().NoError(err)
+		s.Equal("PED-2025-0042", n.String())
+	})
+}
+
+func (s *OrderNumberSuite) TestCompare() {
+	a, _ := wisp.NewOrderNumber("PED", 2024, 900)
+	b, _ := wisp.NewOrderNumber("PED", 2025, 1)
+	c, _ := wisp.NewOrderNumber("PED", 2025, 2)
+
+	s.Equal(-1, a.Compare(b))
+	s.Equal(-1, b.Compare(c))
+	s.Equal(1, c.Compare(a))
+	next, _ := b.Next()
+	s.Equal(0, c.Compare(next))
+}
+
+func (s *OrderNumberSuite) TestSerialization() {
+	n, _ := wisp.NewOrderNumber("PED", 2025, 7)
+
+	data, err := json.Marshal(n)
+	s.Require().NoError(err)
+	s.Equal(`"PED-2025-000007"`, string(data))
+
+	var decoded wisp.OrderNumber
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(n, decoded)
+	s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.True(decoded.IsZero())
+	s.Require().Error(json.Unmarshal([]byte(`"PED-2025-7"`), &decoded))
+	s.Require().Error(json.Unmarshal([]byte(`"PED-2025-000000"`), &decoded))
+
+	val, err := n.Value()
+	s.Require().NoError(err)
+	s.Equal("PED-2025-000007", val)
+
+	var scanned wisp.OrderNumber
+	s.Require().NoError(scanned.Scan([]byte("PED-2025-000007")))
+	s.Equal(n, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Require().Error(scanned.Scan(7))
+}
@@ -0,0 +1,355 @@
+package wisp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"database/sql/driver"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+)
+
+const (
+	// TOTPDigits is the number of digits of the codes produced by a TOTPSecret.
+	TOTPDigits = 6
+	// TOTPPeriod is the time step of the codes produced by a TOTPSecret.
+	TOTPPeriod = 30 * time.Second
+
+	minTOTPSecretBytes = 10 // 80 bits, the shortest secret issued by common authenticator apps.
+	totpSecretBytes    = 20 // 160 bits, the length recommended by RFC 4226.
+
+	minOneTimeCodeLength = 4
+	maxOneTimeCodeLength = 10
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TOTPSecret is a value object holding the shared key of a time-based one-time password
+// (RFC 6238), as enrolled in authenticator apps for 2FA. Codes have TOTPDigits digits, change
+// every TOTPPeriod and use HMAC-SHA1, the parameters every authenticator app supports.
+//
+// Like Secret, it never leaks when printed or serialized to JSON ("[REDACTED]"); the base32 key is
+// only available through Reveal and the database interface, which should point to encrypted storage.
+//
+// The zero value is EmptyTOTPSecret.
+//
+// Example:
+//   secret, err := wisp.GenerateTOTPSecret()
+//   uri := secret.ProvisioningURI("Acme", "ana@example.com") // rendered as a QR code
+//   ok := secret.Verify(input, time.Now(), 1)                  // accepts one step of clock drift
+type TOTPSecret struct {
+	key []byte
+}
+
+// EmptyTOTPSecret represents the zero value for TOTPSecret.
+var EmptyTOTPSecret TOTPSecret
+
+// GenerateTOTPSecret creates a new random 160-bit TOTPSecret.
+func GenerateTOTPSecret() (TOTPSecret, error) {
+	key := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(key); err != nil {
+		return EmptyTOTPSecret, fault.Wrap(err, "failed to generate TOTP secret", fault.WithCode(fault.Internal))
+	}
+	return TOTPSecret{key: key}, nil
+}
+
+// NewTOTPSecret creates a TOTPSecret from its base32 representation. Case, spaces, hyphens and
+// padding are ignored, so keys typed from a setup screen ("jbsw y3dp ehpk 3pxp") are accepted.
+// It returns an error if the key is not valid base32 or is shorter than 80 bits.
+func NewTOTPSecret(encoded string) (TOTPSecret, error) {
+	normalized := strings.ToUpper(strings.NewReplacer(" ", "", "-", "", "=", "").Replace(encoded))
+	key, err := totpEncoding.DecodeString(normalized)
+	if err != nil {
+		return EmptyTOTPSecret, fault.Wrap(err, "TOTP secret must be valid base32", fault.WithCode(fault.Invalid))
+	}
+	if len(key) < minTOTPSecretBytes {
+		return EmptyTOTPSecret, fault.New(
+			"TOTP secret is too short",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("bits", len(key)*8),
+			fault.WithContext("min_bits", minTOTPSecretBytes*8),
+		)
+	}
+	return TOTPSecret{key: key}, nil
+}
+
+// Reveal returns the key in unpadded base32, the form users type into authenticator apps.
+func (s TOTPSecret) Reveal() string {
+	return totpEncoding.EncodeToString(s.key)
+}
+
+// IsZero returns true if the TOTPSecret is the zero value.
+func (s TOTPSecret) IsZero() bool {
+	return len(s.key) == 0
+}
+
+// ProvisioningURI returns the "otpauth://" URI that authenticator apps read from a QR code.
+// The issuer (e.g., the product name) and the account (e.g., the user's email) are shown in the app.
+// Returns an empty string for EmptyTOTPSecret.
+func (s TOTPSecret) ProvisioningURI(issuer, account string) string {
+	if s.IsZero() {
+		return ""
+	}
+
+	label := url.PathEscape(account)
+	if issuer != "" {
+		label = url.PathEscape(issuer) + ":" + label
+	}
+
+	query := url.Values{}
+	query.Set("secret", s.Reveal())
+	if issuer != "" {
+		query.Set("issuer", issuer)
+	}
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(TOTPDigits))
+	query.Set("period", fmt.Sprint(int(TOTPPeriod.Seconds())))
+
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// Code returns the one-time code valid at the given time, expiring at the end of its time step.
+// Returns the zero OneTimeCode for EmptyTOTPSecret.
+func (s TOTPSecret) Code(at time.Time) OneTimeCode {
+	if s.IsZero() {
+		return OneTimeCode{}
+	}
+	step := at.Unix() / int64(TOTPPeriod.Seconds())
+	expiresAt := time.Unix((step+1)*int64(TOTPPeriod.Seconds()), 0)
+	return OneTimeCode{code: s.codeAt(step), expiresAt: expiresAt}
+}
+
+// Verify checks whether the input is the code of the time step containing at, or of up to skew
+// steps before or after it, to tolerate clock drift. Comparisons run in constant time.
+func (s TOTPSecret) Verify(input string, at time.Time, skew int) bool {
+	if s.IsZero() || len(input) != TOTPDigits {
+		return false
+	}
+	step := at.Unix() / int64(TOTPPeriod.Seconds())
+	matched := 0
+	for i := -skew; i <= skew; i++ {
+		matched |= subtle.ConstantTimeCompare([]byte(s.codeAt(step+int64(i))), []byte(input))
+	}
+	return matched == 1
+}
+
+// codeAt computes the HOTP value (RFC 4226) for the given counter.
+func (s TOTPSecret) codeAt(counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, s.key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", TOTPDigits, value%uint32(pow10Int64(TOTPDigits)))
+}
+
+// String returns "[REDACTED]", so the secret is never printed by accident.
+func (s TOTPSecret) String() string {
+	return redactedSecret
+}
+
+// GoString returns "[REDACTED]", protecting the secret from the %#v verb.
+func (s TOTPSecret) GoString() string {
+	return redactedSecret
+}
+
+// Masked implements the Masker interface, so Redact hides the secret as well.
+func (s TOTPSecret) Masked() string {
+	return redactedSecret
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It always serializes the TOTPSecret as "[REDACTED]".
+func (s TOTPSecret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redactedSecret)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a base32 JSON string into a TOTPSecret; null results in EmptyTOTPSecret.
+func (s *TOTPSecret) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = EmptyTOTPSecret
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fault.Wrap(err, "TOTPSecret must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	secret, err := NewTOTPSecret(str)
+	if err != nil {
+		return err
+	}
+	*s = secret
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the key in base32, or nil if it is empty.
+func (s TOTPSecret) Value() (driver.Value, error) {
+	if s.IsZero() {
+		return nil, nil
+	}
+	return s.Reveal(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a base32 string or byte slice and converts it into a TOTPSecret, with validation.
+func (s *TOTPSecret) Scan(src interface{}) error {
+	if src == nil {
+		*s = EmptyTOTPSecret
+		return nil
+	}
+
+	var str string
+	switch v := src.(type) {
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for TOTPSecret",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	secret, err := NewTOTPSecret(str)
+	if err != nil {
+		return err
+	}
+	*s = secret
+	return nil
+}
+
+// OneTimeCode is a value object representing a short numeric code with an expiry, such as a 2FA
+// code sent by SMS or email, or a TOTP code. Matching is done in constant time and fails once the
+// code has expired.
+//
+// Like Secret, the code is "[REDACTED]" when printed; use Reveal to send it to the user.
+// It has no JSON or database interface on purpose: pending codes should be stored hashed.
+//
+// Example:
+//   code, err := wisp.GenerateOneTimeCode(6, 10*time.Minute)
+//   sms.Send(phone, "Seu código: "+code.Reveal())
+//   ok := code.Matches(input, time.Now())
+type OneTimeCode struct {
+	code      string
+	expiresAt time.Time
+}
+
+// GenerateOneTimeCode creates a random code with the given number of digits (4 to 10),
+// valid for ttl from now.
+func GenerateOneTimeCode(length int, ttl time.Duration) (OneTimeCode, error) {
+	if err := validateOneTimeCodeLength(length); err != nil {
+		return OneTimeCode{}, err
+	}
+	if ttl <= 0 {
+		return OneTimeCode{}, fault.New(
+			"one-time code validity must be positive",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("ttl", ttl.String()),
+		)
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(pow10Int64(length)))
+	if err != nil {
+		return OneTimeCode{}, fault.Wrap(err, "failed to generate one-time code", fault.WithCode(fault.Internal))
+	}
+	return OneTimeCode{code: fmt.Sprintf("%0*d", length, n.Int64()), expiresAt: time.Now().Add(ttl)}, nil
+}
+
+// NewOneTimeCode creates a OneTimeCode from existing digits and their expiry.
+// It returns an error if the code is not 4 to 10 digits or the expiry is the zero time.
+func NewOneTimeCode(code string, expiresAt time.Time) (OneTimeCode, error) {
+	if err := validateOneTimeCodeLength(len(code)); err != nil {
+		return OneTimeCode{}, err
+	}
+	for i := 0; i < len(code); i++ {
+		if !isDigit(code[i]) {
+			return OneTimeCode{}, fault.New("one-time code must contain only digits", fault.WithCode(fault.Invalid))
+		}
+	}
+	if expiresAt.IsZero() {
+		return OneTimeCode{}, fault.New("one-time code requires an expiry", fault.WithCode(fault.Invalid))
+	}
+	return OneTimeCode{code: code, expiresAt: expiresAt}, nil
+}
+
+// validateOneTimeCodeLength checks the number of digits of a one-time code.
+func validateOneTimeCodeLength(length int) error {
+	if length < minOneTimeCodeLength || length > maxOneTimeCodeLength {
+		return fault.New(
+			"one-time code length is outside the allowed range",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("length", length),
+			fault.WithContext("min", minOneTimeCodeLength),
+			fault.WithContext("max", maxOneTimeCodeLength),
+		)
+	}
+	return nil
+}
+
+// Reveal returns the digits of the code, to be delivered to the user.
+func (c OneTimeCode) Reveal() string {
+	return c.code
+}
+
+// Len returns the number of digits of the code.
+func (c OneTimeCode) Len() int {
+	return len(c.code)
+}
+
+// ExpiresAt returns the instant the code stops being accepted.
+func (c OneTimeCode) ExpiresAt() time.Time {
+	return c.expiresAt
+}
+
+// IsExpired returns true if the code is no longer valid at the given time.
+func (c OneTimeCode) IsExpired(now time.Time) bool {
+	return !now.Before(c.expiresAt)
+}
+
+// Matches checks, in constant time, whether the input equals the code and the code has not
+// expired at the given time. The zero OneTimeCode never matches.
+func (c OneTimeCode) Matches(input string, now time.Time) bool {
+	if c.IsZero() || c.IsExpired(now) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(c.code), []byte(input)) == 1
+}
+
+// IsZero returns true if the OneTimeCode is the zero value.
+func (c OneTimeCode) IsZero() bool {
+	return c.code == ""
+}
+
+// String returns "[REDACTED]", so the code is never printed by accident.
+func (c OneTimeCode) String() string {
+	return redactedSecret
+}
+
+// GoString returns "[REDACTED]", protecting the code from the %#v verb.
+func (c OneTimeCode) GoString() string {
+	return redactedSecret
+}
+
+// Masked implements the Masker interface, so Redact hides the code as well.
+func (c OneTimeCode) Masked() string {
+	return redactedSecret
+}
@@ -0,0 +1,202 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	wisp "github.com/marcelofabianov/wisp"
+)
+
+// rfc6238Secret is the SHA1 test key of RFC 6238 ("12345678901234567890") in base32.
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+type OTPSuite struct {
+	suite.Suite
+}
+
+func TestOTPSuite(t *testing.T) {
+	suite.Run(t, new(OTPSuite))
+}
+
+func (s *OTPSuite) TestNewTOTPSecret() {
+	s.Run("should normalize typed keys", func() {
+		secret, err := wisp.NewTOTPSecret("gezd gnbv-gy3t qojq gezd gnbv gy3t qojq")
+		s.Require().NoError(err)
+		s.Equal(rfc6238Secret, secret.Reveal())
+	})
+
+	s.Run("should accept padded keys", func() {
+		_, err := wisp.NewTOTPSecret("JBSWY3DPEHPK3PXP====")
+		s.NoError(err)
+	})
+
+	s.Run("should reject invalid keys", func() {
+		for _, input := range []string{"", "JBSWY3DP", "NOT-BASE32-1!"} {
+			secret, err := wisp.NewTOTPSecret(input)
+			s.Require().Error(err, input)
+			s.True(secret.IsZero())
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+
+	s.Run("should generate random 160-bit keys", func() {
+		a, err := wisp.GenerateTOTPSecret()
+		s.Require().NoError(err)
+		b, _ := wisp.GenerateTOTPSecret()
+		s.Len(a.Reveal(), 32)
+		s.NotEqual(a.Reveal(), b.Reveal())
+	})
+}
+
+func (s *OTPSuite) TestTOTPSecret_Codes() {
+	secret, _ := wisp.NewTOTPSecret(rfc6238Secret)
+
+	s.Run("should match the RFC 6238 test vectors", func() {
+		vectors := map[int64]string{59: "287082", 1111111109: "081804", 1234567890: "005924", 2000000000: "279037"}
+		for unix, expected := range vectors {
+			code := secret.Code(time.Unix(unix, 0))
+			s.Equal(expected, code.Reveal(), unix)
+		}
+	})
+
+	s.Run("should expire at the end of the time step", func() {
+		code := secret.Code(time.Unix(59, 0))
+		s.Equal(time.Unix(60, 0), code.ExpiresAt())
+		s.True(code.Matches("287082", time.Unix(59, 0)))
+		s.False(code.Matches("287082", time.Unix(60, 0)))
+	})
+
+	s.Run("should verify with clock drift", func() {
+		at := time.Unix(1111111109, 0)
+		s.True(secret.Verify("081804", at, 0))
+		s.True(secret.Verify("081804", at.Add(wisp.TOTPPeriod), 1))
+		s.False(secret.Verify("081804", at.Add(wisp.TOTPPeriod), 0))
+		s.False(secret.Verify("000000", at, 1))
+		s.False(secret.Verify("81804", at, 1))
+		s.False(wisp.EmptyTOTPSecret.Verify("081804", at, 1))
+	})
+
+	s.Run("should return the zero code for the empty secret", func() {
+		s.True(wisp.EmptyTOTPSecret.Code(time.Now()).IsZero())
+	})
+}
+
+func (s *OTPSuite) TestTOTPSecret_ProvisioningURI() {
+	secret, _ := wisp.NewTOTPSecret(rfc6238Secret)
+
+	uri, err := url.Parse(secret.ProvisioningURI("Acme Corp", "ana@example.com"))
+	s.Require().NoError(err)
+	s.Equal("otpauth", uri.Scheme)
+	s.Equal("totp", uri.Host)
+	s.Equal("/Acme Corp:ana@example.com", uri.Path)
+	s.Equal(rfc6238Secret, uri.Query().Get("secret"))
+	s.Equal("Acme Corp", uri.Query().Get("issuer"))
+	s.Equal("6", uri.Query().Get("digits"))
+	s.Equal("30", uri.Query().Get("period"))
+
+	s.Equal("", wisp.EmptyTOTPSecret.ProvisioningURI("Acme", "ana@example.com"))
+}
+
+func (s *OTPSuite) TestTOTPSecret_NeverLeaks() {
+	secret, _ := wisp.NewTOTPSecret(rfc6238Secret)
+
+	s.Equal("[REDACTED]", secret.String())
+	s.NotContains(fmt.Sprintf("%v %+v %#v", secret, secret, secret), rfc6238Secret)
+
+	data, err := json.Marshal(secret)
+	s.Require().NoError(err)
+	s.Equal(`"[REDACTED]"`, string(data))
+}
+
+func (s *OTPSuite) TestTOTPSecret_Persistence() {
+	secret, _ := wisp.NewTOTPSecret(rfc6238Secret)
+
+	s.Run("should decode JSON input", func() {
+		var decoded wisp.TOTPSecret
+		s.Require().NoError(json.Unmarshal([]byte(`"`+rfc6238Secret+`"`), &decoded))
+		s.Equal(rfc6238Secret, decoded.Reveal())
+		s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+		s.True(decoded.IsZero())
+		s.Error(json.Unmarshal([]byte(`"abc"`), &decoded))
+	})
+
+	s.Run("should round-trip through the database", func() {
+		v, err := secret.Value()
+		s.Require().NoError(err)
+		s.Equal(rfc6238Secret, v)
+
+		var scanned wisp.TOTPSecret
+		s.Require().NoError(scanned.Scan([]byte(rfc6238Secret)))
+		s.Equal(rfc6238Secret, scanned.Reveal())
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+		v, _ = scanned.Value()
+		s.Nil(v)
+
+		err = scanned.Scan(42)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *OTPSuite) TestOneTimeCode() {
+	now := time.Now()
+
+	s.Run("should generate codes with the given length", func() {
+		code, err := wisp.GenerateOneTimeCode(8, time.Minute)
+		s.Require().NoError(err)
+		s.Equal(8, code.Len())
+		s.Regexp(`^\d{8}$`, code.Reveal())
+		s.True(code.Matches(code.Reveal(), time.Now()))
+		s.False(code.IsExpired(time.Now()))
+		s.True(code.IsExpired(time.Now().Add(time.Minute)))
+	})
+
+	s.Run("should reject invalid generation parameters", func() {
+		_, err := wisp.GenerateOneTimeCode(3, time.Minute)
+		s.Error(err)
+		_, err = wisp.GenerateOneTimeCode(11, time.Minute)
+		s.Error(err)
+		_, err = wisp.GenerateOneTimeCode(6, 0)
+		s.Error(err)
+	})
+
+	s.Run("should create codes from digits", func() {
+		code, err := wisp.NewOneTimeCode("012345", now.Add(time.Minute))
+		s.Require().NoError(err)
+		s.True(code.Matches("012345", now))
+		s.False(code.Matches("12345", now))
+		s.False(code.Matches("012346", now))
+		s.False(code.Matches("012345", now.Add(time.Minute)))
+	})
+
+	s.Run("should reject invalid codes", func() {
+		for _, input := range []string{"123", "12345678901", "12a456"} {
+			_, err := wisp.NewOneTimeCode(input, now)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+		_, err := wisp.NewOneTimeCode("123456", time.Time{})
+		s.Error(err)
+	})
+
+	s.Run("should never leak the digits", func() {
+		code, _ := wisp.NewOneTimeCode("987654", now.Add(time.Minute))
+		s.Equal("[REDACTED]", code.String())
+		s.NotContains(fmt.Sprintf("%v %#v", code, code), "987654")
+		s.Equal(map[string]any{"code": "[REDACTED]"}, wisp.Redact(struct {
+			Code wisp.OneTimeCode `json:"code"`
+		}{code}))
+	})
+
+	s.Run("zero code never matches", func() {
+		s.False(wisp.OneTimeCode{}.Matches("", now))
+	})
+}
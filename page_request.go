@@ -0,0 +1,210 @@
+package wisp
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// Defines the default page limits, used until SetPageLimits is called.
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+var (
+	// defaultPageLimit is the limit of a PageRequest that does not specify one.
+	defaultPageLimit = DefaultPageLimit
+	// maxPageLimit is the largest limit accepted by a PageRequest.
+	maxPageLimit = MaxPageLimit
+)
+
+// SetPageLimits configures the limit used when a request does not specify one and the largest
+// limit accepted. Returns an error, without changing the limits, if defaultLimit is less than 1
+// or greater than maxLimit.
+func SetPageLimits(defaultLimit, maxLimit int) error {
+	if defaultLimit < 1 || defaultLimit > maxLimit {
+		return fault.New(
+			"default page limit must be between 1 and the max page limit",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("default_limit", defaultLimit),
+			fault.WithContext("max_limit", maxLimit),
+		)
+	}
+	defaultPageLimit, maxPageLimit = defaultLimit, maxLimit
+	return nil
+}
+
+// ResetPageLimits restores the default page limits (20 by default, at most 100).
+// This is primarily for testing purposes to ensure a clean state.
+func ResetPageLimits() {
+	defaultPageLimit, maxPageLimit = DefaultPageLimit, MaxPageLimit
+}
+
+// PageRequest is a value object representing the page asked by a client of a list endpoint:
+// a limit within bounds and either an offset or a Cursor, never both.
+//
+// The zero value is ZeroPageRequest, which has no limit; use NewPageRequest(0, 0) for the first
+// page with the default limit.
+//
+// Example:
+//   page, err := wisp.ParsePageRequest(r.URL.Query()) // ?limit=50&cursor=AQGW...
+//   rows := query(page.Cursor(), page.Limit()+1)       // one extra row tells if there is a next page
+type PageRequest struct {
+	limit  int
+	offset int
+	cursor Cursor
+}
+
+// ZeroPageRequest represents the zero value for the PageRequest type.
+var ZeroPageRequest PageRequest
+
+// NewPageRequest creates an offset-based PageRequest. A zero limit uses the default page limit.
+// Returns an error if the limit is negative or above the max page limit, or the offset is negative.
+func NewPageRequest(limit, offset int) (PageRequest, error) {
+	l, err := pageLimit(limit)
+	if err != nil {
+		return ZeroPageRequest, err
+	}
+	if offset < 0 {
+		return ZeroPageRequest, fault.New(
+			"page offset cannot be negative",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("offset", offset),
+		)
+	}
+	return PageRequest{limit: l, offset: offset}, nil
+}
+
+// NewCursorPageRequest creates a cursor-based PageRequest; ZeroCursor asks for the first page.
+// A zero limit uses the default page limit.
+// Returns an error if the limit is negative or above the max page limit.
+func NewCursorPageRequest(limit int, cursor Cursor) (PageRequest, error) {
+	l, err := pageLimit(limit)
+	if err != nil {
+		return ZeroPageRequest, err
+	}
+	return PageRequest{limit: l, cursor: cursor}, nil
+}
+
+// pageLimit validates a limit, replacing zero with the default page limit.
+func pageLimit(limit int) (int, error) {
+	if limit == 0 {
+		return defaultPageLimit, nil
+	}
+	if limit < 0 || limit > maxPageLimit {
+		return 0, fault.New(
+			"page limit is out of bounds",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("limit", limit),
+			fault.WithContext("max_limit", maxPageLimit),
+		)
+	}
+	return limit, nil
+}
+
+// ParsePageRequest creates a PageRequest from the "limit", "offset" and "cursor" query parameters.
+// Missing parameters use their defaults. Returns an error if a number is malformed or out of
+// bounds, the cursor is invalid, or both offset and cursor are given.
+func ParsePageRequest(values url.Values) (PageRequest, error) {
+	limit, err := parsePageParam(values, "limit")
+	if err != nil {
+		return ZeroPageRequest, err
+	}
+	offset, err := parsePageParam(values, "offset")
+	if err != nil {
+		return ZeroPageRequest, err
+	}
+
+	token := strings.TrimSpace(values.Get("cursor"))
+	if token == "" {
+		return NewPageRequest(limit, offset)
+	}
+	if values.Has("offset") {
+		return ZeroPageRequest, fault.New(
+			"page request cannot have both offset and cursor",
+			fault.WithCode(fault.Invalid),
+		)
+	}
+
+	cursor, err := ParseCursor(token)
+	if err != nil {
+		return ZeroPageRequest, err
+	}
+	return NewCursorPageRequest(limit, cursor)
+}
+
+// parsePageParam reads an integer query parameter, returning zero if it is missing.
+func parsePageParam(values url.Values, name string) (int, error) {
+	raw := strings.TrimSpace(values.Get(name))
+	if raw == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fault.Wrap(err,
+			"page parameter must be an integer",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("parameter", name),
+			fault.WithContext("input_value", raw),
+		)
+	}
+	return n, nil
+}
+
+// Limit returns the maximum number of items of the page.
+func (p PageRequest) Limit() int {
+	return p.limit
+}
+
+// Offset returns the number of items to skip; always zero for cursor-based requests.
+func (p PageRequest) Offset() int {
+	return p.offset
+}
+
+// Cursor returns the position after which the page starts, or ZeroCursor for offset-based
+// requests and the first page.
+func (p PageRequest) Cursor() Cursor {
+	return p.cursor
+}
+
+// IsCursorBased returns true if the request continues from a cursor.
+func (p PageRequest) IsCursorBased() bool {
+	return !p.cursor.IsZero()
+}
+
+// IsZero returns true if the PageRequest is the zero value.
+func (p PageRequest) IsZero() bool {
+	return p == ZeroPageRequest
+}
+
+// Next returns the offset-based request for the following page, with the same limit.
+func (p PageRequest) Next() PageRequest {
+	return PageRequest{limit: p.limit, offset: p.offset + p.limit}
+}
+
+// After returns the cursor-based request for the page after cursor, with the same limit.
+func (p PageRequest) After(cursor Cursor) PageRequest {
+	return PageRequest{limit: p.limit, cursor: cursor}
+}
+
+// Query returns the request as query parameters, to build the links of the next pages.
+func (p PageRequest) Query() (url.Values, error) {
+	values := url.Values{}
+	if p.IsZero() {
+		return values, nil
+	}
+	values.Set("limit", strconv.Itoa(p.limit))
+	if p.IsCursorBased() {
+		token, err := p.cursor.Encode()
+		if err != nil {
+			return nil, err
+		}
+		values.Set("cursor", token)
+	} else if p.offset > 0 {
+		values.Set("offset", strconv.Itoa(p.offset))
+	}
+	return values, nil
+}
@@ -0,0 +1,145 @@
+package wisp_test
+
+import (
+	"bytes"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type PageRequestSuite struct {
+	suite.Suite
+}
+
+func TestPageRequestSuite(t *testing.T) {
+	suite.Run(t, new(PageRequestSuite))
+}
+
+func (s *PageRequestSuite) SetupTest() {
+	s.Require().NoError(wisp.SetCursorKeys(bytes.Repeat([]byte("k"), wisp.MinCursorKeyLength)))
+}
+
+func (s *PageRequestSuite) TearDownTest() {
+	wisp.ResetPageLimits()
+	s.Require().NoError(wisp.SetCursorKeys())
+}
+
+func (s *PageRequestSuite) TestNewPageRequest() {
+	page, err := wisp.NewPageRequest(0, 0)
+	s.Require().NoError(err)
+	s.Equal(wisp.DefaultPageLimit, page.Limit())
+	s.Zero(page.Offset())
+	s.False(page.IsCursorBased())
+
+	page, err = wisp.NewPageRequest(50, 100)
+	s.Require().NoError(err)
+	s.Equal(50, page.Limit())
+	s.Equal(100, page.Offset())
+
+	next := page.Next()
+	s.Equal(50, next.Limit())
+	s.Equal(150, next.Offset())
+
+	for _, args := range [][2]int{{-1, 0}, {wisp.MaxPageLimit + 1, 0}, {10, -1}} {
+		_, err := wisp.NewPageRequest(args[0], args[1])
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	}
+}
+
+func (s *PageRequestSuite) TestSetPageLimits() {
+	s.Require().NoError(wisp.SetPageLimits(10, 500))
+	page, _ := wisp.NewPageRequest(0, 0)
+	s.Equal(10, page.Limit())
+	_, err := wisp.NewPageRequest(500, 0)
+	s.NoError(err)
+
+	s.Error(wisp.SetPageLimits(0, 10))
+	s.Error(wisp.SetPageLimits(20, 10))
+
+	wisp.ResetPageLimits()
+	_, err = wisp.NewPageRequest(500, 0)
+	s.Error(err)
+}
+
+func (s *PageRequestSuite) TestCursorPageRequest() {
+	cursor, _ := wisp.NewCursor(wisp.MustNewUUID(), time.Now())
+
+	page, err := wisp.NewCursorPageRequest(0, cursor)
+	s.Require().NoError(err)
+	s.True(page.IsCursorBased())
+	s.Equal(cursor, page.Cursor())
+	s.Zero(page.Offset())
+
+	first, err := wisp.NewCursorPageRequest(10, wisp.ZeroCursor)
+	s.Require().NoError(err)
+	s.False(first.IsCursorBased())
+
+	after := first.After(cursor)
+	s.Equal(10, after.Limit())
+	s.Equal(cursor, after.Cursor())
+
+	_, err = wisp.NewCursorPageRequest(1000, cursor)
+	s.Error(err)
+}
+
+func (s *PageRequestSuite) TestParsePageRequest() {
+	cursor, _ := wisp.NewCursor(wisp.MustNewUUID(), time.Now())
+	token, _ := cursor.Encode()
+
+	s.Run("should parse offset pages", func() {
+		page, err := wisp.ParsePageRequest(url.Values{"limit": {"30"}, "offset": {"60"}})
+		s.Require().NoError(err)
+		s.Equal(30, page.Limit())
+		s.Equal(60, page.Offset())
+
+		page, err = wisp.ParsePageRequest(url.Values{})
+		s.Require().NoError(err)
+		s.Equal(wisp.DefaultPageLimit, page.Limit())
+	})
+
+	s.Run("should parse cursor pages", func() {
+		page, err := wisp.ParsePageRequest(url.Values{"limit": {"5"}, "cursor": {token}})
+		s.Require().NoError(err)
+		s.Equal(5, page.Limit())
+		s.Equal(cursor, page.Cursor())
+	})
+
+	s.Run("should reject invalid parameters", func() {
+		for _, values := range []url.Values{
+			{"limit": {"ten"}},
+			{"offset": {"1.5"}},
+			{"limit": {"1000"}},
+			{"cursor": {"tampered"}},
+			{"cursor": {token}, "offset": {"0"}},
+		} {
+			_, err := wisp.ParsePageRequest(values)
+			s.Require().Error(err, values.Encode())
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *PageRequestSuite) TestQuery() {
+	page, _ := wisp.NewPageRequest(25, 50)
+	values, err := page.Query()
+	s.Require().NoError(err)
+	s.Equal("limit=25&offset=50", values.Encode())
+
+	cursor, _ := wisp.NewCursor(wisp.MustNewUUID(), time.Now())
+	values, err = page.After(cursor).Query()
+	s.Require().NoError(err)
+
+	parsed, err := wisp.ParsePageRequest(values)
+	s.Require().NoError(err)
+	s.Equal(page.After(cursor), parsed)
+
+	values, err = wisp.ZeroPageRequest.Query()
+	s.Require().NoError(err)
+	s.Empty(values)
+}
@@ -0,0 +1,190 @@
+package wisp
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// PAN is a value object representing the Primary Account Number of a payment card, the number
+// printed on its front. It is created only after checking the length (12 to 19 digits) and the
+// Luhn check digit, and its brand is detected from the BIN.
+//
+// The full number is card data under PCI DSS and must not leak: String, GoString, Masked and
+// MarshalJSON all return the masked form ("5555 **** **** 4444"), and the number is only available
+// through Reveal. PAN can be decoded from JSON, so payment requests can carry it, but it has no
+// database interface on purpose: store a token from the payment gateway instead.
+//
+// The zero value is EmptyPAN.
+//
+// Example:
+//   pan, err := wisp.NewPAN("5555 5555 5555 4444")
+//   pan.Brand()     // CardBrandMastercard
+//   fmt.Println(pan) // "5555 **** **** 4444"
+//   gateway.Charge(pan.Reveal(), amount)
+type PAN struct {
+	number string
+}
+
+// EmptyPAN represents the zero value for the PAN type.
+var EmptyPAN PAN
+
+// NewPAN creates a new PAN, ignoring spaces and hyphens.
+// Returns EmptyPAN for an empty input, or an error if the number has other characters, does not
+// have 12 to 19 digits or has an invalid check digit. The number is never included in the error.
+func NewPAN(input string) (PAN, error) {
+	var b strings.Builder
+	b.Grow(len(input))
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		switch {
+		case isDigit(c):
+			b.WriteByte(c)
+		case c == ' ' || c == '-':
+		default:
+			return EmptyPAN, fault.New("card number must contain only digits", fault.WithCode(fault.Invalid))
+		}
+	}
+
+	number := b.String()
+	if number == "" {
+		return EmptyPAN, nil
+	}
+	if len(number) < 12 || len(number) > 19 {
+		return EmptyPAN, fault.New(
+			"card number must have between 12 and 19 digits",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("length", len(number)),
+		)
+	}
+	if !luhnValid(number) {
+		return EmptyPAN, fault.New("card number has an invalid check digit", fault.WithCode(fault.Invalid))
+	}
+	return PAN{number: number}, nil
+}
+
+// luhnValid reports whether a string of digits ends with a valid Luhn (mod 10) check digit.
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// Reveal returns the full card number, digits only. Callers must not log, return or store it.
+func (p PAN) Reveal() string {
+	return p.number
+}
+
+// Brand returns the brand detected from the BIN, or EmptyCardBrand if it is not a supported brand.
+func (p PAN) Brand() CardBrand {
+	brand, _ := DetectCardBrand(p.number)
+	return brand
+}
+
+// BIN returns the first 6 digits, the Bank Identification Number of the issuer.
+func (p PAN) BIN() string {
+	if p.IsZero() {
+		return ""
+	}
+	return p.number[:6]
+}
+
+// Last4 returns the last 4 digits, which may be shown to the cardholder.
+func (p PAN) Last4() string {
+	if p.IsZero() {
+		return ""
+	}
+	return p.number[len(p.number)-4:]
+}
+
+// IsZero returns true if the PAN is the zero value.
+func (p PAN) IsZero() bool {
+	return p.number == ""
+}
+
+// Masked implements the Masker interface. It keeps the first 4 and the last 4 digits and groups the
+// number as printed on the card: 4-6-5 for American Express and groups of 4 otherwise
+// (e.g., "5555 **** **** 4444", "3782 ****** *0005").
+func (p PAN) Masked() string {
+	if p.IsZero() {
+		return ""
+	}
+
+	groups := []int{4, 6, 5}
+	if p.Brand() != CardBrandAmex || len(p.number) != 15 {
+		groups = groups[:0]
+		for n := len(p.number); n > 0; n -= 4 {
+			groups = append(groups, min(n, 4))
+		}
+	}
+
+	var b strings.Builder
+	b.Grow(len(p.number) + len(groups))
+	pos := 0
+	for i, size := range groups {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		for end := pos + size; pos < end; pos++ {
+			if pos < 4 || pos >= len(p.number)-4 {
+				b.WriteByte(p.number[pos])
+			} else {
+				b.WriteByte('*')
+			}
+		}
+	}
+	return b.String()
+}
+
+// String returns the masked card number, so the PAN is never printed by accident.
+func (p PAN) String() string {
+	return p.Masked()
+}
+
+// GoString returns the masked card number, protecting the PAN from the %#v verb.
+func (p PAN) GoString() string {
+	return p.Masked()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the PAN in its masked form, or null for the zero value.
+func (p PAN) MarshalJSON() ([]byte, error) {
+	if p.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(p.Masked())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a full card number from a JSON string, with validation; null results in EmptyPAN.
+// A masked number is rejected, since it is not a valid card number.
+func (p *PAN) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*p = EmptyPAN
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "PAN must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	pan, err := NewPAN(s)
+	if err != nil {
+		return err
+	}
+	*p = pan
+	return nil
+}
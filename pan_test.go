@@ -0,0 +1,123 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type PANSuite struct {
+	suite.Suite
+}
+
+func TestPANSuite(t *testing.T) {
+	suite.Run(t, new(PANSuite))
+}
+
+func (s *PANSuite) TestNewPAN() {
+	s.Run("should accept valid card numbers and detect the brand", func() {
+		testCases := []struct {
+			input  string
+			digits string
+			brand  wisp.CardBrand
+		}{
+			{input: "4111 1111 1111 1111", digits: "4111111111111111", brand: wisp.CardBrandVisa},
+			{input: "5555-5555-5555-4444", digits: "5555555555554444", brand: wisp.CardBrandMastercard},
+			{input: "378282246310005", digits: "378282246310005", brand: wisp.CardBrandAmex},
+			{input: "6362 9700 0045 7013", digits: "6362970000457013", brand: wisp.CardBrandElo},
+			{input: "6062 8256 2425 4001", digits: "6062825624254001", brand: wisp.CardBrandHipercard},
+			{input: "6011111111111117", digits: "6011111111111117", brand: wisp.EmptyCardBrand},
+		}
+		for _, tc := range testCases {
+			pan, err := wisp.NewPAN(tc.input)
+			s.Require().NoError(err, tc.input)
+			s.Equal(tc.digits, pan.Reveal())
+			s.Equal(tc.brand, pan.Brand(), tc.input)
+			s.Equal(tc.digits[:6], pan.BIN())
+			s.Equal(tc.digits[len(tc.digits)-4:], pan.Last4())
+		}
+	})
+
+	s.Run("should return EmptyPAN for an empty input", func() {
+		pan, err := wisp.NewPAN("  ")
+		s.Require().NoError(err)
+		s.True(pan.IsZero())
+		s.Equal(wisp.EmptyPAN, pan)
+	})
+
+	s.Run("should reject invalid card numbers without exposing them", func() {
+		for _, input := range []string{"4111 1111 1111 1112", "4111.1111.1111.1111", "41111111111", "41111111111111111111"} {
+			_, err := wisp.NewPAN(input)
+			s.Require().Error(err, input)
+			faultErr, ok := err.(*fault.Error)
+			s.Require().True(ok)
+			s.Equal(fault.Invalid, faultErr.Code)
+			s.NotContains(fmt.Sprintf("%v %v", err, faultErr.Context), "4111")
+		}
+	})
+}
+
+func (s *PANSuite) TestMasking() {
+	s.Run("should mask the middle digits in the card layout", func() {
+		testCases := []struct {
+			input    string
+			expected string
+		}{
+			{input: "5555555555554444", expected: "5555 **** **** 4444"},
+			{input: "378282246310005", expected: "3782 ****** *0005"},
+			{input: "4222222222222", expected: "4222 **** *222 2"},
+		}
+		for _, tc := range testCases {
+			pan, err := wisp.NewPAN(tc.input)
+			s.Require().NoError(err)
+			s.Equal(tc.expected, pan.Masked())
+			s.Equal(tc.expected, pan.String())
+			s.Equal(tc.expected, fmt.Sprintf("%#v", pan))
+		}
+	})
+
+	s.Run("should be masked by Redact", func() {
+		pan, _ := wisp.NewPAN("5555555555554444")
+		redacted := wisp.Redact(struct{ Card wisp.PAN }{Card: pan})
+		s.NotContains(fmt.Sprint(redacted), "5555555555554444")
+	})
+
+	s.Run("should return an empty string for the zero value", func() {
+		s.Equal("", wisp.EmptyPAN.Masked())
+		s.Equal("", wisp.EmptyPAN.BIN())
+		s.Equal("", wisp.EmptyPAN.Last4())
+	})
+}
+
+func (s *PANSuite) TestJSON() {
+	s.Run("should marshal only the masked number", func() {
+		pan, _ := wisp.NewPAN("5555555555554444")
+		data, err := json.Marshal(pan)
+		s.Require().NoError(err)
+		s.Equal(`"5555 **** **** 4444"`, string(data))
+
+		data, err = json.Marshal(wisp.EmptyPAN)
+		s.Require().NoError(err)
+		s.Equal("null", string(data))
+	})
+
+	s.Run("should unmarshal a full card number", func() {
+		var pan wisp.PAN
+		s.Require().NoError(json.Unmarshal([]byte(`"4111 1111 1111 1111"`), &pan))
+		s.Equal("4111111111111111", pan.Reveal())
+
+		s.Require().NoError(json.Unmarshal([]byte("null"), &pan))
+		s.True(pan.IsZero())
+	})
+
+	s.Run("should reject a masked number", func() {
+		var pan wisp.PAN
+		s.Error(json.Unmarshal([]byte(`"5555 **** **** 4444"`), &pan))
+		s.Error(json.Unmarshal([]byte(`4111111111111111`), &pan))
+	})
+}
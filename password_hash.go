@@ -0,0 +1,405 @@
+package wisp
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordAlgorithm identifies the algorithm that produced a PasswordHash.
+type PasswordAlgorithm string
+
+// Defines the supported password hashing algorithms.
+const (
+	PasswordAlgorithmBcrypt   PasswordAlgorithm = "bcrypt"
+	PasswordAlgorithmArgon2id PasswordAlgorithm = "argon2id"
+)
+
+const (
+	argon2idSaltLength = 16
+	argon2idKeyLength  = 32
+
+	argon2idMinKeyLength = 16
+	argon2idMaxKeyLength = 64
+)
+
+// Upper bounds of the argon2id and bcrypt parameters. Verify runs with the parameters stored in the hash,
+// so they are enforced on decoding too: a forged hash must not make a single check allocate
+// gigabytes or run for minutes.
+const (
+	// MaxArgon2idMemory is the maximum argon2id memory, in KiB (1 GiB).
+	MaxArgon2idMemory uint32 = 1 << 20
+	// MaxArgon2idIterations is the maximum number of argon2id iterations.
+	MaxArgon2idIterations uint32 = 16
+	// MaxArgon2idParallelism is the maximum degree of argon2id parallelism.
+	MaxArgon2idParallelism uint8 = 16
+	// MaxBcryptCost is the maximum bcrypt cost; each step doubles the work, up to 2^16 rounds.
+	MaxBcryptCost = 16
+)
+
+// PasswordPolicy describes how new passwords are hashed: the algorithm and its cost parameters.
+// It is also used by PasswordHash.NeedsRehash to detect hashes made with weaker settings.
+//
+// Examples:
+//   policy := wisp.DefaultPasswordPolicy             // argon2id, m=19456 KiB, t=2, p=1
+//   policy, err := wisp.NewBcryptPolicy(12)
+//   policy, err := wisp.NewArgon2idPolicy(65536, 3, 4)
+type PasswordPolicy struct {
+	algorithm   PasswordAlgorithm
+	bcryptCost  int
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// DefaultPasswordPolicy hashes with argon2id using the minimum parameters recommended by OWASP.
+var DefaultPasswordPolicy = PasswordPolicy{
+	algorithm:   PasswordAlgorithmArgon2id,
+	memory:      19456,
+	iterations:  2,
+	parallelism: 1,
+}
+
+// NewBcryptPolicy creates a policy that hashes with bcrypt at the given cost (4 to MaxBcryptCost).
+func NewBcryptPolicy(cost int) (PasswordPolicy, error) {
+	if cost < bcrypt.MinCost || cost > MaxBcryptCost {
+		return PasswordPolicy{}, fault.New(
+			"bcrypt cost is outside the allowed range",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("cost", cost),
+			fault.WithContext("min", bcrypt.MinCost),
+			fault.WithContext("max", MaxBcryptCost),
+		)
+	}
+	return PasswordPolicy{algorithm: PasswordAlgorithmBcrypt, bcryptCost: cost}, nil
+}
+
+// NewArgon2idPolicy creates a policy that hashes with argon2id using memory (in KiB), the number
+// of iterations and the degree of parallelism. All parameters must be positive and at most
+// MaxArgon2idMemory, MaxArgon2idIterations and MaxArgon2idParallelism, and memory must be at
+// least 8 KiB per thread.
+func NewArgon2idPolicy(memory, iterations uint32, parallelism uint8) (PasswordPolicy, error) {
+	if !validArgon2idParams(memory, iterations, parallelism) {
+		return PasswordPolicy{}, fault.New(
+			"invalid argon2id parameters",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("memory", memory),
+			fault.WithContext("iterations", iterations),
+			fault.WithContext("parallelism", parallelism),
+		)
+	}
+	return PasswordPolicy{
+		algorithm:   PasswordAlgorithmArgon2id,
+		memory:      memory,
+		iterations:  iterations,
+		parallelism: parallelism,
+	}, nil
+}
+
+// validArgon2idParams reports whether the argon2id parameters are within the accepted bounds.
+func validArgon2idParams(memory, iterations uint32, parallelism uint8) bool {
+	return iterations > 0 && iterations <= MaxArgon2idIterations &&
+		parallelism > 0 && parallelism <= MaxArgon2idParallelism &&
+		memory >= 8*uint32(parallelism) && memory <= MaxArgon2idMemory
+}
+
+// Algorithm returns the algorithm used by the policy.
+func (p PasswordPolicy) Algorithm() PasswordAlgorithm {
+	return p.algorithm
+}
+
+// BcryptCost returns the bcrypt cost, or 0 for argon2id policies.
+func (p PasswordPolicy) BcryptCost() int {
+	return p.bcryptCost
+}
+
+// Argon2idParams returns the argon2id memory (in KiB), iterations and parallelism,
+// or zeros for bcrypt policies.
+func (p PasswordPolicy) Argon2idParams() (memory, iterations uint32, parallelism uint8) {
+	return p.memory, p.iterations, p.parallelism
+}
+
+// PasswordHash is a value object holding the encoded output of a password hashing algorithm:
+// a bcrypt hash ("$2a$12$...") or an argon2id hash in the PHC format
+// ("$argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>"). The algorithm and its parameters are read
+// from the encoded string, so hashes made under older policies keep verifying.
+//
+// The zero value is EmptyPasswordHash.
+//
+// Example:
+//   hash, err := wisp.HashPassword(pwd, wisp.DefaultPasswordPolicy)
+//   if hash.Verify(attempt) && hash.NeedsRehash(wisp.DefaultPasswordPolicy) {
+//       hash, err = wisp.HashPassword(attempt, wisp.DefaultPasswordPolicy)
+//   }
+type PasswordHash string
+
+// EmptyPasswordHash represents the zero value for PasswordHash.
+var EmptyPasswordHash PasswordHash
+
+// argon2idParams holds the values decoded from an argon2id PHC string.
+type argon2idParams struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	salt        []byte
+	key         []byte
+}
+
+// HashPassword hashes the secret according to the policy, with a random salt.
+// It returns an error if the secret is empty, the policy is the zero value, or the secret is
+// longer than the 72 bytes bcrypt accepts.
+func HashPassword(secret Secret, policy PasswordPolicy) (PasswordHash, error) {
+	if secret.IsZero() {
+		return EmptyPasswordHash, fault.New("cannot hash an empty password", fault.WithCode(fault.Invalid))
+	}
+
+	switch policy.algorithm {
+	case PasswordAlgorithmBcrypt:
+		hash, err := bcrypt.GenerateFromPassword([]byte(secret.Reveal()), policy.bcryptCost)
+		if err != nil {
+			return EmptyPasswordHash, fault.Wrap(err, "failed to hash password with bcrypt", fault.WithCode(fault.Invalid))
+		}
+		return PasswordHash(hash), nil
+	case PasswordAlgorithmArgon2id:
+		salt := make([]byte, argon2idSaltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return EmptyPasswordHash, fault.Wrap(err, "failed to generate password salt", fault.WithCode(fault.Internal))
+		}
+		key := argon2.IDKey([]byte(secret.Reveal()), salt, policy.iterations, policy.memory, policy.parallelism, argon2idKeyLength)
+		return PasswordHash(fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+			argon2.Version, policy.memory, policy.iterations, policy.parallelism,
+			base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key),
+		)), nil
+	default:
+		return EmptyPasswordHash, fault.New(
+			"unknown password hashing algorithm",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("algorithm", policy.algorithm),
+		)
+	}
+}
+
+// NewPasswordHash creates a PasswordHash from an encoded hash, such as one loaded from a legacy
+// table. It returns an error if the string is not a well-formed bcrypt or argon2id hash, or if
+// the bcrypt cost or the argon2id parameters exceed the maximums, or the argon2id key is not 16 to
+// 64 bytes long.
+func NewPasswordHash(encoded string) (PasswordHash, error) {
+	h := PasswordHash(strings.TrimSpace(encoded))
+	switch h.Algorithm() {
+	case PasswordAlgorithmBcrypt:
+		if _, err := h.bcryptCost(); err != nil {
+			return EmptyPasswordHash, err
+		}
+	case PasswordAlgorithmArgon2id:
+		if _, err := h.argon2id(); err != nil {
+			return EmptyPasswordHash, err
+		}
+	default:
+		return EmptyPasswordHash, fault.New("unrecognized password hash format", fault.WithCode(fault.Invalid))
+	}
+	return h, nil
+}
+
+// Algorithm detects the algorithm from the hash prefix.
+// Returns an empty string for unrecognized formats and for EmptyPasswordHash.
+func (h PasswordHash) Algorithm() PasswordAlgorithm {
+	s := string(h)
+	switch {
+	case strings.HasPrefix(s, "$2a$"), strings.HasPrefix(s, "$2b$"), strings.HasPrefix(s, "$2y$"):
+		return PasswordAlgorithmBcrypt
+	case strings.HasPrefix(s, "$argon2id$"):
+		return PasswordAlgorithmArgon2id
+	default:
+		return ""
+	}
+}
+
+// bcryptCost returns the cost of a bcrypt hash, checking it does not exceed MaxBcryptCost.
+func (h PasswordHash) bcryptCost() (int, error) {
+	cost, err := bcrypt.Cost([]byte(h))
+	if err != nil {
+		return 0, fault.Wrap(err, "invalid bcrypt hash", fault.WithCode(fault.Invalid))
+	}
+	if cost > MaxBcryptCost {
+		return 0, fault.New(
+			"bcrypt cost exceeds the maximum",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("cost", cost),
+			fault.WithContext("max", MaxBcryptCost),
+		)
+	}
+	return cost, nil
+}
+
+// argon2id decodes the PHC string of an argon2id hash.
+func (h PasswordHash) argon2id() (argon2idParams, error) {
+	invalid := fault.New("invalid argon2id hash", fault.WithCode(fault.Invalid))
+
+	parts := strings.Split(string(h), "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return argon2idParams{}, invalid
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return argon2idParams{}, invalid
+	}
+
+	var p argon2idParams
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.iterations, &p.parallelism); err != nil {
+		return argon2idParams{}, invalid
+	}
+	if !validArgon2idParams(p.memory, p.iterations, p.parallelism) {
+		return argon2idParams{}, invalid
+	}
+
+	var err error
+	if p.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil || len(p.salt) == 0 {
+		return argon2idParams{}, invalid
+	}
+	if p.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(p.key) < argon2idMinKeyLength || len(p.key) > argon2idMaxKeyLength {
+		return argon2idParams{}, invalid
+	}
+	return p, nil
+}
+
+// Verify checks whether the secret matches the hash. The comparison runs in constant time.
+// It returns false for EmptyPasswordHash and for malformed hashes.
+func (h PasswordHash) Verify(secret Secret) bool {
+	switch h.Algorithm() {
+	case PasswordAlgorithmBcrypt:
+		if _, err := h.bcryptCost(); err != nil {
+			return false
+		}
+		return bcrypt.CompareHashAndPassword([]byte(h), []byte(secret.Reveal())) == nil
+	case PasswordAlgorithmArgon2id:
+		p, err := h.argon2id()
+		if err != nil {
+			return false
+		}
+		key := argon2.IDKey([]byte(secret.Reveal()), p.salt, p.iterations, p.memory, p.parallelism, uint32(len(p.key)))
+		return subtle.ConstantTimeCompare(key, p.key) == 1
+	default:
+		return false
+	}
+}
+
+// NeedsRehash returns true if the hash was not produced under the policy: it uses another
+// algorithm, or cost parameters different from the policy's. Callers usually rehash right after
+// a successful Verify, while the plain password is at hand.
+func (h PasswordHash) NeedsRehash(policy PasswordPolicy) bool {
+	if h.Algorithm() != policy.algorithm {
+		return true
+	}
+
+	switch policy.algorithm {
+	case PasswordAlgorithmBcrypt:
+		cost, err := h.bcryptCost()
+		return err != nil || cost != policy.bcryptCost
+	case PasswordAlgorithmArgon2id:
+		p, err := h.argon2id()
+		return err != nil ||
+			p.memory != policy.memory ||
+			p.iterations != policy.iterations ||
+			p.parallelism != policy.parallelism ||
+			len(p.key) != argon2idKeyLength
+	default:
+		return true
+	}
+}
+
+// IsZero returns true if the PasswordHash is the zero value.
+func (h PasswordHash) IsZero() bool {
+	return h == EmptyPasswordHash
+}
+
+// String returns the encoded hash.
+func (h PasswordHash) String() string {
+	return string(h)
+}
+
+// Masked implements the Masker interface, keeping only the algorithm visible
+// (e.g., "argon2id$***"), so Redact never exposes the hash itself.
+func (h PasswordHash) Masked() string {
+	if h.IsZero() {
+		return ""
+	}
+	return string(h.Algorithm()) + "$***"
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the PasswordHash as its encoded string, or null if it is empty.
+func (h PasswordHash) MarshalJSON() ([]byte, error) {
+	if h.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(h.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a PasswordHash, with validation; null results in EmptyPasswordHash.
+func (h *PasswordHash) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*h = EmptyPasswordHash
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "PasswordHash must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	parsed, err := NewPasswordHash(s)
+	if err != nil {
+		return err
+	}
+	*h = parsed
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the PasswordHash as a string, or nil if it is empty.
+func (h PasswordHash) Value() (driver.Value, error) {
+	if h.IsZero() {
+		return nil, nil
+	}
+	return h.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a PasswordHash, with validation.
+func (h *PasswordHash) Scan(src interface{}) error {
+	if src == nil {
+		*h = EmptyPasswordHash
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for PasswordHash",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	parsed, err := NewPasswordHash(s)
+	if err != nil {
+		return err
+	}
+	*h = parsed
+	return nil
+}
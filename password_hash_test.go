@@ -0,0 +1,247 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type PasswordHashSuite struct {
+	suite.Suite
+	secret wisp.Secret
+	bcrypt wisp.PasswordPolicy
+	argon2 wisp.PasswordPolicy
+}
+
+func TestPasswordHashSuite(t *testing.T) {
+	suite.Run(t, new(PasswordHashSuite))
+}
+
+func (s *PasswordHashSuite) SetupTest() {
+	var err error
+	s.secret, err = wisp.NewSecret("correct horse battery staple")
+	s.Require().NoError(err)
+	// Cheap parameters keep the suite fast.
+	s.bcrypt, err = wisp.NewBcryptPolicy(4)
+	s.Require().NoError(err)
+	s.argon2, err = wisp.NewArgon2idPolicy(64, 1, 1)
+	s.Require().NoError(err)
+}
+
+func (s *PasswordHashSuite) TestPolicies() {
+	s.Run("should expose the default policy", func() {
+		s.Equal(wisp.PasswordAlgorithmArgon2id, wisp.DefaultPasswordPolicy.Algorithm())
+		m, t, p := wisp.DefaultPasswordPolicy.Argon2idParams()
+		s.Equal([]any{uint32(19456), uint32(2), uint8(1)}, []any{m, t, p})
+	})
+
+	s.Run("should validate bcrypt cost", func() {
+		s.Equal(4, s.bcrypt.BcryptCost())
+		_, err := wisp.NewBcryptPolicy(3)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		_, err = wisp.NewBcryptPolicy(wisp.MaxBcryptCost + 1)
+		s.Error(err)
+	})
+
+	s.Run("should validate argon2id parameters", func() {
+		_, err := wisp.NewArgon2idPolicy(64, 0, 1)
+		s.Error(err)
+		_, err = wisp.NewArgon2idPolicy(64, 1, 0)
+		s.Error(err)
+		_, err = wisp.NewArgon2idPolicy(8, 1, 2)
+		s.Error(err)
+		_, err = wisp.NewArgon2idPolicy(wisp.MaxArgon2idMemory+1, 1, 1)
+		s.Error(err)
+		_, err = wisp.NewArgon2idPolicy(64, wisp.MaxArgon2idIterations+1, 1)
+		s.Error(err)
+		_, err = wisp.NewArgon2idPolicy(1024, 1, wisp.MaxArgon2idParallelism+1)
+		s.Error(err)
+		_, err = wisp.NewArgon2idPolicy(wisp.MaxArgon2idMemory, wisp.MaxArgon2idIterations, wisp.MaxArgon2idParallelism)
+		s.NoError(err)
+	})
+}
+
+func (s *PasswordHashSuite) TestHashAndVerify() {
+	wrong, _ := wisp.NewSecret("Tr0ub4dor&3")
+
+	for _, policy := range []wisp.PasswordPolicy{s.bcrypt, s.argon2} {
+		s.Run(string(policy.Algorithm()), func() {
+			hash, err := wisp.HashPassword(s.secret, policy)
+			s.Require().NoError(err)
+			s.Equal(policy.Algorithm(), hash.Algorithm())
+			s.True(hash.Verify(s.secret))
+			s.False(hash.Verify(wrong))
+			s.False(hash.Verify(wisp.EmptySecret))
+
+			again, err := wisp.HashPassword(s.secret, policy)
+			s.Require().NoError(err)
+			s.NotEqual(hash, again, "salt must be random")
+		})
+	}
+
+	s.Run("should fail for an empty secret", func() {
+		_, err := wisp.HashPassword(wisp.EmptySecret, s.argon2)
+		s.Error(err)
+	})
+
+	s.Run("should fail for the zero policy", func() {
+		_, err := wisp.HashPassword(s.secret, wisp.PasswordPolicy{})
+		s.Error(err)
+	})
+
+	s.Run("should fail for secrets too long for bcrypt", func() {
+		long, _ := wisp.NewSecret(string(make([]byte, 73)))
+		_, err := wisp.HashPassword(long, s.bcrypt)
+		s.Error(err)
+	})
+
+	s.Run("should never verify the zero hash", func() {
+		s.False(wisp.EmptyPasswordHash.Verify(s.secret))
+	})
+}
+
+func (s *PasswordHashSuite) TestNewPasswordHash() {
+	s.Run("should accept hashes from both algorithms", func() {
+		for _, policy := range []wisp.PasswordPolicy{s.bcrypt, s.argon2} {
+			hash, _ := wisp.HashPassword(s.secret, policy)
+			parsed, err := wisp.NewPasswordHash(" " + hash.String() + " ")
+			s.Require().NoError(err)
+			s.Equal(hash, parsed)
+		}
+	})
+
+	s.Run("should accept parameters within the bounds", func() {
+		_, err := wisp.NewPasswordHash("$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHQ$a2V5a2V5a2V5a2V5a2V5aw")
+		s.NoError(err)
+	})
+
+	s.Run("should reject parameters beyond the bounds", func() {
+		bcryptHash, _ := wisp.HashPassword(s.secret, s.bcrypt)
+		inputs := []string{
+			strings.Replace(bcryptHash.String(), "$04$", "$17$", 1),
+			strings.Replace(bcryptHash.String(), "$04$", "$31$", 1),
+			"$argon2id$v=19$m=4294967295,t=4294967295,p=255$c2FsdHNhbHQ$a2V5a2V5a2V5a2V5",
+			"$argon2id$v=19$m=1048577,t=1,p=1$c2FsdHNhbHQ$a2V5a2V5a2V5a2V5a2V5aw",
+			"$argon2id$v=19$m=64,t=17,p=1$c2FsdHNhbHQ$a2V5a2V5a2V5a2V5a2V5aw",
+			"$argon2id$v=19$m=1024,t=1,p=17$c2FsdHNhbHQ$a2V5a2V5a2V5a2V5a2V5aw",
+			"$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHQ$a2V5a2V5a2V5a2V5",
+			"$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHQ$a2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2s",
+		}
+		for _, input := range inputs {
+			_, err := wisp.NewPasswordHash(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code, input)
+
+			var decoded wisp.PasswordHash
+			s.Error(json.Unmarshal([]byte(`"`+input+`"`), &decoded), input)
+			s.Error(decoded.Scan(input), input)
+			s.False(wisp.PasswordHash(input).Verify(s.secret), input)
+		}
+	})
+
+	s.Run("should reject malformed hashes", func() {
+		inputs := []string{
+			"",
+			"plaintext",
+			"$2a$04$short",
+			"$argon2id$v=19$m=64,t=1,p=1$c2FsdA",
+			"$argon2id$v=16$m=64,t=1,p=1$c2FsdHNhbHQ$a2V5",
+			"$argon2id$v=19$m=64,t=0,p=1$c2FsdHNhbHQ$a2V5",
+			"$argon2id$v=19$m=64,t=1,p=1$!!!$a2V5",
+			"$argon2i$v=19$m=64,t=1,p=1$c2FsdHNhbHQ$a2V5",
+		}
+		for _, input := range inputs {
+			_, err := wisp.NewPasswordHash(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code, input)
+		}
+	})
+}
+
+func (s *PasswordHashSuite) TestNeedsRehash() {
+	bcryptHash, _ := wisp.HashPassword(s.secret, s.bcrypt)
+	argonHash, _ := wisp.HashPassword(s.secret, s.argon2)
+
+	s.False(bcryptHash.NeedsRehash(s.bcrypt))
+	s.False(argonHash.NeedsRehash(s.argon2))
+
+	s.True(bcryptHash.NeedsRehash(s.argon2), "algorithm changed")
+	s.True(argonHash.NeedsRehash(s.bcrypt), "algorithm changed")
+
+	stronger, _ := wisp.NewBcryptPolicy(5)
+	s.True(bcryptHash.NeedsRehash(stronger))
+
+	moreMemory, _ := wisp.NewArgon2idPolicy(128, 1, 1)
+	s.True(argonHash.NeedsRehash(moreMemory))
+
+	s.True(wisp.EmptyPasswordHash.NeedsRehash(s.argon2))
+}
+
+func (s *PasswordHashSuite) TestMasked() {
+	hash, _ := wisp.HashPassword(s.secret, s.argon2)
+	s.Equal("argon2id$***", hash.Masked())
+	s.Equal("", wisp.EmptyPasswordHash.Masked())
+}
+
+func (s *PasswordHashSuite) TestJSON() {
+	hash, _ := wisp.HashPassword(s.secret, s.argon2)
+
+	s.Run("should round-trip", func() {
+		data, err := json.Marshal(hash)
+		s.Require().NoError(err)
+
+		var decoded wisp.PasswordHash
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(hash, decoded)
+	})
+
+	s.Run("should handle null", func() {
+		data, err := json.Marshal(wisp.EmptyPasswordHash)
+		s.Require().NoError(err)
+		s.Equal("null", string(data))
+
+		decoded := hash
+		s.Require().NoError(json.Unmarshal([]byte("null"), &decoded))
+		s.True(decoded.IsZero())
+	})
+
+	s.Run("should fail for invalid hashes", func() {
+		var decoded wisp.PasswordHash
+		s.Error(json.Unmarshal([]byte(`"plaintext"`), &decoded))
+		s.Error(json.Unmarshal([]byte(`1`), &decoded))
+	})
+}
+
+func (s *PasswordHashSuite) TestDatabase() {
+	hash, _ := wisp.HashPassword(s.secret, s.bcrypt)
+
+	s.Run("Value", func() {
+		v, err := hash.Value()
+		s.Require().NoError(err)
+		s.Equal(hash.String(), v)
+
+		v, err = wisp.EmptyPasswordHash.Value()
+		s.Require().NoError(err)
+		s.Nil(v)
+	})
+
+	s.Run("Scan", func() {
+		var scanned wisp.PasswordHash
+		s.Require().NoError(scanned.Scan([]byte(hash.String())))
+		s.Equal(hash, scanned)
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		s.Error(scanned.Scan("plaintext"))
+		err := scanned.Scan(42)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
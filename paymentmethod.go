@@ -0,0 +1,207 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// PaymentMethod is a value object representing how a customer pays at checkout
+// (e.g., "pix", "boleto", "credit_card"). Each method carries metadata in a global registry:
+// a display name and capability flags that checkout rules can branch on.
+//
+// The registry comes preloaded with the common Brazilian methods and can be extended
+// with RegisterPaymentMethod. Methods are stored in lowercase.
+//
+// Example:
+//   wisp.RegisterPaymentMethod("voucher", wisp.PaymentMethodInfo{DisplayName: "Vale-refeição", IsInstant: true})
+//   m, _ := wisp.NewPaymentMethod("CREDIT_CARD")
+//   m.SupportsInstallments() // true
+type PaymentMethod string
+
+// Built-in payment methods, registered by default.
+const (
+	PaymentMethodPix        PaymentMethod = "pix"
+	PaymentMethodBoleto     PaymentMethod = "boleto"
+	PaymentMethodCreditCard PaymentMethod = "credit_card"
+	PaymentMethodDebitCard  PaymentMethod = "debit_card"
+	PaymentMethodCash       PaymentMethod = "cash"
+	PaymentMethodTransfer   PaymentMethod = "transfer"
+)
+
+// PaymentMethodInfo holds the metadata of a registered PaymentMethod.
+type PaymentMethodInfo struct {
+	// DisplayName is the human-readable name shown to customers.
+	DisplayName string
+	// SupportsInstallments reports whether the payment can be split into installments.
+	SupportsInstallments bool
+	// IsInstant reports whether the payment is confirmed at checkout, without waiting for clearing.
+	IsInstant bool
+}
+
+// EmptyPaymentMethod represents the zero value for the PaymentMethod type.
+var EmptyPaymentMethod PaymentMethod
+
+// defaultPaymentMethods returns the built-in payment methods and their metadata.
+func defaultPaymentMethods() map[PaymentMethod]PaymentMethodInfo {
+	return map[PaymentMethod]PaymentMethodInfo{
+		PaymentMethodPix:        {DisplayName: "Pix", IsInstant: true},
+		PaymentMethodBoleto:     {DisplayName: "Boleto"},
+		PaymentMethodCreditCard: {DisplayName: "Cartão de crédito", SupportsInstallments: true, IsInstant: true},
+		PaymentMethodDebitCard:  {DisplayName: "Cartão de débito", IsInstant: true},
+		PaymentMethodCash:       {DisplayName: "Dinheiro", IsInstant: true},
+		PaymentMethodTransfer:   {DisplayName: "Transferência bancária"},
+	}
+}
+
+// paymentMethods holds the global registry of payment methods and their metadata.
+var paymentMethods = defaultPaymentMethods()
+
+// RegisterPaymentMethod adds a payment method to the global registry, or replaces the metadata
+// of an existing one. The method is normalized to lowercase; empty methods are ignored.
+// When info has no display name, the method itself is used.
+// This function should be called at application startup.
+func RegisterPaymentMethod(method PaymentMethod, info PaymentMethodInfo) {
+	normalized := normalizePaymentMethod(string(method))
+	if normalized == EmptyPaymentMethod {
+		return
+	}
+	if strings.TrimSpace(info.DisplayName) == "" {
+		info.DisplayName = normalized.String()
+	}
+	paymentMethods[normalized] = info
+}
+
+// ResetPaymentMethods restores the registry to the built-in payment methods.
+// This is primarily for testing purposes to ensure a clean state.
+func ResetPaymentMethods() {
+	paymentMethods = defaultPaymentMethods()
+}
+
+func normalizePaymentMethod(value string) PaymentMethod {
+	return PaymentMethod(strings.ToLower(strings.TrimSpace(value)))
+}
+
+// NewPaymentMethod creates a new PaymentMethod from a string.
+// It normalizes the input to lowercase and validates it against the registry.
+func NewPaymentMethod(value string) (PaymentMethod, error) {
+	normalized := normalizePaymentMethod(value)
+	if normalized == EmptyPaymentMethod {
+		return EmptyPaymentMethod, nil
+	}
+
+	if !normalized.IsValid() {
+		return EmptyPaymentMethod, fault.New(
+			"payment method is not registered",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_method", value),
+		)
+	}
+	return normalized, nil
+}
+
+// String returns the payment method as a string.
+func (m PaymentMethod) String() string {
+	return string(m)
+}
+
+// IsValid checks if the payment method is in the global registry.
+func (m PaymentMethod) IsValid() bool {
+	_, ok := paymentMethods[m]
+	return ok
+}
+
+// IsZero returns true if the PaymentMethod is the zero value.
+func (m PaymentMethod) IsZero() bool {
+	return m == EmptyPaymentMethod
+}
+
+// Info returns the registered metadata of the payment method.
+// The second return value is false if the method is not registered.
+func (m PaymentMethod) Info() (PaymentMethodInfo, bool) {
+	info, ok := paymentMethods[m]
+	return info, ok
+}
+
+// DisplayName returns the human-readable name of the method, or an empty string if it is not registered.
+func (m PaymentMethod) DisplayName() string {
+	return paymentMethods[m].DisplayName
+}
+
+// SupportsInstallments reports whether the method allows splitting the payment into installments.
+func (m PaymentMethod) SupportsInstallments() bool {
+	return paymentMethods[m].SupportsInstallments
+}
+
+// IsInstant reports whether the method is confirmed at checkout, without waiting for clearing.
+func (m PaymentMethod) IsInstant() bool {
+	return paymentMethods[m].IsInstant
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (m PaymentMethod) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a PaymentMethod, validating it against the registry.
+func (m *PaymentMethod) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*m = EmptyPaymentMethod
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "PaymentMethod must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	method, err := NewPaymentMethod(s)
+	if err != nil {
+		return err
+	}
+	*m = method
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the payment method as a string or nil if it's the zero value.
+func (m PaymentMethod) Value() (driver.Value, error) {
+	if m.IsZero() {
+		return nil, nil
+	}
+	return m.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values and validates them as a PaymentMethod.
+func (m *PaymentMethod) Scan(src interface{}) error {
+	if src == nil {
+		*m = EmptyPaymentMethod
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for PaymentMethod",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	method, err := NewPaymentMethod(s)
+	if err != nil {
+		return err
+	}
+	*m = method
+	return nil
+}
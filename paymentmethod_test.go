@@ -0,0 +1,108 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type PaymentMethodSuite struct {
+	suite.Suite
+}
+
+func TestPaymentMethodSuite(t *testing.T) {
+	suite.Run(t, new(PaymentMethodSuite))
+}
+
+func (s *PaymentMethodSuite) SetupTest() {
+	wisp.ResetPaymentMethods()
+}
+
+func (s *PaymentMethodSuite) TearDownTest() {
+	wisp.ResetPaymentMethods()
+}
+
+func (s *PaymentMethodSuite) TestNewPaymentMethod() {
+	m, err := wisp.NewPaymentMethod(" CREDIT_CARD ")
+	s.Require().NoError(err)
+	s.Equal(wisp.PaymentMethodCreditCard, m)
+
+	m, err = wisp.NewPaymentMethod("")
+	s.Require().NoError(err)
+	s.True(m.IsZero())
+
+	_, err = wisp.NewPaymentMethod("crypto")
+	s.Require().Error(err)
+}
+
+func (s *PaymentMethodSuite) TestBuiltInMetadata() {
+	testCases := []struct {
+		method       wisp.PaymentMethod
+		displayName  string
+		installments bool
+		instant      bool
+	}{
+		{wisp.PaymentMethodPix, "Pix", false, true},
+		{wisp.PaymentMethodBoleto, "Boleto", false, false},
+		{wisp.PaymentMethodCreditCard, "Cartão de crédito", true, true},
+		{wisp.PaymentMethodDebitCard, "Cartão de débito", false, true},
+		{wisp.PaymentMethodCash, "Dinheiro", false, true},
+		{wisp.PaymentMethodTransfer, "Transferência bancária", false, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.method.String(), func() {
+			s.True(tc.method.IsValid())
+			s.Equal(tc.displayName, tc.method.DisplayName())
+			s.Equal(tc.installments, tc.method.SupportsInstallments())
+			s.Equal(tc.instant, tc.method.IsInstant())
+		})
+	}
+}
+
+func (s *PaymentMethodSuite) TestRegisterPaymentMethod() {
+	wisp.RegisterPaymentMethod(" Voucher ", wisp.PaymentMethodInfo{DisplayName: "Vale-refeição", IsInstant: true})
+	wisp.RegisterPaymentMethod("crypto", wisp.PaymentMethodInfo{})
+	wisp.RegisterPaymentMethod("boleto", wisp.PaymentMethodInfo{DisplayName: "Boleto parcelado", SupportsInstallments: true})
+	wisp.RegisterPaymentMethod(" ", wisp.PaymentMethodInfo{DisplayName: "ignored"})
+
+	voucher, err := wisp.NewPaymentMethod("voucher")
+	s.Require().NoError(err)
+	info, ok := voucher.Info()
+	s.True(ok)
+	s.Equal(wisp.PaymentMethodInfo{DisplayName: "Vale-refeição", IsInstant: true}, info)
+
+	s.Equal("crypto", wisp.PaymentMethod("crypto").DisplayName())
+	s.True(wisp.PaymentMethodBoleto.SupportsInstallments())
+
+	wisp.ResetPaymentMethods()
+	s.False(wisp.PaymentMethod("voucher").IsValid())
+	s.False(wisp.PaymentMethodBoleto.SupportsInstallments())
+}
+
+func (s *PaymentMethodSuite) TestSerialization() {
+	data, err := json.Marshal(wisp.PaymentMethodPix)
+	s.Require().NoError(err)
+	s.Equal(`"pix"`, string(data))
+
+	var decoded wisp.PaymentMethod
+	s.Require().NoError(json.Unmarshal([]byte(`"PIX"`), &decoded))
+	s.Equal(wisp.PaymentMethodPix, decoded)
+	s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.True(decoded.IsZero())
+	s.Require().Error(json.Unmarshal([]byte(`"crypto"`), &decoded))
+
+	val, err := wisp.PaymentMethodBoleto.Value()
+	s.Require().NoError(err)
+	s.Equal("boleto", val)
+
+	var scanned wisp.PaymentMethod
+	s.Require().NoError(scanned.Scan([]byte("cash")))
+	s.Equal(wisp.PaymentMethodCash, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Require().Error(scanned.Scan(1))
+}
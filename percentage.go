@@ -30,18 +30,49 @@ var ZeroPercentage Percentage
 // A factor of 10,000 allows for 4 decimal places of precision (e.g., 0.0001 becomes 1).
 const percentageFactor = 10000.0
 
+// HundredPercent represents 100%, the whole of a value.
+const HundredPercent Percentage = Percentage(percentageFactor)
+
+// percentageConfig holds the validation rules applied by NewPercentageFromFloat.
+type percentageConfig struct {
+	max Percentage
+}
+
+// PercentageOption configures the validation performed by NewPercentageFromFloat.
+type PercentageOption func(*percentageConfig)
+
+// WithMaxPercentage rejects percentages above max. Use WithMaxPercentage(HundredPercent) for
+// values that represent a share of a whole, such as discounts or tax rates.
+func WithMaxPercentage(max Percentage) PercentageOption {
+	return func(c *percentageConfig) {
+		c.max = max
+	}
+}
+
+// AllowAboveHundred explicitly accepts percentages above 100%, such as markup ratios (e.g., 150%).
+// This is the default behavior; the option documents intent at the call site and overrides
+// a previous WithMaxPercentage.
+func AllowAboveHundred() PercentageOption {
+	return func(c *percentageConfig) {
+		c.max = 0
+	}
+}
+
 // NewPercentageFromFloat creates a new Percentage from a float64 value.
 // The float represents the percentage fraction (e.g., 0.5 for 50%).
 // The value is scaled and rounded to the nearest even number to be stored as an integer.
 //
-// Returns an error if the input value is negative.
+// Returns an error if the input value is negative. Values above 1.0 (100%) are accepted unless
+// restricted with WithMaxPercentage.
 //
 // Examples:
 //   p, err := NewPercentageFromFloat(0.5)   // 50%
 //   p, err := NewPercentageFromFloat(0.075) // 7.5%
 //   p, err := NewPercentageFromFloat(-0.1)  // returns an error
-func NewPercentageFromFloat(value float64) (Percentage, error) {
-	if value < 0 {
+//   p, err := NewPercentageFromFloat(1.5, AllowAboveHundred())                // 150% markup
+//   p, err := NewPercentageFromFloat(1.5, WithMaxPercentage(HundredPercent)) // returns an error
+func NewPercentageFromFloat(value float64, opts ...PercentageOption) (Percentage, error) {
+	if value < 0 || math.IsNaN(value) {
 		return ZeroPercentage, fault.New(
 			"percentage value cannot be negative",
 			fault.WithCode(fault.Invalid),
@@ -49,8 +80,68 @@ func NewPercentageFromFloat(value float64) (Percentage, error) {
 		)
 	}
 
+	var cfg percentageConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	scaledValue := math.RoundToEven(value * percentageFactor)
-	return Percentage(scaledValue), nil
+	// math.MaxInt64 rounds up to 2^63 as a float64, which no longer fits in an int64.
+	if scaledValue >= math.MaxInt64 {
+		return ZeroPercentage, fault.New(
+			"percentage value is too large",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+		)
+	}
+
+	p := Percentage(scaledValue)
+	if cfg.max > 0 && p > cfg.max {
+		return ZeroPercentage, fault.New(
+			"percentage value exceeds the allowed maximum",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+			fault.WithContext("max", cfg.max.String()),
+		)
+	}
+	return p, nil
+}
+
+// NewPercentageFromBasisPoints creates a new Percentage from basis points, where 1 basis point
+// is 0.01% (e.g., 150 is 1.50%). This is the exact internal representation, so no rounding occurs.
+// Returns an error if the value is negative.
+//
+// Example:
+//   spread, err := NewPercentageFromBasisPoints(25) // 0.25%
+func NewPercentageFromBasisPoints(bps int64) (Percentage, error) {
+	if bps < 0 {
+		return ZeroPercentage, fault.New(
+			"basis points cannot be negative",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", bps),
+		)
+	}
+	return Percentage(bps), nil
+}
+
+// NewPercentageFromPercent creates a new Percentage from a value expressed in percent, where 15 means 15%
+// (as opposed to NewPercentageFromFloat, where 0.15 means 15%). It accepts the same options as
+// NewPercentageFromFloat.
+//
+// Example:
+//   rate, err := NewPercentageFromPercent(15.5) // 15.50%
+func NewPercentageFromPercent(value float64, opts ...PercentageOption) (Percentage, error) {
+	return NewPercentageFromFloat(value/100, opts...)
+}
+
+// BasisPoints returns the percentage in basis points (e.g., 1.50% is 150).
+func (p Percentage) BasisPoints() int64 {
+	return int64(p)
+}
+
+// Percent returns the percentage expressed in percent (e.g., 15.5% is 15.5).
+func (p Percentage) Percent() float64 {
+	return float64(p) / (percentageFactor / 100)
 }
 
 // IsNegative returns true if the percentage value is negative.
@@ -75,6 +166,90 @@ func (p Percentage) IsZero() bool {
 	return p == ZeroPercentage
 }
 
+// IsAboveHundred returns true if the percentage is greater than 100%, as in markup ratios.
+func (p Percentage) IsAboveHundred() bool {
+	return p > HundredPercent
+}
+
+// Add returns the sum of two percentages (e.g., 10% + 5% = 15%).
+// Returns an error if the result overflows.
+func (p Percentage) Add(other Percentage) (Percentage, error) {
+	sum := p + other
+	if (other > 0 && sum < p) || (other < 0 && sum > p) {
+		return ZeroPercentage, fault.New("percentage addition overflows", fault.WithCode(fault.DomainViolation))
+	}
+	return sum, nil
+}
+
+// Subtract returns the difference of two percentages (e.g., 15% - 5% = 10%).
+// Returns an error if the result would be negative.
+func (p Percentage) Subtract(other Percentage) (Percentage, error) {
+	if other > p {
+		return ZeroPercentage, fault.New(
+			"percentage subtraction results in a negative value",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("minuend", p.String()),
+			fault.WithContext("subtrahend", other.String()),
+		)
+	}
+	return p - other, nil
+}
+
+// Multiply returns the percentage multiplied by a non-negative integer factor (e.g., 5% * 3 = 15%).
+// Returns an error if the factor is negative or the result overflows.
+func (p Percentage) Multiply(factor int64) (Percentage, error) {
+	if factor < 0 {
+		return ZeroPercentage, fault.New(
+			"percentage multiplier cannot be negative",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("factor", factor),
+		)
+	}
+	result, err := mulDivRounded(int64(p), factor, 1, RoundHalfEven)
+	if err != nil {
+		return ZeroPercentage, err
+	}
+	return Percentage(result), nil
+}
+
+// Of returns the percentage of another percentage (e.g., 50% of 20% = 10%),
+// rounded half to even to the 4 decimal places of precision.
+func (p Percentage) Of(other Percentage) (Percentage, error) {
+	result, err := mulDivRounded(int64(p), int64(other), int64(percentageFactor), RoundHalfEven)
+	if err != nil {
+		return ZeroPercentage, err
+	}
+	return Percentage(result), nil
+}
+
+// Complement returns 100% minus the percentage (e.g., the complement of 15% is 85%),
+// which is the share that remains after a discount or a tax is taken.
+// Returns an error if the percentage is above 100%.
+func (p Percentage) Complement() (Percentage, error) {
+	return HundredPercent.Subtract(p)
+}
+
+// Inverse returns the reciprocal of the percentage (e.g., the inverse of 50% is 200%),
+// rounded half to even. It converts between a ratio and its reverse, such as a markup
+// multiplier and the cost share of a price.
+// Returns an error if the percentage is zero.
+func (p Percentage) Inverse() (Percentage, error) {
+	if p.IsZero() {
+		return ZeroPercentage, fault.New("cannot invert a zero percentage", fault.WithCode(fault.Invalid))
+	}
+	result, err := mulDivRounded(int64(percentageFactor), int64(percentageFactor), int64(p), RoundHalfEven)
+	if err != nil {
+		return ZeroPercentage, err
+	}
+	return Percentage(result), nil
+}
+
+// ApplyToInt calculates the percentage of an integer quantity, rounded half to even
+// (e.g., 15% of 40 units is 6). Returns an error if the result overflows.
+func (p Percentage) ApplyToInt(n int64) (int64, error) {
+	return mulDivRounded(n, int64(p), int64(percentageFactor), RoundHalfEven)
+}
+
 // ApplyTo calculates the percentage of a given Money value.
 // It returns a new Money instance representing the calculated amount.
 // The result is rounded to the nearest smallest currency unit (e.g., cent).
@@ -97,6 +272,22 @@ func (p Percentage) ApplyTo(m Money) Money {
 	}
 }
 
+// ApplyToWithMode calculates the percentage of a given Money value using exact integer arithmetic,
+// rounding the result to the smallest currency unit with the given mode.
+// Returns an error if the rounding mode is unknown or the result overflows.
+//
+// Example:
+//   price, _ := wisp.NewMoney(1005, wisp.BRL) // R$10.05
+//   half, _ := wisp.NewPercentageFromFloat(0.5) // 50%
+//   amount, _ := half.ApplyToWithMode(price, wisp.RoundHalfUp) // R$5.03
+func (p Percentage) ApplyToWithMode(m Money, mode RoundingMode) (Money, error) {
+	amount, err := mulDivRounded(m.Amount(), int64(p), int64(percentageFactor), mode)
+	if err != nil {
+		return ZeroMoney, err
+	}
+	return Money{amount: amount, currency: m.Currency()}, nil
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 // It serializes the Percentage as its float64 representation.
 func (p Percentage) MarshalJSON() ([]byte, error) {
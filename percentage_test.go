@@ -2,6 +2,7 @@ package wisp_test
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/marcelofabianov/fault"
@@ -40,6 +41,19 @@ func (s *PercentageSuite) TestNewPercentageFromFloat() {
 		s.Equal(fault.Invalid, faultErr.Code)
 	})
 
+	s.Run("should fail for values that overflow", func() {
+		// 922337203685477.6 * 10000 is exactly 2^63, one past math.MaxInt64.
+		for _, value := range []float64{922337203685477.6, math.Inf(1)} {
+			_, err := wisp.NewPercentageFromFloat(value)
+			s.Require().Error(err, value)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+
+		p, err := wisp.NewPercentageFromFloat(922337203685477.5)
+		s.Require().NoError(err)
+		s.Positive(int64(p))
+	})
+
 	s.Run("should handle rounding correctly", func() {
 		// 0.12345 -> 1234.5 -> rounds to 1234 (even)
 		p, err := wisp.NewPercentageFromFloat(0.12345)
@@ -79,6 +93,44 @@ func (s *PercentageSuite) TestPercentage_ApplyTo() {
 	}
 }
 
+func (s *PercentageSuite) TestPercentage_ApplyToWithMode() {
+	money, _ := wisp.NewMoney(1005, wisp.BRL) // R$ 10.05
+	half, _ := wisp.NewPercentageFromFloat(0.5)
+
+	testCases := []struct {
+		mode     wisp.RoundingMode
+		expected int64
+	}{
+		{mode: wisp.RoundHalfEven, expected: 502},
+		{mode: wisp.RoundHalfUp, expected: 503},
+		{mode: wisp.RoundHalfDown, expected: 502},
+		{mode: wisp.RoundFloor, expected: 502},
+		{mode: wisp.RoundCeil, expected: 503},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.mode.String(), func() {
+			result, err := half.ApplyToWithMode(money, tc.mode)
+			s.Require().NoError(err)
+			s.Equal(tc.expected, result.Amount())
+			s.Equal(wisp.BRL, result.Currency())
+		})
+	}
+
+	s.Run("should use exact arithmetic for large amounts", func() {
+		large, _ := wisp.NewMoney(9_000_000_000_000_000_000, wisp.BRL)
+		result, err := half.ApplyToWithMode(large, wisp.RoundHalfUp)
+		s.Require().NoError(err)
+		s.Equal(int64(4_500_000_000_000_000_000), result.Amount())
+	})
+
+	s.Run("should fail for an unknown rounding mode", func() {
+		_, err := half.ApplyToWithMode(money, wisp.RoundingMode(-1))
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
 func (s *PercentageSuite) TestPercentage_JSONMarshaling() {
 	s.Run("should marshal and unmarshal correctly", func() {
 		p, _ := wisp.NewPercentageFromFloat(0.50) // 50%
@@ -129,3 +181,141 @@ func (s *PercentageSuite) TestPercentage_IsNegative() {
 	s.False(p.IsNegative())
 	s.False(wisp.ZeroPercentage.IsNegative())
 }
+
+func (s *PercentageSuite) TestNewPercentageFromFloat_Options() {
+	s.Run("should accept values above 100% by default", func() {
+		p, err := wisp.NewPercentageFromFloat(1.5)
+		s.Require().NoError(err)
+		s.True(p.IsAboveHundred())
+
+		p, err = wisp.NewPercentageFromFloat(1.5, wisp.AllowAboveHundred())
+		s.Require().NoError(err)
+		s.Equal("150.00%", p.String())
+	})
+
+	s.Run("should enforce a maximum", func() {
+		_, err := wisp.NewPercentageFromFloat(1.0001, wisp.WithMaxPercentage(wisp.HundredPercent))
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+		p, err := wisp.NewPercentageFromFloat(1, wisp.WithMaxPercentage(wisp.HundredPercent))
+		s.Require().NoError(err)
+		s.Equal(wisp.HundredPercent, p)
+		s.False(p.IsAboveHundred())
+	})
+
+	s.Run("last option wins", func() {
+		_, err := wisp.NewPercentageFromFloat(2, wisp.WithMaxPercentage(wisp.HundredPercent), wisp.AllowAboveHundred())
+		s.NoError(err)
+	})
+}
+
+func (s *PercentageSuite) TestPercentage_Arithmetic() {
+	five := wisp.Percentage(500)
+	ten := wisp.Percentage(1000)
+
+	s.Run("Add and Subtract", func() {
+		sum, err := ten.Add(five)
+		s.Require().NoError(err)
+		s.Equal(wisp.Percentage(1500), sum)
+
+		diff, err := ten.Subtract(five)
+		s.Require().NoError(err)
+		s.Equal(five, diff)
+
+		_, err = five.Subtract(ten)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+
+	s.Run("Multiply and Of", func() {
+		p, err := five.Multiply(3)
+		s.Require().NoError(err)
+		s.Equal(wisp.Percentage(1500), p)
+
+		_, err = five.Multiply(-1)
+		s.Error(err)
+
+		half := wisp.Percentage(5000)
+		p, err = half.Of(wisp.Percentage(2000))
+		s.Require().NoError(err)
+		s.Equal(ten, p)
+	})
+
+	s.Run("Complement and Inverse", func() {
+		c, err := wisp.Percentage(1500).Complement()
+		s.Require().NoError(err)
+		s.Equal("85.00%", c.String())
+
+		_, err = wisp.Percentage(15000).Complement()
+		s.Error(err)
+
+		inv, err := wisp.Percentage(5000).Inverse()
+		s.Require().NoError(err)
+		s.Equal(wisp.Percentage(20000), inv)
+
+		inv, err = wisp.Percentage(30000).Inverse()
+		s.Require().NoError(err)
+		s.Equal(wisp.Percentage(3333), inv)
+
+		_, err = wisp.ZeroPercentage.Inverse()
+		s.Error(err)
+	})
+
+	s.Run("ApplyToInt", func() {
+		n, err := wisp.Percentage(1500).ApplyToInt(40)
+		s.Require().NoError(err)
+		s.Equal(int64(6), n)
+
+		n, err = wisp.Percentage(2500).ApplyToInt(10) // 2.5 -> 2 (even)
+		s.Require().NoError(err)
+		s.Equal(int64(2), n)
+	})
+}
+
+func (s *PercentageSuite) TestExplicitConstructors() {
+	s.Run("basis points", func() {
+		p, err := wisp.NewPercentageFromBasisPoints(150)
+		s.Require().NoError(err)
+		s.Equal("1.50%", p.String())
+		s.Equal(int64(150), p.BasisPoints())
+
+		_, err = wisp.NewPercentageFromBasisPoints(-1)
+		s.Error(err)
+	})
+
+	s.Run("percent", func() {
+		p, err := wisp.NewPercentageFromPercent(15)
+		s.Require().NoError(err)
+		s.Equal(wisp.Percentage(1500), p)
+		s.InDelta(15.0, p.Percent(), 0.00001)
+
+		p, err = wisp.NewPercentageFromPercent(0.07)
+		s.Require().NoError(err)
+		s.Equal(int64(7), p.BasisPoints())
+
+		_, err = wisp.NewPercentageFromPercent(150, wisp.WithMaxPercentage(wisp.HundredPercent))
+		s.Error(err)
+		_, err = wisp.NewPercentageFromPercent(-1)
+		s.Error(err)
+	})
+
+	s.Run("PercentageRange", func() {
+		low, _ := wisp.NewPercentageFromPercent(1)
+		high, _ := wisp.NewPercentageFromPercent(5)
+		band, err := wisp.NewRange(low, high)
+		s.Require().NoError(err)
+
+		var pr wisp.PercentageRange = band
+		s.True(pr.Contains(wisp.Percentage(250)))
+		s.Equal("[1.00%, 5.00%]", pr.String())
+
+		data, err := json.Marshal(pr)
+		s.Require().NoError(err)
+		s.JSONEq(`{"min":0.01,"max":0.05}`, string(data))
+
+		var decoded wisp.PercentageRange
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.True(pr.Equals(decoded))
+	})
+}
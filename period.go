@@ -0,0 +1,219 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// Period represents a calendar-based amount of time expressed in years, months and days,
+// such as "1 year, 2 months and 3 days". Unlike time.Duration, a Period is not a fixed
+// number of seconds: one month may have 28 to 31 days depending on where it is applied.
+// This makes it suitable for HR leave accrual, contract terms and SLA reporting.
+//
+// A Period is serialized in the ISO 8601 duration format restricted to date components
+// (e.g., "P1Y2M3D"). All components must be non-negative.
+//
+// The zero value is ZeroPeriod, which represents no elapsed time.
+//
+// Examples:
+//   p, err := wisp.NewPeriod(1, 2, 3)
+//   p, err := wisp.ParsePeriod("P1Y6M")
+//   fmt.Println(p.String()) // "P1Y6M"
+type Period struct {
+	years  int
+	months int
+	days   int
+}
+
+// ZeroPeriod represents the zero value for the Period type.
+var ZeroPeriod Period
+
+var periodRegex = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?$`)
+
+// NewPeriod creates a new Period from years, months and days.
+// It returns an error if any component is negative.
+func NewPeriod(years, months, days int) (Period, error) {
+	if years < 0 || months < 0 || days < 0 {
+		return ZeroPeriod, fault.New(
+			"period components cannot be negative",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("years", years),
+			fault.WithContext("months", months),
+			fault.WithContext("days", days),
+		)
+	}
+	return Period{years: years, months: months, days: days}, nil
+}
+
+// ParsePeriod creates a Period from an ISO 8601 duration string with date components only
+// (e.g., "P1Y2M3D", "P2W"). Weeks are converted into days. "P0D" yields ZeroPeriod.
+func ParsePeriod(s string) (Period, error) {
+	input := strings.ToUpper(strings.TrimSpace(s))
+	matches := periodRegex.FindStringSubmatch(input)
+	if matches == nil || input == "P" {
+		return ZeroPeriod, fault.New(
+			"period must be in ISO 8601 format (e.g., P1Y2M3D)",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input", s),
+		)
+	}
+
+	var parts [4]int
+	for i := range parts {
+		if matches[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(matches[i+1])
+		if err != nil {
+			return ZeroPeriod, fault.Wrap(err,
+				"period component is out of range",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("input", s),
+			)
+		}
+		parts[i] = n
+	}
+
+	return NewPeriod(parts[0], parts[1], parts[2]*7+parts[3])
+}
+
+// periodBetween computes the calendar period from start up to, but not including, end.
+// It assumes start is not after end.
+func periodBetween(start, end Date) Period {
+	months := (end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month())
+	anchor := start.AddMonths(months)
+	for months > 0 && anchor.After(end) {
+		months--
+		anchor = start.AddMonths(months)
+	}
+
+	days := int(end.t.Sub(anchor.t).Hours() / 24)
+	return Period{years: months / 12, months: months % 12, days: days}
+}
+
+// Years returns the years component of the period.
+func (p Period) Years() int {
+	return p.years
+}
+
+// Months returns the months component of the period.
+func (p Period) Months() int {
+	return p.months
+}
+
+// Days returns the days component of the period.
+func (p Period) Days() int {
+	return p.days
+}
+
+// TotalMonths returns the years and months components expressed in months, ignoring days.
+func (p Period) TotalMonths() int {
+	return p.years*12 + p.months
+}
+
+// IsZero returns true if the Period has no years, months or days.
+func (p Period) IsZero() bool {
+	return p == ZeroPeriod
+}
+
+// Equals checks if two periods have exactly the same components.
+// Note that "P1M" and "P30D" are not considered equal.
+func (p Period) Equals(other Period) bool {
+	return p == other
+}
+
+// AddTo returns the date obtained by adding the period to d.
+// Years and months are added first, then days.
+func (p Period) AddTo(d Date) Date {
+	return d.AddYears(p.years).AddMonths(p.months).AddDays(p.days)
+}
+
+// String returns the ISO 8601 representation of the period (e.g., "P1Y2M3D").
+// The zero period is represented as "P0D".
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+
+	var b strings.Builder
+	b.WriteByte('P')
+	if p.years > 0 {
+		b.WriteString(strconv.Itoa(p.years))
+		b.WriteByte('Y')
+	}
+	if p.months > 0 {
+		b.WriteString(strconv.Itoa(p.months))
+		b.WriteByte('M')
+	}
+	if p.days > 0 {
+		b.WriteString(strconv.Itoa(p.days))
+		b.WriteByte('D')
+	}
+	return b.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface, serializing the period as an ISO 8601 string.
+func (p Period) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *Period) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*p = ZeroPeriod
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "Period must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	period, err := ParsePeriod(s)
+	if err != nil {
+		return err
+	}
+	*p = period
+	return nil
+}
+
+// Value implements the driver.Valuer interface, storing the period as an ISO 8601 string.
+// The zero period is a meaningful duration and is stored as "P0D" rather than NULL.
+func (p Period) Value() (driver.Value, error) {
+	return p.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (p *Period) Scan(src interface{}) error {
+	if src == nil {
+		*p = ZeroPeriod
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for Period",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	period, err := ParsePeriod(s)
+	if err != nil {
+		return err
+	}
+	*p = period
+	return nil
+}
@@ -0,0 +1,96 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type PeriodSuite struct {
+	suite.Suite
+}
+
+func TestPeriodSuite(t *testing.T) {
+	suite.Run(t, new(PeriodSuite))
+}
+
+func (s *PeriodSuite) TestNewPeriod() {
+	p, err := wisp.NewPeriod(1, 2, 3)
+	s.Require().NoError(err)
+	s.Equal(1, p.Years())
+	s.Equal(2, p.Months())
+	s.Equal(3, p.Days())
+	s.Equal(14, p.TotalMonths())
+	s.Equal("P1Y2M3D", p.String())
+
+	_, err = wisp.NewPeriod(0, -1, 0)
+	s.Require().Error(err)
+	s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+	s.Equal("P0D", wisp.ZeroPeriod.String())
+	s.True(wisp.ZeroPeriod.IsZero())
+}
+
+func (s *PeriodSuite) TestParsePeriod() {
+	testCases := []struct {
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{input: "P1Y2M3D", expected: "P1Y2M3D"},
+		{input: "p6m", expected: "P6M"},
+		{input: "P2W", expected: "P14D"},
+		{input: "P1W3D", expected: "P10D"},
+		{input: "P0D", expected: "P0D"},
+		{input: "P", wantErr: true},
+		{input: "1Y", wantErr: true},
+		{input: "P1H", wantErr: true},
+		{input: "PT1H", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.input, func() {
+			p, err := wisp.ParsePeriod(tc.input)
+			if tc.wantErr {
+				s.Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.expected, p.String())
+		})
+	}
+}
+
+func (s *PeriodSuite) TestAddTo() {
+	p, _ := wisp.NewPeriod(1, 1, 1)
+	d, _ := wisp.NewDate(2024, time.January, 15)
+	s.Equal("2025-02-16", p.AddTo(d).String())
+}
+
+func (s *PeriodSuite) TestJSONAndSQL() {
+	p, _ := wisp.NewPeriod(0, 3, 0)
+
+	data, err := json.Marshal(p)
+	s.Require().NoError(err)
+	s.Equal(`"P3M"`, string(data))
+
+	var decoded wisp.Period
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(p.Equals(decoded))
+	s.Error(json.Unmarshal([]byte(`"3 months"`), &decoded))
+
+	val, err := p.Value()
+	s.Require().NoError(err)
+	s.Equal("P3M", val)
+
+	var scanned wisp.Period
+	s.Require().NoError(scanned.Scan([]byte("P3M")))
+	s.True(p.Equals(scanned))
+	s.Error(scanned.Scan(42))
+}
@@ -0,0 +1,217 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// permissionRegex validates a permission: two or more colon-separated segments, each made of
+// lowercase letters, digits, underscores or hyphens, or a single "*" wildcard.
+var permissionRegex = regexp.MustCompile(`^(\*|[a-z0-9_-]+)(:(\*|[a-z0-9_-]+))+$`)
+
+// PermissionWildcard matches any value of a permission segment.
+const PermissionWildcard = "*"
+
+// Permission is a value object representing a fine-grained authorization right in the
+// "resource:action" format (e.g., "orders:read", "billing:invoices:export").
+// Any segment may be the "*" wildcard, so "orders:*" grants every action on orders.
+// It complements Role: roles group users, permissions describe what they may do.
+//
+// Concrete permissions (without wildcards) must be registered in a global registry before
+// they can be created, like Role. Wildcard permissions only need to be well formed.
+// Permissions are normalized to lowercase.
+//
+// Example:
+//   wisp.RegisterPermissions("orders:read", "orders:write")
+//   p, err := wisp.NewPermission("orders:*")
+//   canRead := p.Matches("orders:read") // true
+type Permission string
+
+// validPermissions holds the global set of registered permissions.
+var validPermissions = make(map[Permission]struct{})
+
+// EmptyPermission represents the zero value for the Permission type.
+var EmptyPermission Permission
+
+// RegisterPermissions adds one or more permissions to the global registry of known permissions.
+// They are normalized to lowercase; malformed, wildcard and empty values are ignored.
+// This function should be called at application startup to define all possible permissions.
+func RegisterPermissions(permissions ...Permission) {
+	for _, p := range permissions {
+		normalized := normalizePermission(string(p))
+		if permissionRegex.MatchString(string(normalized)) && !normalized.IsWildcard() {
+			validPermissions[normalized] = struct{}{}
+		}
+	}
+}
+
+// ClearRegisteredPermissions removes all permissions from the global registry.
+// This is primarily for testing purposes to ensure a clean state.
+func ClearRegisteredPermissions() {
+	validPermissions = make(map[Permission]struct{})
+}
+
+// NewPermission creates a new Permission from a string.
+// It normalizes the input to lowercase and validates its format.
+// Concrete permissions must be registered; wildcard permissions only need a valid format.
+func NewPermission(value string) (Permission, error) {
+	normalized := normalizePermission(value)
+	if normalized == EmptyPermission {
+		return EmptyPermission, nil
+	}
+
+	if !permissionRegex.MatchString(string(normalized)) {
+		return EmptyPermission, fault.New(
+			"permission must be in 'resource:action' format",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_permission", value),
+		)
+	}
+
+	if !normalized.IsWildcard() && !normalized.IsRegistered() {
+		return EmptyPermission, fault.New(
+			"permission is not registered as a known permission",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_permission", value),
+		)
+	}
+
+	return normalized, nil
+}
+
+func normalizePermission(value string) Permission {
+	return Permission(strings.ToLower(strings.TrimSpace(value)))
+}
+
+// String returns the permission as a string.
+func (p Permission) String() string {
+	return string(p)
+}
+
+// IsZero returns true if the Permission is the zero value.
+func (p Permission) IsZero() bool {
+	return p == EmptyPermission
+}
+
+// IsRegistered checks if the permission is in the global registry of known permissions.
+func (p Permission) IsRegistered() bool {
+	_, ok := validPermissions[p]
+	return ok
+}
+
+// IsWildcard returns true if any segment of the permission is the "*" wildcard.
+func (p Permission) IsWildcard() bool {
+	for _, segment := range strings.Split(string(p), ":") {
+		if segment == PermissionWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// Resource returns the first segment of the permission (e.g., "orders" for "orders:read").
+func (p Permission) Resource() string {
+	resource, _, _ := strings.Cut(string(p), ":")
+	return resource
+}
+
+// Action returns the last segment of the permission (e.g., "read" for "orders:read").
+func (p Permission) Action() string {
+	if i := strings.LastIndexByte(string(p), ':'); i >= 0 {
+		return string(p[i+1:])
+	}
+	return ""
+}
+
+// Matches checks if this permission grants the other one.
+// Segments are compared one by one and a "*" segment in this permission matches any value,
+// including the remaining segments when it is the last one ("orders:*" matches "orders:items:read").
+func (p Permission) Matches(other Permission) bool {
+	if p.IsZero() || other.IsZero() {
+		return false
+	}
+
+	granted := strings.Split(string(p), ":")
+	requested := strings.Split(string(other), ":")
+
+	for i, segment := range granted {
+		if i >= len(requested) {
+			return false
+		}
+		if segment == PermissionWildcard {
+			if i == len(granted)-1 {
+				return true
+			}
+			continue
+		}
+		if segment != requested[i] {
+			return false
+		}
+	}
+	return len(granted) == len(requested)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p Permission) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a Permission, validating it.
+func (p *Permission) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "Permission must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	permission, err := NewPermission(s)
+	if err != nil {
+		return err
+	}
+	*p = permission
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the permission as a string or nil if it's the zero value.
+func (p Permission) Value() (driver.Value, error) {
+	if p.IsZero() {
+		return nil, nil
+	}
+	return p.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values and validates them as a Permission.
+func (p *Permission) Scan(src interface{}) error {
+	if src == nil {
+		*p = EmptyPermission
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for Permission",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	permission, err := NewPermission(s)
+	if err != nil {
+		return err
+	}
+	*p = permission
+	return nil
+}
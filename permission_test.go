@@ -0,0 +1,120 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type PermissionSuite struct {
+	suite.Suite
+}
+
+func TestPermissionSuite(t *testing.T) {
+	suite.Run(t, new(PermissionSuite))
+}
+
+func (s *PermissionSuite) SetupTest() {
+	wisp.ClearRegisteredPermissions()
+	wisp.RegisterPermissions("orders:read", "orders:write", "Orders:Items:Read", "orders:*", "invalid")
+}
+
+func (s *PermissionSuite) TestNewPermission() {
+	testCases := []struct {
+		name        string
+		input       string
+		expected    wisp.Permission
+		expectError bool
+	}{
+		{name: "registered permission", input: "orders:read", expected: "orders:read"},
+		{name: "normalizes case and whitespace", input: "  ORDERS:ITEMS:READ ", expected: "orders:items:read"},
+		{name: "wildcard action", input: "orders:*", expected: "orders:*"},
+		{name: "wildcard resource", input: "*:read", expected: "*:read"},
+		{name: "empty input", input: "  ", expected: wisp.EmptyPermission},
+		{name: "unregistered permission", input: "orders:delete", expectError: true},
+		{name: "single segment", input: "orders", expectError: true},
+		{name: "empty segment", input: "orders::read", expectError: true},
+		{name: "invalid characters", input: "orders:read!", expectError: true},
+		{name: "partial wildcard", input: "orders:re*", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			p, err := wisp.NewPermission(tc.input)
+			if tc.expectError {
+				s.Require().Error(err)
+				faultErr, ok := err.(*fault.Error)
+				s.Require().True(ok)
+				s.Equal(fault.Invalid, faultErr.Code)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.expected, p)
+		})
+	}
+}
+
+func (s *PermissionSuite) TestPermission_Accessors() {
+	p := wisp.Permission("orders:items:read")
+	s.Equal("orders", p.Resource())
+	s.Equal("read", p.Action())
+	s.True(p.IsRegistered())
+	s.False(p.IsWildcard())
+	s.True(wisp.Permission("orders:*").IsWildcard())
+	s.False(wisp.Permission("orders:*").IsRegistered())
+}
+
+func (s *PermissionSuite) TestPermission_Matches() {
+	testCases := []struct {
+		granted   wisp.Permission
+		requested wisp.Permission
+		expected  bool
+	}{
+		{granted: "orders:read", requested: "orders:read", expected: true},
+		{granted: "orders:read", requested: "orders:write", expected: false},
+		{granted: "orders:*", requested: "orders:write", expected: true},
+		{granted: "orders:*", requested: "orders:items:read", expected: true},
+		{granted: "orders:*", requested: "billing:read", expected: false},
+		{granted: "*:read", requested: "billing:read", expected: true},
+		{granted: "*:read", requested: "billing:write", expected: false},
+		{granted: "orders:*:read", requested: "orders:items:read", expected: true},
+		{granted: "orders:*:read", requested: "orders:items:write", expected: false},
+		{granted: "orders:items:read", requested: "orders:items", expected: false},
+		{granted: "orders:read", requested: "orders:read:all", expected: false},
+		{granted: wisp.EmptyPermission, requested: "orders:read", expected: false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.granted.String()+" -> "+tc.requested.String(), func() {
+			s.Equal(tc.expected, tc.granted.Matches(tc.requested))
+		})
+	}
+}
+
+func (s *PermissionSuite) TestPermission_Serialization() {
+	p, _ := wisp.NewPermission("orders:write")
+
+	data, err := json.Marshal(p)
+	s.Require().NoError(err)
+	s.Equal(`"orders:write"`, string(data))
+
+	var decoded wisp.Permission
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(p, decoded)
+	s.Require().Error(json.Unmarshal([]byte(`"orders:delete"`), &decoded))
+
+	val, err := p.Value()
+	s.Require().NoError(err)
+	s.Equal("orders:write", val)
+
+	var scanned wisp.Permission
+	s.Require().NoError(scanned.Scan([]byte("orders:read")))
+	s.Equal(wisp.Permission("orders:read"), scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Require().Error(scanned.Scan(1))
+}
@@ -4,17 +4,22 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
 
 	"github.com/marcelofabianov/fault"
 )
 
-// Phone is a value object representing a Brazilian phone number.
+// Phone is a value object representing a phone number, Brazilian by default.
 // It stores the number in a normalized E.164-like format (e.g., "5511987654321"),
 // including the country code (55 for Brazil), area code (DDD), and the local number.
 //
 // The type validates the DDD, the number of digits for mobile vs. landline, and the mobile prefix.
+// Numbers of other countries are accepted in international format ("+CC ...") once their profile
+// is registered with RegisterPhoneCountry; they are stored in E.164 with the leading "+"
+// (e.g., "+14155552671"), which keeps them apart from Brazilian numbers stored without it.
+// Stored numbers decode with Scan and UnmarshalJSON even where their country is not registered.
 //
 // Examples:
 //   - Input: "(11) 98765-4321"
@@ -28,62 +33,125 @@ var EmptyPhone Phone
 // nonDigitRegex is used to remove all non-numeric characters from a phone number string.
 var nonDigitRegex = regexp.MustCompile(`\D+`)
 
-// validDDDs is the set of all valid Brazilian area codes (DDD).
-var validDDDs = map[string]struct{}{
-	"11": {}, "12": {}, "13": {}, "14": {}, "15": {}, "16": {}, "17": {}, "18": {}, "19": {},
-	"21": {}, "22": {}, "24": {}, "27": {}, "28": {},
-	"31": {}, "32": {}, "33": {}, "34": {}, "35": {}, "37": {}, "38": {},
-	"41": {}, "42": {}, "43": {}, "44": {}, "45": {}, "46": {}, "47": {}, "48": {}, "49": {},
-	"51": {}, "53": {}, "54": {}, "55": {},
-	"61": {}, "62": {}, "63": {}, "64": {}, "65": {}, "66": {}, "67": {}, "68": {}, "69": {},
-	"71": {}, "73": {}, "74": {}, "75": {}, "77": {}, "79": {},
-	"81": {}, "82": {}, "83": {}, "84": {}, "85": {}, "86": {}, "87": {}, "88": {}, "89": {},
-	"91": {}, "92": {}, "93": {}, "94": {}, "95": {}, "96": {}, "97": {}, "98": {}, "99": {},
-}
-
-// parsePhone contains the core logic for validating and normalizing a Brazilian phone number.
-func parsePhone(input string) (Phone, error) {
-	if input == "" {
+// extractDigits copies the ASCII digits found in input into dst and returns how many digits were found.
+// Digits beyond len(dst) are still counted but not copied, so callers can report length errors
+// without allocating an intermediate sanitized string.
+func extractDigits[T string | []byte](input T, dst []byte) int {
+	n := 0
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		if n < len(dst) {
+			dst[n] = c
+		}
+		n++
+	}
+	return n
+}
+
+// dddStates maps every valid Brazilian area code (DDD) to the state it serves.
+// Each DDD belongs to a single UF; DDD 61 also reaches towns of Goiás around Brasília,
+// but is assigned to the Federal District.
+var dddStates = map[string]UF{
+	"11": "SP", "12": "SP", "13": "SP", "14": "SP", "15": "SP", "16": "SP", "17": "SP", "18": "SP", "19": "SP",
+	"21": "RJ", "22": "RJ", "24": "RJ", "27": "ES", "28": "ES",
+	"31": "MG", "32": "MG", "33": "MG", "34": "MG", "35": "MG", "37": "MG", "38": "MG",
+	"41": "PR", "42": "PR", "43": "PR", "44": "PR", "45": "PR", "46": "PR", "47": "SC", "48": "SC", "49": "SC",
+	"51": "RS", "53": "RS", "54": "RS", "55": "RS",
+	"61": "DF", "62": "GO", "63": "TO", "64": "GO", "65": "MT", "66": "MT", "67": "MS", "68": "AC", "69": "RO",
+	"71": "BA", "73": "BA", "74": "BA", "75": "BA", "77": "BA", "79": "SE",
+	"81": "PE", "82": "AL", "83": "PB", "84": "RN", "85": "CE", "86": "PI", "87": "PE", "88": "CE", "89": "PI",
+	"91": "PA", "92": "AM", "93": "PA", "94": "PA", "95": "RR", "96": "AP", "97": "AM", "98": "MA", "99": "MA",
+}
+
+// parsePhone contains the core logic for validating and normalizing a phone number.
+// Numbers in international format whose calling code has a registered profile are validated by it;
+// everything else follows the Brazilian rules.
+// It works directly on string or []byte input so database scans do not need an intermediate string.
+func parsePhone[T string | []byte](input T) (Phone, error) {
+	if len(input) == 0 {
 		return EmptyPhone, nil
 	}
 
-	sanitized := nonDigitRegex.ReplaceAllString(input, "")
+	if len(phoneCountries) > 0 {
+		if digits, ok := internationalDigits(input); ok {
+			if country, ok := lookupPhoneCountry(digits); ok {
+				return parseInternationalPhone(digits, country, string(input))
+			}
+		}
+	}
 
-	if len(sanitized) < 10 {
-		return EmptyPhone, fault.New("phone number is too short", fault.WithCode(fault.Invalid), fault.WithContext("input", input))
+	var raw [18]byte
+	n := extractDigits(input, raw[:])
+	if n <= len(raw) {
+		digits := stripDialingPrefixes(raw[:n])
+		n = len(digits)
+		copy(raw[:], digits)
 	}
 
-	if len(sanitized) > 13 {
-		return EmptyPhone, fault.New("phone number is too long", fault.WithCode(fault.Invalid), fault.WithContext("input", input))
+	var buf [15]byte
+	copy(buf[2:], raw[:min(n, len(buf)-2)])
+
+	if n < 10 {
+		return EmptyPhone, fault.New("phone number is too short", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
 	}
 
-	if !strings.HasPrefix(sanitized, "55") {
-		sanitized = "55" + sanitized
+	if n > 13 {
+		return EmptyPhone, fault.New("phone number is too long", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
+	}
+
+	sanitized := buf[2 : 2+n]
+	if sanitized[0] != '5' || sanitized[1] != '5' {
+		buf[0], buf[1] = '5', '5'
+		sanitized = buf[:2+n]
 	}
 
 	if len(sanitized) != 12 && len(sanitized) != 13 {
-		return EmptyPhone, fault.New("invalid phone number length after normalization", fault.WithCode(fault.Invalid), fault.WithContext("normalized_number", sanitized))
+		return EmptyPhone, fault.New("invalid phone number length after normalization", fault.WithCode(fault.Invalid), fault.WithContext("normalized_number", string(sanitized)))
 	}
 
 	areaCode := sanitized[2:4]
-	if _, ok := validDDDs[areaCode]; !ok {
-		return EmptyPhone, fault.New("invalid area code (DDD)", fault.WithCode(fault.Invalid), fault.WithContext("area_code", areaCode))
+	if _, ok := dddStates[string(areaCode)]; !ok {
+		return EmptyPhone, fault.New("invalid area code (DDD)", fault.WithCode(fault.Invalid), fault.WithContext("area_code", string(areaCode)))
 	}
 
 	numberPart := sanitized[4:]
 	if len(numberPart) == 9 && numberPart[0] != '9' {
-		return EmptyPhone, fault.New("mobile number must start with digit 9", fault.WithCode(fault.Invalid), fault.WithContext("number", numberPart))
+		return EmptyPhone, fault.New("mobile number must start with digit 9", fault.WithCode(fault.Invalid), fault.WithContext("number", string(numberPart)))
 	}
 	if len(numberPart) == 8 && (numberPart[0] < '2' || numberPart[0] > '5') {
-		return EmptyPhone, fault.New("landline number has an invalid prefix", fault.WithCode(fault.Invalid), fault.WithContext("number", numberPart))
+		return EmptyPhone, fault.New("landline number has an invalid prefix", fault.WithCode(fault.Invalid), fault.WithContext("number", string(numberPart)))
 	}
 
 	return Phone(sanitized), nil
 }
 
+// stripDialingPrefixes removes the prefixes of a number dialed from within Brazil, as found in
+// legacy systems: the leading zeros of long-distance dialing and the 2-digit carrier selection
+// code that may follow them (e.g., "0 21 11 98765-4321" or "(0XX11) 4567-1234").
+// Digits not starting with zero are returned unchanged.
+func stripDialingPrefixes(digits []byte) []byte {
+	if len(digits) == 0 || digits[0] != '0' {
+		return digits
+	}
+	for len(digits) > 0 && digits[0] == '0' {
+		digits = digits[1:]
+	}
+	// DDD plus number has 10 or 11 digits; 2 more can only be a carrier code.
+	if len(digits) == 12 || len(digits) == 13 {
+		digits = digits[2:]
+	}
+	return digits
+}
+
 // NewPhone creates a new Phone from a string.
 // It sanitizes the input by removing non-digit characters, validates the length,
 // ensures the Brazilian country code (55) is present, and validates the area code (DDD) and number format.
+// Long-distance prefixes such as "0XX11", "011" or "0 21 11" (with a carrier code) are removed.
+// Inputs starting with "+" or "00" followed by the calling code of a country registered with
+// RegisterPhoneCountry are validated by that country's profile instead.
 // It returns an error if the phone number is invalid in any of these ways.
 func NewPhone(input string) (Phone, error) {
 	return parsePhone(input)
@@ -94,52 +162,100 @@ func (p Phone) String() string {
 	return string(p)
 }
 
-// CountryCode returns the country code part of the number.
+// international returns the profile and national number of a number from another country.
+// For countries not registered, the profile only has the calling code. The boolean is false for
+// Brazilian numbers.
+func (p Phone) international() (PhoneCountry, string, bool) {
+	if len(p) < 2 || p[0] != '+' {
+		return PhoneCountry{}, "", false
+	}
+	digits := string(p[1:])
+	country, ok := lookupPhoneCountry(digits)
+	if !ok {
+		country = PhoneCountry{CallingCode: e164CallingCode(digits)}
+	}
+	return country, digits[len(country.CallingCode):], true
+}
+
+// IsBrazilian returns true if the number follows the built-in Brazilian rules.
+func (p Phone) IsBrazilian() bool {
+	return !p.IsZero() && p[0] != '+'
+}
+
+// CountryCode returns the country code part of the number (e.g., "55" or "1").
 func (p Phone) CountryCode() string {
-	if p.IsZero() || len(p) < 2 {
+	if country, _, ok := p.international(); ok {
+		return country.CallingCode
+	}
+	if !p.IsBrazilian() || len(p) < 2 {
 		return ""
 	}
 	return string(p[0:2])
 }
 
-// AreaCode returns the area code (DDD) part of the number.
+// AreaCode returns the area code (DDD) part of a Brazilian number, or an empty string for other countries.
 func (p Phone) AreaCode() string {
-	if p.IsZero() || len(p) < 4 {
+	if !p.IsBrazilian() || len(p) < 4 {
 		return ""
 	}
 	return string(p[2:4])
 }
 
 // Number returns the local number part (without country or area code).
+// For numbers of other countries, it returns the whole national number.
 func (p Phone) Number() string {
-	if p.IsZero() || len(p) < 4 {
+	if _, national, ok := p.international(); ok {
+		return national
+	}
+	if !p.IsBrazilian() || len(p) < 4 {
 		return ""
 	}
 	return string(p[4:])
 }
 
+// State returns the state (UF) served by the area code, or EmptyUF for the zero value.
+func (p Phone) State() UF {
+	return dddStates[p.AreaCode()]
+}
+
+// Region returns the geographic region of the state served by the area code,
+// or an empty Region for the zero value.
+func (p Phone) Region() Region {
+	return p.State().Region()
+}
+
 // IsZero returns true if the Phone is the zero value.
 func (p Phone) IsZero() bool {
 	return p == EmptyPhone
 }
 
-// IsMobile returns true if the phone number is identified as a mobile number (9 digits).
+// IsMobile returns true if the phone number is identified as a Brazilian mobile number (9 digits).
 func (p Phone) IsMobile() bool {
-	return !p.IsZero() && len(p.Number()) == 9
+	return p.IsBrazilian() && len(p.Number()) == 9
 }
 
-// IsLandline returns true if the phone number is identified as a landline number (8 digits).
+// IsLandline returns true if the phone number is identified as a Brazilian landline number (8 digits).
 func (p Phone) IsLandline() bool {
-	return !p.IsZero() && len(p.Number()) == 8
+	return p.IsBrazilian() && len(p.Number()) == 8
 }
 
 // Formatted returns the phone number in a human-readable format.
 // Mobile: "+55 (11) 98765-4321"
 // Landline: "+55 (11) 4321-5432"
+// Other countries: "+CC " followed by the national number, rendered by the profile's Format when set.
 func (p Phone) Formatted() string {
 	if p.IsZero() {
 		return ""
 	}
+	if country, national, ok := p.international(); ok {
+		if country.Format != nil {
+			national = country.Format(national)
+		}
+		return fmt.Sprintf("+%s %s", country.CallingCode, national)
+	}
+	if !p.IsBrazilian() {
+		return p.String()
+	}
 	number := p.Number()
 	if p.IsMobile() {
 		return fmt.Sprintf("+%s (%s) %s-%s", p.CountryCode(), p.AreaCode(), number[:5], number[5:])
@@ -147,6 +263,51 @@ func (p Phone) Formatted() string {
 	return fmt.Sprintf("+%s (%s) %s-%s", p.CountryCode(), p.AreaCode(), number[:4], number[4:])
 }
 
+// Masked returns the formatted phone number with only the last four digits visible
+// ("+55 (11) *****-4321", or "+1 ******2671" for other countries), so it can be logged or
+// displayed under LGPD. Returns an empty string for EmptyPhone.
+func (p Phone) Masked() string {
+	if p.IsZero() {
+		return ""
+	}
+	number := p.Number()
+	if len(number) < 4 {
+		return ""
+	}
+	if !p.IsBrazilian() {
+		return fmt.Sprintf("+%s %s%s", p.CountryCode(), strings.Repeat("*", len(number)-4), number[len(number)-4:])
+	}
+	return fmt.Sprintf("+%s (%s) %s-%s", p.CountryCode(), p.AreaCode(), strings.Repeat("*", len(number)-4), number[len(number)-4:])
+}
+
+// URI returns the number as an RFC 3966 "tel:" URI (e.g., "tel:+5511987654321"),
+// suitable for click-to-call links. Returns an empty string for EmptyPhone.
+func (p Phone) URI() string {
+	if p.IsZero() {
+		return ""
+	}
+	return "tel:+" + strings.TrimPrefix(p.String(), "+")
+}
+
+// WhatsAppLink returns the WhatsApp click-to-chat link for the number
+// (e.g., "https://wa.me/5511987654321"). Returns an empty string for EmptyPhone.
+func (p Phone) WhatsAppLink() string {
+	if p.IsZero() {
+		return ""
+	}
+	return "https://wa.me/" + strings.TrimPrefix(p.String(), "+")
+}
+
+// WhatsAppLinkWithMessage returns the WhatsApp click-to-chat link with a pre-filled message
+// (e.g., "https://wa.me/5511987654321?text=Ol%C3%A1"). Returns an empty string for EmptyPhone.
+func (p Phone) WhatsAppLinkWithMessage(message string) string {
+	link := p.WhatsAppLink()
+	if link == "" || message == "" {
+		return link
+	}
+	return link + "?text=" + url.QueryEscape(message)
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 // It serializes the Phone to its normalized string representation.
 func (p Phone) MarshalJSON() ([]byte, error) {
@@ -154,13 +315,14 @@ func (p Phone) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// It deserializes a JSON string into a Phone, with validation.
+// It deserializes a JSON string into a Phone, with validation. Numbers of other countries in the
+// stored E.164 form ("+14155552671") are accepted even if their country is not registered.
 func (p *Phone) UnmarshalJSON(data []byte) error {
 	var s string
 	if err := json.Unmarshal(data, &s); err != nil {
 		return fault.Wrap(err, "phone must be a valid JSON string", fault.WithCode(fault.Invalid))
 	}
-	phone, err := NewPhone(s)
+	phone, err := decodePhone(s)
 	if err != nil {
 		return err
 	}
@@ -179,23 +341,24 @@ func (p Phone) Value() (driver.Value, error) {
 
 // Scan implements the sql.Scanner interface for database retrieval.
 // It accepts a string or byte slice from the database and converts it into a Phone, with validation.
+// Numbers of other countries stored in E.164 are accepted even if their country is not registered.
 func (p *Phone) Scan(src interface{}) error {
 	if src == nil {
 		*p = EmptyPhone
 		return nil
 	}
 
-	var s string
+	var phone Phone
+	var err error
 	switch v := src.(type) {
 	case string:
-		s = v
+		phone, err = decodePhone(v)
 	case []byte:
-		s = string(v)
+		phone, err = decodePhone(v)
 	default:
 		return fault.New("unsupported scan type for Phone", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
 	}
 
-	phone, err := NewPhone(s)
 	if err != nil {
 		return err
 	}
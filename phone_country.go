@@ -0,0 +1,199 @@
+package wisp
+
+import (
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// brazilCallingCode is the country calling code of the built-in Brazilian phone profile.
+const brazilCallingCode = "55"
+
+// maxE164Digits is the maximum number of digits of an E.164 phone number, country code included.
+const maxE164Digits = 15
+
+// PhoneCountry is the profile used by Phone to validate numbers of a country other than Brazil,
+// registered with RegisterPhoneCountry. Brazilian numbers keep their built-in rules (DDD,
+// mobile and landline prefixes) and are always the default.
+//
+// Example:
+//   wisp.RegisterPhoneCountry(wisp.PhoneCountry{
+//       CallingCode: "1",
+//       MinDigits:   10,
+//       MaxDigits:   10,
+//   })
+//   p, _ := wisp.NewPhone("+1 415 555 2671")
+//   p.String() // "+14155552671"
+type PhoneCountry struct {
+	// CallingCode is the E.164 country calling code, with 1 to 3 digits and no "+" (e.g., "1", "44", "351").
+	CallingCode string
+	// MinDigits and MaxDigits bound the length of the national number, without the calling code.
+	MinDigits int
+	MaxDigits int
+	// Validate optionally applies country-specific rules to the national number (digits only).
+	Validate func(national string) error
+	// Format optionally renders the national number for display; Formatted adds the "+CC " prefix.
+	Format func(national string) string
+}
+
+// phoneCountries holds the registered profiles, keyed by calling code.
+var phoneCountries = make(map[string]PhoneCountry)
+
+// RegisterPhoneCountry adds or replaces the profile of a country, allowing Phone to accept its
+// numbers in international format ("+CC ..." or "00CC ..."). The calling code must have 1 to 3
+// digits and cannot be Brazil's, whose rules are built in.
+// Returns an error if the profile is invalid.
+func RegisterPhoneCountry(country PhoneCountry) error {
+	code := country.CallingCode
+	if len(code) < 1 || len(code) > 3 || extractDigits(code, nil) != len(code) || code[0] == '0' {
+		return fault.New(
+			"phone calling code must have 1 to 3 digits",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("calling_code", code),
+		)
+	}
+	if code == brazilCallingCode {
+		return fault.New(
+			"the Brazilian phone profile is built in and cannot be replaced",
+			fault.WithCode(fault.Conflict),
+			fault.WithContext("calling_code", code),
+		)
+	}
+	if country.MinDigits < 1 || country.MinDigits > country.MaxDigits || len(code)+country.MaxDigits > maxE164Digits {
+		return fault.New(
+			"phone national number length must be between 1 and the E.164 limit of 15 digits",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("calling_code", code),
+			fault.WithContext("min_digits", country.MinDigits),
+			fault.WithContext("max_digits", country.MaxDigits),
+		)
+	}
+
+	phoneCountries[code] = country
+	return nil
+}
+
+// ClearPhoneCountries removes all registered phone country profiles, leaving only Brazil.
+// This is primarily for testing purposes to ensure a clean state.
+func ClearPhoneCountries() {
+	phoneCountries = make(map[string]PhoneCountry)
+}
+
+// lookupPhoneCountry finds the registered profile whose calling code prefixes digits.
+// E.164 calling codes are prefix-free, so at most one profile matches.
+func lookupPhoneCountry(digits string) (PhoneCountry, bool) {
+	for size := 1; size <= 3 && size <= len(digits); size++ {
+		if country, ok := phoneCountries[digits[:size]]; ok {
+			return country, true
+		}
+	}
+	return PhoneCountry{}, false
+}
+
+// twoDigitCallingCodes are the 2-digit country calling codes assigned by the ITU. Among the other
+// codes, 1 and 7 have a single digit and all the rest have 3.
+var twoDigitCallingCodes = map[string]struct{}{
+	"20": {}, "27": {}, "30": {}, "31": {}, "32": {}, "33": {}, "34": {}, "36": {}, "39": {},
+	"40": {}, "41": {}, "43": {}, "44": {}, "45": {}, "46": {}, "47": {}, "48": {}, "49": {},
+	"51": {}, "52": {}, "53": {}, "54": {}, "55": {}, "56": {}, "57": {}, "58": {},
+	"60": {}, "61": {}, "62": {}, "63": {}, "64": {}, "65": {}, "66": {},
+	"81": {}, "82": {}, "84": {}, "86": {},
+	"90": {}, "91": {}, "92": {}, "93": {}, "94": {}, "95": {}, "98": {},
+}
+
+// e164CallingCode returns the calling code that prefixes the digits of an E.164 number: the one
+// of its registered profile or, for countries not registered, the one given by the ITU assignment.
+func e164CallingCode(digits string) string {
+	if country, ok := lookupPhoneCountry(digits); ok {
+		return country.CallingCode
+	}
+	switch {
+	case digits == "":
+		return ""
+	case digits[0] == '1' || digits[0] == '7':
+		return digits[:1]
+	case len(digits) < 2:
+		return digits
+	}
+	if _, ok := twoDigitCallingCodes[digits[:2]]; ok {
+		return digits[:2]
+	}
+	return digits[:min(3, len(digits))]
+}
+
+// isStoredInternationalPhone reports whether input is a number of another country in the form
+// Phone stores it, "+" and the E.164 digits (e.g., "+14155552671").
+func isStoredInternationalPhone[T string | []byte](input T) bool {
+	if len(input) < 3 || len(input) > 1+maxE164Digits || input[0] != '+' || input[1] == '0' {
+		return false
+	}
+	if input[1] == '5' && input[2] == '5' {
+		return false
+	}
+	digits := string(input[1:])
+	return extractDigits(digits, nil) == len(digits) && len(digits) > len(e164CallingCode(digits))
+}
+
+// decodePhone parses a Phone read back from storage. Numbers of other countries stored in E.164
+// are accepted as they are when their country is not registered, so decoding does not depend on
+// the profiles registered by the process; everything else goes through parsePhone.
+func decodePhone[T string | []byte](input T) (Phone, error) {
+	if isStoredInternationalPhone(input) {
+		if _, ok := lookupPhoneCountry(string(input[1:])); !ok {
+			return Phone(input), nil
+		}
+	}
+	return parsePhone(input)
+}
+
+// internationalDigits returns the digits of an input written in international format, with a
+// leading "+" or "00", and whether it was in that format.
+func internationalDigits[T string | []byte](input T) (string, bool) {
+	trimmed := strings.TrimSpace(string(input))
+	var rest string
+	switch {
+	case strings.HasPrefix(trimmed, "+"):
+		rest = trimmed[1:]
+	case strings.HasPrefix(trimmed, "00"):
+		rest = trimmed[2:]
+	default:
+		return "", false
+	}
+
+	var buf [maxE164Digits + 1]byte
+	n := extractDigits(rest, buf[:])
+	if n > len(buf) {
+		n = len(buf)
+	}
+	return string(buf[:n]), true
+}
+
+// parseInternationalPhone validates digits (calling code included) against a registered profile.
+func parseInternationalPhone(digits string, country PhoneCountry, input string) (Phone, error) {
+	if len(digits) > maxE164Digits {
+		return EmptyPhone, fault.New("phone number is too long", fault.WithCode(fault.Invalid), fault.WithContext("input", input))
+	}
+
+	national := digits[len(country.CallingCode):]
+	if len(national) < country.MinDigits || len(national) > country.MaxDigits {
+		return EmptyPhone, fault.New(
+			"phone number has an invalid length for its country",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input", input),
+			fault.WithContext("calling_code", country.CallingCode),
+		)
+	}
+
+	if country.Validate != nil {
+		if err := country.Validate(national); err != nil {
+			return EmptyPhone, fault.Wrap(err,
+				"phone number is not valid for its country",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("input", input),
+				fault.WithContext("calling_code", country.CallingCode),
+			)
+		}
+	}
+
+	return Phone("+" + digits), nil
+}
@@ -0,0 +1,185 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type PhoneCountrySuite struct {
+	suite.Suite
+}
+
+func TestPhoneCountrySuite(t *testing.T) {
+	suite.Run(t, new(PhoneCountrySuite))
+}
+
+func (s *PhoneCountrySuite) SetupTest() {
+	wisp.ClearPhoneCountries()
+	s.Require().NoError(wisp.RegisterPhoneCountry(wisp.PhoneCountry{
+		CallingCode: "1",
+		MinDigits:   10,
+		MaxDigits:   10,
+		Validate: func(national string) error {
+			if national[0] < '2' {
+				return errors.New("area code cannot start with 0 or 1")
+			}
+			return nil
+		},
+		Format: func(national string) string {
+			return "(" + national[:3] + ") " + national[3:6] + "-" + national[6:]
+		},
+	}))
+	s.Require().NoError(wisp.RegisterPhoneCountry(wisp.PhoneCountry{CallingCode: "351", MinDigits: 9, MaxDigits: 9}))
+}
+
+func (s *PhoneCountrySuite) TearDownTest() {
+	wisp.ClearPhoneCountries()
+}
+
+func (s *PhoneCountrySuite) TestRegisterPhoneCountry() {
+	s.Run("should reject invalid calling codes", func() {
+		for _, code := range []string{"", "1234", "4a", "01"} {
+			err := wisp.RegisterPhoneCountry(wisp.PhoneCountry{CallingCode: code, MinDigits: 8, MaxDigits: 10})
+			s.Require().Error(err, code)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+
+	s.Run("should not replace the Brazilian profile", func() {
+		err := wisp.RegisterPhoneCountry(wisp.PhoneCountry{CallingCode: "55", MinDigits: 10, MaxDigits: 11})
+		s.Require().Error(err)
+		s.Equal(fault.Conflict, err.(*fault.Error).Code)
+	})
+
+	s.Run("should reject invalid lengths", func() {
+		s.Error(wisp.RegisterPhoneCountry(wisp.PhoneCountry{CallingCode: "44", MinDigits: 0, MaxDigits: 10}))
+		s.Error(wisp.RegisterPhoneCountry(wisp.PhoneCountry{CallingCode: "44", MinDigits: 10, MaxDigits: 9}))
+		s.Error(wisp.RegisterPhoneCountry(wisp.PhoneCountry{CallingCode: "44", MinDigits: 10, MaxDigits: 14}))
+	})
+}
+
+func (s *PhoneCountrySuite) TestInternationalPhone() {
+	s.Run("should parse a registered country in international format", func() {
+		for _, input := range []string{"+1 (415) 555-2671", "001 415 555 2671", " +14155552671 "} {
+			p, err := wisp.NewPhone(input)
+			s.Require().NoError(err, input)
+			s.Equal(wisp.Phone("+14155552671"), p)
+			s.False(p.IsBrazilian())
+			s.Equal("1", p.CountryCode())
+			s.Equal("", p.AreaCode())
+			s.Equal("4155552671", p.Number())
+			s.False(p.IsMobile())
+			s.False(p.IsLandline())
+			s.True(p.State().IsZero())
+			s.Equal("+1 (415) 555-2671", p.Formatted())
+			s.Equal("+1 ******2671", p.Masked())
+		}
+	})
+
+	s.Run("should format without a custom formatter", func() {
+		p, err := wisp.NewPhone("+351 912 345 678")
+		s.Require().NoError(err)
+		s.Equal("+351 912345678", p.Formatted())
+		s.Equal("351", p.CountryCode())
+	})
+
+	s.Run("should apply the profile rules", func() {
+		_, err := wisp.NewPhone("+1 415 555 267")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+		_, err = wisp.NewPhone("+1 115 555 2671")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+
+	s.Run("should keep Brazilian numbers as the default", func() {
+		for _, input := range []string{"+55 (62) 98287-0053", "(62) 98287-0053", "5562982870053"} {
+			p, err := wisp.NewPhone(input)
+			s.Require().NoError(err, input)
+			s.Equal(wisp.Phone("5562982870053"), p)
+			s.True(p.IsBrazilian())
+			s.Equal("55", p.CountryCode())
+		}
+	})
+
+	s.Run("should reject numbers of unregistered countries", func() {
+		wisp.ClearPhoneCountries()
+		_, err := wisp.NewPhone("+1 415 555 2671")
+		s.Require().Error(err)
+	})
+}
+
+func (s *PhoneCountrySuite) TestPersistence() {
+	p, err := wisp.NewPhone("+1 415 555 2671")
+	s.Require().NoError(err)
+
+	data, err := json.Marshal(p)
+	s.Require().NoError(err)
+	s.Equal(`"+14155552671"`, string(data))
+
+	var decoded wisp.Phone
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(p, decoded)
+
+	val, err := p.Value()
+	s.Require().NoError(err)
+
+	var scanned wisp.Phone
+	s.Require().NoError(scanned.Scan([]byte(val.(string))))
+	s.Equal(p, scanned)
+}
+
+func (s *PhoneCountrySuite) TestDecodeWithoutRegistry() {
+	wisp.ClearPhoneCountries()
+
+	s.Run("should decode stored numbers of unregistered countries", func() {
+		var scanned wisp.Phone
+		s.Require().NoError(scanned.Scan([]byte("+14155552671")))
+		s.Equal(wisp.Phone("+14155552671"), scanned)
+		s.False(scanned.IsBrazilian())
+		s.Equal("1", scanned.CountryCode())
+		s.Equal("4155552671", scanned.Number())
+		s.Equal("+1 4155552671", scanned.Formatted())
+		s.Equal("+1 ******2671", scanned.Masked())
+
+		var decoded wisp.Phone
+		s.Require().NoError(json.Unmarshal([]byte(`"+351912345678"`), &decoded))
+		s.Equal("351", decoded.CountryCode())
+		s.Equal("+351 912345678", decoded.Formatted())
+
+		s.Require().NoError(decoded.Scan("+442071838750"))
+		s.Equal("44", decoded.CountryCode())
+		s.Equal("2071838750", decoded.Number())
+	})
+
+	s.Run("should keep validating other inputs", func() {
+		var decoded wisp.Phone
+		for _, input := range []string{"+1 415 555 2671", "+1", "+0123456789", "+1415555267100000"} {
+			s.Require().Error(decoded.Scan(input), input)
+		}
+		s.Require().NoError(decoded.Scan("+5562982870053"))
+		s.Equal(wisp.Phone("5562982870053"), decoded)
+	})
+
+	s.Run("should apply the profile once registered", func() {
+		s.Require().NoError(wisp.RegisterPhoneCountry(wisp.PhoneCountry{
+			CallingCode: "1",
+			MinDigits:   10,
+			MaxDigits:   10,
+			Format: func(national string) string {
+				return "(" + national[:3] + ") " + national[3:6] + "-" + national[6:]
+			},
+		}))
+		var scanned wisp.Phone
+		s.Require().NoError(scanned.Scan("+14155552671"))
+		s.Equal("+1 (415) 555-2671", scanned.Formatted())
+		s.Require().Error(scanned.Scan("+1415555267"))
+	})
+}
@@ -33,6 +33,10 @@ func (s *PhoneSuite) TestNewPhone() {
 		{name: "should create a valid landline phone", input: "(11) 4567-1234", expected: "551145671234"},
 		{name: "should create an empty phone from an empty string", input: "", expected: wisp.EmptyPhone},
 		{name: "should create a valid mobile phone from E.164 format with plus sign", input: "+5562982870053", expected: "5562982870053"},
+		{name: "should strip the long-distance zero", input: "(011) 98765-4321", expected: "5511987654321"},
+		{name: "should strip the 0XX carrier placeholder", input: "(0XX11) 4567-1234", expected: "551145671234"},
+		{name: "should strip a carrier selection code from a mobile", input: "0 21 11 98765-4321", expected: "5511987654321"},
+		{name: "should strip a carrier selection code from a landline", input: "015 11 4567-1234", expected: "551145671234"},
 		// Error Paths
 		{name: "should fail for number too short", input: "6298287", expectError: true, errCode: fault.Invalid},
 		{name: "should fail for number too long", input: "5562982870053123", expectError: true, errCode: fault.Invalid},
@@ -85,6 +89,34 @@ func (s *PhoneSuite) TestPhone_ComponentsAndStateChecks() {
 	})
 }
 
+func (s *PhoneSuite) TestPhone_StateAndRegion() {
+	testCases := []struct {
+		input  string
+		state  wisp.UF
+		region wisp.Region
+	}{
+		{"(11) 98765-4321", "SP", wisp.RegionSudeste},
+		{"(61) 3321-5432", "DF", wisp.RegionCentroOeste},
+		{"(71) 99876-5432", "BA", wisp.RegionNordeste},
+		{"(92) 98765-4321", "AM", wisp.RegionNorte},
+		{"(48) 98765-4321", "SC", wisp.RegionSul},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.input, func() {
+			phone, err := wisp.NewPhone(tc.input)
+			s.Require().NoError(err)
+			s.Equal(tc.state, phone.State())
+			s.Equal(tc.region, phone.Region())
+		})
+	}
+
+	s.Run("should return empty values for the zero phone", func() {
+		s.True(wisp.EmptyPhone.State().IsZero())
+		s.True(wisp.EmptyPhone.Region().IsZero())
+	})
+}
+
 func (s *PhoneSuite) TestPhone_Formatted() {
 	mobile, _ := wisp.NewPhone("5562982870053")
 	landline, _ := wisp.NewPhone("551145671234")
@@ -94,6 +126,19 @@ func (s *PhoneSuite) TestPhone_Formatted() {
 	s.Equal("", wisp.EmptyPhone.Formatted())
 }
 
+func (s *PhoneSuite) TestPhone_Links() {
+	mobile, _ := wisp.NewPhone("(11) 98765-4321")
+
+	s.Equal("tel:+5511987654321", mobile.URI())
+	s.Equal("https://wa.me/5511987654321", mobile.WhatsAppLink())
+	s.Equal("https://wa.me/5511987654321?text=Ol%C3%A1+mundo", mobile.WhatsAppLinkWithMessage("Olá mundo"))
+	s.Equal("https://wa.me/5511987654321", mobile.WhatsAppLinkWithMessage(""))
+
+	s.Equal("", wisp.EmptyPhone.URI())
+	s.Equal("", wisp.EmptyPhone.WhatsAppLink())
+	s.Equal("", wisp.EmptyPhone.WhatsAppLinkWithMessage("Olá"))
+}
+
 func (s *PhoneSuite) TestPhone_JSONMarshaling() {
 	s.Run("should marshal and unmarshal correctly", func() {
 		phone, _ := wisp.NewPhone("+55 (62) 98287-0053")
@@ -152,5 +197,29 @@ func (s *PhoneSuite) TestPhone_DatabaseInterface() {
 			err := phone.Scan(12345)
 			s.Require().Error(err)
 		})
+
+		s.Run("should scan and normalize a byte slice", func() {
+			var phone wisp.Phone
+			err := phone.Scan([]byte("(11) 98765-4321"))
+			s.Require().NoError(err)
+			s.Equal(wisp.Phone("5511987654321"), phone)
+		})
+
+		s.Run("should fail to scan an invalid byte slice", func() {
+			var phone wisp.Phone
+			err := phone.Scan([]byte("5511987654321000"))
+			s.Require().Error(err)
+		})
 	})
 }
+
+func BenchmarkPhone_ScanBytes(b *testing.B) {
+	src := []byte("5511987654321")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var phone wisp.Phone
+		if err := phone.Scan(src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package wisp
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Masker is implemented by value objects holding personal data (CPF, CNPJ, TaxID, RG, Email, Phone)
+// that can be rendered with most of their content hidden.
+type Masker interface {
+	Masked() string
+}
+
+var (
+	maskerType        = reflect.TypeFor[Masker]()
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// redactedText replaces values that hold personal data but cannot be masked field by field.
+const redactedText = "***"
+
+// Redact returns a copy of v suitable for logging under LGPD, with every Masker replaced by
+// its masked text. Structs become maps keyed by their JSON field names (fields tagged `json:"-"`
+// and unexported fields are dropped), slices become []any, maps become map[string]any with keys
+// converted as encoding/json does (Masker keys are masked), and pointers are followed; a value
+// that refers back to itself is rendered as nil where the cycle closes.
+// Values that marshal themselves to JSON, such as Money or Date, are kept as they are, unless
+// they hold a Masker in their fields without having a Masked form themselves: those are
+// replaced by "***".
+//
+// Example:
+//   type Customer struct {
+//       Name  string     `json:"name"`
+//       CPF   wisp.CPF   `json:"cpf"`
+//       Email wisp.Email `json:"email"`
+//   }
+//   logger.Info("customer created", "customer", wisp.Redact(customer))
+//   // {"name":"Maria","cpf":"***.982.247-**","email":"m***@example.com"}
+func Redact(v any) any {
+	if v == nil {
+		return nil
+	}
+	r := redactor{visiting: make(map[redactVisit]bool)}
+	return r.value(reflect.ValueOf(v))
+}
+
+// redactVisit identifies a pointer, map or slice being walked, to detect cycles.
+type redactVisit struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+// redactor builds redacted copies, tracking the references on the current path.
+type redactor struct {
+	visiting map[redactVisit]bool
+}
+
+// value walks v and builds its redacted copy.
+func (r redactor) value(v reflect.Value) any {
+	if !v.CanInterface() {
+		return nil
+	}
+	if v.Type().Implements(maskerType) {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return nil
+		}
+		return v.Interface().(Masker).Masked()
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Kind() == reflect.Pointer {
+			visit := redactVisit{ptr: v.Pointer(), typ: v.Type()}
+			if r.visiting[visit] {
+				return nil
+			}
+			r.visiting[visit] = true
+			defer delete(r.visiting, visit)
+		}
+		return r.value(v.Elem())
+	}
+
+	if v.Type().Implements(jsonMarshalerType) {
+		if holdsPII(v.Type()) {
+			return redactedText
+		}
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		out := make(map[string]any, v.NumField())
+		r.structFields(v, out)
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		visit := redactVisit{ptr: v.Pointer(), typ: v.Type(), len: v.Len()}
+		if r.visiting[visit] {
+			return nil
+		}
+		r.visiting[visit] = true
+		defer delete(r.visiting, visit)
+		fallthrough
+	case reflect.Array:
+		out := make([]any, v.Len())
+		for i := range out {
+			out[i] = r.value(v.Index(i))
+		}
+		return out
+	case reflect.Map:
+		if v.IsNil() {
+			return v.Interface()
+		}
+		if !redactableMapKey(v.Type().Key()) {
+			if holdsPII(v.Type()) {
+				return redactedText
+			}
+			return v.Interface()
+		}
+		visit := redactVisit{ptr: v.Pointer(), typ: v.Type()}
+		if r.visiting[visit] {
+			return nil
+		}
+		r.visiting[visit] = true
+		defer delete(r.visiting, visit)
+
+		out := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			out[redactMapKey(iter.Key())] = r.value(iter.Value())
+		}
+		return out
+	default:
+		return v.Interface()
+	}
+}
+
+// redactableMapKey reports whether map keys of type t can be turned into strings the way
+// encoding/json does: strings, integers and encoding.TextMarshaler implementations.
+func redactableMapKey(t reflect.Type) bool {
+	if t.Implements(maskerType) || t.Implements(textMarshalerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// redactMapKey turns a map key into a string, masking keys that hold personal data.
+func redactMapKey(k reflect.Value) string {
+	if k.Type().Implements(maskerType) {
+		if k.Kind() == reflect.Pointer && k.IsNil() {
+			return ""
+		}
+		return k.Interface().(Masker).Masked()
+	}
+	if k.Kind() == reflect.String {
+		return k.String()
+	}
+	if k.Type().Implements(textMarshalerType) {
+		if k.Kind() == reflect.Pointer && k.IsNil() {
+			return ""
+		}
+		text, err := k.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return redactedText
+		}
+		return string(text)
+	}
+	switch k.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(k.Int(), 10)
+	default:
+		return strconv.FormatUint(k.Uint(), 10)
+	}
+}
+
+// structFields adds the redacted exported fields of v to out, flattening untagged embedded
+// structs the same way encoding/json does.
+func (r redactor) structFields(v reflect.Value, out map[string]any) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct &&
+			!field.Type.Implements(maskerType) && !field.Type.Implements(jsonMarshalerType) {
+			r.structFields(v.Field(i), out)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		out[name] = r.value(v.Field(i))
+	}
+}
+
+// piiTypes caches whether a type holds a Masker, keyed by reflect.Type.
+var piiTypes sync.Map
+
+// holdsPII reports whether values of type t may carry a Masker in their fields, elements or
+// interfaces, exported or not.
+func holdsPII(t reflect.Type) bool {
+	if cached, ok := piiTypes.Load(t); ok {
+		return cached.(bool)
+	}
+	result := typeHoldsPII(t, make(map[reflect.Type]bool))
+	piiTypes.Store(t, result)
+	return result
+}
+
+// typeHoldsPII implements holdsPII, with seen breaking recursive types.
+func typeHoldsPII(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+
+	if t.Implements(maskerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		return typeHoldsPII(t.Elem(), seen)
+	case reflect.Map:
+		return typeHoldsPII(t.Key(), seen) || typeHoldsPII(t.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if typeHoldsPII(t.Field(i).Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type PIISuite struct {
+	suite.Suite
+}
+
+func TestPIISuite(t *testing.T) {
+	suite.Run(t, new(PIISuite))
+}
+
+func (s *PIISuite) TestMasked() {
+	s.Run("should mask a CPF", func() {
+		s.Equal("***.982.247-**", wisp.CPF("52998224725").Masked())
+		s.Equal("", wisp.EmptyCPF.Masked())
+	})
+
+	s.Run("should mask a CNPJ", func() {
+		s.Equal("**.543.915/0001-**", wisp.CNPJ("45543915000181").Masked())
+		s.Equal("", wisp.EmptyCNPJ.Masked())
+	})
+
+	s.Run("should mask a TaxID by kind", func() {
+		s.Equal("***.982.247-**", wisp.TaxID("52998224725").Masked())
+		s.Equal("**.543.915/0001-**", wisp.TaxID("45543915000181").Masked())
+		s.Equal("", wisp.EmptyTaxID.Masked())
+	})
+
+	s.Run("should mask an email", func() {
+		s.Equal("j***@example.com", wisp.MustNewEmail("john.doe@example.com").Masked())
+		s.Equal("a***@example.com", wisp.MustNewEmail("a@example.com").Masked())
+		s.Equal("", wisp.EmptyEmail.Masked())
+	})
+
+	s.Run("should mask a phone", func() {
+		mobile, _ := wisp.NewPhone("(11) 98765-4321")
+		s.Equal("+55 (11) *****-4321", mobile.Masked())
+
+		landline, _ := wisp.NewPhone("(11) 4321-5432")
+		s.Equal("+55 (11) ****-5432", landline.Masked())
+
+		s.Equal("", wisp.EmptyPhone.Masked())
+	})
+}
+
+type redactAddress struct {
+	City string `json:"city"`
+}
+
+type redactContact struct {
+	Phone wisp.Phone `json:"phone"`
+}
+
+type redactCustomer struct {
+	redactContact
+	Name      string         `json:"name"`
+	CPF       wisp.CPF       `json:"cpf"`
+	Email     *wisp.Email    `json:"email,omitempty"`
+	Balance   wisp.Money     `json:"balance"`
+	Address   redactAddress  `json:"address"`
+	Documents []wisp.TaxID   `json:"documents"`
+	Extra     map[string]any `json:"extra"`
+	Secret    string         `json:"-"`
+	Untagged  string
+	internal  string
+}
+
+func (s *PIISuite) TestRedact() {
+	email := wisp.MustNewEmail("maria@example.com")
+	phone, _ := wisp.NewPhone("(11) 98765-4321")
+	balance, _ := wisp.NewMoney(1050, wisp.BRL)
+
+	customer := redactCustomer{
+		redactContact: redactContact{Phone: phone},
+		Name:          "Maria",
+		CPF:           wisp.CPF("52998224725"),
+		Email:         &email,
+		Balance:       balance,
+		Address:       redactAddress{City: "São Paulo"},
+		Documents:     []wisp.TaxID{wisp.TaxID("45543915000181")},
+		Extra:         map[string]any{"backup_email": wisp.MustNewEmail("backup@example.com")},
+		Secret:        "hunter2",
+		Untagged:      "kept",
+		internal:      "dropped",
+	}
+
+	s.Run("should mask PII fields and keep the rest", func() {
+		redacted, ok := wisp.Redact(&customer).(map[string]any)
+		s.Require().True(ok)
+
+		s.Equal("+55 (11) *****-4321", redacted["phone"])
+		s.Equal("Maria", redacted["name"])
+		s.Equal("***.982.247-**", redacted["cpf"])
+		s.Equal("m***@example.com", redacted["email"])
+		s.Equal(balance, redacted["balance"])
+		s.Equal(map[string]any{"city": "São Paulo"}, redacted["address"])
+		s.Equal([]any{"**.543.915/0001-**"}, redacted["documents"])
+		s.Equal(map[string]any{"backup_email": "b***@example.com"}, redacted["extra"])
+		s.Equal("kept", redacted["Untagged"])
+		s.NotContains(redacted, "Secret")
+		s.NotContains(redacted, "internal")
+	})
+
+	s.Run("should marshal to JSON without the raw values", func() {
+		data, err := json.Marshal(wisp.Redact(customer))
+		s.Require().NoError(err)
+		s.NotContains(string(data), "52998224725")
+		s.NotContains(string(data), "maria@example.com")
+		s.NotContains(string(data), "hunter2")
+	})
+
+	s.Run("should handle nil and plain values", func() {
+		s.Nil(wisp.Redact(nil))
+		s.Equal(42, wisp.Redact(42))
+		s.Equal("***.982.247-**", wisp.Redact(wisp.CPF("52998224725")))
+
+		var missing *wisp.Email
+		s.Nil(wisp.Redact(missing))
+	})
+}
+
+type redactNode struct {
+	Name string      `json:"name"`
+	CPF  wisp.CPF    `json:"cpf"`
+	Next *redactNode `json:"next"`
+}
+
+type redactOpaque struct {
+	cpf wisp.CPF
+}
+
+func (o redactOpaque) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{"cpf": o.cpf.String()})
+}
+
+func (s *PIISuite) TestRedact_Composites() {
+	s.Run("should stop at cycles", func() {
+		node := &redactNode{Name: "a", CPF: wisp.CPF("52998224725")}
+		node.Next = node
+
+		redacted, ok := wisp.Redact(node).(map[string]any)
+		s.Require().True(ok)
+		s.Equal("***.982.247-**", redacted["cpf"])
+		s.Nil(redacted["next"])
+
+		other := &redactNode{Name: "b", Next: node}
+		node.Next = other
+		redacted = wisp.Redact(node).(map[string]any)
+		s.Equal(map[string]any{"name": "b", "cpf": "", "next": nil}, redacted["next"])
+
+		loop := map[string]any{}
+		loop["self"] = loop
+		s.Equal(map[string]any{"self": nil}, wisp.Redact(loop))
+	})
+
+	s.Run("should mask a Contact", func() {
+		phone, _ := wisp.NewPhone("(11) 98765-4321")
+		email := wisp.MustNewEmail("maria@example.com")
+
+		contact, err := wisp.NewContact(phone, email, wisp.ContactChannelWhatsApp)
+		s.Require().NoError(err)
+		s.Equal("+55 (11) *****-4321", wisp.Redact(contact))
+
+		contact, err = contact.WithPreferred(wisp.ContactChannelEmail)
+		s.Require().NoError(err)
+		data, err := json.Marshal(wisp.Redact(map[string]any{"contact": contact}))
+		s.Require().NoError(err)
+		s.JSONEq(`{"contact":"m***@example.com"}`, string(data))
+	})
+
+	s.Run("should mask maps with non-string keys", func() {
+		email := wisp.MustNewEmail("maria.silva@example.com")
+		byID := struct {
+			ByID  map[int]wisp.Email      `json:"by_id"`
+			ByCPF map[wisp.CPF]string     `json:"by_cpf"`
+			ByDay map[wisp.DayOfWeek]bool `json:"by_day"`
+		}{
+			ByID:  map[int]wisp.Email{7: email},
+			ByCPF: map[wisp.CPF]string{wisp.CPF("52998224725"): "maria"},
+		}
+
+		redacted := wisp.Redact(byID).(map[string]any)
+		s.Equal(map[string]any{"7": "m***@example.com"}, redacted["by_id"])
+		s.Equal(map[string]any{"***.982.247-**": "maria"}, redacted["by_cpf"])
+
+		data, err := json.Marshal(redacted)
+		s.Require().NoError(err)
+		s.NotContains(string(data), "maria.silva")
+		s.NotContains(string(data), "52998224725")
+	})
+
+	s.Run("should hide maps whose keys cannot be converted", func() {
+		type key struct{ ID int }
+		s.Equal("***", wisp.Redact(map[key]wisp.Email{{ID: 1}: wisp.MustNewEmail("maria@example.com")}))
+		s.Equal(map[key]int{{ID: 1}: 2}, wisp.Redact(map[key]int{{ID: 1}: 2}))
+	})
+
+	s.Run("should hide JSON marshalers holding PII", func() {
+		redacted := wisp.Redact(redactOpaque{cpf: wisp.CPF("52998224725")})
+		s.Equal("***", redacted)
+	})
+}
@@ -0,0 +1,172 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// pisWeights are the official weights for the PIS check digit.
+var pisWeights = [10]int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// PIS represents a Brazilian worker registration number. PIS (Programa de Integração Social),
+// PASEP (its public-sector counterpart), NIT (Número de Identificação do Trabalhador, used by the
+// INSS) and NIS (Número de Identificação Social) share the same numbering, so one type covers all
+// of them. It is required in payroll and eSocial records.
+// The value is stored without formatting (digits only) but can be displayed with proper formatting.
+//
+// Examples:
+//   - Input: "120.12345.67-2" or "12012345672"
+//   - Storage: "12012345672"
+//   - Formatted output: "120.12345.67-2"
+//
+// A PIS is considered valid when:
+//   - It contains exactly 11 digits
+//   - It's not a sequence of repeated digits (e.g., "11111111111")
+//   - The check digit is mathematically correct according to the official algorithm
+type PIS string
+
+// EmptyPIS represents the zero value for PIS type.
+var EmptyPIS PIS
+
+// parsePIS validates and normalizes a PIS from string or []byte input.
+func parsePIS[T string | []byte](input T) (PIS, error) {
+	if len(input) == 0 {
+		return EmptyPIS, nil
+	}
+
+	var digits [11]byte
+	if extractDigits(input, digits[:]) != 11 {
+		return EmptyPIS, fault.New("PIS must have 11 digits", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
+	}
+
+	allSame := true
+	for i := 1; i < 11; i++ {
+		if digits[i] != digits[0] {
+			allSame = false
+			break
+		}
+	}
+	if allSame {
+		return EmptyPIS, fault.New("invalid PIS sequence of repeated digits", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
+	}
+
+	if pisCheckDigit(digits[:10]) != int(digits[10]-'0') {
+		return EmptyPIS, fault.New("invalid PIS check digit", fault.WithCode(fault.Invalid), fault.WithContext("input", string(input)))
+	}
+
+	return PIS(digits[:]), nil
+}
+
+// pisCheckDigit calculates the PIS check digit for the first 10 ASCII digits using the official
+// modulo 11 algorithm with weights 3, 2, 9, 8, 7, 6, 5, 4, 3, 2.
+func pisCheckDigit(digits []byte) int {
+	sum := 0
+	for i, d := range digits {
+		sum += int(d-'0') * pisWeights[i]
+	}
+	dv := 11 - sum%11
+	if dv >= 10 {
+		return 0
+	}
+	return dv
+}
+
+// NewPIS creates a new PIS from the given input string.
+// It accepts PIS, PASEP, NIT or NIS numbers with or without dots and dash and validates them.
+//
+// Examples:
+//   pis, err := NewPIS("120.12345.67-2")  // Valid formatted
+//   pis, err := NewPIS("12012345672")     // Valid unformatted
+//   pis, err := NewPIS("")                // Returns EmptyPIS
+//   pis, err := NewPIS("12012345670")     // Error: invalid check digit
+func NewPIS(input string) (PIS, error) {
+	return parsePIS(input)
+}
+
+// String returns the PIS as a string without formatting (digits only).
+// For formatted output, use Formatted() method instead.
+func (p PIS) String() string {
+	return string(p)
+}
+
+// IsZero returns true if the PIS is the zero value (EmptyPIS).
+func (p PIS) IsZero() bool {
+	return p == EmptyPIS
+}
+
+// Formatted returns the PIS in the standard format (XXX.XXXXX.XX-X).
+// If the PIS is invalid or has wrong length, returns the unformatted string.
+func (p PIS) Formatted() string {
+	if len(p) != 11 {
+		return p.String()
+	}
+	return fmt.Sprintf("%s.%s.%s-%s", p[0:3], p[3:8], p[8:10], p[10:11])
+}
+
+// Masked returns the PIS formatted with only the middle digits visible ("***.12345.**-*"),
+// so it can be logged or displayed under LGPD. Returns an empty string for EmptyPIS.
+func (p PIS) Masked() string {
+	if len(p) != 11 {
+		return ""
+	}
+	return fmt.Sprintf("***.%s.**-*", p[3:8])
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the PIS as a JSON string without formatting.
+func (p PIS) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a PIS, performing full validation.
+func (p *PIS) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "PIS must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	pis, err := NewPIS(s)
+	if err != nil {
+		return err
+	}
+	*p = pis
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the PIS as a string or nil if zero value.
+func (p PIS) Value() (driver.Value, error) {
+	if p.IsZero() {
+		return nil, nil
+	}
+	return p.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values and validates them as PIS.
+func (p *PIS) Scan(src interface{}) error {
+	if src == nil {
+		*p = EmptyPIS
+		return nil
+	}
+
+	var pis PIS
+	var err error
+	switch v := src.(type) {
+	case string:
+		pis, err = parsePIS(v)
+	case []byte:
+		pis, err = parsePIS(v)
+	default:
+		return fault.New("unsupported scan type for PIS", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+
+	if err != nil {
+		return err
+	}
+	*p = pis
+	return nil
+}
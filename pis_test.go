@@ -0,0 +1,91 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type PISSuite struct {
+	suite.Suite
+}
+
+func TestPISSuite(t *testing.T) {
+	suite.Run(t, new(PISSuite))
+}
+
+func (s *PISSuite) TestNewPIS() {
+	testCases := []struct {
+		name        string
+		input       string
+		expected    wisp.PIS
+		expectError bool
+	}{
+		{name: "should create a valid PIS from unmasked string", input: "12012345672", expected: "12012345672"},
+		{name: "should create a valid PIS from formatted string", input: "120.12345.67-2", expected: "12012345672"},
+		{name: "should create a valid PIS when the check digit is 0", input: "20000000005", expected: "20000000005"},
+		{name: "should create an empty PIS from an empty string", input: "", expected: wisp.EmptyPIS},
+		{name: "should fail for PIS with invalid length", input: "1201234567", expectError: true},
+		{name: "should fail for PIS with all repeated digits", input: "00000000000", expectError: true},
+		{name: "should fail for PIS with incorrect check digit", input: "12012345670", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			pis, err := wisp.NewPIS(tc.input)
+			if tc.expectError {
+				s.Require().Error(err)
+				s.Equal(wisp.EmptyPIS, pis)
+				s.Equal(fault.Invalid, err.(*fault.Error).Code)
+			} else {
+				s.Require().NoError(err)
+				s.Equal(tc.expected, pis)
+			}
+		})
+	}
+}
+
+func (s *PISSuite) TestPIS_Methods() {
+	pis, _ := wisp.NewPIS("12012345672")
+	s.Equal("12012345672", pis.String())
+	s.Equal("120.12345.67-2", pis.Formatted())
+	s.Equal("***.12345.**-*", pis.Masked())
+	s.True(wisp.EmptyPIS.IsZero())
+	s.Equal("", wisp.EmptyPIS.Formatted())
+	s.Equal("", wisp.EmptyPIS.Masked())
+}
+
+func (s *PISSuite) TestPIS_JSON() {
+	pis, _ := wisp.NewPIS("12012345672")
+	data, err := json.Marshal(pis)
+	s.Require().NoError(err)
+	s.Equal(`"12012345672"`, string(data))
+
+	var decoded wisp.PIS
+	s.Require().NoError(json.Unmarshal([]byte(`"120.12345.67-2"`), &decoded))
+	s.Equal(pis, decoded)
+	s.Error(json.Unmarshal([]byte(`"12012345670"`), &decoded))
+	s.Error(json.Unmarshal([]byte(`123`), &decoded))
+}
+
+func (s *PISSuite) TestPIS_SQL() {
+	pis, _ := wisp.NewPIS("12012345672")
+	value, err := pis.Value()
+	s.Require().NoError(err)
+	s.Equal("12012345672", value)
+
+	value, err = wisp.EmptyPIS.Value()
+	s.Require().NoError(err)
+	s.Nil(value)
+
+	var scanned wisp.PIS
+	s.Require().NoError(scanned.Scan([]byte("12012345672")))
+	s.Equal(pis, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Error(scanned.Scan(123))
+}
@@ -0,0 +1,324 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// PixKeyKind identifies the type of a Pix key ("tipo de chave").
+type PixKeyKind string
+
+// Defines the five kinds of Pix keys accepted by the Central Bank directory (DICT).
+const (
+	PixKeyKindCPF   PixKeyKind = "cpf"
+	PixKeyKindCNPJ  PixKeyKind = "cnpj"
+	PixKeyKindEmail PixKeyKind = "email"
+	PixKeyKindPhone PixKeyKind = "phone"
+	PixKeyKindEVP   PixKeyKind = "evp" // Random key ("chave aleatória"), a UUID.
+)
+
+// maxPixEmailLength is the maximum length of an email Pix key in the DICT.
+const maxPixEmailLength = 77
+
+// PixKey is a value object representing a key of Pix, the Brazilian instant payment system.
+// It holds one of five kinds of keys, each validated by the matching wisp type, and is stored in
+// the normalized format of the DICT:
+//   - CPF and CNPJ: digits only ("52998224725")
+//   - email: lowercase ("ana@example.com")
+//   - phone: "+55" followed by area code and number ("+5511987654321")
+//   - EVP (random key): lowercase UUID ("123e4567-e89b-12d3-a456-426614174000")
+//
+// The zero value is EmptyPixKey.
+//
+// Examples:
+//   key, _ := wisp.NewPixKey("+55 (11) 98765-4321")
+//   key.Kind()   // PixKeyKindPhone
+//   key.String() // "+5511987654321"
+//   key.Masked() // "+55 (11) *****-4321"
+type PixKey string
+
+// EmptyPixKey represents the zero value for the PixKey type.
+var EmptyPixKey PixKey
+
+// NewPixKey creates a PixKey, detecting its kind from the input:
+// an "@" means email, a 36-character UUID means EVP, a leading "+" means phone, and otherwise
+// 11 digits are a CPF and 14 characters a CNPJ. Numbers with 10, 12 or 13 digits, and those
+// with 11 digits that are not a valid CPF, are tried as a phone, but callers should send phones
+// with "+55", as the DICT does, or use NewPixKeyOfKind to avoid the ambiguity.
+// Returns EmptyPixKey for an empty input, or an error if no kind matches.
+func NewPixKey(input string) (PixKey, error) {
+	trimmed := strings.TrimSpace(input)
+	switch {
+	case trimmed == "":
+		return EmptyPixKey, nil
+	case strings.Contains(trimmed, "@"):
+		return NewPixKeyOfKind(PixKeyKindEmail, trimmed)
+	case len(trimmed) == 36 && strings.Count(trimmed, "-") == 4:
+		return NewPixKeyOfKind(PixKeyKindEVP, trimmed)
+	case strings.HasPrefix(trimmed, "+"):
+		return NewPixKeyOfKind(PixKeyKindPhone, trimmed)
+	}
+
+	switch extractDigits(trimmed, nil) {
+	case 11:
+		if key, err := NewPixKeyOfKind(PixKeyKindCPF, trimmed); err == nil {
+			return key, nil
+		}
+		if key, err := NewPixKeyOfKind(PixKeyKindPhone, trimmed); err == nil {
+			return key, nil
+		}
+	case 10, 12, 13:
+		return NewPixKeyOfKind(PixKeyKindPhone, trimmed)
+	}
+
+	taxID, err := NewTaxID(trimmed)
+	if err != nil {
+		return EmptyPixKey, fault.Wrap(err,
+			"pix key must be a CPF, CNPJ, email, phone or random key",
+			fault.WithCode(fault.Invalid),
+		)
+	}
+	return PixKey(taxID), nil
+}
+
+// NewPixKeyOfKind creates a PixKey of the given kind, validating the input with the matching
+// wisp type (CPF, CNPJ, Email, Phone or UUID).
+// Returns an error if the input is empty, invalid for the kind, or the kind is unknown.
+func NewPixKeyOfKind(kind PixKeyKind, input string) (PixKey, error) {
+	trimmed := strings.TrimSpace(input)
+	if trimmed == "" {
+		return EmptyPixKey, fault.New(
+			"pix key cannot be empty",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("kind", kind),
+		)
+	}
+
+	switch kind {
+	case PixKeyKindCPF:
+		cpf, err := NewCPF(trimmed)
+		if err != nil {
+			return EmptyPixKey, err
+		}
+		return PixKey(cpf), nil
+	case PixKeyKindCNPJ:
+		cnpj, err := NewCNPJ(trimmed)
+		if err != nil {
+			return EmptyPixKey, err
+		}
+		return PixKey(cnpj), nil
+	case PixKeyKindEmail:
+		email, err := parseEmail(trimmed)
+		if err != nil {
+			return EmptyPixKey, err
+		}
+		if len(email) > maxPixEmailLength {
+			return EmptyPixKey, fault.New(
+				"email pix key exceeds maximum length",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("length", len(email)),
+				fault.WithContext("max_length", maxPixEmailLength),
+			)
+		}
+		return PixKey(email), nil
+	case PixKeyKindPhone:
+		phone, err := NewPhone(trimmed)
+		if err != nil {
+			return EmptyPixKey, err
+		}
+		if !phone.IsBrazilian() {
+			return EmptyPixKey, fault.New(
+				"phone pix key must be a Brazilian number",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("country_code", phone.CountryCode()),
+			)
+		}
+		return PixKey("+" + phone.String()), nil
+	case PixKeyKindEVP:
+		if len(trimmed) != 36 {
+			return EmptyPixKey, fault.New(
+				"random pix key must be a UUID in canonical format",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("input_value", input),
+			)
+		}
+		id, err := ParseUUID(trimmed)
+		if err != nil {
+			return EmptyPixKey, err
+		}
+		return PixKey(id.String()), nil
+	default:
+		return EmptyPixKey, fault.New(
+			"unknown pix key kind",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("kind", kind),
+		)
+	}
+}
+
+// Kind returns the kind of the key, detected from its normalized format,
+// or an empty kind for the zero value.
+func (k PixKey) Kind() PixKeyKind {
+	s := string(k)
+	switch {
+	case s == "":
+		return ""
+	case strings.Contains(s, "@"):
+		return PixKeyKindEmail
+	case s[0] == '+':
+		return PixKeyKindPhone
+	case len(s) == 36:
+		return PixKeyKindEVP
+	case len(s) == 11:
+		return PixKeyKindCPF
+	default:
+		return PixKeyKindCNPJ
+	}
+}
+
+// CPF returns the key as a CPF and true, or EmptyCPF and false for other kinds.
+func (k PixKey) CPF() (CPF, bool) {
+	if k.Kind() != PixKeyKindCPF {
+		return EmptyCPF, false
+	}
+	return CPF(k), true
+}
+
+// CNPJ returns the key as a CNPJ and true, or EmptyCNPJ and false for other kinds.
+func (k PixKey) CNPJ() (CNPJ, bool) {
+	if k.Kind() != PixKeyKindCNPJ {
+		return EmptyCNPJ, false
+	}
+	return CNPJ(k), true
+}
+
+// Email returns the key as an Email and true, or EmptyEmail and false for other kinds.
+func (k PixKey) Email() (Email, bool) {
+	if k.Kind() != PixKeyKindEmail {
+		return EmptyEmail, false
+	}
+	return Email(k), true
+}
+
+// Phone returns the key as a Phone and true, or EmptyPhone and false for other kinds.
+func (k PixKey) Phone() (Phone, bool) {
+	if k.Kind() != PixKeyKindPhone {
+		return EmptyPhone, false
+	}
+	return Phone(k[1:]), true
+}
+
+// String returns the key in the normalized DICT format.
+func (k PixKey) String() string {
+	return string(k)
+}
+
+// IsZero returns true if the PixKey is the zero value.
+func (k PixKey) IsZero() bool {
+	return k == EmptyPixKey
+}
+
+// Formatted returns the key in a human-readable format: CPF, CNPJ and phone with their masks,
+// email and random keys as stored.
+func (k PixKey) Formatted() string {
+	switch k.Kind() {
+	case PixKeyKindCPF:
+		return CPF(k).Formatted()
+	case PixKeyKindCNPJ:
+		return CNPJ(k).Formatted()
+	case PixKeyKindPhone:
+		return Phone(k[1:]).Formatted()
+	default:
+		return k.String()
+	}
+}
+
+// Masked returns the key with most of its content hidden, for payment confirmation screens and
+// logs: CPF, CNPJ, email and phone follow the masks of their types, and random keys keep only the
+// first and last 4 characters ("123e****-****-****-****-********4000").
+func (k PixKey) Masked() string {
+	switch k.Kind() {
+	case PixKeyKindCPF:
+		return CPF(k).Masked()
+	case PixKeyKindCNPJ:
+		return CNPJ(k).Masked()
+	case PixKeyKindEmail:
+		return Email(k).Masked()
+	case PixKeyKindPhone:
+		return Phone(k[1:]).Masked()
+	case PixKeyKindEVP:
+		masked := []byte(k)
+		for i := 4; i < len(masked)-4; i++ {
+			if masked[i] != '-' {
+				masked[i] = '*'
+			}
+		}
+		return string(masked)
+	default:
+		return ""
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the PixKey as a JSON string in the normalized format.
+func (k PixKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a PixKey, detecting its kind.
+func (k *PixKey) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "PixKey must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	key, err := NewPixKey(s)
+	if err != nil {
+		return err
+	}
+	*k = key
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the PixKey as a string or nil if zero value.
+func (k PixKey) Value() (driver.Value, error) {
+	if k.IsZero() {
+		return nil, nil
+	}
+	return k.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice and converts it into a PixKey, with validation.
+func (k *PixKey) Scan(src interface{}) error {
+	if src == nil {
+		*k = EmptyPixKey
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for PixKey",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	key, err := NewPixKey(s)
+	if err != nil {
+		return err
+	}
+	*k = key
+	return nil
+}
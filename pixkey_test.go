@@ -0,0 +1,178 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type PixKeySuite struct {
+	suite.Suite
+}
+
+func TestPixKeySuite(t *testing.T) {
+	suite.Run(t, new(PixKeySuite))
+}
+
+func (s *PixKeySuite) TestNewPixKey() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected wisp.PixKey
+		kind     wisp.PixKeyKind
+	}{
+		{name: "formatted CPF", input: "529.982.247-25", expected: "52998224725", kind: wisp.PixKeyKindCPF},
+		{name: "CPF digits", input: "52998224725", expected: "52998224725", kind: wisp.PixKeyKindCPF},
+		{name: "formatted CNPJ", input: "45.543.915/0001-81", expected: "45543915000181", kind: wisp.PixKeyKindCNPJ},
+		{name: "email", input: " Ana.Souza@Example.com ", expected: "ana.souza@example.com", kind: wisp.PixKeyKindEmail},
+		{name: "phone in DICT format", input: "+5511987654321", expected: "+5511987654321", kind: wisp.PixKeyKindPhone},
+		{name: "formatted phone", input: "+55 (11) 98765-4321", expected: "+5511987654321", kind: wisp.PixKeyKindPhone},
+		{name: "phone without country code that is not a CPF", input: "(11) 98765-4321", expected: "+5511987654321", kind: wisp.PixKeyKindPhone},
+		{name: "phone with country code and no plus sign", input: "5511987654321", expected: "+5511987654321", kind: wisp.PixKeyKindPhone},
+		{name: "landline", input: "(11) 4567-1234", expected: "+551145671234", kind: wisp.PixKeyKindPhone},
+		{name: "random key", input: "123E4567-E89B-12D3-A456-426614174000", expected: "123e4567-e89b-12d3-a456-426614174000", kind: wisp.PixKeyKindEVP},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			key, err := wisp.NewPixKey(tc.input)
+			s.Require().NoError(err)
+			s.Equal(tc.expected, key)
+			s.Equal(tc.kind, key.Kind())
+		})
+	}
+
+	s.Run("should return the zero value for an empty input", func() {
+		key, err := wisp.NewPixKey("   ")
+		s.Require().NoError(err)
+		s.True(key.IsZero())
+		s.Equal(wisp.PixKeyKind(""), key.Kind())
+	})
+
+	s.Run("should reject invalid keys", func() {
+		inputs := []string{
+			"529.982.247-26",
+			"45.543.915/0001-82",
+			"not-an-email@",
+			"+1 415 555 2671",
+			"+55 (23) 98765-4321",
+			"123e4567-e89b-12d3-a456-42661417400z",
+			"abc",
+			"12345",
+		}
+		for _, input := range inputs {
+			key, err := wisp.NewPixKey(input)
+			s.Require().Error(err, input)
+			s.True(key.IsZero())
+			s.Equal(fault.Invalid, err.(*fault.Error).Code, input)
+		}
+	})
+
+	s.Run("should reject emails longer than the DICT limit", func() {
+		_, err := wisp.NewPixKey("a-very-long-local-part-for-a-pix-key-that-exceeds@the-dict-limit.example.com.br")
+		s.Error(err)
+	})
+}
+
+func (s *PixKeySuite) TestNewPixKeyOfKind() {
+	s.Run("should force the kind of ambiguous numbers", func() {
+		key, err := wisp.NewPixKeyOfKind(wisp.PixKeyKindPhone, "11987654321")
+		s.Require().NoError(err)
+		s.Equal(wisp.PixKey("+5511987654321"), key)
+	})
+
+	s.Run("should reject inputs invalid for the kind", func() {
+		_, err := wisp.NewPixKeyOfKind(wisp.PixKeyKindCPF, "ana@example.com")
+		s.Error(err)
+		_, err = wisp.NewPixKeyOfKind(wisp.PixKeyKindEVP, "123e4567e89b12d3a456426614174000")
+		s.Error(err)
+		_, err = wisp.NewPixKeyOfKind(wisp.PixKeyKindEmail, "")
+		s.Error(err)
+		_, err = wisp.NewPixKeyOfKind("iban", "x")
+		s.Error(err)
+	})
+}
+
+func (s *PixKeySuite) TestAccessors() {
+	cpfKey, _ := wisp.NewPixKey("529.982.247-25")
+	cpf, ok := cpfKey.CPF()
+	s.True(ok)
+	s.Equal(wisp.CPF("52998224725"), cpf)
+	_, ok = cpfKey.Email()
+	s.False(ok)
+
+	cnpjKey, _ := wisp.NewPixKey("45.543.915/0001-81")
+	cnpj, ok := cnpjKey.CNPJ()
+	s.True(ok)
+	s.Equal(wisp.CNPJ("45543915000181"), cnpj)
+	_, ok = cnpjKey.CPF()
+	s.False(ok)
+
+	emailKey, _ := wisp.NewPixKey("ana@example.com")
+	email, ok := emailKey.Email()
+	s.True(ok)
+	s.Equal(wisp.Email("ana@example.com"), email)
+
+	phoneKey, _ := wisp.NewPixKey("+5511987654321")
+	phone, ok := phoneKey.Phone()
+	s.True(ok)
+	s.Equal(wisp.Phone("5511987654321"), phone)
+	_, ok = phoneKey.CNPJ()
+	s.False(ok)
+}
+
+func (s *PixKeySuite) TestFormattedAndMasked() {
+	testCases := []struct {
+		input     string
+		formatted string
+		masked    string
+	}{
+		{input: "52998224725", formatted: "529.982.247-25", masked: "***.982.247-**"},
+		{input: "45543915000181", formatted: "45.543.915/0001-81", masked: "**.543.915/0001-**"},
+		{input: "ana@example.com", formatted: "ana@example.com", masked: "a***@example.com"},
+		{input: "+5511987654321", formatted: "+55 (11) 98765-4321", masked: "+55 (11) *****-4321"},
+		{input: "123e4567-e89b-12d3-a456-426614174000", formatted: "123e4567-e89b-12d3-a456-426614174000", masked: "123e****-****-****-****-********4000"},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.input, func() {
+			key, err := wisp.NewPixKey(tc.input)
+			s.Require().NoError(err)
+			s.Equal(tc.formatted, key.Formatted())
+			s.Equal(tc.masked, key.Masked())
+		})
+	}
+	s.Equal("", wisp.EmptyPixKey.Masked())
+}
+
+func (s *PixKeySuite) TestJSON_SQL() {
+	key, _ := wisp.NewPixKey("+55 (11) 98765-4321")
+
+	data, err := json.Marshal(key)
+	s.Require().NoError(err)
+	s.Equal(`"+5511987654321"`, string(data))
+
+	var decoded wisp.PixKey
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(key, decoded)
+	s.Error(json.Unmarshal([]byte(`"invalid"`), &decoded))
+	s.Error(json.Unmarshal([]byte(`1`), &decoded))
+
+	v, err := key.Value()
+	s.Require().NoError(err)
+	s.Equal("+5511987654321", v)
+	v, _ = wisp.EmptyPixKey.Value()
+	s.Nil(v)
+
+	var scanned wisp.PixKey
+	s.Require().NoError(scanned.Scan([]byte("52998224725")))
+	s.Equal(wisp.PixKeyKindCPF, scanned.Kind())
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	err = scanned.Scan(1)
+	s.Require().Error(err)
+	s.Equal(fault.Invalid, err.(*fault.Error).Code)
+}
@@ -4,6 +4,8 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/marcelofabianov/fault"
 )
@@ -35,6 +37,50 @@ func NewPositiveInt(value int) (PositiveInt, error) {
 	return PositiveInt(value), nil
 }
 
+// ParsePositiveInt creates a new PositiveInt from a base-10 string, ignoring surrounding whitespace.
+// It returns an error if the string is not an integer or the value is not strictly greater than zero.
+func ParsePositiveInt(s string) (PositiveInt, error) {
+	i, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return ZeroPositiveInt, fault.Wrap(err,
+			"value must be a valid integer",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", s),
+		)
+	}
+	return NewPositiveInt(i)
+}
+
+// Add returns the sum of the value and n.
+// It returns an error if the result is not positive or overflows.
+func (p PositiveInt) Add(n int) (PositiveInt, error) {
+	sum, ok := checkedAddInt(int(p), n)
+	if !ok {
+		return ZeroPositiveInt, errIntOverflow("add", int(p), n)
+	}
+	return NewPositiveInt(sum)
+}
+
+// Subtract returns the value minus n.
+// It returns an error if the result is not positive (e.g., 3 - 3) or overflows.
+func (p PositiveInt) Subtract(n int) (PositiveInt, error) {
+	diff, ok := checkedSubInt(int(p), n)
+	if !ok {
+		return ZeroPositiveInt, errIntOverflow("subtract", int(p), n)
+	}
+	return NewPositiveInt(diff)
+}
+
+// Multiply returns the value multiplied by n.
+// It returns an error if the result is not positive or overflows.
+func (p PositiveInt) Multiply(n int) (PositiveInt, error) {
+	product, ok := checkedMulInt(int(p), n)
+	if !ok {
+		return ZeroPositiveInt, errIntOverflow("multiply", int(p), n)
+	}
+	return NewPositiveInt(product)
+}
+
 // Int returns the underlying integer value.
 func (p PositiveInt) Int() int {
 	return int(p)
@@ -2,6 +2,7 @@ package wisp_test
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -67,3 +68,42 @@ func (s *PositiveIntSuite) TestPositiveInt_SQL() {
 	err = scannedPI.Scan(int64(0))
 	s.Require().Error(err)
 }
+
+func (s *PositiveIntSuite) TestParsePositiveInt() {
+	pi, err := wisp.ParsePositiveInt(" 42 ")
+	s.Require().NoError(err)
+	s.Equal(42, pi.Int())
+
+	_, err = wisp.ParsePositiveInt("0")
+	s.Error(err)
+	_, err = wisp.ParsePositiveInt("abc")
+	s.Error(err)
+}
+
+func (s *PositiveIntSuite) TestPositiveInt_Arithmetic() {
+	pi, _ := wisp.NewPositiveInt(3)
+
+	sum, err := pi.Add(2)
+	s.Require().NoError(err)
+	s.Equal(5, sum.Int())
+
+	diff, err := pi.Subtract(2)
+	s.Require().NoError(err)
+	s.Equal(1, diff.Int())
+
+	_, err = pi.Subtract(3)
+	s.Error(err)
+
+	product, err := pi.Multiply(4)
+	s.Require().NoError(err)
+	s.Equal(12, product.Int())
+
+	_, err = pi.Multiply(0)
+	s.Error(err)
+
+	big, _ := wisp.NewPositiveInt(math.MaxInt)
+	_, err = big.Add(1)
+	s.Error(err)
+	_, err = big.Multiply(2)
+	s.Error(err)
+}
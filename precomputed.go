@@ -0,0 +1,83 @@
+package wisp
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Precomputed is a generic wrapper that stores a value object together with its formatted
+// representation, computed once at construction time.
+// It is intended for read-heavy paths (API responses, templates, render loops) where the same
+// value is formatted many times, trading a few bytes of memory for not re-formatting on every call.
+//
+// The wrapped value is immutable, so the cached representation can never become stale.
+// JSON serialization delegates to the wrapped value, so the wire format is unchanged; decoding
+// recomputes the representation with the format function of the target, so decode into a value
+// built with NewPrecomputed. A zero Precomputed falls back to fmt.Sprint, which uses the String
+// method of the value when it has one.
+//
+// Example:
+//   phone, _ := NewPhone("(11) 98765-4321")
+//   p := NewPrecomputed(phone, Phone.Formatted)
+//   p.Formatted() // "+55 (11) 98765-4321" (no formatting work)
+//
+//   price, _ := NewMoney(1050, BRL)
+//   m := NewPrecomputed(price, Money.String)
+//   m.Formatted() // "BRL 10.50"
+//
+//   decoded := NewPrecomputed(EmptyPhone, Phone.Formatted)
+//   err := json.Unmarshal([]byte(`"5511987654321"`), &decoded)
+//   decoded.Formatted() // "+55 (11) 98765-4321"
+type Precomputed[T any] struct {
+	value     T
+	formatted string
+	format    func(T) string
+}
+
+// NewPrecomputed wraps value and caches the result of format(value).
+// Method expressions such as Phone.Formatted, CPF.Formatted or Money.String are typical format functions.
+func NewPrecomputed[T any](value T, format func(T) string) Precomputed[T] {
+	return Precomputed[T]{
+		value:     value,
+		formatted: format(value),
+		format:    format,
+	}
+}
+
+// Get returns the wrapped value.
+func (p Precomputed[T]) Get() T {
+	return p.value
+}
+
+// Formatted returns the cached formatted representation.
+func (p Precomputed[T]) Formatted() string {
+	return p.formatted
+}
+
+// String returns the cached formatted representation.
+func (p Precomputed[T]) String() string {
+	return p.formatted
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the wrapped value, ignoring the cached representation.
+func (p Precomputed[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes the wrapped value, with the validation of T, and recomputes the cached
+// representation with the format function of p, or fmt.Sprint when p is the zero value.
+func (p *Precomputed[T]) UnmarshalJSON(data []byte) error {
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	format := p.format
+	if format == nil {
+		format = func(v T) string { return fmt.Sprint(v) }
+	}
+	*p = NewPrecomputed(value, format)
+	return nil
+}
@@ -0,0 +1,121 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type PrecomputedSuite struct {
+	suite.Suite
+}
+
+func TestPrecomputedSuite(t *testing.T) {
+	suite.Run(t, new(PrecomputedSuite))
+}
+
+func (s *PrecomputedSuite) TestNewPrecomputed() {
+	s.Run("should cache the formatted phone", func() {
+		phone, err := wisp.NewPhone("(11) 98765-4321")
+		s.Require().NoError(err)
+
+		p := wisp.NewPrecomputed(phone, wisp.Phone.Formatted)
+		s.Equal(phone, p.Get())
+		s.Equal("+55 (11) 98765-4321", p.Formatted())
+		s.Equal(p.Formatted(), p.String())
+	})
+
+	s.Run("should cache the formatted CPF", func() {
+		cpf, err := wisp.NewCPF("86222616038")
+		s.Require().NoError(err)
+
+		p := wisp.NewPrecomputed(cpf, wisp.CPF.Formatted)
+		s.Equal("862.226.160-38", p.Formatted())
+	})
+
+	s.Run("should cache the money display", func() {
+		price, err := wisp.NewMoney(1050, wisp.BRL)
+		s.Require().NoError(err)
+
+		p := wisp.NewPrecomputed(price, wisp.Money.String)
+		s.Equal("BRL 10.50", p.Formatted())
+		s.True(price.Equals(p.Get()))
+	})
+
+	s.Run("should call the format function only once", func() {
+		calls := 0
+		p := wisp.NewPrecomputed(42, func(v int) string {
+			calls++
+			return "forty-two"
+		})
+
+		for i := 0; i < 3; i++ {
+			s.Equal("forty-two", p.Formatted())
+		}
+		s.Equal(1, calls)
+	})
+}
+
+func (s *PrecomputedSuite) TestPrecomputed_MarshalJSON() {
+	price, _ := wisp.NewMoney(1050, wisp.BRL)
+	p := wisp.NewPrecomputed(price, wisp.Money.String)
+
+	data, err := json.Marshal(p)
+	s.Require().NoError(err)
+
+	expected, err := json.Marshal(price)
+	s.Require().NoError(err)
+	s.JSONEq(string(expected), string(data))
+}
+
+func (s *PrecomputedSuite) TestPrecomputed_UnmarshalJSON() {
+	s.Run("should round-trip and recompute the representation", func() {
+		phone, _ := wisp.NewPhone("(11) 98765-4321")
+		p := wisp.NewPrecomputed(phone, wisp.Phone.Formatted)
+
+		data, err := json.Marshal(p)
+		s.Require().NoError(err)
+
+		decoded := wisp.NewPrecomputed(wisp.EmptyPhone, wisp.Phone.Formatted)
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(phone, decoded.Get())
+		s.Equal("+55 (11) 98765-4321", decoded.Formatted())
+	})
+
+	s.Run("should fall back to fmt.Sprint for the zero value", func() {
+		price, _ := wisp.NewMoney(1050, wisp.BRL)
+		data, err := json.Marshal(wisp.NewPrecomputed(price, wisp.Money.String))
+		s.Require().NoError(err)
+
+		var decoded wisp.Precomputed[wisp.Money]
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.True(price.Equals(decoded.Get()))
+		s.Equal("BRL 10.50", decoded.Formatted())
+	})
+
+	s.Run("should validate the wrapped value", func() {
+		decoded := wisp.NewPrecomputed(wisp.EmptyPhone, wisp.Phone.Formatted)
+		s.Require().Error(json.Unmarshal([]byte(`"123"`), &decoded))
+		s.True(decoded.Get().IsZero())
+	})
+}
+
+func BenchmarkPhone_Formatted(b *testing.B) {
+	phone, _ := wisp.NewPhone("(11) 98765-4321")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = phone.Formatted()
+	}
+}
+
+func BenchmarkPrecomputed_Formatted(b *testing.B) {
+	phone, _ := wisp.NewPhone("(11) 98765-4321")
+	p := wisp.NewPrecomputed(phone, wisp.Phone.Formatted)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = p.Formatted()
+	}
+}
@@ -3,6 +3,12 @@ package wisp
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/marcelofabianov/fault"
 )
@@ -54,11 +60,236 @@ func ParsePreferences(jsonData []byte) (Preferences, error) {
 	return NewPreferences(data)
 }
 
+// preferencePathSeparator separates the segments of a nested preference path.
+const preferencePathSeparator = "."
+
 // Get retrieves a value from the preferences by its key.
+// The key may be a dot-separated path into nested objects (e.g., "notifications.email.enabled");
+// a top-level key that contains dots takes precedence over path resolution.
 // The second return value is false if the key does not exist.
 func (p Preferences) Get(key string) (any, bool) {
-	val, ok := p.data[key]
-	return val, ok
+	if val, ok := p.data[key]; ok {
+		return val, true
+	}
+	if !strings.Contains(key, preferencePathSeparator) {
+		return nil, false
+	}
+
+	var current any = p.data
+	for _, segment := range strings.Split(key, preferencePathSeparator) {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[segment]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// lookup retrieves a value by key, returning a NotFound error if the key does not exist.
+func (p Preferences) lookup(key string) (any, error) {
+	val, ok := p.Get(key)
+	if !ok {
+		return nil, fault.New(
+			"preference key not found",
+			fault.WithCode(fault.NotFound),
+			fault.WithContext("key", key),
+		)
+	}
+	return val, nil
+}
+
+// preferenceTypeMismatch builds the error returned when a value does not have the requested type.
+func preferenceTypeMismatch(key, expected string, val any) error {
+	return fault.New(
+		"preference value has an unexpected type",
+		fault.WithCode(fault.Invalid),
+		fault.WithContext("key", key),
+		fault.WithContext("expected_type", expected),
+		fault.WithContext("received_type", fmt.Sprintf("%T", val)),
+	)
+}
+
+// GetString retrieves a string value by key.
+// Returns a NotFound error if the key does not exist and an Invalid error if the value is not a string.
+func (p Preferences) GetString(key string) (string, error) {
+	val, err := p.lookup(key)
+	if err != nil {
+		return "", err
+	}
+	s, ok := val.(string)
+	if !ok {
+		return "", preferenceTypeMismatch(key, "string", val)
+	}
+	return s, nil
+}
+
+// GetStringOr retrieves a string value by key, returning def if the key is missing or not a string.
+func (p Preferences) GetStringOr(key, def string) string {
+	if s, err := p.GetString(key); err == nil {
+		return s
+	}
+	return def
+}
+
+// GetBool retrieves a bool value by key.
+// Returns a NotFound error if the key does not exist and an Invalid error if the value is not a bool.
+func (p Preferences) GetBool(key string) (bool, error) {
+	val, err := p.lookup(key)
+	if err != nil {
+		return false, err
+	}
+	b, ok := val.(bool)
+	if !ok {
+		return false, preferenceTypeMismatch(key, "bool", val)
+	}
+	return b, nil
+}
+
+// GetBoolOr retrieves a bool value by key, returning def if the key is missing or not a bool.
+func (p Preferences) GetBoolOr(key string, def bool) bool {
+	if b, err := p.GetBool(key); err == nil {
+		return b
+	}
+	return def
+}
+
+// GetFloat retrieves a numeric value by key as a float64.
+// Any Go integer or float type is accepted, as well as json.Number.
+// Returns a NotFound error if the key does not exist and an Invalid error if the value is not numeric.
+func (p Preferences) GetFloat(key string) (float64, error) {
+	val, err := p.lookup(key)
+	if err != nil {
+		return 0, err
+	}
+
+	switch v := val.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f, nil
+		}
+	}
+	return 0, preferenceTypeMismatch(key, "float64", val)
+}
+
+// GetFloatOr retrieves a numeric value by key, returning def if the key is missing or not numeric.
+func (p Preferences) GetFloatOr(key string, def float64) float64 {
+	if f, err := p.GetFloat(key); err == nil {
+		return f
+	}
+	return def
+}
+
+// GetInt retrieves an integer value by key.
+// Since JSON numbers are decoded as float64, whole float values are accepted.
+// Returns a NotFound error if the key does not exist and an Invalid error if the value is not a whole number.
+func (p Preferences) GetInt(key string) (int, error) {
+	val, err := p.lookup(key)
+	if err != nil {
+		return 0, err
+	}
+
+	switch v := val.(type) {
+	case int:
+		return v, nil
+	case int32:
+		return int(v), nil
+	case int64:
+		return int(v), nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i), nil
+		}
+	case float64:
+		if v == math.Trunc(v) && v <= math.MaxInt && v >= math.MinInt {
+			return int(v), nil
+		}
+	}
+	return 0, preferenceTypeMismatch(key, "int", val)
+}
+
+// GetIntOr retrieves an integer value by key, returning def if the key is missing or not a whole number.
+func (p Preferences) GetIntOr(key string, def int) int {
+	if i, err := p.GetInt(key); err == nil {
+		return i
+	}
+	return def
+}
+
+// GetTime retrieves a time value by key.
+// It accepts a time.Time or an RFC 3339 string, which is how times are stored once serialized to JSON.
+// Returns a NotFound error if the key does not exist and an Invalid error if the value is not a time.
+func (p Preferences) GetTime(key string) (time.Time, error) {
+	val, err := p.lookup(key)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch v := val.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return time.Time{}, fault.Wrap(err,
+				"preference value is not a valid RFC 3339 time",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("key", key),
+			)
+		}
+		return t, nil
+	}
+	return time.Time{}, preferenceTypeMismatch(key, "time.Time", val)
+}
+
+// GetTimeOr retrieves a time value by key, returning def if the key is missing or not a time.
+func (p Preferences) GetTimeOr(key string, def time.Time) time.Time {
+	if t, err := p.GetTime(key); err == nil {
+		return t
+	}
+	return def
+}
+
+// GetAs retrieves a value by key as type T.
+// If the stored value is not a T, it is converted through its JSON representation, which allows
+// decoding nested objects into structs or whole JSON numbers into integer types.
+// Returns a NotFound error if the key does not exist and an Invalid error if the conversion fails.
+//
+// Example:
+//   type Notifications struct { Email bool `json:"email"` }
+//   n, err := GetAs[Notifications](prefs, "notifications")
+func GetAs[T any](p Preferences, key string) (T, error) {
+	var zero T
+
+	val, err := p.lookup(key)
+	if err != nil {
+		return zero, err
+	}
+
+	if v, ok := val.(T); ok {
+		return v, nil
+	}
+
+	data, err := json.Marshal(val)
+	if err == nil {
+		var v T
+		if err = json.Unmarshal(data, &v); err == nil {
+			return v, nil
+		}
+	}
+	return zero, preferenceTypeMismatch(key, fmt.Sprintf("%T", zero), val)
 }
 
 // Set adds or updates a key-value pair, returning a new Preferences instance.
@@ -73,6 +304,226 @@ func (p Preferences) Set(key string, value any) Preferences {
 	return Preferences{data: newData}
 }
 
+// SetPath adds or updates a value at a dot-separated path (e.g., "notifications.email.enabled"),
+// creating intermediate objects as needed and returning a new Preferences instance.
+// Every nested map along the path is copied, so neither the original Preferences nor any map
+// shared with it is modified.
+// Returns an error if the path is malformed or crosses a value that is not an object.
+func (p Preferences) SetPath(path string, value any) (Preferences, error) {
+	segments := strings.Split(path, preferencePathSeparator)
+	for _, segment := range segments {
+		if segment == "" {
+			return p, fault.New(
+				"preference path contains an empty segment",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("path", path),
+			)
+		}
+	}
+
+	root := p.Data()
+	current := root
+	for i, segment := range segments[:len(segments)-1] {
+		var child map[string]any
+		switch existing := current[segment].(type) {
+		case nil:
+			child = make(map[string]any, 1)
+		case map[string]any:
+			child = make(map[string]any, len(existing)+1)
+			for k, v := range existing {
+				child[k] = v
+			}
+		default:
+			return p, fault.New(
+				"preference path crosses a value that is not an object",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("path", path),
+				fault.WithContext("segment", strings.Join(segments[:i+1], preferencePathSeparator)),
+			)
+		}
+		current[segment] = child
+		current = child
+	}
+	current[segments[len(segments)-1]] = value
+
+	return Preferences{data: root}, nil
+}
+
+// Delete removes a key, returning a new Preferences instance. Like Get, the key may be a
+// dot-separated path into nested objects; the maps along the path are copied, so the original
+// Preferences is not modified. Deleting a missing key returns an equal Preferences.
+func (p Preferences) Delete(key string) Preferences {
+	if _, ok := p.data[key]; ok {
+		newData := p.Data()
+		delete(newData, key)
+		return Preferences{data: newData}
+	}
+
+	if _, ok := p.Get(key); !ok || !strings.Contains(key, preferencePathSeparator) {
+		return p
+	}
+
+	segments := strings.Split(key, preferencePathSeparator)
+	root := p.Data()
+	current := root
+	for _, segment := range segments[:len(segments)-1] {
+		existing := current[segment].(map[string]any)
+		child := make(map[string]any, len(existing))
+		for k, v := range existing {
+			child[k] = v
+		}
+		current[segment] = child
+		current = child
+	}
+	delete(current, segments[len(segments)-1])
+
+	return Preferences{data: root}
+}
+
+// Prune keeps only the allowed keys, returning a new Preferences instance. This drops obsolete
+// settings that would otherwise accumulate in stored JSON. An allowed key may be a dot-separated
+// path, in which case only that nested value is kept within its parent objects.
+//
+// Example:
+//   pruned := prefs.Prune("theme", "notifications.email")
+func (p Preferences) Prune(allowedKeys ...string) Preferences {
+	pruned := Preferences{data: make(map[string]any, len(allowedKeys))}
+	for _, key := range allowedKeys {
+		if v, ok := p.data[key]; ok {
+			pruned.data[key] = v
+			continue
+		}
+		if v, ok := p.Get(key); ok {
+			if updated, err := pruned.SetPath(key, v); err == nil {
+				pruned = updated
+			}
+		}
+	}
+	return pruned
+}
+
+// MergeStrategy defines how Preferences.Merge resolves keys present on both sides.
+type MergeStrategy int
+
+const (
+	// MergeOverwrite replaces top-level values with the ones from the other Preferences.
+	MergeOverwrite MergeStrategy = iota
+	// MergePreserve keeps the existing values and only adds keys missing from the receiver.
+	MergePreserve
+	// MergeDeep merges nested objects recursively, with values from the other Preferences
+	// winning for any non-object conflict. This suits partial settings updates.
+	MergeDeep
+)
+
+// Merge combines the preferences with other according to the strategy, returning a new
+// Preferences instance. Neither input is modified.
+// Returns an error if the strategy is unknown.
+//
+// Example:
+//   update, _ := ParsePreferences([]byte(`{"notifications":{"email":false}}`))
+//   merged, _ := prefs.Merge(update, MergeDeep)
+func (p Preferences) Merge(other Preferences, strategy MergeStrategy) (Preferences, error) {
+	switch strategy {
+	case MergeOverwrite, MergePreserve:
+		merged := p.Data()
+		for k, v := range other.data {
+			if _, exists := merged[k]; exists && strategy == MergePreserve {
+				continue
+			}
+			merged[k] = v
+		}
+		return Preferences{data: merged}, nil
+	case MergeDeep:
+		return Preferences{data: deepMergePreferences(p.data, other.data)}, nil
+	}
+
+	return p, fault.New(
+		"unknown preferences merge strategy",
+		fault.WithCode(fault.Invalid),
+		fault.WithContext("strategy", int(strategy)),
+	)
+}
+
+// deepMergePreferences returns a new map with the values of override merged recursively into base.
+func deepMergePreferences(base, override map[string]any) map[string]any {
+	merged := make(map[string]any, len(base)+len(override))
+	for k, v := range base {
+		merged[k] = v
+	}
+
+	for k, v := range override {
+		baseMap, baseIsMap := merged[k].(map[string]any)
+		overrideMap, overrideIsMap := v.(map[string]any)
+		if baseIsMap && overrideIsMap {
+			merged[k] = deepMergePreferences(baseMap, overrideMap)
+			continue
+		}
+		merged[k] = v
+	}
+	return merged
+}
+
+// PreferencesDiff describes the differences between two Preferences.
+// Keys are dot-separated paths into nested objects, sorted alphabetically.
+type PreferencesDiff struct {
+	// Added lists the keys present only in the other Preferences.
+	Added []string
+	// Changed lists the keys present on both sides with different values.
+	Changed []string
+	// Removed lists the keys present only in the receiver.
+	Removed []string
+}
+
+// IsEmpty returns true if there are no differences.
+func (d PreferencesDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Changed) == 0 && len(d.Removed) == 0
+}
+
+// Diff compares the preferences with other and reports the added, changed and removed keys,
+// descending into nested objects present on both sides. It is useful for auditing settings updates.
+//
+// Example:
+//   diff := before.Diff(after)
+//   diff.Changed // ["notifications.email.enabled"]
+func (p Preferences) Diff(other Preferences) PreferencesDiff {
+	var diff PreferencesDiff
+	diffPreferences("", p.data, other.data, &diff)
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Changed)
+	sort.Strings(diff.Removed)
+	return diff
+}
+
+// diffPreferences collects the differences between two maps into diff, prefixing keys with prefix.
+func diffPreferences(prefix string, before, after map[string]any, diff *PreferencesDiff) {
+	for k, beforeValue := range before {
+		path := prefix + k
+		afterValue, exists := after[k]
+		if !exists {
+			diff.Removed = append(diff.Removed, path)
+			continue
+		}
+
+		beforeMap, beforeIsMap := beforeValue.(map[string]any)
+		afterMap, afterIsMap := afterValue.(map[string]any)
+		if beforeIsMap && afterIsMap {
+			diffPreferences(path+preferencePathSeparator, beforeMap, afterMap, diff)
+			continue
+		}
+
+		if !reflect.DeepEqual(beforeValue, afterValue) {
+			diff.Changed = append(diff.Changed, path)
+		}
+	}
+
+	for k := range after {
+		if _, exists := before[k]; !exists {
+			diff.Added = append(diff.Added, prefix+k)
+		}
+	}
+}
+
 // IsZero returns true if the preferences map is empty.
 func (p Preferences) IsZero() bool {
 	return len(p.data) == 0
@@ -3,7 +3,9 @@ package wisp_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/marcelofabianov/fault"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/marcelofabianov/wisp"
@@ -109,3 +111,273 @@ func (s *PreferencesSuite) TestPreferences_JSON_SQL() {
 		s.Equal(prefs.Data(), scannedPrefs.Data())
 	})
 }
+
+func (s *PreferencesSuite) TestPreferences_TypedGetters() {
+	prefs, err := wisp.ParsePreferences([]byte(`{
+		"theme": "dark",
+		"beta": true,
+		"page_size": 25,
+		"ratio": 0.75,
+		"last_login": "2025-03-10T14:30:00Z",
+		"notifications": {"email": true, "frequency": "daily"}
+	}`))
+	s.Require().NoError(err)
+
+	s.Run("GetString", func() {
+		v, err := prefs.GetString("theme")
+		s.Require().NoError(err)
+		s.Equal("dark", v)
+		s.Equal("en", prefs.GetStringOr("language", "en"))
+		s.Equal("fallback", prefs.GetStringOr("beta", "fallback"))
+	})
+
+	s.Run("GetBool", func() {
+		v, err := prefs.GetBool("beta")
+		s.Require().NoError(err)
+		s.True(v)
+		s.True(prefs.GetBoolOr("missing", true))
+	})
+
+	s.Run("GetInt", func() {
+		v, err := prefs.GetInt("page_size")
+		s.Require().NoError(err)
+		s.Equal(25, v)
+
+		_, err = prefs.GetInt("ratio")
+		s.Require().Error(err)
+		s.Equal(10, prefs.GetIntOr("ratio", 10))
+
+		v, err = prefs.Set("count", int64(7)).GetInt("count")
+		s.Require().NoError(err)
+		s.Equal(7, v)
+	})
+
+	s.Run("GetFloat", func() {
+		v, err := prefs.GetFloat("ratio")
+		s.Require().NoError(err)
+		s.Equal(0.75, v)
+
+		v, err = prefs.Set("count", 3).GetFloat("count")
+		s.Require().NoError(err)
+		s.Equal(3.0, v)
+		s.Equal(1.5, prefs.GetFloatOr("theme", 1.5))
+	})
+
+	s.Run("GetTime", func() {
+		v, err := prefs.GetTime("last_login")
+		s.Require().NoError(err)
+		s.True(v.Equal(time.Date(2025, 3, 10, 14, 30, 0, 0, time.UTC)))
+
+		now := time.Now()
+		v, err = prefs.Set("now", now).GetTime("now")
+		s.Require().NoError(err)
+		s.Equal(now, v)
+
+		_, err = prefs.GetTime("theme")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		s.Equal(now, prefs.GetTimeOr("theme", now))
+	})
+
+	s.Run("GetAs", func() {
+		type notifications struct {
+			Email     bool   `json:"email"`
+			Frequency string `json:"frequency"`
+		}
+
+		n, err := wisp.GetAs[notifications](prefs, "notifications")
+		s.Require().NoError(err)
+		s.Equal(notifications{Email: true, Frequency: "daily"}, n)
+
+		size, err := wisp.GetAs[int64](prefs, "page_size")
+		s.Require().NoError(err)
+		s.Equal(int64(25), size)
+
+		theme, err := wisp.GetAs[string](prefs, "theme")
+		s.Require().NoError(err)
+		s.Equal("dark", theme)
+
+		_, err = wisp.GetAs[int](prefs, "theme")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+
+	s.Run("should distinguish missing keys from type mismatches", func() {
+		_, err := prefs.GetString("missing")
+		s.Require().Error(err)
+		s.Equal(fault.NotFound, err.(*fault.Error).Code)
+
+		_, err = prefs.GetBool("theme")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+		_, err = wisp.GetAs[string](prefs, "missing")
+		s.Require().Error(err)
+		s.Equal(fault.NotFound, err.(*fault.Error).Code)
+	})
+}
+
+func (s *PreferencesSuite) TestPreferences_NestedPaths() {
+	prefs, err := wisp.ParsePreferences([]byte(`{
+		"theme": "dark",
+		"notifications": {"email": {"enabled": true, "frequency": "daily"}},
+		"legacy.flat.key": "flat"
+	}`))
+	s.Require().NoError(err)
+
+	s.Run("should get nested values by path", func() {
+		v, ok := prefs.Get("notifications.email.enabled")
+		s.True(ok)
+		s.Equal(true, v)
+
+		enabled, err := prefs.GetBool("notifications.email.enabled")
+		s.Require().NoError(err)
+		s.True(enabled)
+
+		_, ok = prefs.Get("notifications.sms.enabled")
+		s.False(ok)
+		_, ok = prefs.Get("theme.color")
+		s.False(ok)
+	})
+
+	s.Run("should prefer top-level keys containing dots", func() {
+		v, ok := prefs.Get("legacy.flat.key")
+		s.True(ok)
+		s.Equal("flat", v)
+	})
+
+	s.Run("should set nested values with copy-on-write", func() {
+		updated, err := prefs.SetPath("notifications.email.frequency", "weekly")
+		s.Require().NoError(err)
+
+		s.Equal("weekly", updated.GetStringOr("notifications.email.frequency", ""))
+		s.Equal("daily", prefs.GetStringOr("notifications.email.frequency", ""))
+		s.True(updated.GetBoolOr("notifications.email.enabled", false))
+	})
+
+	s.Run("should create intermediate objects", func() {
+		updated, err := wisp.EmptyPreferences.SetPath("notifications.push.enabled", false)
+		s.Require().NoError(err)
+
+		enabled, err := updated.GetBool("notifications.push.enabled")
+		s.Require().NoError(err)
+		s.False(enabled)
+		s.True(wisp.EmptyPreferences.IsZero())
+
+		data, err := json.Marshal(updated)
+		s.Require().NoError(err)
+		s.JSONEq(`{"notifications":{"push":{"enabled":false}}}`, string(data))
+	})
+
+	s.Run("should not modify maps shared with the caller", func() {
+		nested := map[string]any{"enabled": true}
+		shared, err := wisp.NewPreferences(map[string]any{"email": nested})
+		s.Require().NoError(err)
+
+		_, err = shared.SetPath("email.enabled", false)
+		s.Require().NoError(err)
+		s.Equal(true, nested["enabled"])
+	})
+
+	s.Run("should reject invalid paths", func() {
+		_, err := prefs.SetPath("theme.color", "blue")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+		_, err = prefs.SetPath("notifications..enabled", true)
+		s.Require().Error(err)
+
+		_, err = prefs.SetPath("", true)
+		s.Require().Error(err)
+	})
+}
+
+func (s *PreferencesSuite) TestPreferences_Merge() {
+	base, _ := wisp.ParsePreferences([]byte(`{"theme":"dark","notifications":{"email":true,"sms":false}}`))
+	update, _ := wisp.ParsePreferences([]byte(`{"theme":"light","language":"pt-BR","notifications":{"sms":true}}`))
+
+	s.Run("MergeOverwrite", func() {
+		merged, err := base.Merge(update, wisp.MergeOverwrite)
+		s.Require().NoError(err)
+		data, _ := json.Marshal(merged)
+		s.JSONEq(`{"theme":"light","language":"pt-BR","notifications":{"sms":true}}`, string(data))
+	})
+
+	s.Run("MergePreserve", func() {
+		merged, err := base.Merge(update, wisp.MergePreserve)
+		s.Require().NoError(err)
+		data, _ := json.Marshal(merged)
+		s.JSONEq(`{"theme":"dark","language":"pt-BR","notifications":{"email":true,"sms":false}}`, string(data))
+	})
+
+	s.Run("MergeDeep", func() {
+		merged, err := base.Merge(update, wisp.MergeDeep)
+		s.Require().NoError(err)
+		data, _ := json.Marshal(merged)
+		s.JSONEq(`{"theme":"light","language":"pt-BR","notifications":{"email":true,"sms":true}}`, string(data))
+
+		s.False(base.GetBoolOr("notifications.sms", true))
+		_, ok := update.Get("notifications.email")
+		s.False(ok)
+	})
+
+	s.Run("should fail for an unknown strategy", func() {
+		_, err := base.Merge(update, wisp.MergeStrategy(99))
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *PreferencesSuite) TestPreferences_Diff() {
+	before, _ := wisp.ParsePreferences([]byte(`{"theme":"dark","beta":true,"tags":["a"],"notifications":{"email":true,"sms":false}}`))
+	after, _ := wisp.ParsePreferences([]byte(`{"theme":"light","language":"pt-BR","tags":["a"],"notifications":{"email":true,"push":true}}`))
+
+	diff := before.Diff(after)
+	s.Equal([]string{"language", "notifications.push"}, diff.Added)
+	s.Equal([]string{"theme"}, diff.Changed)
+	s.Equal([]string{"beta", "notifications.sms"}, diff.Removed)
+	s.False(diff.IsEmpty())
+
+	s.True(before.Diff(before).IsEmpty())
+
+	replaced := before.Set("notifications", "off")
+	s.Equal([]string{"notifications"}, before.Diff(replaced).Changed)
+}
+
+func (s *PreferencesSuite) TestPreferences_DeleteAndPrune() {
+	prefs, _ := wisp.ParsePreferences([]byte(`{
+		"theme": "dark",
+		"legacy_flag": true,
+		"notifications": {"email": true, "sms": false, "push": {"enabled": true}}
+	}`))
+
+	s.Run("Delete top-level key", func() {
+		updated := prefs.Delete("legacy_flag")
+		_, ok := updated.Get("legacy_flag")
+		s.False(ok)
+		_, ok = prefs.Get("legacy_flag")
+		s.True(ok)
+	})
+
+	s.Run("Delete nested key", func() {
+		updated := prefs.Delete("notifications.push.enabled")
+		data, _ := json.Marshal(updated)
+		s.JSONEq(`{"theme":"dark","legacy_flag":true,"notifications":{"email":true,"sms":false,"push":{}}}`, string(data))
+		s.True(prefs.GetBoolOr("notifications.push.enabled", false))
+	})
+
+	s.Run("Delete missing key", func() {
+		s.Equal(prefs, prefs.Delete("missing"))
+		s.Equal(prefs, prefs.Delete("theme.color"))
+		s.Equal(prefs, prefs.Delete("notifications.missing"))
+	})
+
+	s.Run("Prune", func() {
+		pruned := prefs.Prune("theme", "notifications.email", "unknown")
+		data, _ := json.Marshal(pruned)
+		s.JSONEq(`{"theme":"dark","notifications":{"email":true}}`, string(data))
+		s.Len(prefs.Data(), 3)
+
+		s.True(prefs.Prune().IsZero())
+	})
+}
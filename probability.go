@@ -0,0 +1,163 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// Probability represents the likelihood of an event, constrained to the [0, 1] interval.
+// Like Percentage, it is stored as a scaled integer to avoid floating-point drift when
+// probabilities are combined, which makes it suitable for risk-scoring domains.
+//
+// The value is stored scaled by a factor of 1,000,000 (6 decimal places of precision).
+// For example, a probability of 0.25 is stored as the integer 250000.
+//
+// Example:
+//   fraud, _ := wisp.NewProbability(0.02)
+//   chargeback, _ := wisp.NewProbability(0.5)
+//   both := fraud.And(chargeback)   // 0.01
+//   either := fraud.Or(chargeback)  // 0.51
+//   safe := fraud.Complement()      // 0.98
+type Probability int64
+
+// probabilityFactor is the scaling factor used to store the probability as an integer.
+const probabilityFactor = 1000000
+
+// ZeroProbability represents the zero value for the Probability type (an impossible event).
+var ZeroProbability Probability
+
+// CertainProbability represents a probability of 1 (a certain event).
+const CertainProbability Probability = probabilityFactor
+
+// NewProbability creates a new Probability from a float64 between 0 and 1.
+// The value is scaled and rounded to the nearest even number to be stored as an integer.
+// Returns an error if the value is outside the [0, 1] interval or is not a number.
+func NewProbability(value float64) (Probability, error) {
+	if math.IsNaN(value) || value < 0 || value > 1 {
+		return ZeroProbability, fault.New(
+			"probability must be between 0 and 1",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+		)
+	}
+	return Probability(math.RoundToEven(value * probabilityFactor)), nil
+}
+
+// NewProbabilityFromPercentage creates a new Probability from a Percentage (e.g., 25% becomes 0.25).
+// Returns an error if the percentage is negative or greater than 100%.
+func NewProbabilityFromPercentage(p Percentage) (Probability, error) {
+	if p < 0 || p > percentageFactor {
+		return ZeroProbability, fault.New(
+			"percentage must be between 0% and 100% to be converted to a probability",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_percentage", p.String()),
+		)
+	}
+	return Probability(int64(p) * (probabilityFactor / percentageFactor)), nil
+}
+
+// Float64 returns the probability as a float64 between 0 and 1.
+func (p Probability) Float64() float64 {
+	return float64(p) / probabilityFactor
+}
+
+// Percentage converts the probability to a Percentage, rounding to its 4 decimal places of precision.
+func (p Probability) Percentage() Percentage {
+	return Percentage(math.RoundToEven(float64(p) / (probabilityFactor / percentageFactor)))
+}
+
+// Complement returns the probability of the event not happening (1 - p).
+func (p Probability) Complement() Probability {
+	return CertainProbability - p
+}
+
+// And returns the probability of both independent events happening (p × q).
+func (p Probability) And(other Probability) Probability {
+	return Probability(math.RoundToEven(float64(int64(p)*int64(other)) / probabilityFactor))
+}
+
+// Or returns the probability of at least one of two independent events happening (p + q − p × q).
+func (p Probability) Or(other Probability) Probability {
+	return p + other - p.And(other)
+}
+
+// IsZero returns true if the probability is zero (an impossible event).
+func (p Probability) IsZero() bool {
+	return p == ZeroProbability
+}
+
+// IsCertain returns true if the probability is one (a certain event).
+func (p Probability) IsCertain() bool {
+	return p == CertainProbability
+}
+
+// GreaterThan checks if this probability is greater than another.
+func (p Probability) GreaterThan(other Probability) bool {
+	return p > other
+}
+
+// LessThan checks if this probability is less than another.
+func (p Probability) LessThan(other Probability) bool {
+	return p < other
+}
+
+// String returns the probability with 6 decimal places (e.g., "0.250000").
+func (p Probability) String() string {
+	return fmt.Sprintf("%.6f", p.Float64())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the Probability as its float64 representation.
+func (p Probability) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Float64())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON number into a Probability, with validation.
+func (p *Probability) UnmarshalJSON(data []byte) error {
+	var f float64
+	if err := json.Unmarshal(data, &f); err != nil {
+		return fault.Wrap(err, "Probability must be a valid JSON number", fault.WithCode(fault.Invalid))
+	}
+
+	prob, err := NewProbability(f)
+	if err != nil {
+		return err
+	}
+	*p = prob
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the scaled integer representation of the probability.
+func (p Probability) Value() (driver.Value, error) {
+	return int64(p), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts the scaled int64 representation from the database, with validation.
+func (p *Probability) Scan(src interface{}) error {
+	if src == nil {
+		*p = ZeroProbability
+		return nil
+	}
+
+	var intVal int64
+	switch v := src.(type) {
+	case int64:
+		intVal = v
+	default:
+		return fault.New("unsupported scan type for Probability", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+
+	if intVal < 0 || intVal > probabilityFactor {
+		return fault.New("probability from database must be between 0 and 1", fault.WithCode(fault.Invalid), fault.WithContext("source_value", intVal))
+	}
+
+	*p = Probability(intVal)
+	return nil
+}
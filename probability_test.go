@@ -0,0 +1,104 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type ProbabilitySuite struct {
+	suite.Suite
+}
+
+func TestProbabilitySuite(t *testing.T) {
+	suite.Run(t, new(ProbabilitySuite))
+}
+
+func (s *ProbabilitySuite) TestNewProbability() {
+	testCases := []struct {
+		name        string
+		input       float64
+		expected    wisp.Probability
+		expectError bool
+	}{
+		{name: "zero", input: 0, expected: wisp.ZeroProbability},
+		{name: "one", input: 1, expected: wisp.CertainProbability},
+		{name: "quarter", input: 0.25, expected: 250000},
+		{name: "rounds to 6 decimals", input: 0.1234567, expected: 123457},
+		{name: "negative", input: -0.1, expectError: true},
+		{name: "greater than one", input: 1.01, expectError: true},
+		{name: "NaN", input: math.NaN(), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			p, err := wisp.NewProbability(tc.input)
+			if tc.expectError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.expected, p)
+		})
+	}
+}
+
+func (s *ProbabilitySuite) TestProbability_Composition() {
+	fraud, _ := wisp.NewProbability(0.02)
+	chargeback, _ := wisp.NewProbability(0.5)
+
+	s.Equal(0.98, fraud.Complement().Float64())
+	s.Equal(0.01, fraud.And(chargeback).Float64())
+	s.Equal(0.51, fraud.Or(chargeback).Float64())
+	s.True(fraud.Or(wisp.CertainProbability).IsCertain())
+	s.True(fraud.And(wisp.ZeroProbability).IsZero())
+	s.True(chargeback.GreaterThan(fraud))
+	s.True(fraud.LessThan(chargeback))
+	s.Equal("0.020000", fraud.String())
+}
+
+func (s *ProbabilitySuite) TestProbability_Percentage() {
+	pct, _ := wisp.NewPercentageFromFloat(0.075)
+
+	p, err := wisp.NewProbabilityFromPercentage(pct)
+	s.Require().NoError(err)
+	s.Equal(0.075, p.Float64())
+	s.Equal(pct, p.Percentage())
+
+	tooMuch, _ := wisp.NewPercentageFromFloat(1.5)
+	_, err = wisp.NewProbabilityFromPercentage(tooMuch)
+	s.Require().Error(err)
+
+	precise, _ := wisp.NewProbability(0.123456)
+	s.Equal(wisp.Percentage(1235), precise.Percentage())
+}
+
+func (s *ProbabilitySuite) TestProbability_Serialization() {
+	p, _ := wisp.NewProbability(0.3)
+
+	data, err := json.Marshal(p)
+	s.Require().NoError(err)
+	s.Equal(`0.3`, string(data))
+
+	var decoded wisp.Probability
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(p, decoded)
+	s.Require().Error(json.Unmarshal([]byte(`1.5`), &decoded))
+	s.Require().Error(json.Unmarshal([]byte(`"0.3"`), &decoded))
+
+	val, err := p.Value()
+	s.Require().NoError(err)
+	s.Equal(int64(300000), val)
+
+	var scanned wisp.Probability
+	s.Require().NoError(scanned.Scan(int64(300000)))
+	s.Equal(p, scanned)
+	s.Require().Error(scanned.Scan(int64(1000001)))
+	s.Require().Error(scanned.Scan(0.3))
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+}
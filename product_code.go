@@ -0,0 +1,128 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// maxProductCodeLength is the longest product code accepted in NF-e and NFC-e items (cProd).
+const maxProductCodeLength = 60
+
+// ProductCode is a value object representing the code of a product or service as informed in the
+// items of fiscal documents (the cProd field of NF-e and NFC-e). It is usually the SKU of the
+// product, but also accepts GTINs and the codes of suppliers, which do not follow the company's
+// SKU patterns.
+//
+// The code is trimmed and its case is kept; it must have up to 60 printable characters.
+//
+// The zero value is EmptyProductCode.
+//
+// Example:
+//   code, err := wisp.NewProductCode("CFG-001/A")
+//   code := sku.ProductCode()
+type ProductCode string
+
+// EmptyProductCode represents the zero value for the ProductCode type.
+var EmptyProductCode ProductCode
+
+// NewProductCode creates a new ProductCode.
+// Returns EmptyProductCode for an empty input, or an error if the code has non-printable
+// characters or more than 60 characters.
+func NewProductCode(input string) (ProductCode, error) {
+	code := strings.TrimSpace(input)
+	if code == "" {
+		return EmptyProductCode, nil
+	}
+
+	if !utf8.ValidString(code) || strings.IndexFunc(code, func(r rune) bool { return !unicode.IsPrint(r) }) >= 0 {
+		return EmptyProductCode, fault.New(
+			"product code must have only printable characters",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	if length := utf8.RuneCountInString(code); length > maxProductCodeLength {
+		return EmptyProductCode, fault.New(
+			"product code cannot be longer than 60 characters",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("length", length),
+		)
+	}
+	return ProductCode(code), nil
+}
+
+// String returns the product code.
+func (c ProductCode) String() string {
+	return string(c)
+}
+
+// IsZero returns true if the ProductCode is the zero value.
+func (c ProductCode) IsZero() bool {
+	return c == EmptyProductCode
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the ProductCode as a JSON string.
+func (c ProductCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a ProductCode, with validation.
+func (c *ProductCode) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "ProductCode must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	code, err := NewProductCode(s)
+	if err != nil {
+		return err
+	}
+	*c = code
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the ProductCode as a string, or nil for the zero value.
+func (c ProductCode) Value() (driver.Value, error) {
+	if c.IsZero() {
+		return nil, nil
+	}
+	return c.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a ProductCode.
+func (c *ProductCode) Scan(src interface{}) error {
+	if src == nil {
+		*c = EmptyProductCode
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for ProductCode",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	code, err := NewProductCode(s)
+	if err != nil {
+		return err
+	}
+	*c = code
+	return nil
+}
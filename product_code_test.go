@@ -0,0 +1,83 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type ProductCodeSuite struct {
+	suite.Suite
+}
+
+func TestProductCodeSuite(t *testing.T) {
+	suite.Run(t, new(ProductCodeSuite))
+}
+
+func (s *ProductCodeSuite) TestNewProductCode() {
+	s.Run("should trim and keep the case of valid codes", func() {
+		for input, expected := range map[string]string{
+			" cfg-001/A ":   "cfg-001/A",
+			"7891000315507": "7891000315507",
+			"Café 500g":     "Café 500g",
+		} {
+			code, err := wisp.NewProductCode(input)
+			s.Require().NoError(err, input)
+			s.Equal(expected, code.String())
+		}
+	})
+
+	s.Run("should return the zero value for an empty input", func() {
+		code, err := wisp.NewProductCode("")
+		s.Require().NoError(err)
+		s.True(code.IsZero())
+	})
+
+	s.Run("should reject non-printable characters and long codes", func() {
+		for _, input := range []string{"ABC\t1", "ABC\x00", strings.Repeat("A", 61)} {
+			_, err := wisp.NewProductCode(input)
+			s.Require().Error(err)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *ProductCodeSuite) TestSerialization() {
+	s.Run("should round-trip through JSON", func() {
+		code, _ := wisp.NewProductCode("CFG-001/A")
+		data, err := json.Marshal(code)
+		s.Require().NoError(err)
+		s.Equal(`"CFG-001/A"`, string(data))
+
+		var decoded wisp.ProductCode
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(code, decoded)
+		s.Error(json.Unmarshal([]byte(`1`), &decoded))
+	})
+
+	s.Run("should store and scan database values", func() {
+		code, _ := wisp.NewProductCode("CFG-001/A")
+		value, err := code.Value()
+		s.Require().NoError(err)
+		s.Equal("CFG-001/A", value)
+
+		value, err = wisp.EmptyProductCode.Value()
+		s.Require().NoError(err)
+		s.Nil(value)
+
+		var scanned wisp.ProductCode
+		s.Require().NoError(scanned.Scan([]byte("X-1")))
+		s.Equal("X-1", scanned.String())
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		err = scanned.Scan(1)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 
 	"github.com/marcelofabianov/fault"
@@ -21,6 +22,54 @@ func SetDefaultPrecision(p int) {
 	}
 }
 
+// maxQuantityPrecision is the largest precision supported by Round and ParseQuantity, the most
+// decimal places a scaled int64 can hold.
+const maxQuantityPrecision = 18
+
+// unitConversions holds the registered conversion factors between units: one unit of the first
+// is worth factor units of the second.
+var unitConversions = make(map[[2]Unit]int64)
+
+// RegisterUnitConversion registers how many units of to make one unit of from (e.g., 1 KG = 1000 G,
+// 1 BOX = 12 UN), so quantities can be converted in both directions with Quantity.ConvertTo.
+// This function should be called at application startup, after RegisterUnits.
+// Returns an error if either unit is not registered, the units are the same, or the factor is not
+// positive.
+//
+// Example:
+//   wisp.RegisterUnits("BOX", "UN")
+//   err := wisp.RegisterUnitConversion("BOX", "UN", 12)
+func RegisterUnitConversion(from, to Unit, factor int64) error {
+	from = Unit(strings.ToUpper(strings.TrimSpace(string(from))))
+	to = Unit(strings.ToUpper(strings.TrimSpace(string(to))))
+	if !from.IsValid() || !to.IsValid() {
+		return fault.New(
+			"units must be registered before their conversion",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("from", from),
+			fault.WithContext("to", to),
+		)
+	}
+	if from == to || factor <= 0 {
+		return fault.New(
+			"unit conversion requires different units and a positive factor",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("from", from),
+			fault.WithContext("to", to),
+			fault.WithContext("factor", factor),
+		)
+	}
+
+	unitConversions[[2]Unit{from, to}] = factor
+	return nil
+}
+
+// ClearUnitConversions removes all conversions from the global registry.
+// This is primarily for testing purposes to ensure a clean state.
+func ClearUnitConversions() {
+	unitConversions = make(map[[2]Unit]int64)
+}
+
 // Quantity is a value object representing a numeric amount with a specific unit of measure.
 // It is designed to handle decimal values with a defined precision by storing the value as a scaled integer,
 // thus avoiding floating-point inaccuracies in calculations.
@@ -80,6 +129,48 @@ func NewQuantityWithPrecision(value float64, unit Unit, precision int) (Quantity
 	return newQuantity(value, unit, precision)
 }
 
+// ParseQuantity creates a new Quantity from a decimal number followed by its unit, such as
+// "2.500 kg" or "12 UN", without going through floating-point. The precision is the number of
+// decimal places written, and either "." or "," is accepted as the decimal separator.
+// Returns an error if the input is not a number and a unit, the number has more than 18 decimal
+// places or does not fit, or the unit is not registered.
+func ParseQuantity(input string) (Quantity, error) {
+	fields := strings.Fields(input)
+	if len(fields) != 2 {
+		return Quantity{}, fault.New(
+			"quantity must be a number followed by a unit",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+
+	number := strings.Replace(fields[0], ",", ".", 1)
+	integer, fraction, hasFraction := strings.Cut(number, ".")
+	digits := strings.TrimPrefix(integer, "-")
+	if digits == "" || !isASCIIDigits(digits) || (hasFraction && (fraction == "" || !isASCIIDigits(fraction))) || len(fraction) > maxQuantityPrecision {
+		return Quantity{}, fault.New(
+			"invalid quantity number",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+
+	value, err := strconv.ParseInt(integer+fraction, 10, 64)
+	if err != nil {
+		return Quantity{}, fault.Wrap(err, "quantity number is out of range", fault.WithCode(fault.Invalid), fault.WithContext("input_value", input))
+	}
+
+	unit := Unit(strings.ToUpper(fields[1]))
+	if !unit.IsValid() {
+		return Quantity{}, fault.New(
+			"unit is not registered as a valid unit of measure",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("unit", unit),
+		)
+	}
+	return Quantity{value: value, unit: unit, precision: len(fraction)}, nil
+}
+
 // IntValue returns the scaled integer value of the quantity.
 func (q Quantity) IntValue() int64 {
 	return q.value
@@ -136,6 +227,116 @@ func (q Quantity) Add(other Quantity) (Quantity, error) {
 	}, nil
 }
 
+// Subtract returns a new Quantity that is the difference between this quantity and another.
+// It returns an error if the units or precisions of the two quantities are different.
+// The result may be negative, as for an inventory adjustment.
+func (q Quantity) Subtract(other Quantity) (Quantity, error) {
+	if q.unit != other.unit {
+		return Quantity{}, fault.New(
+			"cannot subtract quantities with different units",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("unit_a", q.unit),
+			fault.WithContext("unit_b", other.unit),
+		)
+	}
+
+	if q.precision != other.precision {
+		return Quantity{}, fault.New(
+			"cannot subtract quantities with different precisions",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("precision_a", q.precision),
+			fault.WithContext("precision_b", other.precision),
+		)
+	}
+
+	return Quantity{
+		value:     q.value - other.value,
+		unit:      q.unit,
+		precision: q.precision,
+	}, nil
+}
+
+// Multiply returns a new Quantity multiplied by an integer factor, keeping its unit and precision
+// (e.g., 6 boxes of 2.500 KG each).
+// Returns an error if the result overflows.
+func (q Quantity) Multiply(factor int64) (Quantity, error) {
+	value, err := mulDivRounded(q.value, factor, 1, RoundHalfEven)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{value: value, unit: q.unit, precision: q.precision}, nil
+}
+
+// Round returns the quantity with the given precision, rounding with the given mode when decimal
+// places are dropped (e.g., 2.575 KG rounded to 2 places with RoundHalfUp is 2.58 KG).
+// Returns an error if the precision is not between 0 and 18, the rounding mode is unknown, or the
+// result overflows.
+func (q Quantity) Round(precision int, mode RoundingMode) (Quantity, error) {
+	if precision < 0 || precision > maxQuantityPrecision {
+		return Quantity{}, fault.New(
+			"precision must be between 0 and 18",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("precision", precision),
+		)
+	}
+
+	var value int64
+	var err error
+	if precision >= q.precision {
+		value, err = mulDivRounded(q.value, pow10Int64(precision-q.precision), 1, mode)
+	} else {
+		value, err = mulDivRounded(q.value, 1, pow10Int64(q.precision-precision), mode)
+	}
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{value: value, unit: q.unit, precision: precision}, nil
+}
+
+// ConvertTo returns the quantity in another unit, using the factor registered with
+// RegisterUnitConversion in either direction, and keeping its precision. Converting to a smaller
+// unit is exact; converting to a larger one rounds with the given mode.
+// Returns a NotFound error if no conversion between the units is registered, or an error if the
+// rounding mode is unknown or the result overflows.
+//
+// Example:
+//   wisp.RegisterUnitConversion("BOX", "UN", 12)
+//   q, _ := wisp.ParseQuantity("30 UN")
+//   boxes, _ := q.ConvertTo("BOX", wisp.RoundFloor) // "2 BOX"
+func (q Quantity) ConvertTo(unit Unit, mode RoundingMode) (Quantity, error) {
+	if unit == q.unit {
+		return q, nil
+	}
+
+	var value int64
+	var err error
+	if factor, ok := unitConversions[[2]Unit{q.unit, unit}]; ok {
+		value, err = mulDivRounded(q.value, factor, 1, mode)
+	} else if factor, ok := unitConversions[[2]Unit{unit, q.unit}]; ok {
+		value, err = mulDivRounded(q.value, 1, factor, mode)
+	} else {
+		return Quantity{}, fault.New(
+			"no conversion registered between the units",
+			fault.WithCode(fault.NotFound),
+			fault.WithContext("from", q.unit),
+			fault.WithContext("to", unit),
+		)
+	}
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{value: value, unit: unit, precision: q.precision}, nil
+}
+
+// String returns the quantity with all its decimal places and its unit (e.g., "2.500 KG",
+// "12 UN"), or an empty string for the zero value.
+func (q Quantity) String() string {
+	if q.IsZero() {
+		return ""
+	}
+	return formatMinorUnits(q.value, q.precision) + " " + q.unit.String()
+}
+
 // MultiplyByMoney calculates the total cost by multiplying the quantity by a price per unit.
 // It returns a new Money instance representing the total value.
 func (q Quantity) MultiplyByMoney(pricePerUnit Money) (Money, error) {
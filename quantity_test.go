@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/marcelofabianov/fault"
 	"github.com/stretchr/testify/suite"
 
 	wisp "github.com/marcelofabianov/wisp"
@@ -109,3 +110,166 @@ func (s *QuantitySuite) TestQuantity_JSONMarshaling() {
 		s.Require().Error(err)
 	})
 }
+
+func (s *QuantitySuite) TestParseQuantity() {
+	s.Run("should parse decimal numbers with their precision", func() {
+		testCases := map[string]struct {
+			value     int64
+			precision int
+			unit      wisp.Unit
+		}{
+			"2.500 kg": {2500, 3, UnitKG},
+			"12 UN":    {12, 0, UnitUN},
+			"1,75 l":   {175, 2, UnitL},
+			"-0.5 KG":  {-5, 1, UnitKG},
+		}
+		for input, expected := range testCases {
+			q, err := wisp.ParseQuantity(input)
+			s.Require().NoError(err, input)
+			s.Equal(expected.value, q.IntValue(), input)
+			s.Equal(expected.precision, q.Precision(), input)
+			s.Equal(expected.unit, q.Unit(), input)
+		}
+	})
+
+	s.Run("should reject malformed input and unregistered units", func() {
+		for _, input := range []string{"", "2.5", "KG", "2.5.1 KG", "2. KG", ".5 KG", "abc KG", "1e3 KG", "2.5 BOX", "99999999999999999999 UN", "2.5 KG extra"} {
+			_, err := wisp.ParseQuantity(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code, input)
+		}
+	})
+}
+
+func (s *QuantitySuite) TestQuantity_Subtract() {
+	q1, _ := wisp.ParseQuantity("10.50 KG")
+	q2, _ := wisp.ParseQuantity("12.25 KG")
+
+	s.Run("should subtract quantities, allowing negative results", func() {
+		result, err := q1.Subtract(q2)
+		s.Require().NoError(err)
+		s.Equal("-1.75 KG", result.String())
+	})
+
+	s.Run("should fail with different units or precisions", func() {
+		liters, _ := wisp.ParseQuantity("1.00 L")
+		_, err := q1.Subtract(liters)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+
+		finer, _ := wisp.ParseQuantity("1.000 KG")
+		_, err = q1.Subtract(finer)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+}
+
+func (s *QuantitySuite) TestQuantity_Multiply() {
+	q, _ := wisp.ParseQuantity("2.500 KG")
+
+	result, err := q.Multiply(6)
+	s.Require().NoError(err)
+	s.Equal("15.000 KG", result.String())
+
+	big, _ := wisp.NewQuantityWithPrecision(1, UnitUN, 18)
+	_, err = big.Multiply(100)
+	s.Require().Error(err)
+	s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+}
+
+func (s *QuantitySuite) TestQuantity_Round() {
+	q, _ := wisp.ParseQuantity("2.575 KG")
+
+	s.Run("should drop decimal places with the rounding mode", func() {
+		up, err := q.Round(2, wisp.RoundHalfUp)
+		s.Require().NoError(err)
+		s.Equal("2.58 KG", up.String())
+
+		even, err := q.Round(2, wisp.RoundHalfEven)
+		s.Require().NoError(err)
+		s.Equal("2.58 KG", even.String())
+
+		floor, err := q.Round(0, wisp.RoundFloor)
+		s.Require().NoError(err)
+		s.Equal("2 KG", floor.String())
+	})
+
+	s.Run("should add decimal places exactly", func() {
+		finer, err := q.Round(5, wisp.RoundHalfEven)
+		s.Require().NoError(err)
+		s.Equal("2.57500 KG", finer.String())
+		s.Equal(int64(257500), finer.IntValue())
+	})
+
+	s.Run("should reject invalid precisions", func() {
+		for _, precision := range []int{-1, 19} {
+			_, err := q.Round(precision, wisp.RoundHalfEven)
+			s.Require().Error(err)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *QuantitySuite) TestQuantity_ConvertTo() {
+	wisp.RegisterUnits("BOX", "G")
+	defer wisp.ClearUnitConversions()
+	s.Require().NoError(wisp.RegisterUnitConversion("box", "un", 12))
+	s.Require().NoError(wisp.RegisterUnitConversion(UnitKG, "G", 1000))
+
+	s.Run("should convert to a smaller unit exactly", func() {
+		q, _ := wisp.ParseQuantity("2.500 KG")
+		grams, err := q.ConvertTo("G", wisp.RoundHalfEven)
+		s.Require().NoError(err)
+		s.Equal("2500.000 G", grams.String())
+	})
+
+	s.Run("should convert to a larger unit with rounding", func() {
+		q, _ := wisp.ParseQuantity("30 UN")
+		boxes, err := q.ConvertTo("BOX", wisp.RoundFloor)
+		s.Require().NoError(err)
+		s.Equal("2 BOX", boxes.String())
+
+		boxes, err = q.ConvertTo("BOX", wisp.RoundCeil)
+		s.Require().NoError(err)
+		s.Equal("3 BOX", boxes.String())
+	})
+
+	s.Run("should return the same quantity for the same unit", func() {
+		q, _ := wisp.ParseQuantity("1.5 L")
+		same, err := q.ConvertTo(UnitL, wisp.RoundHalfEven)
+		s.Require().NoError(err)
+		s.Equal(q, same)
+	})
+
+	s.Run("should fail when no conversion is registered", func() {
+		q, _ := wisp.ParseQuantity("1.5 L")
+		_, err := q.ConvertTo(UnitKG, wisp.RoundHalfEven)
+		s.Require().Error(err)
+		s.Equal(fault.NotFound, err.(*fault.Error).Code)
+	})
+
+	s.Run("should reject invalid conversions", func() {
+		for _, tc := range []struct {
+			from, to wisp.Unit
+			factor   int64
+		}{
+			{"PALLET", "BOX", 40},
+			{"BOX", "BOX", 1},
+			{"BOX", "UN", 0},
+		} {
+			err := wisp.RegisterUnitConversion(tc.from, tc.to, tc.factor)
+			s.Require().Error(err)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *QuantitySuite) TestQuantity_String() {
+	q, _ := wisp.NewQuantityWithPrecision(2.5, UnitKG, 3)
+	s.Equal("2.500 KG", q.String())
+
+	units, _ := wisp.NewQuantityWithPrecision(12, UnitUN, 0)
+	s.Equal("12 UN", units.String())
+
+	s.Empty(wisp.Quantity{}.String())
+}
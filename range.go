@@ -0,0 +1,214 @@
+package wisp
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// Range is a generic value object representing an inclusive interval [min, max] over any ordered type,
+// such as integers, floats or strings. It removes the need to re-implement ranges for quantities,
+// scores or measurements; DateRange, TimeRange and MoneyRange remain the dedicated types for their domains.
+//
+// A Range is immutable and ensures min is not greater than max.
+// IntRange, FloatRange and PercentageRange are provided as aliases for the most common instantiations.
+//
+// The zero value of a Range is empty: it contains no value, even though min and max are the zero of T.
+//
+// Examples:
+//   r, err := wisp.NewRange(1, 10)          // Range[int]
+//   qty, err := wisp.NewRange[int64](5, 50) // IntRange
+//   r.Contains(7)                            // true
+type Range[T cmp.Ordered] struct {
+	min   T
+	max   T
+	valid bool
+}
+
+// IntRange is a Range of int64 values.
+type IntRange = Range[int64]
+
+// FloatRange is a Range of float64 values.
+type FloatRange = Range[float64]
+
+// PercentageRange is a Range of Percentage values, such as an allowed interest rate band.
+type PercentageRange = Range[Percentage]
+
+// NewRange creates a new Range from min and max, inclusive.
+// It returns an error if min is greater than max or if either bound is NaN.
+func NewRange[T cmp.Ordered](min, max T) (Range[T], error) {
+	if min != min || max != max {
+		return Range[T]{}, fault.New("range bounds cannot be NaN", fault.WithCode(fault.Invalid))
+	}
+	if min > max {
+		return Range[T]{}, fault.New(
+			"min cannot be greater than max",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("min", min),
+			fault.WithContext("max", max),
+		)
+	}
+	return Range[T]{min: min, max: max, valid: true}, nil
+}
+
+// Min returns the lower bound of the range.
+func (r Range[T]) Min() T {
+	return r.min
+}
+
+// Max returns the upper bound of the range.
+func (r Range[T]) Max() T {
+	return r.max
+}
+
+// IsZero returns true if the range is the empty zero value.
+func (r Range[T]) IsZero() bool {
+	return !r.valid
+}
+
+// Equals checks if two ranges have the same bounds.
+func (r Range[T]) Equals(other Range[T]) bool {
+	return r == other
+}
+
+// Contains checks if v is within the range (inclusive).
+func (r Range[T]) Contains(v T) bool {
+	return r.valid && v >= r.min && v <= r.max
+}
+
+// Overlaps checks if two ranges share at least one value.
+func (r Range[T]) Overlaps(other Range[T]) bool {
+	return r.valid && other.valid && r.min <= other.max && other.min <= r.max
+}
+
+// Intersect returns the values shared by both ranges.
+// The boolean is false, and the range empty, if the ranges do not overlap.
+func (r Range[T]) Intersect(other Range[T]) (Range[T], bool) {
+	if !r.Overlaps(other) {
+		return Range[T]{}, false
+	}
+	return Range[T]{min: max(r.min, other.min), max: min(r.max, other.max), valid: true}, true
+}
+
+// Union returns the smallest range covering both ranges.
+// It returns an error if the ranges do not overlap, since the result would include values from neither.
+// An empty range is the identity of the union.
+func (r Range[T]) Union(other Range[T]) (Range[T], error) {
+	if !r.valid {
+		return other, nil
+	}
+	if !other.valid {
+		return r, nil
+	}
+	if !r.Overlaps(other) {
+		return Range[T]{}, fault.New(
+			"cannot unite ranges that do not overlap",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("range_a", r.String()),
+			fault.WithContext("range_b", other.String()),
+		)
+	}
+	return Range[T]{min: min(r.min, other.min), max: max(r.max, other.max), valid: true}, nil
+}
+
+// Clamp returns v limited to the bounds of the range.
+// For an empty range, v is returned unchanged.
+func (r Range[T]) Clamp(v T) T {
+	if !r.valid {
+		return v
+	}
+	return min(max(v, r.min), r.max)
+}
+
+// String returns a formatted representation of the range, like "[1, 10]".
+func (r Range[T]) String() string {
+	if !r.valid {
+		return ""
+	}
+	return fmt.Sprintf("[%v, %v]", r.min, r.max)
+}
+
+// rangeJSON is the JSON representation of a Range.
+type rangeJSON[T cmp.Ordered] struct {
+	Min *T `json:"min"`
+	Max *T `json:"max"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the Range into a JSON object with "min" and "max" fields, or null if empty.
+func (r Range[T]) MarshalJSON() ([]byte, error) {
+	if !r.valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(rangeJSON[T]{Min: &r.min, Max: &r.max})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Both "min" and "max" are required.
+func (r *Range[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*r = Range[T]{}
+		return nil
+	}
+
+	var dto rangeJSON[T]
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for Range", fault.WithCode(fault.Invalid))
+	}
+	if dto.Min == nil || dto.Max == nil {
+		return fault.New("range requires both min and max", fault.WithCode(fault.Invalid))
+	}
+
+	parsed, err := NewRange(*dto.Min, *dto.Max)
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the Range as a JSON string or nil if it's empty.
+func (r Range[T]) Value() (driver.Value, error) {
+	if !r.valid {
+		return nil, nil
+	}
+
+	data, err := r.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal range for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as Range.
+func (r *Range[T]) Scan(src interface{}) error {
+	if src == nil {
+		*r = Range[T]{}
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for Range",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return r.UnmarshalJSON(data)
+}
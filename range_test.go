@@ -0,0 +1,124 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type RangeSuite struct {
+	suite.Suite
+}
+
+func TestRangeSuite(t *testing.T) {
+	suite.Run(t, new(RangeSuite))
+}
+
+func (s *RangeSuite) TestNewRange() {
+	r, err := wisp.NewRange(1, 10)
+	s.Require().NoError(err)
+	s.Equal(1, r.Min())
+	s.Equal(10, r.Max())
+	s.False(r.IsZero())
+	s.Equal("[1, 10]", r.String())
+
+	_, err = wisp.NewRange(10, 1)
+	s.Require().Error(err)
+	s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+	_, err = wisp.NewRange(math.NaN(), 1)
+	s.Error(err)
+
+	single, err := wisp.NewRange(0, 0)
+	s.Require().NoError(err)
+	s.True(single.Contains(0))
+	s.False(wisp.Range[int]{}.Contains(0))
+}
+
+func (s *RangeSuite) TestOperations() {
+	r, _ := wisp.NewRange[int64](10, 20)
+	touching, _ := wisp.NewRange[int64](20, 30)
+	apart, _ := wisp.NewRange[int64](25, 30)
+
+	s.Run("Contains and Clamp", func() {
+		s.True(r.Contains(10))
+		s.True(r.Contains(20))
+		s.False(r.Contains(21))
+		s.Equal(int64(10), r.Clamp(3))
+		s.Equal(int64(15), r.Clamp(15))
+		s.Equal(int64(20), r.Clamp(99))
+		s.Equal(int64(99), wisp.IntRange{}.Clamp(99))
+	})
+
+	s.Run("Overlaps", func() {
+		s.True(r.Overlaps(touching))
+		s.False(r.Overlaps(apart))
+		s.False(r.Overlaps(wisp.IntRange{}))
+	})
+
+	s.Run("Intersect", func() {
+		inter, ok := r.Intersect(touching)
+		s.True(ok)
+		s.Equal("[20, 20]", inter.String())
+
+		_, ok = r.Intersect(apart)
+		s.False(ok)
+	})
+
+	s.Run("Union", func() {
+		union, err := r.Union(touching)
+		s.Require().NoError(err)
+		s.Equal("[10, 30]", union.String())
+
+		_, err = r.Union(apart)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+
+		union, err = wisp.IntRange{}.Union(r)
+		s.Require().NoError(err)
+		s.True(union.Equals(r))
+	})
+
+	s.Run("strings", func() {
+		letters, _ := wisp.NewRange("b", "m")
+		s.True(letters.Contains("k"))
+		s.False(letters.Contains("z"))
+	})
+}
+
+func (s *RangeSuite) TestJSONAndSQL() {
+	r, _ := wisp.NewRange(1.5, 9.75)
+
+	data, err := json.Marshal(r)
+	s.Require().NoError(err)
+	s.JSONEq(`{"min":1.5,"max":9.75}`, string(data))
+
+	var decoded wisp.FloatRange
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(r.Equals(decoded))
+
+	s.Error(json.Unmarshal([]byte(`{"min":5,"max":1}`), &decoded))
+	s.Error(json.Unmarshal([]byte(`{"min":5}`), &decoded))
+	s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.True(decoded.IsZero())
+
+	data, err = json.Marshal(wisp.FloatRange{})
+	s.Require().NoError(err)
+	s.Equal("null", string(data))
+
+	val, err := r.Value()
+	s.Require().NoError(err)
+	var scanned wisp.FloatRange
+	s.Require().NoError(scanned.Scan(val))
+	s.True(r.Equals(scanned))
+	s.Error(scanned.Scan(42))
+
+	val, err = wisp.FloatRange{}.Value()
+	s.Require().NoError(err)
+	s.Nil(val)
+}
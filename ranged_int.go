@@ -0,0 +1,201 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// Integer is a constraint matching all signed and unsigned integer types.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// RangedInt is the generic counterpart of RangedValue: an integer of type T that must stay within
+// a `[min, max]` range given at construction, such as a priority from 1 to 5 or an integer
+// percentage from 0 to 100. Accessors return T, so no conversions are needed downstream.
+//
+// All operations are immutable, returning a new RangedInt instance.
+//
+// Example:
+//   priority, _ := wisp.NewRangedInt[uint8](3, 1, 5)
+//   higher, err := priority.Add(1) // 4
+//   var p uint8 = higher.Current()
+type RangedInt[T Integer] struct {
+	current T
+	min     T
+	max     T
+}
+
+// NewRangedInt creates a new RangedInt.
+// It returns an error if min > max, or if the current value is outside the [min, max] range.
+func NewRangedInt[T Integer](current, min, max T) (RangedInt[T], error) {
+	if min > max {
+		return RangedInt[T]{}, fault.New(
+			"min value cannot be greater than max value",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("min", min),
+			fault.WithContext("max", max),
+		)
+	}
+	if current < min || current > max {
+		return RangedInt[T]{}, fault.New(
+			"current value is outside the allowed range [min, max]",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("current", current),
+			fault.WithContext("min", min),
+			fault.WithContext("max", max),
+		)
+	}
+	return RangedInt[T]{current: current, min: min, max: max}, nil
+}
+
+// Current returns the current value.
+func (r RangedInt[T]) Current() T {
+	return r.current
+}
+
+// Min returns the minimum allowed value.
+func (r RangedInt[T]) Min() T {
+	return r.min
+}
+
+// Max returns the maximum allowed value.
+func (r RangedInt[T]) Max() T {
+	return r.max
+}
+
+// IsAtMin returns true if the current value is equal to the minimum value.
+func (r RangedInt[T]) IsAtMin() bool {
+	return r.current == r.min
+}
+
+// IsAtMax returns true if the current value is equal to the maximum value.
+func (r RangedInt[T]) IsAtMax() bool {
+	return r.current == r.max
+}
+
+// IsZero returns true if the RangedInt is the zero value.
+func (r RangedInt[T]) IsZero() bool {
+	return r == RangedInt[T]{}
+}
+
+// Add returns a new RangedInt with the amount added to the current value.
+// A negative amount subtracts. It returns ErrValueExceedsMax if the operation would exceed
+// the max value, or ErrValueSubceedsMin if it would fall below the min value.
+func (r RangedInt[T]) Add(amount T) (RangedInt[T], error) {
+	result := r.current + amount
+	if amount < 0 {
+		if result > r.current || result < r.min {
+			return RangedInt[T]{}, ErrValueSubceedsMin
+		}
+	} else if result < r.current || result > r.max {
+		return RangedInt[T]{}, ErrValueExceedsMax
+	}
+	return RangedInt[T]{current: result, min: r.min, max: r.max}, nil
+}
+
+// Subtract returns a new RangedInt with the amount subtracted from the current value.
+// A negative amount adds. It returns ErrValueSubceedsMin if the operation would fall below
+// the min value, or ErrValueExceedsMax if it would exceed the max value.
+func (r RangedInt[T]) Subtract(amount T) (RangedInt[T], error) {
+	result := r.current - amount
+	if amount < 0 {
+		if result < r.current || result > r.max {
+			return RangedInt[T]{}, ErrValueExceedsMax
+		}
+	} else if result > r.current || result < r.min {
+		return RangedInt[T]{}, ErrValueSubceedsMin
+	}
+	return RangedInt[T]{current: result, min: r.min, max: r.max}, nil
+}
+
+// Set returns a new RangedInt with the current value set to a new value.
+// It returns an error if the new value is outside the allowed [min, max] range.
+func (r RangedInt[T]) Set(newValue T) (RangedInt[T], error) {
+	return NewRangedInt(newValue, r.min, r.max)
+}
+
+// String returns the value with its range, like "3 [1, 5]".
+func (r RangedInt[T]) String() string {
+	return fmt.Sprintf("%d [%d, %d]", r.current, r.min, r.max)
+}
+
+// rangedIntJSON is the JSON representation of a RangedInt.
+type rangedIntJSON[T Integer] struct {
+	Current T `json:"current"`
+	Min     T `json:"min"`
+	Max     T `json:"max"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the RangedInt to a JSON object with "current", "min", and "max" fields.
+func (r RangedInt[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(rangedIntJSON[T]{Current: r.current, Min: r.min, Max: r.max})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object into a RangedInt, with validation; null results in the zero value.
+func (r *RangedInt[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*r = RangedInt[T]{}
+		return nil
+	}
+
+	var dto rangedIntJSON[T]
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON for RangedInt", fault.WithCode(fault.Invalid))
+	}
+	parsed, err := NewRangedInt(dto.Current, dto.Min, dto.Max)
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the RangedInt as a JSON string or nil if it's the zero value.
+func (r RangedInt[T]) Value() (driver.Value, error) {
+	if r.IsZero() {
+		return nil, nil
+	}
+
+	data, err := r.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal ranged int for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as RangedInt.
+func (r *RangedInt[T]) Scan(src interface{}) error {
+	if src == nil {
+		*r = RangedInt[T]{}
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for RangedInt",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return r.UnmarshalJSON(data)
+}
@@ -0,0 +1,178 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type RangedIntSuite struct {
+	suite.Suite
+}
+
+func TestRangedIntSuite(t *testing.T) {
+	suite.Run(t, new(RangedIntSuite))
+}
+
+func (s *RangedIntSuite) TestNewRangedInt() {
+	s.Run("should create a valid ranged int keeping its type", func() {
+		priority, err := wisp.NewRangedInt[uint8](3, 1, 5)
+		s.Require().NoError(err)
+		var current uint8 = priority.Current()
+		s.Equal(uint8(3), current)
+		s.Equal(uint8(1), priority.Min())
+		s.Equal(uint8(5), priority.Max())
+		s.Equal("3 [1, 5]", priority.String())
+	})
+
+	s.Run("should accept values at the boundaries", func() {
+		r, err := wisp.NewRangedInt(0, 0, 100)
+		s.Require().NoError(err)
+		s.True(r.IsAtMin())
+
+		r, err = wisp.NewRangedInt(100, 0, 100)
+		s.Require().NoError(err)
+		s.True(r.IsAtMax())
+	})
+
+	s.Run("should fail if current is outside the range", func() {
+		_, err := wisp.NewRangedInt(6, 1, 5)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+		_, err = wisp.NewRangedInt(-1, 0, 5)
+		s.Require().Error(err)
+	})
+
+	s.Run("should fail if min is greater than max", func() {
+		_, err := wisp.NewRangedInt(3, 5, 1)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *RangedIntSuite) TestRangedInt_AddSubtract() {
+	r, _ := wisp.NewRangedInt[int16](3, 1, 5)
+
+	s.Run("should add and subtract within the range", func() {
+		added, err := r.Add(2)
+		s.Require().NoError(err)
+		s.Equal(int16(5), added.Current())
+
+		subtracted, err := r.Subtract(2)
+		s.Require().NoError(err)
+		s.Equal(int16(1), subtracted.Current())
+		s.Equal(int16(3), r.Current(), "original should be unchanged")
+	})
+
+	s.Run("should treat negative amounts as the opposite operation", func() {
+		added, err := r.Add(-1)
+		s.Require().NoError(err)
+		s.Equal(int16(2), added.Current())
+
+		subtracted, err := r.Subtract(-1)
+		s.Require().NoError(err)
+		s.Equal(int16(4), subtracted.Current())
+	})
+
+	s.Run("should fail when leaving the range", func() {
+		_, err := r.Add(3)
+		s.ErrorIs(err, wisp.ErrValueExceedsMax)
+
+		_, err = r.Subtract(3)
+		s.ErrorIs(err, wisp.ErrValueSubceedsMin)
+
+		_, err = r.Add(-3)
+		s.ErrorIs(err, wisp.ErrValueSubceedsMin)
+	})
+
+	s.Run("should not wrap around on overflow", func() {
+		u, _ := wisp.NewRangedInt[uint8](250, 0, 255)
+		_, err := u.Add(10)
+		s.ErrorIs(err, wisp.ErrValueExceedsMax)
+
+		low, _ := wisp.NewRangedInt[uint8](2, 0, 255)
+		_, err = low.Subtract(3)
+		s.ErrorIs(err, wisp.ErrValueSubceedsMin)
+
+		wide, _ := wisp.NewRangedInt[int64](0, math.MinInt64, math.MaxInt64)
+		_, err = wide.Add(math.MinInt64)
+		s.Require().NoError(err)
+		_, err = wide.Subtract(math.MinInt64)
+		s.ErrorIs(err, wisp.ErrValueExceedsMax)
+	})
+}
+
+func (s *RangedIntSuite) TestRangedInt_Set() {
+	r, _ := wisp.NewRangedInt(50, 0, 100)
+
+	updated, err := r.Set(80)
+	s.Require().NoError(err)
+	s.Equal(80, updated.Current())
+
+	_, err = r.Set(101)
+	s.Require().Error(err)
+}
+
+func (s *RangedIntSuite) TestRangedInt_JSON() {
+	s.Run("should marshal and unmarshal", func() {
+		r, _ := wisp.NewRangedInt[uint8](3, 1, 5)
+		data, err := json.Marshal(r)
+		s.Require().NoError(err)
+		s.JSONEq(`{"current":3,"min":1,"max":5}`, string(data))
+
+		var decoded wisp.RangedInt[uint8]
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(r, decoded)
+	})
+
+	s.Run("should validate on unmarshal", func() {
+		var r wisp.RangedInt[int]
+		s.Error(json.Unmarshal([]byte(`{"current":9,"min":1,"max":5}`), &r))
+		s.Error(json.Unmarshal([]byte(`{"current":"3"}`), &r))
+
+		var u wisp.RangedInt[uint8]
+		s.Error(json.Unmarshal([]byte(`{"current":300,"min":0,"max":255}`), &u))
+	})
+
+	s.Run("should unmarshal null to the zero value", func() {
+		r, _ := wisp.NewRangedInt(3, 1, 5)
+		s.Require().NoError(json.Unmarshal([]byte("null"), &r))
+		s.True(r.IsZero())
+	})
+}
+
+func (s *RangedIntSuite) TestRangedInt_SQL() {
+	s.Run("should round trip through Value and Scan", func() {
+		r, _ := wisp.NewRangedInt(3, 1, 5)
+		val, err := r.Value()
+		s.Require().NoError(err)
+		s.JSONEq(`{"current":3,"min":1,"max":5}`, val.(string))
+
+		var scanned wisp.RangedInt[int]
+		s.Require().NoError(scanned.Scan([]byte(val.(string))))
+		s.Equal(r, scanned)
+	})
+
+	s.Run("should handle zero and nil", func() {
+		val, err := wisp.RangedInt[int]{}.Value()
+		s.Require().NoError(err)
+		s.Nil(val)
+
+		var r wisp.RangedInt[int]
+		s.Require().NoError(r.Scan(nil))
+		s.True(r.IsZero())
+	})
+
+	s.Run("should fail on unsupported type", func() {
+		var r wisp.RangedInt[int]
+		err := r.Scan(42)
+		s.Require().Error(err)
+		s.Equal("int", err.(*fault.Error).Context["received_type"])
+	})
+}
@@ -0,0 +1,43 @@
+package wisp
+
+// Region represents one of the five geographic regions of Brazil, as defined by IBGE.
+type Region string
+
+// Defines the Brazilian geographic regions.
+const (
+	RegionNorte       Region = "NORTE"
+	RegionNordeste    Region = "NORDESTE"
+	RegionCentroOeste Region = "CENTRO_OESTE"
+	RegionSudeste     Region = "SUDESTE"
+	RegionSul         Region = "SUL"
+)
+
+// regionNames holds the display names of the regions.
+var regionNames = map[Region]string{
+	RegionNorte:       "Norte",
+	RegionNordeste:    "Nordeste",
+	RegionCentroOeste: "Centro-Oeste",
+	RegionSudeste:     "Sudeste",
+	RegionSul:         "Sul",
+}
+
+// String returns the region code (e.g., "CENTRO_OESTE").
+func (r Region) String() string {
+	return string(r)
+}
+
+// Name returns the display name of the region (e.g., "Centro-Oeste"), or an empty string if it is not valid.
+func (r Region) Name() string {
+	return regionNames[r]
+}
+
+// IsValid checks if the region is one of the five Brazilian regions.
+func (r Region) IsValid() bool {
+	_, ok := regionNames[r]
+	return ok
+}
+
+// IsZero returns true if the Region is the zero value.
+func (r Region) IsZero() bool {
+	return r == ""
+}
@@ -0,0 +1,349 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	mrand "math/rand/v2"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// BackoffStrategy defines how the delay between retries grows.
+type BackoffStrategy string
+
+// Defines the supported backoff strategies.
+const (
+	BackoffConstant    BackoffStrategy = "constant"    // base, base, base, ...
+	BackoffLinear      BackoffStrategy = "linear"      // base, 2*base, 3*base, ...
+	BackoffExponential BackoffStrategy = "exponential" // base, 2*base, 4*base, ...
+)
+
+// IsValid checks if the strategy is one of the supported backoff strategies.
+func (b BackoffStrategy) IsValid() bool {
+	switch b {
+	case BackoffConstant, BackoffLinear, BackoffExponential:
+		return true
+	default:
+		return false
+	}
+}
+
+// RetryPolicy is a value object describing how a failed operation is retried, such as the
+// delivery settings of a webhook or an integration stored on an entity. It combines the maximum
+// number of attempts, a backoff strategy from a base delay, an optional cap for each delay, an
+// optional jitter and an optional limit for the total time spent retrying.
+//
+// Attempts are numbered from 1, the first call included: a policy with 3 attempts retries twice.
+//
+// The zero value is ZeroRetryPolicy, which never retries.
+//
+// Example:
+//   policy, _ := wisp.NewRetryPolicy(5, wisp.BackoffExponential, time.Second)
+//   policy, _ = policy.WithMaxDelay(30 * time.Second)
+//   policy, _ = policy.WithJitter(0.2)
+//   if delay, ok := policy.NextDelay(attempt); ok {
+//       time.Sleep(delay) // ~1s, 2s, 4s, 8s, each reduced by up to 20%
+//   }
+type RetryPolicy struct {
+	maxAttempts int
+	strategy    BackoffStrategy
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	jitter      float64
+	maxElapsed  time.Duration
+}
+
+// ZeroRetryPolicy represents the zero value for RetryPolicy, which never retries.
+var ZeroRetryPolicy RetryPolicy
+
+// DefaultRetryPolicy makes 5 attempts with exponential backoff from 1 second, capped at
+// 30 seconds per delay and 20% jitter.
+var DefaultRetryPolicy = RetryPolicy{
+	maxAttempts: 5,
+	strategy:    BackoffExponential,
+	baseDelay:   time.Second,
+	maxDelay:    30 * time.Second,
+	jitter:      0.2,
+}
+
+// NewRetryPolicy creates a new RetryPolicy without delay cap, jitter or elapsed time limit.
+// It returns an error if maxAttempts is less than 1, the strategy is unknown or the base delay
+// is negative.
+func NewRetryPolicy(maxAttempts int, strategy BackoffStrategy, baseDelay time.Duration) (RetryPolicy, error) {
+	if maxAttempts < 1 {
+		return ZeroRetryPolicy, fault.New(
+			"retry policy must allow at least one attempt",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("max_attempts", maxAttempts),
+		)
+	}
+	if !strategy.IsValid() {
+		return ZeroRetryPolicy, fault.New(
+			"invalid backoff strategy",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("strategy", strategy),
+		)
+	}
+	if baseDelay < 0 {
+		return ZeroRetryPolicy, fault.New(
+			"retry base delay cannot be negative",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("base_delay", baseDelay.String()),
+		)
+	}
+	return RetryPolicy{maxAttempts: maxAttempts, strategy: strategy, baseDelay: baseDelay}, nil
+}
+
+// WithMaxDelay returns a copy of the policy where no single delay exceeds maxDelay.
+// Zero removes the cap. Returns an error if maxDelay is negative or less than the base delay.
+func (p RetryPolicy) WithMaxDelay(maxDelay time.Duration) (RetryPolicy, error) {
+	if maxDelay < 0 || (maxDelay > 0 && maxDelay < p.baseDelay) {
+		return ZeroRetryPolicy, fault.New(
+			"retry max delay must not be less than the base delay",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("max_delay", maxDelay.String()),
+			fault.WithContext("base_delay", p.baseDelay.String()),
+		)
+	}
+	p.maxDelay = maxDelay
+	return p, nil
+}
+
+// WithJitter returns a copy of the policy where each delay is randomly reduced by up to the given
+// fraction (0 to 1), so clients failing together do not retry together. Zero removes the jitter.
+func (p RetryPolicy) WithJitter(fraction float64) (RetryPolicy, error) {
+	if !(fraction >= 0 && fraction <= 1) {
+		return ZeroRetryPolicy, fault.New(
+			"retry jitter must be between 0 and 1",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("jitter", fraction),
+		)
+	}
+	p.jitter = fraction
+	return p, nil
+}
+
+// WithMaxElapsed returns a copy of the policy that stops retrying once maxElapsed has passed since
+// the first attempt. Zero removes the limit. Returns an error if maxElapsed is negative.
+func (p RetryPolicy) WithMaxElapsed(maxElapsed time.Duration) (RetryPolicy, error) {
+	if maxElapsed < 0 {
+		return ZeroRetryPolicy, fault.New(
+			"retry max elapsed time cannot be negative",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("max_elapsed", maxElapsed.String()),
+		)
+	}
+	p.maxElapsed = maxElapsed
+	return p, nil
+}
+
+// MaxAttempts returns the maximum number of attempts, the first call included.
+func (p RetryPolicy) MaxAttempts() int {
+	return p.maxAttempts
+}
+
+// Strategy returns the backoff strategy.
+func (p RetryPolicy) Strategy() BackoffStrategy {
+	return p.strategy
+}
+
+// BaseDelay returns the delay before the first retry.
+func (p RetryPolicy) BaseDelay() time.Duration {
+	return p.baseDelay
+}
+
+// MaxDelay returns the cap for each delay, or zero if uncapped.
+func (p RetryPolicy) MaxDelay() time.Duration {
+	return p.maxDelay
+}
+
+// Jitter returns the maximum fraction randomly removed from each delay.
+func (p RetryPolicy) Jitter() float64 {
+	return p.jitter
+}
+
+// MaxElapsed returns the limit for the total time spent retrying, or zero if unlimited.
+func (p RetryPolicy) MaxElapsed() time.Duration {
+	return p.maxElapsed
+}
+
+// IsZero returns true if the RetryPolicy is the zero value.
+func (p RetryPolicy) IsZero() bool {
+	return p == ZeroRetryPolicy
+}
+
+// Delay returns the delay before retrying after the given failed attempt, following the backoff
+// strategy and the cap, without jitter. Returns zero for attempts less than 1.
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	if attempt < 1 {
+		return 0
+	}
+
+	var delay float64
+	base := float64(p.baseDelay)
+	switch p.strategy {
+	case BackoffLinear:
+		delay = base * float64(attempt)
+	case BackoffExponential:
+		delay = base * math.Pow(2, float64(attempt-1))
+	default:
+		delay = base
+	}
+
+	if p.maxDelay > 0 && delay > float64(p.maxDelay) {
+		return p.maxDelay
+	}
+	if delay >= math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(delay)
+}
+
+// NextDelay returns the delay before retrying after the given failed attempt, with jitter applied,
+// and true; or zero and false if the attempt was the last one allowed.
+func (p RetryPolicy) NextDelay(attempt int) (time.Duration, bool) {
+	if attempt < 1 || attempt >= p.maxAttempts {
+		return 0, false
+	}
+
+	delay := p.Delay(attempt)
+	if p.jitter > 0 {
+		delay -= time.Duration(float64(delay) * p.jitter * mrand.Float64())
+	}
+	return delay, true
+}
+
+// ShouldRetry checks if another attempt is allowed after the given failed attempt, when elapsed
+// time has passed since the first one.
+func (p RetryPolicy) ShouldRetry(attempt int, elapsed time.Duration) bool {
+	if attempt < 1 || attempt >= p.maxAttempts {
+		return false
+	}
+	return p.maxElapsed == 0 || elapsed < p.maxElapsed
+}
+
+// retryPolicyJSON is the JSON representation of a RetryPolicy, with durations in the
+// time.Duration format (e.g., "1m30s").
+type retryPolicyJSON struct {
+	MaxAttempts int             `json:"max_attempts"`
+	Strategy    BackoffStrategy `json:"strategy"`
+	BaseDelay   string          `json:"base_delay"`
+	MaxDelay    string          `json:"max_delay,omitempty"`
+	Jitter      float64         `json:"jitter,omitempty"`
+	MaxElapsed  string          `json:"max_elapsed,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the RetryPolicy as an object such as
+// {"max_attempts":5,"strategy":"exponential","base_delay":"1s","max_delay":"30s","jitter":0.2},
+// or null for the zero value.
+func (p RetryPolicy) MarshalJSON() ([]byte, error) {
+	if p.IsZero() {
+		return []byte("null"), nil
+	}
+
+	dto := retryPolicyJSON{
+		MaxAttempts: p.maxAttempts,
+		Strategy:    p.strategy,
+		BaseDelay:   p.baseDelay.String(),
+		Jitter:      p.jitter,
+	}
+	if p.maxDelay > 0 {
+		dto.MaxDelay = p.maxDelay.String()
+	}
+	if p.maxElapsed > 0 {
+		dto.MaxElapsed = p.maxElapsed.String()
+	}
+	return json.Marshal(dto)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object into a RetryPolicy, with validation; null results in ZeroRetryPolicy.
+func (p *RetryPolicy) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*p = ZeroRetryPolicy
+		return nil
+	}
+
+	var dto retryPolicyJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for RetryPolicy", fault.WithCode(fault.Invalid))
+	}
+
+	var durations [3]time.Duration
+	for i, s := range []string{dto.BaseDelay, dto.MaxDelay, dto.MaxElapsed} {
+		if s == "" {
+			continue
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fault.Wrap(err,
+				"retry policy durations must use the time.Duration format (e.g., 1m30s)",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("input_value", s),
+			)
+		}
+		durations[i] = d
+	}
+
+	policy, err := NewRetryPolicy(dto.MaxAttempts, dto.Strategy, durations[0])
+	if err != nil {
+		return err
+	}
+	if policy, err = policy.WithMaxDelay(durations[1]); err != nil {
+		return err
+	}
+	if policy, err = policy.WithJitter(dto.Jitter); err != nil {
+		return err
+	}
+	if policy, err = policy.WithMaxElapsed(durations[2]); err != nil {
+		return err
+	}
+	*p = policy
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the RetryPolicy as a JSON string, or nil for the zero value.
+func (p RetryPolicy) Value() (driver.Value, error) {
+	if p.IsZero() {
+		return nil, nil
+	}
+
+	data, err := p.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal retry policy for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as a RetryPolicy.
+func (p *RetryPolicy) Scan(src interface{}) error {
+	if src == nil {
+		*p = ZeroRetryPolicy
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for RetryPolicy",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return p.UnmarshalJSON(data)
+}
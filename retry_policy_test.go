@@ -0,0 +1,209 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type RetryPolicySuite struct {
+	suite.Suite
+}
+
+func TestRetryPolicySuite(t *testing.T) {
+	suite.Run(t, new(RetryPolicySuite))
+}
+
+func (s *RetryPolicySuite) TestNewRetryPolicy() {
+	s.Run("should create a valid policy", func() {
+		p, err := wisp.NewRetryPolicy(3, wisp.BackoffLinear, 2*time.Second)
+		s.Require().NoError(err)
+		s.Equal(3, p.MaxAttempts())
+		s.Equal(wisp.BackoffLinear, p.Strategy())
+		s.Equal(2*time.Second, p.BaseDelay())
+		s.Zero(p.MaxDelay())
+		s.Zero(p.Jitter())
+		s.Zero(p.MaxElapsed())
+		s.False(p.IsZero())
+	})
+
+	s.Run("should reject invalid arguments", func() {
+		testCases := []struct {
+			name     string
+			attempts int
+			strategy wisp.BackoffStrategy
+			base     time.Duration
+		}{
+			{name: "no attempts", attempts: 0, strategy: wisp.BackoffConstant, base: time.Second},
+			{name: "unknown strategy", attempts: 3, strategy: "fibonacci", base: time.Second},
+			{name: "negative base delay", attempts: 3, strategy: wisp.BackoffConstant, base: -time.Second},
+		}
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				p, err := wisp.NewRetryPolicy(tc.attempts, tc.strategy, tc.base)
+				s.Require().Error(err)
+				s.True(p.IsZero())
+				s.Equal(fault.Invalid, err.(*fault.Error).Code)
+			})
+		}
+	})
+
+	s.Run("should validate the optional settings", func() {
+		p, _ := wisp.NewRetryPolicy(3, wisp.BackoffExponential, time.Second)
+
+		_, err := p.WithMaxDelay(500 * time.Millisecond)
+		s.Error(err)
+		_, err = p.WithMaxDelay(-time.Second)
+		s.Error(err)
+		_, err = p.WithJitter(1.5)
+		s.Error(err)
+		_, err = p.WithJitter(math.NaN())
+		s.Error(err)
+		_, err = p.WithMaxElapsed(-time.Minute)
+		s.Error(err)
+
+		p, err = p.WithMaxDelay(10 * time.Second)
+		s.Require().NoError(err)
+		p, err = p.WithJitter(0.5)
+		s.Require().NoError(err)
+		p, err = p.WithMaxElapsed(time.Minute)
+		s.Require().NoError(err)
+		s.Equal(10*time.Second, p.MaxDelay())
+		s.Equal(0.5, p.Jitter())
+		s.Equal(time.Minute, p.MaxElapsed())
+	})
+}
+
+func (s *RetryPolicySuite) TestDelay() {
+	constant, _ := wisp.NewRetryPolicy(10, wisp.BackoffConstant, time.Second)
+	linear, _ := wisp.NewRetryPolicy(10, wisp.BackoffLinear, time.Second)
+	exponential, _ := wisp.NewRetryPolicy(10, wisp.BackoffExponential, time.Second)
+	capped, _ := exponential.WithMaxDelay(5 * time.Second)
+
+	for attempt, expected := range map[int][4]time.Duration{
+		1: {time.Second, time.Second, time.Second, time.Second},
+		2: {time.Second, 2 * time.Second, 2 * time.Second, 2 * time.Second},
+		3: {time.Second, 3 * time.Second, 4 * time.Second, 4 * time.Second},
+		4: {time.Second, 4 * time.Second, 8 * time.Second, 5 * time.Second},
+	} {
+		s.Equal(expected[0], constant.Delay(attempt))
+		s.Equal(expected[1], linear.Delay(attempt))
+		s.Equal(expected[2], exponential.Delay(attempt))
+		s.Equal(expected[3], capped.Delay(attempt))
+	}
+
+	s.Zero(exponential.Delay(0))
+	s.Equal(time.Duration(math.MaxInt64), exponential.Delay(200))
+	s.Equal(5*time.Second, capped.Delay(200))
+}
+
+func (s *RetryPolicySuite) TestNextDelay() {
+	s.Run("should stop after the last attempt", func() {
+		p, _ := wisp.NewRetryPolicy(3, wisp.BackoffExponential, time.Second)
+
+		d, ok := p.NextDelay(1)
+		s.True(ok)
+		s.Equal(time.Second, d)
+		d, ok = p.NextDelay(2)
+		s.True(ok)
+		s.Equal(2*time.Second, d)
+		_, ok = p.NextDelay(3)
+		s.False(ok)
+		_, ok = p.NextDelay(0)
+		s.False(ok)
+		_, ok = wisp.ZeroRetryPolicy.NextDelay(1)
+		s.False(ok)
+	})
+
+	s.Run("should reduce the delay by up to the jitter", func() {
+		p, _ := wisp.NewRetryPolicy(5, wisp.BackoffConstant, 10*time.Second)
+		p, _ = p.WithJitter(0.2)
+		for range 100 {
+			d, ok := p.NextDelay(1)
+			s.True(ok)
+			s.GreaterOrEqual(d, 8*time.Second)
+			s.LessOrEqual(d, 10*time.Second)
+		}
+	})
+}
+
+func (s *RetryPolicySuite) TestShouldRetry() {
+	p, _ := wisp.NewRetryPolicy(4, wisp.BackoffConstant, time.Second)
+	s.True(p.ShouldRetry(1, time.Hour))
+	s.True(p.ShouldRetry(3, 0))
+	s.False(p.ShouldRetry(4, 0))
+
+	p, _ = p.WithMaxElapsed(time.Minute)
+	s.True(p.ShouldRetry(1, 59*time.Second))
+	s.False(p.ShouldRetry(1, time.Minute))
+	s.False(wisp.ZeroRetryPolicy.ShouldRetry(1, 0))
+}
+
+func (s *RetryPolicySuite) TestJSON_SQL() {
+	p, _ := wisp.NewRetryPolicy(5, wisp.BackoffExponential, 500*time.Millisecond)
+	p, _ = p.WithMaxDelay(30 * time.Second)
+	p, _ = p.WithJitter(0.25)
+	p, _ = p.WithMaxElapsed(5 * time.Minute)
+
+	s.Run("should round-trip through JSON", func() {
+		data, err := json.Marshal(p)
+		s.Require().NoError(err)
+		s.JSONEq(`{"max_attempts":5,"strategy":"exponential","base_delay":"500ms","max_delay":"30s","jitter":0.25,"max_elapsed":"5m0s"}`, string(data))
+
+		var decoded wisp.RetryPolicy
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(p, decoded)
+	})
+
+	s.Run("should omit unset settings", func() {
+		simple, _ := wisp.NewRetryPolicy(2, wisp.BackoffConstant, time.Second)
+		data, err := json.Marshal(struct {
+			Policy wisp.RetryPolicy `json:"policy"`
+			Empty  wisp.RetryPolicy `json:"empty"`
+		}{Policy: simple})
+		s.Require().NoError(err)
+		s.JSONEq(`{"policy":{"max_attempts":2,"strategy":"constant","base_delay":"1s"},"empty":null}`, string(data))
+	})
+
+	s.Run("should validate when decoding", func() {
+		var decoded wisp.RetryPolicy
+		s.Error(json.Unmarshal([]byte(`{"max_attempts":0,"strategy":"constant","base_delay":"1s"}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`{"max_attempts":3,"strategy":"constant","base_delay":"soon"}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`{"max_attempts":3,"strategy":"constant","base_delay":"1s","jitter":2}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`[]`), &decoded))
+		s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+		s.True(decoded.IsZero())
+	})
+
+	s.Run("should be stored as JSON", func() {
+		v, err := p.Value()
+		s.Require().NoError(err)
+
+		var scanned wisp.RetryPolicy
+		s.Require().NoError(scanned.Scan([]byte(v.(string))))
+		s.Equal(p, scanned)
+
+		v, err = wisp.ZeroRetryPolicy.Value()
+		s.Require().NoError(err)
+		s.Nil(v)
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		err = scanned.Scan(42)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *RetryPolicySuite) TestDefaultRetryPolicy() {
+	p := wisp.DefaultRetryPolicy
+	s.Equal(5, p.MaxAttempts())
+	s.Equal(wisp.BackoffExponential, p.Strategy())
+	s.Equal(30*time.Second, p.Delay(10))
+}
@@ -0,0 +1,261 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// RG number lengths accepted by default, after removing punctuation.
+const (
+	minRGLength = 4
+	maxRGLength = 14
+)
+
+// RGValidator checks the number of an RG issued by a specific state.
+// It receives the number without punctuation, uppercase, and returns an error if it is invalid.
+type RGValidator func(number string) error
+
+// defaultRGValidators returns the rules of the states supported by default.
+func defaultRGValidators() map[UF]RGValidator {
+	return map[UF]RGValidator{
+		"SP": validateSPRG,
+		"RJ": validateRJRG,
+	}
+}
+
+// rgValidators holds the number rules by issuing state.
+var rgValidators = defaultRGValidators()
+
+// RegisterRGValidator sets the number rule of a state, replacing any existing one.
+// Passing a nil validator removes the rule, so only the generic format is checked.
+// São Paulo (check digit) and Rio de Janeiro (9 digits) have rules by default.
+func RegisterRGValidator(uf UF, validator RGValidator) {
+	if validator == nil {
+		delete(rgValidators, uf)
+		return
+	}
+	rgValidators[uf] = validator
+}
+
+// ResetRGValidators restores the default RG rules.
+// This is primarily for testing purposes to ensure a clean state.
+func ResetRGValidators() {
+	rgValidators = defaultRGValidators()
+}
+
+// RG represents a Brazilian identity card number (Registro Geral) together with the state that
+// issued it. There is no national format: each state's identification institute numbers its own
+// cards, so the same number may exist in two states and the state is part of the value.
+//
+// The number is stored without punctuation, uppercase. Every number must have 4 to 14 digits,
+// the last of which may be an "X" check digit; states with a registered RGValidator apply their
+// own rules as well:
+//   - SP: 8 digits and a modulo 11 check digit ("X" for 10), shorter numbers are zero-padded
+//   - RJ: 9 digits
+//
+// Examples:
+//   - Input: "24.678.131-2", "SP"
+//   - Storage: "246781312" (SP)
+//   - Formatted output: "24.678.131-2"
+type RG struct {
+	number string
+	uf     UF
+}
+
+// ZeroRG represents the zero value for RG type.
+var ZeroRG RG
+
+// NewRG creates a new RG from the number and the issuing state.
+// It accepts the number with or without dots, dashes and spaces, and validates it.
+// Returns ZeroRG if the number is empty, or an error if the state is empty or invalid, or the
+// number does not follow the generic format or the rule of its state.
+//
+// Examples:
+//   rg, err := NewRG("24.678.131-2", "SP")  // Valid
+//   rg, err := NewRG("12.345.671-X", "sp")  // Valid, "X" check digit
+//   rg, err := NewRG("24.678.131-0", "SP")  // Error: invalid check digit
+func NewRG(number string, uf UF) (RG, error) {
+	normalized := strings.ToUpper(documentSeparators.Replace(strings.TrimSpace(number)))
+	if normalized == "" {
+		return ZeroRG, nil
+	}
+
+	state, err := NewUF(uf.String())
+	if err != nil {
+		return ZeroRG, err
+	}
+	if state.IsZero() {
+		return ZeroRG, fault.New("RG issuing state is required", fault.WithCode(fault.Invalid))
+	}
+
+	if len(normalized) < minRGLength || len(normalized) > maxRGLength || !isRGNumber(normalized) {
+		return ZeroRG, fault.New(
+			"RG must have 4 to 14 digits, the last of which may be X",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input", number),
+		)
+	}
+
+	if state == "SP" && len(normalized) < 9 {
+		normalized = strings.Repeat("0", 9-len(normalized)) + normalized
+	}
+	if validator, ok := rgValidators[state]; ok {
+		if err := validator(normalized); err != nil {
+			return ZeroRG, err
+		}
+	}
+	return RG{number: normalized, uf: state}, nil
+}
+
+// isRGNumber reports whether s has only digits, except for an optional final "X".
+func isRGNumber(s string) bool {
+	return isASCIIDigits(s[:len(s)-1]) && (isDigit(s[len(s)-1]) || s[len(s)-1] == 'X')
+}
+
+// validateSPRG checks the modulo 11 check digit of an RG issued in São Paulo: the 8 digits are
+// weighted 2 to 9, and the digit is 11 minus the remainder, "X" for 10 and 0 for 11.
+func validateSPRG(number string) error {
+	if len(number) != 9 || !isASCIIDigits(number[:8]) {
+		return fault.New("RG from SP must have 8 digits and a check digit", fault.WithCode(fault.Invalid), fault.WithContext("input", number))
+	}
+
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += int(number[i]-'0') * (i + 2)
+	}
+	var expected byte
+	switch dv := 11 - sum%11; dv {
+	case 10:
+		expected = 'X'
+	case 11:
+		expected = '0'
+	default:
+		expected = byte('0' + dv)
+	}
+
+	if number[8] != expected {
+		return fault.New("invalid RG check digit", fault.WithCode(fault.Invalid), fault.WithContext("input", number))
+	}
+	return nil
+}
+
+// validateRJRG checks the length of an RG issued in Rio de Janeiro.
+func validateRJRG(number string) error {
+	if len(number) != 9 || !isASCIIDigits(number) {
+		return fault.New("RG from RJ must have 9 digits", fault.WithCode(fault.Invalid), fault.WithContext("input", number))
+	}
+	return nil
+}
+
+// Number returns the RG number without formatting.
+func (r RG) Number() string {
+	return r.number
+}
+
+// UF returns the state that issued the RG.
+func (r RG) UF() UF {
+	return r.uf
+}
+
+// String returns the RG number without formatting.
+// For formatted output, use Formatted() method instead.
+func (r RG) String() string {
+	return r.number
+}
+
+// IsZero returns true if the RG is the zero value (ZeroRG).
+func (r RG) IsZero() bool {
+	return r == ZeroRG
+}
+
+// Formatted returns the RG in the format used by its state: XX.XXX.XXX-X for the 9-character
+// numbers of SP and RJ, and the unformatted number for other states.
+func (r RG) Formatted() string {
+	if len(r.number) != 9 || (r.uf != "SP" && r.uf != "RJ") {
+		return r.number
+	}
+	return fmt.Sprintf("%s.%s.%s-%s", r.number[0:2], r.number[2:5], r.number[5:8], r.number[8:9])
+}
+
+// Masked returns the RG with only its last three characters visible, followed by the state
+// ("******312 SP"), so it can be logged or displayed under LGPD. Returns an empty string for ZeroRG.
+func (r RG) Masked() string {
+	if r.IsZero() {
+		return ""
+	}
+	return strings.Repeat("*", len(r.number)-3) + r.number[len(r.number)-3:] + " " + r.uf.String()
+}
+
+// rgJSON is the JSON representation of an RG.
+type rgJSON struct {
+	Number string `json:"number"`
+	UF     UF     `json:"uf"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the RG as a JSON object with "number" and "uf" fields, or null for ZeroRG.
+func (r RG) MarshalJSON() ([]byte, error) {
+	if r.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(rgJSON{Number: r.number, UF: r.uf})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object into an RG, performing full validation.
+func (r *RG) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*r = ZeroRG
+		return nil
+	}
+
+	var dto rgJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for RG", fault.WithCode(fault.Invalid))
+	}
+	rg, err := NewRG(dto.Number, dto.UF)
+	if err != nil {
+		return err
+	}
+	*r = rg
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the RG as a JSON string or nil if zero value.
+func (r RG) Value() (driver.Value, error) {
+	if r.IsZero() {
+		return nil, nil
+	}
+
+	data, err := r.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err, "failed to marshal RG for database storage", fault.WithCode(fault.Internal))
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as RG.
+func (r *RG) Scan(src interface{}) error {
+	if src == nil {
+		*r = ZeroRG
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New("unsupported scan type for RG", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+
+	return r.UnmarshalJSON(data)
+}
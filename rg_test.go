@@ -0,0 +1,154 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type RGSuite struct {
+	suite.Suite
+}
+
+func TestRGSuite(t *testing.T) {
+	suite.Run(t, new(RGSuite))
+}
+
+func (s *RGSuite) TearDownTest() {
+	wisp.ResetRGValidators()
+}
+
+func (s *RGSuite) TestNewRG() {
+	s.Run("should accept valid numbers for each state", func() {
+		testCases := []struct {
+			number    string
+			uf        wisp.UF
+			expected  string
+			formatted string
+		}{
+			{number: "24.678.131-2", uf: "SP", expected: "246781312", formatted: "24.678.131-2"},
+			{number: "12.345.671-x", uf: "sp", expected: "12345671X", formatted: "12.345.671-X"},
+			{number: "1.234.567-2", uf: "SP", expected: "012345672", formatted: "01.234.567-2"},
+			{number: "12.345.678-9", uf: "RJ", expected: "123456789", formatted: "12.345.678-9"},
+			{number: "1234567", uf: "MG", expected: "1234567", formatted: "1234567"},
+		}
+		for _, tc := range testCases {
+			rg, err := wisp.NewRG(tc.number, tc.uf)
+			s.Require().NoError(err, tc.number)
+			s.Equal(tc.expected, rg.Number())
+			s.Equal(tc.expected, rg.String())
+			s.Equal(tc.formatted, rg.Formatted())
+		}
+	})
+
+	s.Run("should normalize the state", func() {
+		rg, err := wisp.NewRG("24.678.131-2", " sp ")
+		s.Require().NoError(err)
+		s.Equal(wisp.UF("SP"), rg.UF())
+	})
+
+	s.Run("should return ZeroRG for an empty number", func() {
+		rg, err := wisp.NewRG("", "SP")
+		s.Require().NoError(err)
+		s.True(rg.IsZero())
+	})
+
+	s.Run("should reject invalid values", func() {
+		testCases := []struct {
+			number string
+			uf     wisp.UF
+		}{
+			{number: "24.678.131-0", uf: "SP"},
+			{number: "24.678.131-2", uf: ""},
+			{number: "24.678.131-2", uf: "XX"},
+			{number: "123", uf: "MG"},
+			{number: "123456789012345", uf: "MG"},
+			{number: "12X45", uf: "MG"},
+			{number: "MG 12.345.678", uf: "MG"},
+			{number: "1234567", uf: "RJ"},
+		}
+		for _, tc := range testCases {
+			_, err := wisp.NewRG(tc.number, tc.uf)
+			s.Require().Error(err, tc.number)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *RGSuite) TestValidators() {
+	s.Run("should apply registered rules", func() {
+		wisp.RegisterRGValidator("MG", func(number string) error {
+			if len(number) != 8 {
+				return fault.New("RG from MG must have 8 digits", fault.WithCode(fault.Invalid))
+			}
+			return nil
+		})
+		_, err := wisp.NewRG("1234567", "MG")
+		s.Error(err)
+		_, err = wisp.NewRG("12345678", "MG")
+		s.NoError(err)
+	})
+
+	s.Run("should remove a rule with a nil validator", func() {
+		wisp.RegisterRGValidator("RJ", nil)
+		_, err := wisp.NewRG("1234567", "RJ")
+		s.NoError(err)
+	})
+}
+
+func (s *RGSuite) TestMasked() {
+	rg, _ := wisp.NewRG("24.678.131-2", "SP")
+	s.Equal("******312 SP", rg.Masked())
+	s.Equal("", wisp.ZeroRG.Masked())
+}
+
+func (s *RGSuite) TestJSON() {
+	s.Run("should marshal and unmarshal", func() {
+		rg, _ := wisp.NewRG("24.678.131-2", "SP")
+		data, err := json.Marshal(rg)
+		s.Require().NoError(err)
+		s.JSONEq(`{"number":"246781312","uf":"SP"}`, string(data))
+
+		var decoded wisp.RG
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(rg, decoded)
+	})
+
+	s.Run("should handle null", func() {
+		data, err := json.Marshal(wisp.ZeroRG)
+		s.Require().NoError(err)
+		s.Equal("null", string(data))
+
+		var decoded wisp.RG
+		s.Require().NoError(json.Unmarshal([]byte("null"), &decoded))
+		s.True(decoded.IsZero())
+	})
+
+	s.Run("should validate on unmarshal", func() {
+		var decoded wisp.RG
+		s.Error(json.Unmarshal([]byte(`{"number":"246781310","uf":"SP"}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`{"number":"246781312"}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`"246781312"`), &decoded))
+	})
+}
+
+func (s *RGSuite) TestSQL() {
+	rg, _ := wisp.NewRG("12.345.678-9", "RJ")
+	value, err := rg.Value()
+	s.Require().NoError(err)
+
+	var scanned wisp.RG
+	s.Require().NoError(scanned.Scan([]byte(value.(string))))
+	s.Equal(rg, scanned)
+
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	value, err = scanned.Value()
+	s.Require().NoError(err)
+	s.Nil(value)
+	s.Error(scanned.Scan(1))
+}
@@ -27,6 +27,9 @@ type Role string
 // validRoles holds the global set of registered roles.
 var validRoles = make(map[Role]struct{})
 
+// roleHierarchy holds, for each role, the roles it directly implies (e.g., ADMIN implies MANAGER).
+var roleHierarchy = make(map[Role]map[Role]struct{})
+
 // EmptyRole represents the zero value for the Role type.
 var EmptyRole Role
 
@@ -66,6 +69,69 @@ func ClearRegisteredRoles() {
 	validRoles = make(map[Role]struct{})
 }
 
+// RegisterRoleHierarchy declares that the role higher implies each of the lower roles.
+// Implications are transitive: if ADMIN implies MANAGER and MANAGER implies USER, ADMIN also implies USER.
+// Calling it more than once merges the implications. Empty roles are ignored.
+// Like RegisterRoles, it should be called at application startup.
+//
+// Example:
+//   wisp.RegisterRoleHierarchy("ADMIN", "MANAGER")
+//   wisp.RegisterRoleHierarchy("MANAGER", "USER")
+func RegisterRoleHierarchy(higher Role, lower ...Role) {
+	higher = Role(strings.TrimSpace(string(higher)))
+	if higher == EmptyRole {
+		return
+	}
+
+	implied, ok := roleHierarchy[higher]
+	if !ok {
+		implied = make(map[Role]struct{})
+		roleHierarchy[higher] = implied
+	}
+
+	for _, l := range lower {
+		normalized := Role(strings.TrimSpace(string(l)))
+		if normalized != EmptyRole && normalized != higher {
+			implied[normalized] = struct{}{}
+		}
+	}
+}
+
+// ClearRoleHierarchy removes all registered role implications.
+// This is primarily for testing purposes to ensure a clean state.
+func ClearRoleHierarchy() {
+	roleHierarchy = make(map[Role]map[Role]struct{})
+}
+
+// HasAtLeast checks if the role is the same as other or implies it through the registered hierarchy.
+// The zero role never satisfies the check.
+func (r Role) HasAtLeast(other Role) bool {
+	if r.IsZero() || other.IsZero() {
+		return false
+	}
+	if r == other {
+		return true
+	}
+
+	visited := map[Role]struct{}{r: {}}
+	pending := []Role{r}
+	for len(pending) > 0 {
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		for implied := range roleHierarchy[current] {
+			if implied == other {
+				return true
+			}
+			if _, seen := visited[implied]; !seen {
+				visited[implied] = struct{}{}
+				pending = append(pending, implied)
+			}
+		}
+	}
+	return false
+}
+
 // String returns the role as a string.
 func (r Role) String() string {
 	return string(r)
@@ -72,3 +72,34 @@ func (s *RoleSuite) TestRole_IsZero() {
 	s.False(adminRole.IsZero())
 	s.True(wisp.EmptyRole.IsZero())
 }
+
+func (s *RoleSuite) TestRole_HasAtLeast() {
+	wisp.ClearRoleHierarchy()
+	defer wisp.ClearRoleHierarchy()
+
+	wisp.RegisterRoles("ADMIN", "MANAGER", "USER", "AUDITOR")
+	wisp.RegisterRoleHierarchy("ADMIN", "MANAGER")
+	wisp.RegisterRoleHierarchy("MANAGER", "USER")
+	wisp.RegisterRoleHierarchy("USER", "ADMIN", "")
+
+	testCases := []struct {
+		name     string
+		role     wisp.Role
+		other    wisp.Role
+		expected bool
+	}{
+		{name: "same role", role: "USER", other: "USER", expected: true},
+		{name: "direct implication", role: "ADMIN", other: "MANAGER", expected: true},
+		{name: "transitive implication", role: "ADMIN", other: "USER", expected: true},
+		{name: "cycles are tolerated", role: "MANAGER", other: "ADMIN", expected: true},
+		{name: "unrelated role", role: "ADMIN", other: "AUDITOR", expected: false},
+		{name: "lower role", role: "AUDITOR", other: "USER", expected: false},
+		{name: "zero role", role: wisp.EmptyRole, other: wisp.EmptyRole, expected: false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.expected, tc.role.HasAtLeast(tc.other))
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// RoleSet is an immutable value object holding a set of distinct, registered roles
+// (e.g., the roles granted to a user). Roles are kept sorted so that equal sets have
+// identical JSON and database representations.
+//
+// It is serialized as a JSON array of strings, both in JSON and in the database.
+//
+// Example:
+//   wisp.RegisterRoles("ADMIN", "MANAGER", "USER")
+//   wisp.RegisterRoleHierarchy("ADMIN", "MANAGER")
+//   roles, _ := wisp.NewRoleSet("USER", "ADMIN")
+//   canManage := roles.HasAtLeast("MANAGER") // true
+type RoleSet struct {
+	roles []Role
+}
+
+// EmptyRoleSet represents the zero value for the RoleSet type.
+var EmptyRoleSet = RoleSet{}
+
+// NewRoleSet creates a new RoleSet from one or more role names.
+// Each role is validated with NewRole; empty values are ignored and duplicates are removed.
+// Returns an error if any role is not registered.
+func NewRoleSet(values ...string) (RoleSet, error) {
+	seen := make(map[Role]struct{}, len(values))
+	roles := make([]Role, 0, len(values))
+
+	for _, v := range values {
+		role, err := NewRole(v)
+		if err != nil {
+			return EmptyRoleSet, err
+		}
+		if role.IsZero() {
+			continue
+		}
+		if _, ok := seen[role]; ok {
+			continue
+		}
+		seen[role] = struct{}{}
+		roles = append(roles, role)
+	}
+
+	if len(roles) == 0 {
+		return EmptyRoleSet, nil
+	}
+
+	sort.Slice(roles, func(i, j int) bool { return roles[i] < roles[j] })
+	return RoleSet{roles: roles}, nil
+}
+
+// Roles returns a copy of the roles in the set, sorted alphabetically.
+func (rs RoleSet) Roles() []Role {
+	roles := make([]Role, len(rs.roles))
+	copy(roles, rs.roles)
+	return roles
+}
+
+// Len returns the number of roles in the set.
+func (rs RoleSet) Len() int {
+	return len(rs.roles)
+}
+
+// IsZero returns true if the RoleSet has no roles.
+func (rs RoleSet) IsZero() bool {
+	return len(rs.roles) == 0
+}
+
+// Contains checks if the role is explicitly part of the set, ignoring the hierarchy.
+func (rs RoleSet) Contains(role Role) bool {
+	i := sort.Search(len(rs.roles), func(i int) bool { return rs.roles[i] >= role })
+	return i < len(rs.roles) && rs.roles[i] == role
+}
+
+// HasAtLeast checks if any role in the set satisfies Role.HasAtLeast for the given role.
+func (rs RoleSet) HasAtLeast(role Role) bool {
+	for _, r := range rs.roles {
+		if r.HasAtLeast(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// With returns a new RoleSet that also contains the given roles.
+// Returns an error if any role is not registered.
+func (rs RoleSet) With(roles ...Role) (RoleSet, error) {
+	values := make([]string, 0, len(rs.roles)+len(roles))
+	for _, r := range rs.roles {
+		values = append(values, r.String())
+	}
+	for _, r := range roles {
+		values = append(values, r.String())
+	}
+	return NewRoleSet(values...)
+}
+
+// Without returns a new RoleSet with the given roles removed.
+func (rs RoleSet) Without(roles ...Role) RoleSet {
+	remove := make(map[Role]struct{}, len(roles))
+	for _, r := range roles {
+		remove[r] = struct{}{}
+	}
+
+	kept := make([]Role, 0, len(rs.roles))
+	for _, r := range rs.roles {
+		if _, ok := remove[r]; !ok {
+			kept = append(kept, r)
+		}
+	}
+
+	if len(kept) == 0 {
+		return EmptyRoleSet
+	}
+	return RoleSet{roles: kept}
+}
+
+// String returns the roles joined by commas.
+func (rs RoleSet) String() string {
+	var b strings.Builder
+	for i, r := range rs.roles {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(r.String())
+	}
+	return b.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the set as a JSON array of strings; an empty set becomes [].
+func (rs RoleSet) MarshalJSON() ([]byte, error) {
+	roles := rs.roles
+	if roles == nil {
+		roles = []Role{}
+	}
+	return json.Marshal(roles)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON array of strings into a RoleSet, validating each role.
+func (rs *RoleSet) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*rs = EmptyRoleSet
+		return nil
+	}
+
+	var values []string
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fault.Wrap(err, "RoleSet must be a valid JSON array of strings or null", fault.WithCode(fault.Invalid))
+	}
+
+	set, err := NewRoleSet(values...)
+	if err != nil {
+		return err
+	}
+	*rs = set
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the set as a JSON array or nil if the set is empty.
+func (rs RoleSet) Value() (driver.Value, error) {
+	if rs.IsZero() {
+		return nil, nil
+	}
+	return rs.MarshalJSON()
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a JSON array as string or []byte and validates each role.
+func (rs *RoleSet) Scan(src interface{}) error {
+	if src == nil {
+		*rs = EmptyRoleSet
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fault.New(
+			"unsupported scan type for RoleSet",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return rs.UnmarshalJSON(data)
+}
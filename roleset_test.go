@@ -0,0 +1,114 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type RoleSetSuite struct {
+	suite.Suite
+}
+
+func TestRoleSetSuite(t *testing.T) {
+	suite.Run(t, new(RoleSetSuite))
+}
+
+func (s *RoleSetSuite) SetupTest() {
+	wisp.ClearRegisteredRoles()
+	wisp.ClearRoleHierarchy()
+	wisp.RegisterRoles("ADMIN", "MANAGER", "USER")
+	wisp.RegisterRoleHierarchy("ADMIN", "MANAGER")
+}
+
+func (s *RoleSetSuite) TearDownTest() {
+	wisp.ClearRoleHierarchy()
+}
+
+func (s *RoleSetSuite) TestNewRoleSet() {
+	s.Run("should deduplicate and sort roles", func() {
+		rs, err := wisp.NewRoleSet("USER", " ADMIN ", "USER", "")
+		s.Require().NoError(err)
+		s.Equal([]wisp.Role{"ADMIN", "USER"}, rs.Roles())
+		s.Equal(2, rs.Len())
+		s.Equal("ADMIN,USER", rs.String())
+	})
+
+	s.Run("should return the empty set for no roles", func() {
+		rs, err := wisp.NewRoleSet()
+		s.Require().NoError(err)
+		s.True(rs.IsZero())
+	})
+
+	s.Run("should fail for unregistered roles", func() {
+		_, err := wisp.NewRoleSet("USER", "GUEST")
+		s.Require().Error(err)
+	})
+}
+
+func (s *RoleSetSuite) TestRoleSet_Checks() {
+	rs, _ := wisp.NewRoleSet("ADMIN", "USER")
+
+	s.True(rs.Contains("ADMIN"))
+	s.False(rs.Contains("MANAGER"))
+	s.True(rs.HasAtLeast("MANAGER"))
+	s.False(wisp.EmptyRoleSet.HasAtLeast("USER"))
+
+	userOnly := rs.Without("ADMIN")
+	s.Equal([]wisp.Role{"USER"}, userOnly.Roles())
+	s.False(userOnly.HasAtLeast("MANAGER"))
+	s.True(rs.Without("ADMIN", "USER").IsZero())
+
+	withManager, err := userOnly.With("MANAGER")
+	s.Require().NoError(err)
+	s.Equal([]wisp.Role{"MANAGER", "USER"}, withManager.Roles())
+
+	_, err = userOnly.With("GUEST")
+	s.Require().Error(err)
+}
+
+func (s *RoleSetSuite) TestRoleSet_JSON() {
+	rs, _ := wisp.NewRoleSet("USER", "ADMIN")
+
+	data, err := json.Marshal(rs)
+	s.Require().NoError(err)
+	s.Equal(`["ADMIN","USER"]`, string(data))
+
+	var decoded wisp.RoleSet
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(rs, decoded)
+
+	data, err = json.Marshal(wisp.EmptyRoleSet)
+	s.Require().NoError(err)
+	s.Equal(`[]`, string(data))
+
+	s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.True(decoded.IsZero())
+
+	s.Require().Error(json.Unmarshal([]byte(`["GUEST"]`), &decoded))
+	s.Require().Error(json.Unmarshal([]byte(`"ADMIN"`), &decoded))
+}
+
+func (s *RoleSetSuite) TestRoleSet_SQL() {
+	rs, _ := wisp.NewRoleSet("MANAGER")
+
+	val, err := rs.Value()
+	s.Require().NoError(err)
+	s.Equal([]byte(`["MANAGER"]`), val)
+
+	val, err = wisp.EmptyRoleSet.Value()
+	s.Require().NoError(err)
+	s.Nil(val)
+
+	var scanned wisp.RoleSet
+	s.Require().NoError(scanned.Scan(`["MANAGER"]`))
+	s.Equal(rs, scanned)
+	s.Require().NoError(scanned.Scan([]byte(`["USER","ADMIN"]`)))
+	s.Equal(2, scanned.Len())
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Require().Error(scanned.Scan(10))
+}
@@ -0,0 +1,106 @@
+package wisp
+
+import (
+	"math/big"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// RoundingMode defines how a result that falls between two minor units is rounded,
+// so financial integrations can match the accounting rules of their counterparts.
+//
+// The zero value is RoundHalfEven (banker's rounding), the package default.
+//
+// Example:
+//   m, _ := wisp.NewMoney(1001, wisp.BRL)
+//   half, _ := m.DivideRounded(2, wisp.RoundHalfUp) // 501 centavos
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to the nearest value, and ties to the even neighbor (banker's rounding).
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value, and ties away from zero (commercial rounding).
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest value, and ties toward zero.
+	RoundHalfDown
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+	// RoundCeil rounds toward positive infinity.
+	RoundCeil
+)
+
+// roundingModeNames maps each rounding mode to its name.
+var roundingModeNames = map[RoundingMode]string{
+	RoundHalfEven: "half_even",
+	RoundHalfUp:   "half_up",
+	RoundHalfDown: "half_down",
+	RoundFloor:    "floor",
+	RoundCeil:     "ceil",
+}
+
+// String returns the name of the rounding mode (e.g., "half_up").
+func (m RoundingMode) String() string {
+	return roundingModeNames[m]
+}
+
+// IsValid checks if the rounding mode is one of the supported modes.
+func (m RoundingMode) IsValid() bool {
+	_, ok := roundingModeNames[m]
+	return ok
+}
+
+// mulDivRounded computes a*b/den exactly and rounds the result with the given mode.
+// It returns an error if the mode is unknown, den is zero, or the result overflows an int64.
+func mulDivRounded(a, b, den int64, mode RoundingMode) (int64, error) {
+	if !mode.IsValid() {
+		return 0, fault.New(
+			"unknown rounding mode",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("rounding_mode", int(mode)),
+		)
+	}
+	if den == 0 {
+		return 0, fault.New("division by zero", fault.WithCode(fault.Invalid))
+	}
+
+	num := new(big.Int).Mul(big.NewInt(a), big.NewInt(b))
+	d := big.NewInt(den)
+	if d.Sign() < 0 {
+		num.Neg(num)
+		d.Neg(d)
+	}
+
+	// Euclidean division: rem is always in [0, d), so quo is the floor of num/d.
+	quo, rem := new(big.Int).DivMod(num, d, new(big.Int))
+
+	if rem.Sign() != 0 {
+		twiceRem := new(big.Int).Lsh(rem, 1)
+		cmpHalf := twiceRem.Cmp(d)
+		negative := num.Sign() < 0
+
+		roundUp := false
+		switch mode {
+		case RoundFloor:
+		case RoundCeil:
+			roundUp = true
+		case RoundHalfUp:
+			roundUp = cmpHalf > 0 || (cmpHalf == 0 && !negative)
+		case RoundHalfDown:
+			roundUp = cmpHalf > 0 || (cmpHalf == 0 && negative)
+		case RoundHalfEven:
+			roundUp = cmpHalf > 0 || (cmpHalf == 0 && quo.Bit(0) == 1)
+		}
+
+		if roundUp {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+
+	if !quo.IsInt64() {
+		return 0, fault.New(
+			"rounded result overflows the supported range",
+			fault.WithCode(fault.DomainViolation),
+		)
+	}
+	return quo.Int64(), nil
+}
@@ -0,0 +1,222 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// Scope is an immutable value object holding a set of distinct permissions, such as the
+// rights granted to a role or carried by an access token. Permissions are kept sorted so
+// that equal scopes have identical representations.
+//
+// Like an OAuth scope, it is represented as a single space-delimited string
+// (e.g., "orders:read orders:write"), both in JSON and in the database.
+//
+// Example:
+//   wisp.RegisterPermissions("orders:read", "orders:write", "billing:read")
+//   scope, _ := wisp.ParseScope("orders:* billing:read")
+//   scope.Allows("orders:write") // true
+type Scope struct {
+	permissions []Permission
+}
+
+// EmptyScope represents the zero value for the Scope type.
+var EmptyScope = Scope{}
+
+// NewScope creates a new Scope from one or more permissions.
+// Each permission is validated with NewPermission; empty values are ignored and duplicates removed.
+func NewScope(values ...string) (Scope, error) {
+	seen := make(map[Permission]struct{}, len(values))
+	permissions := make([]Permission, 0, len(values))
+
+	for _, v := range values {
+		p, err := NewPermission(v)
+		if err != nil {
+			return EmptyScope, err
+		}
+		if p.IsZero() {
+			continue
+		}
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		permissions = append(permissions, p)
+	}
+
+	return newScope(permissions), nil
+}
+
+// ParseScope creates a new Scope from a space-delimited string of permissions.
+func ParseScope(value string) (Scope, error) {
+	return NewScope(strings.Fields(value)...)
+}
+
+// newScope sorts already validated, distinct permissions into a Scope.
+func newScope(permissions []Permission) Scope {
+	if len(permissions) == 0 {
+		return EmptyScope
+	}
+	sort.Slice(permissions, func(i, j int) bool { return permissions[i] < permissions[j] })
+	return Scope{permissions: permissions}
+}
+
+// Permissions returns a copy of the permissions in the scope, sorted alphabetically.
+func (s Scope) Permissions() []Permission {
+	permissions := make([]Permission, len(s.permissions))
+	copy(permissions, s.permissions)
+	return permissions
+}
+
+// Len returns the number of permissions in the scope.
+func (s Scope) Len() int {
+	return len(s.permissions)
+}
+
+// IsZero returns true if the Scope has no permissions.
+func (s Scope) IsZero() bool {
+	return len(s.permissions) == 0
+}
+
+// Contains checks if the permission is literally part of the scope, without wildcard matching.
+func (s Scope) Contains(p Permission) bool {
+	i := sort.Search(len(s.permissions), func(i int) bool { return s.permissions[i] >= p })
+	return i < len(s.permissions) && s.permissions[i] == p
+}
+
+// Allows checks if any permission in the scope matches the requested permission, honoring wildcards.
+func (s Scope) Allows(p Permission) bool {
+	for _, granted := range s.permissions {
+		if granted.Matches(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsAll checks if the scope allows every one of the requested permissions.
+func (s Scope) AllowsAll(permissions ...Permission) bool {
+	for _, p := range permissions {
+		if !s.Allows(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns a new Scope with the permissions of both scopes.
+func (s Scope) Union(other Scope) Scope {
+	permissions := make([]Permission, 0, len(s.permissions)+len(other.permissions))
+	permissions = append(permissions, s.permissions...)
+	for _, p := range other.permissions {
+		if !s.Contains(p) {
+			permissions = append(permissions, p)
+		}
+	}
+	return newScope(permissions)
+}
+
+// Intersect returns a new Scope with the permissions literally present in both scopes.
+func (s Scope) Intersect(other Scope) Scope {
+	permissions := make([]Permission, 0, len(s.permissions))
+	for _, p := range s.permissions {
+		if other.Contains(p) {
+			permissions = append(permissions, p)
+		}
+	}
+	return newScope(permissions)
+}
+
+// Difference returns a new Scope with the permissions of s that are not literally present in other.
+func (s Scope) Difference(other Scope) Scope {
+	permissions := make([]Permission, 0, len(s.permissions))
+	for _, p := range s.permissions {
+		if !other.Contains(p) {
+			permissions = append(permissions, p)
+		}
+	}
+	return newScope(permissions)
+}
+
+// String returns the permissions as a space-delimited string.
+func (s Scope) String() string {
+	var b strings.Builder
+	for i, p := range s.permissions {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(p.String())
+	}
+	return b.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the scope as a space-delimited JSON string.
+func (s Scope) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a space-delimited JSON string into a Scope, validating each permission.
+func (s *Scope) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = EmptyScope
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fault.Wrap(err, "Scope must be a valid JSON string or null", fault.WithCode(fault.Invalid))
+	}
+
+	scope, err := ParseScope(str)
+	if err != nil {
+		return err
+	}
+	*s = scope
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the scope as a space-delimited string or nil if it's empty.
+func (s Scope) Value() (driver.Value, error) {
+	if s.IsZero() {
+		return nil, nil
+	}
+	return s.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values and validates each permission.
+func (s *Scope) Scan(src interface{}) error {
+	if src == nil {
+		*s = EmptyScope
+		return nil
+	}
+
+	var str string
+	switch v := src.(type) {
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for Scope",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	scope, err := ParseScope(str)
+	if err != nil {
+		return err
+	}
+	*s = scope
+	return nil
+}
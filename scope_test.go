@@ -0,0 +1,108 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type ScopeSuite struct {
+	suite.Suite
+}
+
+func TestScopeSuite(t *testing.T) {
+	suite.Run(t, new(ScopeSuite))
+}
+
+func (s *ScopeSuite) SetupTest() {
+	wisp.ClearRegisteredPermissions()
+	wisp.RegisterPermissions("orders:read", "orders:write", "billing:read", "billing:write")
+}
+
+func (s *ScopeSuite) TestNewScope() {
+	s.Run("should deduplicate and sort permissions", func() {
+		scope, err := wisp.NewScope("orders:write", "billing:read", "ORDERS:WRITE", "")
+		s.Require().NoError(err)
+		s.Equal([]wisp.Permission{"billing:read", "orders:write"}, scope.Permissions())
+		s.Equal(2, scope.Len())
+		s.Equal("billing:read orders:write", scope.String())
+	})
+
+	s.Run("should parse a space-delimited string", func() {
+		scope, err := wisp.ParseScope("  orders:*   billing:read ")
+		s.Require().NoError(err)
+		s.Equal([]wisp.Permission{"billing:read", "orders:*"}, scope.Permissions())
+	})
+
+	s.Run("should fail for invalid permissions", func() {
+		_, err := wisp.ParseScope("orders:read orders:delete")
+		s.Require().Error(err)
+	})
+
+	s.Run("should return the empty scope for blank input", func() {
+		scope, err := wisp.ParseScope("   ")
+		s.Require().NoError(err)
+		s.True(scope.IsZero())
+	})
+}
+
+func (s *ScopeSuite) TestScope_Allows() {
+	scope, _ := wisp.ParseScope("orders:* billing:read")
+
+	s.True(scope.Allows("orders:write"))
+	s.True(scope.Allows("billing:read"))
+	s.False(scope.Allows("billing:write"))
+	s.True(scope.AllowsAll("orders:read", "billing:read"))
+	s.False(scope.AllowsAll("orders:read", "billing:write"))
+	s.True(scope.Contains("orders:*"))
+	s.False(scope.Contains("orders:read"))
+	s.False(wisp.EmptyScope.Allows("orders:read"))
+}
+
+func (s *ScopeSuite) TestScope_SetOperations() {
+	a, _ := wisp.ParseScope("orders:read orders:write")
+	b, _ := wisp.ParseScope("orders:write billing:read")
+
+	s.Equal("billing:read orders:read orders:write", a.Union(b).String())
+	s.Equal("orders:write", a.Intersect(b).String())
+	s.Equal("orders:read", a.Difference(b).String())
+	s.True(a.Difference(a).IsZero())
+	s.Equal(a, a.Union(wisp.EmptyScope))
+}
+
+func (s *ScopeSuite) TestScope_Serialization() {
+	scope, _ := wisp.ParseScope("orders:read billing:read")
+
+	s.Run("JSON", func() {
+		data, err := json.Marshal(scope)
+		s.Require().NoError(err)
+		s.Equal(`"billing:read orders:read"`, string(data))
+
+		var decoded wisp.Scope
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(scope, decoded)
+
+		s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+		s.True(decoded.IsZero())
+		s.Require().Error(json.Unmarshal([]byte(`["orders:read"]`), &decoded))
+	})
+
+	s.Run("SQL", func() {
+		val, err := scope.Value()
+		s.Require().NoError(err)
+		s.Equal("billing:read orders:read", val)
+
+		val, err = wisp.EmptyScope.Value()
+		s.Require().NoError(err)
+		s.Nil(val)
+
+		var scanned wisp.Scope
+		s.Require().NoError(scanned.Scan([]byte("orders:read billing:read")))
+		s.Equal(scope, scanned)
+		s.Require().Error(scanned.Scan("orders:delete"))
+		s.Require().Error(scanned.Scan(3.14))
+	})
+}
@@ -0,0 +1,304 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// ScoreBand is a labeled threshold in a band table: a score belongs to the band with the
+// highest Min that is less than or equal to its value (e.g., {Label: "A", Min: 90}).
+type ScoreBand struct {
+	Label string `json:"label"`
+	Min   int64  `json:"min"`
+}
+
+// scoreBandTables holds the global band tables by scale name, sorted by descending Min.
+var scoreBandTables = make(map[string][]ScoreBand)
+
+// RegisterScoreBands registers (or replaces) the band table of a named scale.
+// Bands with an empty label are ignored. This function should be called at application startup.
+//
+// Example:
+//   wisp.RegisterScoreBands("grade", wisp.ScoreBand{"A", 90}, wisp.ScoreBand{"B", 80}, wisp.ScoreBand{"C", 0})
+func RegisterScoreBands(scale string, bands ...ScoreBand) {
+	scale = strings.TrimSpace(scale)
+	if scale == "" {
+		return
+	}
+
+	table := make([]ScoreBand, 0, len(bands))
+	for _, b := range bands {
+		if strings.TrimSpace(b.Label) != "" {
+			table = append(table, b)
+		}
+	}
+	sort.SliceStable(table, func(i, j int) bool { return table[i].Min > table[j].Min })
+	scoreBandTables[scale] = table
+}
+
+// ClearScoreBands removes all registered band tables.
+// This is primarily for testing purposes to ensure a clean state.
+func ClearScoreBands() {
+	scoreBandTables = make(map[string][]ScoreBand)
+}
+
+// Score is a value object representing a score within inclusive `[min, max]` bounds, such as a
+// credit score (300–850) or a gamification rating, optionally classified by a registered band table.
+//
+// All operations are immutable, returning a new Score instance.
+//
+// Example:
+//   wisp.RegisterScoreBands("credit", wisp.ScoreBand{"excellent", 750}, wisp.ScoreBand{"good", 650}, wisp.ScoreBand{"poor", 300})
+//   score, _ := wisp.NewScore(720, 300, 850)
+//   score, _ = score.WithScale("credit")
+//   band, _ := score.Band() // "good"
+type Score struct {
+	value int64
+	min   int64
+	max   int64
+	scale string
+}
+
+// ZeroScore represents the zero value for Score.
+var ZeroScore = Score{}
+
+// NewScore creates a new Score without a band table.
+// It returns an error if min > max, or if the value is outside the [min, max] range.
+func NewScore(value, min, max int64) (Score, error) {
+	if min > max {
+		return ZeroScore, fault.New("min score cannot be greater than max score", fault.WithCode(fault.Invalid))
+	}
+	if value < min || value > max {
+		return ZeroScore, fault.New(
+			"score is outside the allowed range [min, max]",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("value", value),
+			fault.WithContext("min", min),
+			fault.WithContext("max", max),
+		)
+	}
+	return Score{value: value, min: min, max: max}, nil
+}
+
+// WithScale returns a new Score classified by the band table registered under the given scale name.
+// Returns an error if no band table is registered with that name.
+func (s Score) WithScale(scale string) (Score, error) {
+	scale = strings.TrimSpace(scale)
+	if _, ok := scoreBandTables[scale]; !ok {
+		return ZeroScore, fault.New(
+			"score band table is not registered",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("scale", scale),
+		)
+	}
+	s.scale = scale
+	return s, nil
+}
+
+// Int64 returns the score value.
+func (s Score) Int64() int64 {
+	return s.value
+}
+
+// Min returns the minimum allowed score.
+func (s Score) Min() int64 {
+	return s.min
+}
+
+// Max returns the maximum allowed score.
+func (s Score) Max() int64 {
+	return s.max
+}
+
+// Scale returns the name of the band table used to classify the score, or an empty string.
+func (s Score) Scale() string {
+	return s.scale
+}
+
+// Band returns the label of the band the score falls into.
+// The second return value is false if the score has no scale or no band covers its value.
+func (s Score) Band() (string, bool) {
+	if s.scale == "" {
+		return "", false
+	}
+	for _, b := range scoreBandTables[s.scale] {
+		if s.value >= b.Min {
+			return b.Label, true
+		}
+	}
+	return "", false
+}
+
+// Normalized returns the position of the score within its bounds as a value between 0 and 1.
+// A score whose bounds are equal is normalized to 1.
+func (s Score) Normalized() float64 {
+	if s.max == s.min {
+		return 1
+	}
+	return float64(s.value-s.min) / float64(s.max-s.min)
+}
+
+// Set returns a new Score with the same bounds and scale and a different value.
+// It returns an error if the new value is outside the [min, max] range.
+func (s Score) Set(value int64) (Score, error) {
+	updated, err := NewScore(value, s.min, s.max)
+	if err != nil {
+		return ZeroScore, err
+	}
+	updated.scale = s.scale
+	return updated, nil
+}
+
+// Compare compares two scores and returns -1, 0 or +1.
+// Scores with the same bounds are compared by value; otherwise their normalized positions are compared.
+func (s Score) Compare(other Score) int {
+	if s.min == other.min && s.max == other.max {
+		switch {
+		case s.value < other.value:
+			return -1
+		case s.value > other.value:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	a, b := s.Normalized(), other.Normalized()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// GreaterThan checks if this score is greater than another, as defined by Compare.
+func (s Score) GreaterThan(other Score) bool {
+	return s.Compare(other) > 0
+}
+
+// LessThan checks if this score is less than another, as defined by Compare.
+func (s Score) LessThan(other Score) bool {
+	return s.Compare(other) < 0
+}
+
+// Equals checks if two scores have the same value, bounds and scale.
+func (s Score) Equals(other Score) bool {
+	return s == other
+}
+
+// IsZero returns true if the Score is the zero value.
+func (s Score) IsZero() bool {
+	return s == ZeroScore
+}
+
+// String returns the score in "value/max" format (e.g., "720/850").
+func (s Score) String() string {
+	return fmt.Sprintf("%d/%d", s.value, s.max)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the Score to a JSON object with "value", "min", "max" and, when set, "scale" and "band" fields,
+// or null for the zero value.
+func (s Score) MarshalJSON() ([]byte, error) {
+	if s.IsZero() {
+		return []byte("null"), nil
+	}
+	band, _ := s.Band()
+	return json.Marshal(&struct {
+		Value int64  `json:"value"`
+		Min   int64  `json:"min"`
+		Max   int64  `json:"max"`
+		Scale string `json:"scale,omitempty"`
+		Band  string `json:"band,omitempty"`
+	}{
+		Value: s.value,
+		Min:   s.min,
+		Max:   s.max,
+		Scale: s.scale,
+		Band:  band,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object into a Score, with validation; null results in ZeroScore.
+// The "band" field is ignored because it is derived from the registered band table.
+func (s *Score) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = ZeroScore
+		return nil
+	}
+
+	var dto struct {
+		Value int64  `json:"value"`
+		Min   int64  `json:"min"`
+		Max   int64  `json:"max"`
+		Scale string `json:"scale"`
+	}
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON for Score", fault.WithCode(fault.Invalid))
+	}
+
+	score, err := NewScore(dto.Value, dto.Min, dto.Max)
+	if err != nil {
+		return err
+	}
+	if dto.Scale != "" {
+		if score, err = score.WithScale(dto.Scale); err != nil {
+			return err
+		}
+	}
+
+	*s = score
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the Score as a JSON string or nil if it's the zero value.
+func (s Score) Value() (driver.Value, error) {
+	if s.IsZero() {
+		return nil, nil
+	}
+
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal score for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as a Score.
+func (s *Score) Scan(src interface{}) error {
+	if src == nil {
+		*s = ZeroScore
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for Score",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return s.UnmarshalJSON(data)
+}
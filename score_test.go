@@ -0,0 +1,163 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type ScoreSuite struct {
+	suite.Suite
+}
+
+func TestScoreSuite(t *testing.T) {
+	suite.Run(t, new(ScoreSuite))
+}
+
+func (s *ScoreSuite) SetupTest() {
+	wisp.ClearScoreBands()
+	wisp.RegisterScoreBands("grade",
+		wisp.ScoreBand{Label: "C", Min: 70},
+		wisp.ScoreBand{Label: "A", Min: 90},
+		wisp.ScoreBand{Label: "B", Min: 80},
+		wisp.ScoreBand{Label: "", Min: 0},
+	)
+}
+
+func (s *ScoreSuite) TestNewScore() {
+	score, err := wisp.NewScore(85, 0, 100)
+	s.Require().NoError(err)
+	s.Equal(int64(85), score.Int64())
+	s.Equal(int64(0), score.Min())
+	s.Equal(int64(100), score.Max())
+	s.Equal("85/100", score.String())
+
+	_, err = wisp.NewScore(101, 0, 100)
+	s.Require().Error(err)
+	_, err = wisp.NewScore(5, 10, 0)
+	s.Require().Error(err)
+}
+
+func (s *ScoreSuite) TestScore_Band() {
+	testCases := []struct {
+		value    int64
+		expected string
+		found    bool
+	}{
+		{value: 100, expected: "A", found: true},
+		{value: 90, expected: "A", found: true},
+		{value: 89, expected: "B", found: true},
+		{value: 70, expected: "C", found: true},
+		{value: 69, expected: "", found: false},
+	}
+
+	for _, tc := range testCases {
+		score, _ := wisp.NewScore(tc.value, 0, 100)
+		score, err := score.WithScale("grade")
+		s.Require().NoError(err)
+
+		band, ok := score.Band()
+		s.Equal(tc.found, ok, "value %d", tc.value)
+		s.Equal(tc.expected, band, "value %d", tc.value)
+	}
+
+	s.Run("should not have a band without a scale", func() {
+		score, _ := wisp.NewScore(95, 0, 100)
+		_, ok := score.Band()
+		s.False(ok)
+	})
+
+	s.Run("should fail for an unknown scale", func() {
+		score, _ := wisp.NewScore(95, 0, 100)
+		_, err := score.WithScale("credit")
+		s.Require().Error(err)
+	})
+
+	s.Run("Set should keep the scale", func() {
+		score, _ := wisp.NewScore(95, 0, 100)
+		score, _ = score.WithScale("grade")
+		lower, err := score.Set(75)
+		s.Require().NoError(err)
+		band, _ := lower.Band()
+		s.Equal("C", band)
+
+		_, err = score.Set(150)
+		s.Require().Error(err)
+	})
+}
+
+func (s *ScoreSuite) TestScore_Comparisons() {
+	a, _ := wisp.NewScore(80, 0, 100)
+	b, _ := wisp.NewScore(90, 0, 100)
+	credit, _ := wisp.NewScore(575, 300, 850)
+
+	s.True(b.GreaterThan(a))
+	s.True(a.LessThan(b))
+	s.Equal(0, a.Compare(a))
+	s.InDelta(0.5, credit.Normalized(), 1e-9)
+	s.True(a.GreaterThan(credit))
+	s.True(a.Equals(a))
+	s.False(a.Equals(b))
+
+	fixed, _ := wisp.NewScore(5, 5, 5)
+	s.Equal(1.0, fixed.Normalized())
+}
+
+func (s *ScoreSuite) TestScore_Serialization() {
+	score, _ := wisp.NewScore(92, 0, 100)
+	score, _ = score.WithScale("grade")
+
+	s.Run("JSON", func() {
+		data, err := json.Marshal(score)
+		s.Require().NoError(err)
+		s.JSONEq(`{"value":92,"min":0,"max":100,"scale":"grade","band":"A"}`, string(data))
+
+		var decoded wisp.Score
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(score, decoded)
+
+		s.Require().Error(json.Unmarshal([]byte(`{"value":92,"min":0,"max":100,"scale":"credit"}`), &decoded))
+		s.Require().Error(json.Unmarshal([]byte(`{"value":120,"min":0,"max":100}`), &decoded))
+
+		s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+		s.True(decoded.IsZero())
+
+		data, err = json.Marshal(wisp.ZeroScore)
+		s.Require().NoError(err)
+		s.Equal("null", string(data))
+
+		var holder struct {
+			Score wisp.Score `json:"score"`
+		}
+		s.Require().NoError(json.Unmarshal([]byte(`{"score":null}`), &holder))
+		s.True(holder.Score.IsZero())
+		data, err = json.Marshal(holder)
+		s.Require().NoError(err)
+		s.Require().NoError(json.Unmarshal(data, &holder))
+	})
+
+	s.Run("SQL", func() {
+		val, err := score.Value()
+		s.Require().NoError(err)
+
+		var scanned wisp.Score
+		s.Require().NoError(scanned.Scan(val))
+		s.Equal(score, scanned)
+		s.Require().NoError(scanned.Scan([]byte(`{"value":3,"min":1,"max":5}`)))
+		s.Equal("3/5", scanned.String())
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+		s.Require().NoError(scanned.Scan("null"))
+		s.True(scanned.IsZero())
+		s.Require().NoError(scanned.Scan([]byte("null")))
+		s.True(scanned.IsZero())
+		nilVal, err := scanned.Value()
+		s.Require().NoError(err)
+		s.Nil(nilVal)
+		s.Require().Error(scanned.Scan(10))
+	})
+}
@@ -0,0 +1,97 @@
+package wisp
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// redactedSecret is the text shown in place of a Secret when it is printed or serialized.
+const redactedSecret = "[REDACTED]"
+
+// Secret is a value object holding sensitive text, such as a password or an API key, that must
+// never leak into logs or responses. String, GoString, Masked and MarshalJSON all return
+// "[REDACTED]"; the actual content is only available through Reveal.
+//
+// Secret can be decoded from JSON, so request bodies can carry it, but it has no database
+// interface on purpose: secrets should be stored hashed, see PasswordHash.
+//
+// The zero value is EmptySecret.
+//
+// Example:
+//   pwd, err := wisp.NewSecret("correct horse battery staple")
+//   fmt.Println(pwd)   // "[REDACTED]"
+//   hash, err := wisp.HashPassword(pwd, wisp.DefaultPasswordPolicy)
+type Secret struct {
+	value string
+}
+
+// EmptySecret represents the zero value for Secret.
+var EmptySecret Secret
+
+// NewSecret creates a new Secret. The value is kept as given, including surrounding whitespace.
+// It returns an error if the value is empty.
+func NewSecret(value string) (Secret, error) {
+	if value == "" {
+		return EmptySecret, fault.New("secret cannot be empty", fault.WithCode(fault.Invalid))
+	}
+	return Secret{value: value}, nil
+}
+
+// Reveal returns the actual content of the secret. Callers must not log or return it.
+func (s Secret) Reveal() string {
+	return s.value
+}
+
+// Equals compares two secrets in constant time.
+func (s Secret) Equals(other Secret) bool {
+	return subtle.ConstantTimeCompare([]byte(s.value), []byte(other.value)) == 1
+}
+
+// IsZero returns true if the Secret is the zero value.
+func (s Secret) IsZero() bool {
+	return s.value == ""
+}
+
+// String returns "[REDACTED]", so the secret is never printed by accident.
+func (s Secret) String() string {
+	return redactedSecret
+}
+
+// GoString returns "[REDACTED]", protecting the secret from the %#v verb.
+func (s Secret) GoString() string {
+	return redactedSecret
+}
+
+// Masked implements the Masker interface, so Redact hides the secret as well.
+func (s Secret) Masked() string {
+	return redactedSecret
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It always serializes the Secret as "[REDACTED]".
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redactedSecret)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a Secret; null results in EmptySecret.
+func (s *Secret) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = EmptySecret
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fault.Wrap(err, "Secret must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	secret, err := NewSecret(str)
+	if err != nil {
+		return err
+	}
+	*s = secret
+	return nil
+}
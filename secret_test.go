@@ -0,0 +1,84 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type SecretSuite struct {
+	suite.Suite
+}
+
+func TestSecretSuite(t *testing.T) {
+	suite.Run(t, new(SecretSuite))
+}
+
+func (s *SecretSuite) TestNewSecret() {
+	s.Run("should keep the value as given", func() {
+		secret, err := wisp.NewSecret(" p@ss word ")
+		s.Require().NoError(err)
+		s.Equal(" p@ss word ", secret.Reveal())
+		s.False(secret.IsZero())
+	})
+
+	s.Run("should fail for an empty value", func() {
+		secret, err := wisp.NewSecret("")
+		s.Require().Error(err)
+		s.True(secret.IsZero())
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *SecretSuite) TestSecret_NeverLeaks() {
+	secret, _ := wisp.NewSecret("hunter2")
+
+	s.Equal("[REDACTED]", secret.String())
+	s.Equal("[REDACTED]", fmt.Sprintf("%v", secret))
+	s.Equal("[REDACTED]", fmt.Sprintf("%#v", secret))
+	s.Equal("[REDACTED]", secret.Masked())
+
+	data, err := json.Marshal(map[string]any{"password": secret})
+	s.Require().NoError(err)
+	s.JSONEq(`{"password":"[REDACTED]"}`, string(data))
+
+	s.Equal(map[string]any{"password": "[REDACTED]"}, wisp.Redact(struct {
+		Password wisp.Secret `json:"password"`
+	}{secret}))
+}
+
+func (s *SecretSuite) TestSecret_Equals() {
+	a, _ := wisp.NewSecret("hunter2")
+	b, _ := wisp.NewSecret("hunter2")
+	c, _ := wisp.NewSecret("hunter3")
+
+	s.True(a.Equals(b))
+	s.False(a.Equals(c))
+}
+
+func (s *SecretSuite) TestSecret_UnmarshalJSON() {
+	s.Run("should decode a string", func() {
+		var payload struct {
+			Password wisp.Secret `json:"password"`
+		}
+		s.Require().NoError(json.Unmarshal([]byte(`{"password":"hunter2"}`), &payload))
+		s.Equal("hunter2", payload.Password.Reveal())
+	})
+
+	s.Run("should decode null as empty", func() {
+		var secret wisp.Secret
+		s.Require().NoError(json.Unmarshal([]byte(`null`), &secret))
+		s.True(secret.IsZero())
+	})
+
+	s.Run("should fail for invalid values", func() {
+		var secret wisp.Secret
+		s.Error(json.Unmarshal([]byte(`""`), &secret))
+		s.Error(json.Unmarshal([]byte(`123`), &secret))
+	})
+}
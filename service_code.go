@@ -0,0 +1,230 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// maxServiceItem is the last item of the list of services annexed to LC 116/2003, as amended by
+// LC 157/2016.
+const maxServiceItem = 40
+
+// defaultServiceCodes returns the descriptions of the most commonly invoiced LC 116 sub-items.
+func defaultServiceCodes() map[ServiceCode]string {
+	return map[ServiceCode]string{
+		"01.01": "Análise e desenvolvimento de sistemas",
+		"01.02": "Programação",
+		"01.03": "Processamento, armazenamento ou hospedagem de dados, textos, imagens, vídeos, páginas eletrônicas, aplicativos e sistemas de informação",
+		"01.04": "Elaboração de programas de computadores, inclusive de jogos eletrônicos",
+		"01.05": "Licenciamento ou cessão de direito de uso de programas de computação",
+		"01.06": "Assessoria e consultoria em informática",
+		"01.07": "Suporte técnico em informática, inclusive instalação, configuração e manutenção de programas de computação e bancos de dados",
+		"01.08": "Planejamento, confecção, manutenção e atualização de páginas eletrônicas",
+		"04.01": "Medicina e biomedicina",
+		"07.02": "Execução, por administração, empreitada ou subempreitada, de obras de construção civil, hidráulica ou elétrica e de outras obras semelhantes",
+		"08.01": "Ensino regular pré-escolar, fundamental, médio e superior",
+		"08.02": "Instrução, treinamento, orientação pedagógica e educacional, avaliação de conhecimentos de qualquer natureza",
+		"14.01": "Lubrificação, limpeza, revisão, conserto, manutenção e conservação de máquinas, veículos, aparelhos e equipamentos",
+		"17.01": "Assessoria ou consultoria de qualquer natureza, não contida em outros itens desta lista",
+		"17.06": "Propaganda e publicidade, inclusive promoção de vendas e planejamento de campanhas",
+		"17.14": "Advocacia",
+		"17.19": "Contabilidade, inclusive serviços técnicos e auxiliares",
+	}
+}
+
+// registeredServiceCodes holds the descriptions of known service codes.
+var registeredServiceCodes = defaultServiceCodes()
+
+// RegisterServiceCode adds the description of a service code to the registry, or overrides the
+// description of a registered one, so codes invoiced by the application can be displayed.
+// Returns an error if the code is not a valid LC 116 sub-item or the description is empty.
+//
+// Example:
+//   err := wisp.RegisterServiceCode("25.01", "Funerais, inclusive fornecimento de caixão")
+func RegisterServiceCode(code string, description string) error {
+	serviceCode, err := NewServiceCode(code)
+	if err != nil {
+		return err
+	}
+	if serviceCode.IsZero() {
+		return fault.New("service code cannot be empty", fault.WithCode(fault.Invalid))
+	}
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return fault.New(
+			"service code description cannot be empty",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("code", serviceCode.String()),
+		)
+	}
+
+	registeredServiceCodes[serviceCode] = description
+	return nil
+}
+
+// ResetServiceCodes restores the registry to the built-in service codes.
+// This is primarily for testing purposes to ensure a clean state.
+func ResetServiceCodes() {
+	registeredServiceCodes = defaultServiceCodes()
+}
+
+// ServiceCode is a value object representing a service from the list annexed to LC 116/2003, the
+// code informed in the municipal service invoice (NFS-e) to determine the ISS due. It identifies an
+// item (1 to 40) and a sub-item, and is stored as "II.SS" (e.g., "07.02").
+// Any well-formed code is valid; codes in the registry also provide their description.
+//
+// The zero value is EmptyServiceCode.
+//
+// Examples:
+//   code, err := wisp.NewServiceCode("1.07")  // "01.07"
+//   code, err := wisp.NewServiceCode("0702")  // "07.02"
+//   code.Compact()                            // "0702"
+type ServiceCode string
+
+// EmptyServiceCode represents the zero value for the ServiceCode type.
+var EmptyServiceCode ServiceCode
+
+// NewServiceCode creates a new ServiceCode from an item and sub-item separated by a dot ("7.02",
+// "07.02") or from 4 digits without separator ("0702").
+// Returns EmptyServiceCode for an empty input, or an error if the code is malformed, the item is
+// not between 1 and 40 or the sub-item is zero.
+func NewServiceCode(input string) (ServiceCode, error) {
+	trimmed := strings.TrimSpace(input)
+	if trimmed == "" {
+		return EmptyServiceCode, nil
+	}
+
+	item, subItem, found := strings.Cut(trimmed, ".")
+	if !found && len(trimmed) == 4 {
+		item, subItem = trimmed[:2], trimmed[2:]
+	}
+	if item == "" || len(item) > 2 || len(subItem) != 2 || !isASCIIDigits(item) || !isASCIIDigits(subItem) {
+		return EmptyServiceCode, fault.New(
+			"service code must have the format II.SS",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+
+	itemNumber, _ := strconv.Atoi(item)
+	subItemNumber, _ := strconv.Atoi(subItem)
+	if itemNumber < 1 || itemNumber > maxServiceItem || subItemNumber == 0 {
+		return EmptyServiceCode, fault.New(
+			"service code is not in the LC 116 list",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	return ServiceCode(fmt.Sprintf("%02d.%02d", itemNumber, subItemNumber)), nil
+}
+
+// Item returns the item of the code (e.g., 7 for "07.02"), or 0 for the zero value.
+func (c ServiceCode) Item() int {
+	if c.IsZero() {
+		return 0
+	}
+	item, _ := strconv.Atoi(string(c[:2]))
+	return item
+}
+
+// SubItem returns the sub-item of the code (e.g., 2 for "07.02"), or 0 for the zero value.
+func (c ServiceCode) SubItem() int {
+	if c.IsZero() {
+		return 0
+	}
+	subItem, _ := strconv.Atoi(string(c[3:]))
+	return subItem
+}
+
+// Compact returns the code as 4 digits without the dot (e.g., "0702"), as some municipal
+// invoice systems expect.
+func (c ServiceCode) Compact() string {
+	return strings.Replace(string(c), ".", "", 1)
+}
+
+// Description returns the description of a registered code, or an empty string if it is not
+// registered.
+func (c ServiceCode) Description() string {
+	return registeredServiceCodes[c]
+}
+
+// IsRegistered checks if the code is in the registry.
+func (c ServiceCode) IsRegistered() bool {
+	_, ok := registeredServiceCodes[c]
+	return ok
+}
+
+// String returns the code in the format "II.SS".
+func (c ServiceCode) String() string {
+	return string(c)
+}
+
+// IsZero returns true if the ServiceCode is the zero value.
+func (c ServiceCode) IsZero() bool {
+	return c == EmptyServiceCode
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the ServiceCode as a JSON string.
+func (c ServiceCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a ServiceCode, with validation.
+func (c *ServiceCode) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "ServiceCode must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	code, err := NewServiceCode(s)
+	if err != nil {
+		return err
+	}
+	*c = code
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the ServiceCode as a string, or nil for the zero value.
+func (c ServiceCode) Value() (driver.Value, error) {
+	if c.IsZero() {
+		return nil, nil
+	}
+	return c.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a ServiceCode.
+func (c *ServiceCode) Scan(src interface{}) error {
+	if src == nil {
+		*c = EmptyServiceCode
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for ServiceCode",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	code, err := NewServiceCode(s)
+	if err != nil {
+		return err
+	}
+	*c = code
+	return nil
+}
@@ -0,0 +1,141 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type ServiceCodeSuite struct {
+	suite.Suite
+}
+
+func TestServiceCodeSuite(t *testing.T) {
+	suite.Run(t, new(ServiceCodeSuite))
+}
+
+func (s *ServiceCodeSuite) TearDownTest() {
+	wisp.ResetServiceCodes()
+}
+
+func (s *ServiceCodeSuite) TestNewServiceCode() {
+	s.Run("should normalize valid codes", func() {
+		testCases := map[string]string{
+			"07.02":  "07.02",
+			"7.02":   "07.02",
+			"0702":   "07.02",
+			" 1.07 ": "01.07",
+			"40.01":  "40.01",
+			"17.19":  "17.19",
+		}
+		for input, expected := range testCases {
+			code, err := wisp.NewServiceCode(input)
+			s.Require().NoError(err, input)
+			s.Equal(expected, code.String())
+		}
+	})
+
+	s.Run("should return the zero value for an empty input", func() {
+		code, err := wisp.NewServiceCode("")
+		s.Require().NoError(err)
+		s.True(code.IsZero())
+	})
+
+	s.Run("should reject malformed codes and codes outside the list", func() {
+		for _, input := range []string{"7.2", "702", "07-02", "07.002", ".02", "ab.cd", "00.01", "41.01", "07.00", "123.01"} {
+			_, err := wisp.NewServiceCode(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *ServiceCodeSuite) TestParts() {
+	code, err := wisp.NewServiceCode("0702")
+	s.Require().NoError(err)
+
+	s.Equal(7, code.Item())
+	s.Equal(2, code.SubItem())
+	s.Equal("0702", code.Compact())
+
+	s.Equal(0, wisp.EmptyServiceCode.Item())
+	s.Equal(0, wisp.EmptyServiceCode.SubItem())
+	s.Equal("", wisp.EmptyServiceCode.Compact())
+}
+
+func (s *ServiceCodeSuite) TestRegistry() {
+	s.Run("should describe built-in codes", func() {
+		code, err := wisp.NewServiceCode("1.07")
+		s.Require().NoError(err)
+		s.True(code.IsRegistered())
+		s.Contains(code.Description(), "Suporte técnico em informática")
+	})
+
+	s.Run("should return an empty description for unregistered codes", func() {
+		code, err := wisp.NewServiceCode("25.01")
+		s.Require().NoError(err)
+		s.False(code.IsRegistered())
+		s.Empty(code.Description())
+	})
+
+	s.Run("should register and override descriptions", func() {
+		s.Require().NoError(wisp.RegisterServiceCode("2501", "  Funerais  "))
+		s.Require().NoError(wisp.RegisterServiceCode("17.14", "Serviços advocatícios"))
+
+		code, _ := wisp.NewServiceCode("25.01")
+		s.Equal("Funerais", code.Description())
+		code, _ = wisp.NewServiceCode("17.14")
+		s.Equal("Serviços advocatícios", code.Description())
+
+		wisp.ResetServiceCodes()
+		s.Equal("Advocacia", code.Description())
+	})
+
+	s.Run("should reject invalid registrations", func() {
+		s.Error(wisp.RegisterServiceCode("", "Nada"))
+		s.Error(wisp.RegisterServiceCode("99.01", "Inexistente"))
+		err := wisp.RegisterServiceCode("25.01", " ")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *ServiceCodeSuite) TestSerialization() {
+	s.Run("should round-trip through JSON", func() {
+		code, _ := wisp.NewServiceCode("7.02")
+		data, err := json.Marshal(code)
+		s.Require().NoError(err)
+		s.Equal(`"07.02"`, string(data))
+
+		var decoded wisp.ServiceCode
+		s.Require().NoError(json.Unmarshal([]byte(`"0106"`), &decoded))
+		s.Equal("01.06", decoded.String())
+		s.Error(json.Unmarshal([]byte(`"41.01"`), &decoded))
+		s.Error(json.Unmarshal([]byte(`701`), &decoded))
+	})
+
+	s.Run("should store and scan database values", func() {
+		code, _ := wisp.NewServiceCode("17.01")
+		value, err := code.Value()
+		s.Require().NoError(err)
+		s.Equal("17.01", value)
+
+		value, err = wisp.EmptyServiceCode.Value()
+		s.Require().NoError(err)
+		s.Nil(value)
+
+		var scanned wisp.ServiceCode
+		s.Require().NoError(scanned.Scan([]byte("0801")))
+		s.Equal("08.01", scanned.String())
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		err = scanned.Scan(701)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
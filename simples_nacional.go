@@ -0,0 +1,295 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// SimplesAnnex represents one of the five annexes of the Simples Nacional (LC 123/2006, with the
+// tables of LC 155/2016), which set the tax rates of a company by the kind of activity it performs.
+//
+// Examples:
+//   - Input: "III", "anexo iii", "Anexo 3" or "3"
+//   - Stored as: "III"
+type SimplesAnnex string
+
+const (
+	// SimplesAnnexI covers commerce.
+	SimplesAnnexI SimplesAnnex = "I"
+	// SimplesAnnexII covers industry.
+	SimplesAnnexII SimplesAnnex = "II"
+	// SimplesAnnexIII covers services such as maintenance, travel agencies and, with Fator R of 28%
+	// or more, the activities of Annex V.
+	SimplesAnnexIII SimplesAnnex = "III"
+	// SimplesAnnexIV covers services such as construction, cleaning and surveillance, which pay the
+	// employer social security contribution (CPP) outside the Simples.
+	SimplesAnnexIV SimplesAnnex = "IV"
+	// SimplesAnnexV covers intellectual services such as engineering, auditing and technology, when
+	// Fator R is below 28%.
+	SimplesAnnexV SimplesAnnex = "V"
+)
+
+// EmptySimplesAnnex represents the zero value for the SimplesAnnex type.
+var EmptySimplesAnnex SimplesAnnex
+
+// SimplesRevenueLimit is the highest gross revenue over the last 12 months (RBT12) allowed in the
+// Simples Nacional: R$ 4.800.000,00, in centavos.
+const SimplesRevenueLimit int64 = 480_000_000
+
+// SimplesBracket is one revenue bracket ("faixa") of a Simples Nacional annex.
+type SimplesBracket struct {
+	// Number is the position of the bracket in the annex, from 1 to 6.
+	Number int
+	// UpTo is the highest RBT12 of the bracket.
+	UpTo Money
+	// NominalRate is the rate set by law for the bracket ("alíquota nominal").
+	NominalRate Percentage
+	// Deduction is the amount subtracted from RBT12 times the nominal rate ("parcela a deduzir").
+	Deduction Money
+}
+
+// simplesBracketLimits holds the highest RBT12 of each bracket, in centavos, shared by all annexes.
+var simplesBracketLimits = [6]int64{18_000_000, 36_000_000, 72_000_000, 180_000_000, 360_000_000, SimplesRevenueLimit}
+
+// simplesTables holds the nominal rate (in basis points) and the deduction (in centavos) of each
+// bracket, by annex.
+var simplesTables = map[SimplesAnnex][6][2]int64{
+	SimplesAnnexI: {
+		{400, 0}, {730, 594_000}, {950, 1_386_000}, {1070, 2_250_000}, {1430, 8_730_000}, {1900, 37_800_000},
+	},
+	SimplesAnnexII: {
+		{450, 0}, {780, 594_000}, {1000, 1_386_000}, {1120, 2_250_000}, {1470, 8_550_000}, {3000, 72_000_000},
+	},
+	SimplesAnnexIII: {
+		{600, 0}, {1120, 936_000}, {1350, 1_764_000}, {1600, 3_564_000}, {2100, 12_564_000}, {3300, 64_800_000},
+	},
+	SimplesAnnexIV: {
+		{450, 0}, {900, 810_000}, {1020, 1_242_000}, {1400, 3_978_000}, {2200, 18_378_000}, {3300, 82_800_000},
+	},
+	SimplesAnnexV: {
+		{1550, 0}, {1800, 450_000}, {1950, 990_000}, {2050, 1_710_000}, {2300, 6_210_000}, {3050, 54_000_000},
+	},
+}
+
+// simplesAnnexAliases maps the Arabic numerals accepted by NewSimplesAnnex to the annexes.
+var simplesAnnexAliases = map[string]SimplesAnnex{
+	"1": SimplesAnnexI, "2": SimplesAnnexII, "3": SimplesAnnexIII, "4": SimplesAnnexIV, "5": SimplesAnnexV,
+}
+
+// NewSimplesAnnex creates a new SimplesAnnex from a string.
+// It accepts the Roman or Arabic numeral of the annex, optionally preceded by "Anexo", ignoring case
+// (e.g., "III", "anexo iii", "Anexo 3", "3").
+// Returns EmptySimplesAnnex for an empty input, or an error if the value is not a known annex.
+func NewSimplesAnnex(input string) (SimplesAnnex, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(input))
+	if normalized == "" {
+		return EmptySimplesAnnex, nil
+	}
+
+	normalized = strings.TrimSpace(strings.TrimPrefix(normalized, "ANEXO"))
+	if alias, ok := simplesAnnexAliases[normalized]; ok {
+		normalized = string(alias)
+	}
+
+	annex := SimplesAnnex(normalized)
+	if !annex.IsValid() {
+		return EmptySimplesAnnex, fault.New(
+			"invalid Simples Nacional annex",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	return annex, nil
+}
+
+// Brackets returns the six revenue brackets of the annex, or nil for an invalid annex.
+func (a SimplesAnnex) Brackets() []SimplesBracket {
+	table, ok := simplesTables[a]
+	if !ok {
+		return nil
+	}
+
+	brackets := make([]SimplesBracket, len(table))
+	for i, row := range table {
+		brackets[i] = SimplesBracket{
+			Number:      i + 1,
+			UpTo:        Money{amount: simplesBracketLimits[i], currency: BRL},
+			NominalRate: Percentage(row[0]),
+			Deduction:   Money{amount: row[1], currency: BRL},
+		}
+	}
+	return brackets
+}
+
+// Bracket returns the bracket of the annex that applies to a gross revenue over the last 12 months
+// (RBT12), which must be in BRL.
+// Returns an error if the annex is invalid, the revenue is not in BRL or is negative, or the revenue
+// is above SimplesRevenueLimit, which excludes the company from the Simples Nacional.
+func (a SimplesAnnex) Bracket(rbt12 Money) (SimplesBracket, error) {
+	if !a.IsValid() {
+		return SimplesBracket{}, fault.New(
+			"invalid Simples Nacional annex",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("annex", a.String()),
+		)
+	}
+	if rbt12.Currency() != BRL {
+		return SimplesBracket{}, fault.New(
+			"Simples Nacional revenue must be in BRL",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("currency", rbt12.Currency().String()),
+		)
+	}
+	if rbt12.Amount() < 0 {
+		return SimplesBracket{}, fault.New(
+			"Simples Nacional revenue cannot be negative",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("rbt12", rbt12.Amount()),
+		)
+	}
+	if rbt12.Amount() > SimplesRevenueLimit {
+		return SimplesBracket{}, fault.New(
+			"revenue exceeds the Simples Nacional limit",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("rbt12", rbt12.Amount()),
+			fault.WithContext("limit", SimplesRevenueLimit),
+		)
+	}
+
+	brackets := a.Brackets()
+	for _, bracket := range brackets {
+		if rbt12.Amount() <= bracket.UpTo.Amount() {
+			return bracket, nil
+		}
+	}
+	return brackets[len(brackets)-1], nil
+}
+
+// EffectiveRate returns the rate a company actually pays over its monthly revenue ("alíquota
+// efetiva"), given its gross revenue over the last 12 months (RBT12):
+//
+//   (RBT12 × nominal rate − deduction) / RBT12
+//
+// The result is rounded to the nearest basis point. A company with no revenue yet pays the nominal
+// rate of the first bracket. Returns the same errors as Bracket.
+//
+// Example:
+//   rbt12, _ := wisp.NewMoney(50_000_000, wisp.BRL) // R$ 500.000,00
+//   rate, _ := wisp.SimplesAnnexIII.EffectiveRate(rbt12)
+//   rate.String() // "9.97%"
+func (a SimplesAnnex) EffectiveRate(rbt12 Money) (Percentage, error) {
+	bracket, err := a.Bracket(rbt12)
+	if err != nil {
+		return ZeroPercentage, err
+	}
+	if rbt12.Amount() == 0 {
+		return bracket.NominalRate, nil
+	}
+
+	numerator := rbt12.Amount()*int64(bracket.NominalRate) - bracket.Deduction.Amount()*int64(percentageFactor)
+	rate, err := mulDivRounded(numerator, 1, rbt12.Amount(), RoundHalfEven)
+	if err != nil {
+		return ZeroPercentage, err
+	}
+	return Percentage(rate), nil
+}
+
+// MonthlyTax returns the amount due in the monthly DAS for a month's revenue, applying the
+// effective rate given by the gross revenue over the last 12 months (RBT12). Both must be in BRL.
+// Returns the same errors as Bracket, or an error if the month's revenue is not in BRL or is negative.
+func (a SimplesAnnex) MonthlyTax(revenue, rbt12 Money) (Money, error) {
+	if revenue.Currency() != BRL || revenue.Amount() < 0 {
+		return ZeroMoney, fault.New(
+			"Simples Nacional monthly revenue must be a non-negative amount in BRL",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("currency", revenue.Currency().String()),
+			fault.WithContext("amount", revenue.Amount()),
+		)
+	}
+
+	rate, err := a.EffectiveRate(rbt12)
+	if err != nil {
+		return ZeroMoney, err
+	}
+	return rate.ApplyTo(revenue), nil
+}
+
+// String returns the annex as its Roman numeral.
+func (a SimplesAnnex) String() string {
+	return string(a)
+}
+
+// IsValid checks if the annex is one of the five Simples Nacional annexes.
+func (a SimplesAnnex) IsValid() bool {
+	_, ok := simplesTables[a]
+	return ok
+}
+
+// IsZero returns true if the SimplesAnnex is the zero value.
+func (a SimplesAnnex) IsZero() bool {
+	return a == EmptySimplesAnnex
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the SimplesAnnex as a JSON string.
+func (a SimplesAnnex) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a SimplesAnnex, with validation.
+func (a *SimplesAnnex) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "SimplesAnnex must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	annex, err := NewSimplesAnnex(s)
+	if err != nil {
+		return err
+	}
+	*a = annex
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the SimplesAnnex as a string, or nil for the zero value.
+func (a SimplesAnnex) Value() (driver.Value, error) {
+	if a.IsZero() {
+		return nil, nil
+	}
+	return a.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a SimplesAnnex.
+func (a *SimplesAnnex) Scan(src interface{}) error {
+	if src == nil {
+		*a = EmptySimplesAnnex
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for SimplesAnnex",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	annex, err := NewSimplesAnnex(s)
+	if err != nil {
+		return err
+	}
+	*a = annex
+	return nil
+}
@@ -0,0 +1,209 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type SimplesNacionalSuite struct {
+	suite.Suite
+}
+
+func TestSimplesNacionalSuite(t *testing.T) {
+	suite.Run(t, new(SimplesNacionalSuite))
+}
+
+func (s *SimplesNacionalSuite) brl(cents int64) wisp.Money {
+	m, err := wisp.NewMoney(cents, wisp.BRL)
+	s.Require().NoError(err)
+	return m
+}
+
+func (s *SimplesNacionalSuite) TestNewSimplesAnnex() {
+	s.Run("should accept Roman and Arabic numerals", func() {
+		testCases := map[string]wisp.SimplesAnnex{
+			"I":         wisp.SimplesAnnexI,
+			"ii":        wisp.SimplesAnnexII,
+			"anexo iii": wisp.SimplesAnnexIII,
+			"Anexo 4":   wisp.SimplesAnnexIV,
+			" 5 ":       wisp.SimplesAnnexV,
+		}
+		for input, expected := range testCases {
+			annex, err := wisp.NewSimplesAnnex(input)
+			s.Require().NoError(err, input)
+			s.Equal(expected, annex)
+		}
+	})
+
+	s.Run("should return the zero value for an empty input", func() {
+		annex, err := wisp.NewSimplesAnnex("  ")
+		s.Require().NoError(err)
+		s.True(annex.IsZero())
+	})
+
+	s.Run("should reject unknown annexes", func() {
+		for _, input := range []string{"VI", "6", "0", "anexo"} {
+			_, err := wisp.NewSimplesAnnex(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *SimplesNacionalSuite) TestBrackets() {
+	s.Run("should return the six brackets of the annex", func() {
+		brackets := wisp.SimplesAnnexIII.Brackets()
+		s.Require().Len(brackets, 6)
+		s.Equal(1, brackets[0].Number)
+		s.Equal(int64(18_000_000), brackets[0].UpTo.Amount())
+		s.Equal(int64(600), brackets[0].NominalRate.BasisPoints())
+		s.Equal(int64(0), brackets[0].Deduction.Amount())
+		s.Equal(int64(3300), brackets[5].NominalRate.BasisPoints())
+		s.Equal(int64(64_800_000), brackets[5].Deduction.Amount())
+		s.Equal(wisp.SimplesRevenueLimit, brackets[5].UpTo.Amount())
+	})
+
+	s.Run("should return nil for an invalid annex", func() {
+		s.Nil(wisp.EmptySimplesAnnex.Brackets())
+	})
+}
+
+func (s *SimplesNacionalSuite) TestBracket() {
+	s.Run("should find the bracket by RBT12, including its upper limit", func() {
+		testCases := []struct {
+			rbt12  int64
+			number int
+		}{
+			{0, 1},
+			{18_000_000, 1},
+			{18_000_001, 2},
+			{50_000_000, 3},
+			{180_000_000, 4},
+			{360_000_001, 6},
+			{wisp.SimplesRevenueLimit, 6},
+		}
+		for _, tc := range testCases {
+			bracket, err := wisp.SimplesAnnexI.Bracket(s.brl(tc.rbt12))
+			s.Require().NoError(err)
+			s.Equal(tc.number, bracket.Number, tc.rbt12)
+		}
+	})
+
+	s.Run("should return the deduction of the bracket", func() {
+		bracket, err := wisp.SimplesAnnexV.Bracket(s.brl(100_000_000))
+		s.Require().NoError(err)
+		s.Equal(int64(2050), bracket.NominalRate.BasisPoints())
+		s.Equal(int64(1_710_000), bracket.Deduction.Amount())
+		s.Equal(wisp.BRL, bracket.Deduction.Currency())
+	})
+
+	s.Run("should reject revenue above the Simples Nacional limit", func() {
+		_, err := wisp.SimplesAnnexI.Bracket(s.brl(wisp.SimplesRevenueLimit + 1))
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+
+	s.Run("should reject negative revenue, other currencies and invalid annexes", func() {
+		usd, err := wisp.NewMoney(100, wisp.USD)
+		s.Require().NoError(err)
+
+		_, err = wisp.SimplesAnnexI.Bracket(s.brl(-1))
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+		_, err = wisp.SimplesAnnexI.Bracket(usd)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+		_, err = wisp.SimplesAnnex("VI").Bracket(s.brl(100))
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *SimplesNacionalSuite) TestEffectiveRate() {
+	s.Run("should compute the effective rate from the nominal rate and deduction", func() {
+		testCases := []struct {
+			annex    wisp.SimplesAnnex
+			rbt12    int64
+			expected int64
+		}{
+			{wisp.SimplesAnnexIII, 50_000_000, 997},  // (500000 × 13.5% − 17640) / 500000 = 9.972%
+			{wisp.SimplesAnnexI, 18_000_000, 400},    // first bracket: nominal rate
+			{wisp.SimplesAnnexI, 36_000_000, 565},    // (360000 × 7.3% − 5940) / 360000 = 5.65%
+			{wisp.SimplesAnnexV, 480_000_000, 1925},  // (4.8M × 30.5% − 540000) / 4.8M = 19.25%
+			{wisp.SimplesAnnexIV, 100_000_000, 1002}, // (1M × 14% − 39780) / 1M = 10.022%
+			{wisp.SimplesAnnexII, 300_000_000, 1185}, // (3M × 14.7% − 85500) / 3M = 11.85%
+		}
+		for _, tc := range testCases {
+			rate, err := tc.annex.EffectiveRate(s.brl(tc.rbt12))
+			s.Require().NoError(err)
+			s.Equal(tc.expected, rate.BasisPoints(), "%s %d", tc.annex, tc.rbt12)
+		}
+	})
+
+	s.Run("should use the nominal rate of the first bracket when there is no revenue", func() {
+		rate, err := wisp.SimplesAnnexV.EffectiveRate(s.brl(0))
+		s.Require().NoError(err)
+		s.Equal(int64(1550), rate.BasisPoints())
+	})
+
+	s.Run("should propagate bracket errors", func() {
+		_, err := wisp.SimplesAnnexIII.EffectiveRate(s.brl(wisp.SimplesRevenueLimit + 1))
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+}
+
+func (s *SimplesNacionalSuite) TestMonthlyTax() {
+	s.Run("should apply the effective rate to the month's revenue", func() {
+		tax, err := wisp.SimplesAnnexIII.MonthlyTax(s.brl(4_000_000), s.brl(50_000_000))
+		s.Require().NoError(err)
+		s.Equal(int64(398_800), tax.Amount()) // R$ 40.000,00 × 9.97%
+	})
+
+	s.Run("should reject an invalid month's revenue", func() {
+		_, err := wisp.SimplesAnnexIII.MonthlyTax(s.brl(-100), s.brl(50_000_000))
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *SimplesNacionalSuite) TestSerialization() {
+	s.Run("should round-trip through JSON", func() {
+		data, err := json.Marshal(wisp.SimplesAnnexIV)
+		s.Require().NoError(err)
+		s.Equal(`"IV"`, string(data))
+
+		var annex wisp.SimplesAnnex
+		s.Require().NoError(json.Unmarshal([]byte(`"anexo 2"`), &annex))
+		s.Equal(wisp.SimplesAnnexII, annex)
+
+		s.Error(json.Unmarshal([]byte(`"VII"`), &annex))
+	})
+
+	s.Run("should store and scan database values", func() {
+		value, err := wisp.SimplesAnnexV.Value()
+		s.Require().NoError(err)
+		s.Equal("V", value)
+
+		value, err = wisp.EmptySimplesAnnex.Value()
+		s.Require().NoError(err)
+		s.Nil(value)
+
+		var annex wisp.SimplesAnnex
+		s.Require().NoError(annex.Scan([]byte("III")))
+		s.Equal(wisp.SimplesAnnexIII, annex)
+		s.Require().NoError(annex.Scan(nil))
+		s.True(annex.IsZero())
+
+		err = annex.Scan(3)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
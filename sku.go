@@ -0,0 +1,247 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// defaultSKUPattern is used while no pattern is registered: up to 40 uppercase alphanumeric
+// characters, with '-', '_' or '.' allowed after the first one.
+const defaultSKUPattern = `^[A-Z0-9][A-Z0-9._-]{0,39}$`
+
+// SKUPattern describes the format of the SKUs of a company or catalog.
+// Every non-zero field is checked; the value is matched after normalization (trimmed, uppercase).
+type SKUPattern struct {
+	// Regex is a regular expression the whole SKU must match (e.g., `^[A-Z]{3}-\d{4}$`).
+	Regex string
+	// MinLength is the minimum number of characters.
+	MinLength int
+	// MaxLength is the maximum number of characters.
+	MaxLength int
+	// Charset holds the only characters allowed (e.g., "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-").
+	Charset string
+}
+
+// skuRule is a registered SKUPattern with its compiled regular expression.
+type skuRule struct {
+	pattern SKUPattern
+	re      *regexp.Regexp
+}
+
+// registeredSKUPatterns holds the SKU patterns by name.
+var registeredSKUPatterns = make(map[string]skuRule)
+
+// defaultSKURule is the rule applied while no pattern is registered.
+var defaultSKURule = skuRule{re: regexp.MustCompile(defaultSKUPattern)}
+
+// RegisterSKUPattern adds a named SKU pattern to the global registry, or replaces the pattern with
+// the same name. Once a pattern is registered, NewSKU accepts only SKUs that match one of the
+// registered patterns instead of the default format.
+// This function should be called at application startup.
+// Returns an error if the name is empty, the regular expression does not compile, no rule is given,
+// or the length limits are negative or inverted.
+//
+// Example:
+//   err := wisp.RegisterSKUPattern("acme", wisp.SKUPattern{Regex: `^ACM-\d{6}$`})
+func RegisterSKUPattern(name string, pattern SKUPattern) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fault.New("SKU pattern name cannot be empty", fault.WithCode(fault.Invalid))
+	}
+	if pattern.Regex == "" && pattern.MinLength == 0 && pattern.MaxLength == 0 && pattern.Charset == "" {
+		return fault.New("SKU pattern must define at least one rule", fault.WithCode(fault.Invalid), fault.WithContext("name", name))
+	}
+	if pattern.MinLength < 0 || pattern.MaxLength < 0 || (pattern.MaxLength > 0 && pattern.MinLength > pattern.MaxLength) {
+		return fault.New(
+			"invalid SKU pattern length limits",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("name", name),
+			fault.WithContext("min_length", pattern.MinLength),
+			fault.WithContext("max_length", pattern.MaxLength),
+		)
+	}
+
+	rule := skuRule{pattern: pattern}
+	if pattern.Regex != "" {
+		re, err := regexp.Compile(pattern.Regex)
+		if err != nil {
+			return fault.Wrap(err, "invalid SKU pattern", fault.WithCode(fault.Invalid), fault.WithContext("pattern", pattern.Regex))
+		}
+		rule.re = re
+	}
+
+	registeredSKUPatterns[name] = rule
+	return nil
+}
+
+// ResetSKUPatterns removes all SKU patterns from the global registry, restoring the default format.
+// This is primarily for testing purposes to ensure a clean state.
+func ResetSKUPatterns() {
+	registeredSKUPatterns = make(map[string]skuRule)
+}
+
+// matches checks if a normalized SKU follows the rule.
+func (r skuRule) matches(sku string) bool {
+	length := utf8.RuneCountInString(sku)
+	if length < r.pattern.MinLength || (r.pattern.MaxLength > 0 && length > r.pattern.MaxLength) {
+		return false
+	}
+	if r.pattern.Charset != "" && strings.IndexFunc(sku, func(c rune) bool { return !strings.ContainsRune(r.pattern.Charset, c) }) >= 0 {
+		return false
+	}
+	return r.re == nil || r.re.MatchString(sku)
+}
+
+// SKU is a value object representing a Stock Keeping Unit, the code a company gives to each product
+// it sells or stocks. Each company has its own format, configured with RegisterSKUPattern; while no
+// pattern is registered, SKUs must have up to 40 uppercase letters, digits, '-', '_' or '.'.
+//
+// The SKU is trimmed and normalized to uppercase.
+//
+// The zero value is EmptySKU.
+//
+// Example:
+//   wisp.RegisterSKUPattern("acme", wisp.SKUPattern{Regex: `^ACM-\d{6}$`})
+//   sku, err := wisp.NewSKU("acm-000123") // "ACM-000123"
+type SKU string
+
+// EmptySKU represents the zero value for the SKU type.
+var EmptySKU SKU
+
+// NewSKU creates a new SKU, normalized to uppercase.
+// Returns EmptySKU for an empty input, or an error if the SKU does not match any registered pattern
+// (or the default format, when none is registered).
+func NewSKU(input string) (SKU, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(input))
+	if normalized == "" {
+		return EmptySKU, nil
+	}
+
+	if len(registeredSKUPatterns) == 0 {
+		if !defaultSKURule.matches(normalized) {
+			return EmptySKU, fault.New(
+				"SKU must have up to 40 letters, digits, '-', '_' or '.'",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("input_value", input),
+			)
+		}
+		return SKU(normalized), nil
+	}
+
+	for _, rule := range registeredSKUPatterns {
+		if rule.matches(normalized) {
+			return SKU(normalized), nil
+		}
+	}
+	return EmptySKU, fault.New(
+		"SKU does not match any registered pattern",
+		fault.WithCode(fault.Invalid),
+		fault.WithContext("input_value", input),
+	)
+}
+
+// NewSKUWithPattern creates a new SKU that must match the named registered pattern, for
+// applications that hold the catalogs of several companies.
+// Returns EmptySKU for an empty input, a NotFound error if the pattern is not registered, or an
+// error if the SKU does not match it.
+func NewSKUWithPattern(input, name string) (SKU, error) {
+	rule, ok := registeredSKUPatterns[strings.TrimSpace(name)]
+	if !ok {
+		return EmptySKU, fault.New("SKU pattern is not registered", fault.WithCode(fault.NotFound), fault.WithContext("name", name))
+	}
+
+	normalized := strings.ToUpper(strings.TrimSpace(input))
+	if normalized == "" {
+		return EmptySKU, nil
+	}
+	if !rule.matches(normalized) {
+		return EmptySKU, fault.New(
+			"SKU does not match the pattern",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+			fault.WithContext("name", name),
+		)
+	}
+	return SKU(normalized), nil
+}
+
+// ProductCode returns the SKU as the product code of fiscal documents.
+func (s SKU) ProductCode() ProductCode {
+	return ProductCode(s)
+}
+
+// String returns the normalized SKU.
+func (s SKU) String() string {
+	return string(s)
+}
+
+// IsZero returns true if the SKU is the zero value.
+func (s SKU) IsZero() bool {
+	return s == EmptySKU
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the SKU as a JSON string.
+func (s SKU) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into an SKU, with validation.
+func (s *SKU) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fault.Wrap(err, "SKU must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	sku, err := NewSKU(str)
+	if err != nil {
+		return err
+	}
+	*s = sku
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the SKU as a string, or nil for the zero value.
+func (s SKU) Value() (driver.Value, error) {
+	if s.IsZero() {
+		return nil, nil
+	}
+	return s.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into an SKU, with validation.
+func (s *SKU) Scan(src interface{}) error {
+	if src == nil {
+		*s = EmptySKU
+		return nil
+	}
+
+	var str string
+	switch v := src.(type) {
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for SKU",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	sku, err := NewSKU(str)
+	if err != nil {
+		return err
+	}
+	*s = sku
+	return nil
+}
@@ -0,0 +1,147 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type SKUSuite struct {
+	suite.Suite
+}
+
+func TestSKUSuite(t *testing.T) {
+	suite.Run(t, new(SKUSuite))
+}
+
+func (s *SKUSuite) TearDownTest() {
+	wisp.ResetSKUPatterns()
+}
+
+func (s *SKUSuite) TestNewSKUWithDefaultFormat() {
+	s.Run("should normalize valid SKUs", func() {
+		sku, err := wisp.NewSKU("  tsh-blk_m.01 ")
+		s.Require().NoError(err)
+		s.Equal("TSH-BLK_M.01", sku.String())
+	})
+
+	s.Run("should return the zero value for an empty input", func() {
+		sku, err := wisp.NewSKU("   ")
+		s.Require().NoError(err)
+		s.True(sku.IsZero())
+	})
+
+	s.Run("should reject SKUs outside the default format", func() {
+		for _, input := range []string{"-ABC", "AB C", "ABC/1", "ÇA-01", "A1234567890123456789012345678901234567890"} {
+			_, err := wisp.NewSKU(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *SKUSuite) TestRegisteredPatterns() {
+	s.Run("should accept only SKUs matching a registered pattern", func() {
+		s.Require().NoError(wisp.RegisterSKUPattern("acme", wisp.SKUPattern{Regex: `^ACM-\d{6}$`}))
+		s.Require().NoError(wisp.RegisterSKUPattern("globex", wisp.SKUPattern{MinLength: 8, MaxLength: 8, Charset: "0123456789ABCDEF"}))
+
+		for _, input := range []string{"acm-000123", "00FF12AB"} {
+			_, err := wisp.NewSKU(input)
+			s.NoError(err, input)
+		}
+		for _, input := range []string{"TSH-BLK-M", "ACM-12345", "00FF12AG", "00FF12A"} {
+			_, err := wisp.NewSKU(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+
+	s.Run("should validate against a named pattern", func() {
+		s.Require().NoError(wisp.RegisterSKUPattern("acme", wisp.SKUPattern{Regex: `^ACM-\d{6}$`}))
+		s.Require().NoError(wisp.RegisterSKUPattern("globex", wisp.SKUPattern{MaxLength: 8, Charset: "0123456789ABCDEF"}))
+
+		sku, err := wisp.NewSKUWithPattern("acm-000123", "acme")
+		s.Require().NoError(err)
+		s.Equal("ACM-000123", sku.String())
+
+		_, err = wisp.NewSKUWithPattern("00FF12AB", "acme")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+		_, err = wisp.NewSKUWithPattern("ACM-000123", "initech")
+		s.Require().Error(err)
+		s.Equal(fault.NotFound, err.(*fault.Error).Code)
+	})
+
+	s.Run("should restore the default format on reset", func() {
+		s.Require().NoError(wisp.RegisterSKUPattern("acme", wisp.SKUPattern{Regex: `^ACM-\d{6}$`}))
+		wisp.ResetSKUPatterns()
+
+		_, err := wisp.NewSKU("TSH-BLK-M")
+		s.NoError(err)
+	})
+
+	s.Run("should reject invalid patterns", func() {
+		testCases := map[string]wisp.SKUPattern{
+			"regex":    {Regex: `^[A-Z`},
+			"no rules": {},
+			"negative": {MinLength: -1},
+			"inverted": {MinLength: 10, MaxLength: 5},
+		}
+		for name, pattern := range testCases {
+			err := wisp.RegisterSKUPattern(name, pattern)
+			s.Require().Error(err, name)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+		s.Error(wisp.RegisterSKUPattern(" ", wisp.SKUPattern{MaxLength: 10}))
+
+		_, err := wisp.NewSKU("TSH-BLK-M")
+		s.NoError(err, "failed registrations must not replace the default format")
+	})
+}
+
+func (s *SKUSuite) TestProductCode() {
+	sku, err := wisp.NewSKU("tsh-01")
+	s.Require().NoError(err)
+	s.Equal(wisp.ProductCode("TSH-01"), sku.ProductCode())
+}
+
+func (s *SKUSuite) TestSerialization() {
+	s.Run("should round-trip through JSON", func() {
+		sku, _ := wisp.NewSKU("tsh-01")
+		data, err := json.Marshal(sku)
+		s.Require().NoError(err)
+		s.Equal(`"TSH-01"`, string(data))
+
+		var decoded wisp.SKU
+		s.Require().NoError(json.Unmarshal([]byte(`"abc-9"`), &decoded))
+		s.Equal("ABC-9", decoded.String())
+		s.Error(json.Unmarshal([]byte(`"a b"`), &decoded))
+		s.Error(json.Unmarshal([]byte(`12`), &decoded))
+	})
+
+	s.Run("should store and scan database values", func() {
+		sku, _ := wisp.NewSKU("tsh-01")
+		value, err := sku.Value()
+		s.Require().NoError(err)
+		s.Equal("TSH-01", value)
+
+		value, err = wisp.EmptySKU.Value()
+		s.Require().NoError(err)
+		s.Nil(value)
+
+		var scanned wisp.SKU
+		s.Require().NoError(scanned.Scan([]byte("tsh-02")))
+		s.Equal("TSH-02", scanned.String())
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		err = scanned.Scan(12)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
@@ -25,8 +25,21 @@ var (
 		"£", " pound ",
 		"+", " plus ",
 	)
+	canonicalSlugRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
 )
 
+// strictSlugScan controls whether Slug.Scan validates values read from the database.
+// It can be configured globally using SetSlugStrictScan.
+var strictSlugScan = false
+
+// SetSlugStrictScan configures how Slug.Scan treats values read from the database.
+// In permissive mode (the default) values are trusted and stored as-is.
+// In strict mode Scan rejects any value that is not already a normalized slug,
+// surfacing corrupt data instead of silently propagating it.
+func SetSlugStrictScan(strict bool) {
+	strictSlugScan = strict
+}
+
 // Slug represents a URL-friendly string identifier that follows web standards.
 // It automatically normalizes input text to create safe, consistent URLs.
 //
@@ -152,8 +165,9 @@ func (s Slug) Value() (driver.Value, error) {
 }
 
 // Scan implements the sql.Scanner interface for database retrieval.
-// It accepts string or []byte values and stores them as-is (assuming they're already normalized).
-// For proper validation, use NewSlug when creating slugs from user input.
+// It accepts string or []byte values. In permissive mode (the default) they are stored as-is,
+// assuming they're already normalized. In strict mode (see SetSlugStrictScan) a value that is
+// not a normalized slug returns an error.
 func (s *Slug) Scan(src interface{}) error {
 	if src == nil {
 		*s = EmptySlug
@@ -170,9 +184,14 @@ func (s *Slug) Scan(src interface{}) error {
 		return fault.New("unsupported scan type for Slug", fault.WithCode(fault.Invalid))
 	}
 
-	if str == "" {
-		*s = EmptySlug
-		return nil
+	if strictSlugScan && str != "" && !canonicalSlugRegex.MatchString(str) {
+		normalized, _ := NewSlug(str)
+		return fault.New(
+			"malformed slug read from database",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("scanned_value", str),
+			fault.WithContext("normalized_value", normalized.String()),
+		)
 	}
 
 	*s = Slug(str)
@@ -53,3 +53,60 @@ func (s *SlugSuite) TestSlug_Equals() {
 	s.True(s1.Equals(s2))
 	s.False(s1.Equals(s3))
 }
+
+func (s *SlugSuite) TestSlug_Scan() {
+	s.Run("should scan a string", func() {
+		var slug wisp.Slug
+		s.Require().NoError(slug.Scan("hello-world"))
+		s.Equal(wisp.Slug("hello-world"), slug)
+	})
+
+	s.Run("should scan a byte slice", func() {
+		var slug wisp.Slug
+		s.Require().NoError(slug.Scan([]byte("hello-world")))
+		s.Equal(wisp.Slug("hello-world"), slug)
+	})
+
+	s.Run("should scan empty values and nil as EmptySlug", func() {
+		var slug wisp.Slug
+		s.Require().NoError(slug.Scan([]byte{}))
+		s.True(slug.IsZero())
+		s.Require().NoError(slug.Scan(nil))
+		s.True(slug.IsZero())
+	})
+
+	s.Run("should fail to scan an incompatible type", func() {
+		var slug wisp.Slug
+		s.Require().Error(slug.Scan(42))
+	})
+}
+
+func (s *SlugSuite) TestSlug_ScanStrict() {
+	s.T().Cleanup(func() { wisp.SetSlugStrictScan(false) })
+
+	s.Run("should store malformed values as-is in permissive mode", func() {
+		wisp.SetSlugStrictScan(false)
+		var slug wisp.Slug
+		s.Require().NoError(slug.Scan("Hello World"))
+		s.Equal(wisp.Slug("Hello World"), slug)
+	})
+
+	s.Run("should accept normalized values in strict mode", func() {
+		wisp.SetSlugStrictScan(true)
+		var slug wisp.Slug
+		s.Require().NoError(slug.Scan([]byte("hello-world-2")))
+		s.Equal(wisp.Slug("hello-world-2"), slug)
+		s.Require().NoError(slug.Scan(""))
+		s.True(slug.IsZero())
+	})
+
+	s.Run("should reject malformed values in strict mode", func() {
+		wisp.SetSlugStrictScan(true)
+		for _, input := range []string{"Hello World", "hello--world", "-hello", "hello-", "café"} {
+			slug := wisp.Slug("previous")
+			err := slug.Scan(input)
+			s.Require().Error(err, input)
+			s.Equal(wisp.Slug("previous"), slug)
+		}
+	})
+}
@@ -0,0 +1,264 @@
+package wisp
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// SortDirection is the direction of a SortOrder.
+type SortDirection string
+
+// Defines the sort directions.
+const (
+	SortAscending  SortDirection = "asc"
+	SortDescending SortDirection = "desc"
+)
+
+// sortFieldRegex restricts sort fields to identifiers, optionally qualified ("users.name"),
+// so they are safe to use in ORDER BY clauses.
+var sortFieldRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)?$`)
+
+// sortFields holds the fields each resource can be sorted by.
+var sortFields = make(map[string]map[string]struct{})
+
+// RegisterSortFields adds fields to the allow-list of a resource (e.g., "users"), making them valid
+// for ParseSortOrder. Fields are lowercased; fields that are not identifiers are ignored.
+// This function should be called at application startup.
+//
+// Example:
+//   wisp.RegisterSortFields("orders", "created_at", "total", "status")
+func RegisterSortFields(resource string, fields ...string) {
+	allowed, ok := sortFields[resource]
+	if !ok {
+		allowed = make(map[string]struct{}, len(fields))
+		sortFields[resource] = allowed
+	}
+	for _, f := range fields {
+		normalized := strings.ToLower(strings.TrimSpace(f))
+		if sortFieldRegex.MatchString(normalized) {
+			allowed[normalized] = struct{}{}
+		}
+	}
+}
+
+// ClearSortFields removes the allow-lists of all resources.
+// This is primarily for testing purposes to ensure a clean state.
+func ClearSortFields() {
+	sortFields = make(map[string]map[string]struct{})
+}
+
+// SortOrder is a value object representing one sort key of a list endpoint: a field from the
+// allow-list of the resource and a direction. Since the field must be an identifier, the order can
+// be written into an ORDER BY clause with SQL.
+//
+// The text form follows the common query-string convention: "name" sorts ascending, "-name"
+// sorts descending, and "name:asc" or "name:desc" are also accepted.
+//
+// Orders decoded from JSON have only their syntax checked, since no resource is known there; SQL
+// returns an empty string for them until they are checked with ForResource.
+//
+// The zero value is ZeroSortOrder, meaning no particular order.
+//
+// Example:
+//   wisp.RegisterSortFields("orders", "created_at", "total")
+//   orders, err := wisp.ParseSortOrders("orders", r.URL.Query().Get("sort")) // "-created_at,total"
+//   orders[0].SQL() // "created_at DESC"
+type SortOrder struct {
+	field     string
+	direction SortDirection
+	allowed   bool
+}
+
+// ZeroSortOrder represents the zero value for the SortOrder type.
+var ZeroSortOrder SortOrder
+
+// NewSortOrder creates a SortOrder for a field in the allow-list of the resource.
+// Returns an error if the direction is invalid, or a DomainViolation error if the field is not
+// allowed for the resource.
+func NewSortOrder(resource, field string, direction SortDirection) (SortOrder, error) {
+	if direction != SortAscending && direction != SortDescending {
+		return ZeroSortOrder, fault.New(
+			"sort direction must be asc or desc",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("direction", direction),
+		)
+	}
+
+	normalized := strings.ToLower(strings.TrimSpace(field))
+	if _, ok := sortFields[resource][normalized]; !ok {
+		return ZeroSortOrder, fault.New(
+			"sort field is not allowed",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("resource", resource),
+			fault.WithContext("field", field),
+		)
+	}
+	return SortOrder{field: normalized, direction: direction, allowed: true}, nil
+}
+
+// ParseSortOrder parses a single sort key in text form ("name", "-name", "name:desc") for the
+// resource. Returns ZeroSortOrder for an empty input.
+func ParseSortOrder(resource, s string) (SortOrder, error) {
+	field, direction, err := parseSortText(s)
+	if err != nil || field == "" {
+		return ZeroSortOrder, err
+	}
+	return NewSortOrder(resource, field, direction)
+}
+
+// ParseSortOrders parses a comma-separated list of sort keys ("-created_at,name") for the resource,
+// as received in a "sort" query parameter. Returns nil for an empty input, or an error if a key is
+// invalid or a field is repeated.
+func ParseSortOrders(resource, s string) ([]SortOrder, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(s, ",")
+	orders := make([]SortOrder, 0, len(parts))
+	for _, part := range parts {
+		order, err := ParseSortOrder(resource, part)
+		if err != nil {
+			return nil, err
+		}
+		if order.IsZero() {
+			return nil, fault.New("sort key cannot be empty", fault.WithCode(fault.Invalid), fault.WithContext("input_value", s))
+		}
+		for _, existing := range orders {
+			if existing.field == order.field {
+				return nil, fault.New(
+					"sort field is repeated",
+					fault.WithCode(fault.Invalid),
+					fault.WithContext("field", order.field),
+				)
+			}
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
+
+// parseSortText splits a sort key in text form into its field and direction.
+func parseSortText(s string) (string, SortDirection, error) {
+	text := strings.TrimSpace(s)
+	if text == "" {
+		return "", "", nil
+	}
+
+	direction := SortAscending
+	if rest, ok := strings.CutPrefix(text, "-"); ok {
+		text, direction = rest, SortDescending
+	} else if field, dir, ok := strings.Cut(text, ":"); ok {
+		text, direction = field, SortDirection(strings.ToLower(strings.TrimSpace(dir)))
+	}
+
+	field := strings.ToLower(strings.TrimSpace(text))
+	if !sortFieldRegex.MatchString(field) {
+		return "", "", fault.New(
+			"sort field must be an identifier",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", s),
+		)
+	}
+	if direction != SortAscending && direction != SortDescending {
+		return "", "", fault.New(
+			"sort direction must be asc or desc",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", s),
+		)
+	}
+	return field, direction, nil
+}
+
+// Field returns the sort field.
+func (o SortOrder) Field() string {
+	return o.field
+}
+
+// Direction returns the sort direction.
+func (o SortOrder) Direction() SortDirection {
+	return o.direction
+}
+
+// ForResource checks the order against the allow-list of the resource, as required for orders
+// decoded from JSON before they are used in SQL. Returns ZeroSortOrder for the zero value, or a
+// DomainViolation error if the field is not allowed for the resource.
+//
+// Example:
+//   order, err := req.Sort.ForResource("orders")
+func (o SortOrder) ForResource(resource string) (SortOrder, error) {
+	if o.IsZero() {
+		return ZeroSortOrder, nil
+	}
+	return NewSortOrder(resource, o.field, o.direction)
+}
+
+// IsAllowed returns true if the field was checked against the allow-list of a resource, so the
+// order can be used in SQL.
+func (o SortOrder) IsAllowed() bool {
+	return o.allowed
+}
+
+// IsDescending returns true if the order is descending.
+func (o SortOrder) IsDescending() bool {
+	return o.direction == SortDescending
+}
+
+// IsZero returns true if the SortOrder is the zero value.
+func (o SortOrder) IsZero() bool {
+	return o == ZeroSortOrder
+}
+
+// Reverse returns the order in the opposite direction, as used to fetch the previous page.
+func (o SortOrder) Reverse() SortOrder {
+	switch o.direction {
+	case SortAscending:
+		o.direction = SortDescending
+	case SortDescending:
+		o.direction = SortAscending
+	}
+	return o
+}
+
+// String returns the order in text form: "name" when ascending and "-name" when descending.
+func (o SortOrder) String() string {
+	if o.direction == SortDescending {
+		return "-" + o.field
+	}
+	return o.field
+}
+
+// SQL returns the order as an ORDER BY term (e.g., "created_at DESC"), or an empty string for the
+// zero value and for orders whose field was not checked against an allow-list (see ForResource).
+func (o SortOrder) SQL() string {
+	if !o.allowed {
+		return ""
+	}
+	return o.field + " " + strings.ToUpper(string(o.direction))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the SortOrder in text form ("-created_at").
+func (o SortOrder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a sort key in text form. Since no resource is known here, only the syntax of
+// the field is validated, and the order cannot be used in SQL until it is checked with
+// ForResource.
+func (o *SortOrder) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "SortOrder must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	field, direction, err := parseSortText(s)
+	if err != nil {
+		return err
+	}
+	*o = SortOrder{field: field, direction: direction}
+	return nil
+}
@@ -0,0 +1,131 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type SortOrderSuite struct {
+	suite.Suite
+}
+
+func TestSortOrderSuite(t *testing.T) {
+	suite.Run(t, new(SortOrderSuite))
+}
+
+func (s *SortOrderSuite) SetupTest() {
+	wisp.RegisterSortFields("orders", "created_at", "Total", "customers.name", "bad field")
+}
+
+func (s *SortOrderSuite) TearDownTest() {
+	wisp.ClearSortFields()
+}
+
+func (s *SortOrderSuite) TestParseSortOrder() {
+	testCases := []struct {
+		input     string
+		field     string
+		direction wisp.SortDirection
+	}{
+		{input: "created_at", field: "created_at", direction: wisp.SortAscending},
+		{input: "-created_at", field: "created_at", direction: wisp.SortDescending},
+		{input: " total:DESC ", field: "total", direction: wisp.SortDescending},
+		{input: "total:asc", field: "total", direction: wisp.SortAscending},
+		{input: "customers.name", field: "customers.name", direction: wisp.SortAscending},
+	}
+	for _, tc := range testCases {
+		order, err := wisp.ParseSortOrder("orders", tc.input)
+		s.Require().NoError(err, tc.input)
+		s.Equal(tc.field, order.Field())
+		s.Equal(tc.direction, order.Direction())
+	}
+
+	order, err := wisp.ParseSortOrder("orders", "")
+	s.Require().NoError(err)
+	s.True(order.IsZero())
+
+	s.Run("should reject fields outside the allow-list", func() {
+		for _, input := range []string{"status", "-password", "bad field"} {
+			_, err := wisp.ParseSortOrder("orders", input)
+			s.Require().Error(err, input)
+		}
+		_, err := wisp.ParseSortOrder("orders", "status")
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+		_, err = wisp.ParseSortOrder("users", "created_at")
+		s.Error(err)
+	})
+
+	s.Run("should reject malformed keys", func() {
+		for _, input := range []string{"total;drop table", "total:up", "--total", "1total"} {
+			_, err := wisp.ParseSortOrder("orders", input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *SortOrderSuite) TestParseSortOrders() {
+	orders, err := wisp.ParseSortOrders("orders", "-created_at,total")
+	s.Require().NoError(err)
+	s.Require().Len(orders, 2)
+	s.Equal("-created_at", orders[0].String())
+	s.Equal("total", orders[1].String())
+
+	orders, err = wisp.ParseSortOrders("orders", "  ")
+	s.Require().NoError(err)
+	s.Nil(orders)
+
+	_, err = wisp.ParseSortOrders("orders", "total,-total")
+	s.Error(err)
+	_, err = wisp.ParseSortOrders("orders", "total,,created_at")
+	s.Error(err)
+	_, err = wisp.ParseSortOrders("orders", "total,status")
+	s.Error(err)
+}
+
+func (s *SortOrderSuite) TestNewSortOrder() {
+	order, err := wisp.NewSortOrder("orders", "Created_At", wisp.SortDescending)
+	s.Require().NoError(err)
+	s.True(order.IsDescending())
+	s.Equal("created_at DESC", order.SQL())
+
+	reversed := order.Reverse()
+	s.False(reversed.IsDescending())
+	s.Equal("created_at ASC", reversed.SQL())
+	s.Equal("", wisp.ZeroSortOrder.SQL())
+
+	_, err = wisp.NewSortOrder("orders", "total", "up")
+	s.Error(err)
+}
+
+func (s *SortOrderSuite) TestJSON() {
+	order, _ := wisp.ParseSortOrder("orders", "-total")
+	data, err := json.Marshal(order)
+	s.Require().NoError(err)
+	s.Equal(`"-total"`, string(data))
+
+	var decoded wisp.SortOrder
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(order.String(), decoded.String())
+	s.False(decoded.IsAllowed())
+	s.Equal("", decoded.SQL())
+
+	allowed, err := decoded.ForResource("orders")
+	s.Require().NoError(err)
+	s.Equal(order, allowed)
+	s.Equal("total DESC", allowed.SQL())
+
+	s.Require().NoError(json.Unmarshal([]byte(`"password_hash"`), &decoded))
+	s.Equal("", decoded.SQL())
+	_, err = decoded.ForResource("orders")
+	s.Require().Error(err)
+	s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+
+	s.Error(json.Unmarshal([]byte(`"total desc"`), &decoded))
+	s.Error(json.Unmarshal([]byte(`1`), &decoded))
+}
@@ -0,0 +1,278 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// StateMachine is a generic value object that holds the current state of a workflow
+// (e.g., an order going from "pending" to "paid" to "shipped") and only allows moves
+// that were explicitly registered for its state type.
+//
+// The allowed states and transitions are registered once per state type with
+// RegisterStateTransitions, usually at application startup. Any state that appears
+// in the registration (as a source or as a target) is a valid state.
+//
+// All operations are immutable: Transition returns a new StateMachine.
+//
+// Example:
+//   type OrderState string
+//
+//   wisp.RegisterStateTransitions(map[OrderState][]OrderState{
+//       "pending": {"paid", "cancelled"},
+//       "paid":    {"shipped"},
+//   })
+//
+//   sm, _ := wisp.NewStateMachine[OrderState]("pending")
+//   sm, err := sm.Transition("paid", actor)    // ok
+//   _, err = sm.Transition("pending", actor)   // fault.DomainViolation
+type StateMachine[T ~string] struct {
+	current T
+}
+
+// stateTransitionRegistry holds, for each state type, the allowed targets of every registered state.
+// The values are of type map[T]map[T]struct{} for the corresponding T.
+var stateTransitionRegistry = make(map[reflect.Type]any)
+
+// transitionsFor returns the registered transitions for the state type T, or nil if none were registered.
+func transitionsFor[T ~string]() map[T]map[T]struct{} {
+	transitions, _ := stateTransitionRegistry[reflect.TypeFor[T]()].(map[T]map[T]struct{})
+	return transitions
+}
+
+// RegisterStateTransitions adds allowed transitions for the state type T.
+// Each key is a source state and its slice lists the states it may move to.
+// Target states are registered as valid states as well, so terminal states need no entry of their own.
+// Calling it more than once for the same type merges the transitions. Empty states are ignored.
+func RegisterStateTransitions[T ~string](transitions map[T][]T) {
+	registered := transitionsFor[T]()
+	if registered == nil {
+		registered = make(map[T]map[T]struct{})
+		stateTransitionRegistry[reflect.TypeFor[T]()] = registered
+	}
+
+	ensure := func(state T) map[T]struct{} {
+		targets, ok := registered[state]
+		if !ok {
+			targets = make(map[T]struct{})
+			registered[state] = targets
+		}
+		return targets
+	}
+
+	for from, targets := range transitions {
+		if from == "" {
+			continue
+		}
+		allowed := ensure(from)
+		for _, to := range targets {
+			if to == "" {
+				continue
+			}
+			ensure(to)
+			allowed[to] = struct{}{}
+		}
+	}
+}
+
+// ClearStateTransitions removes all registered states and transitions for the state type T.
+// This is primarily for testing purposes to ensure a clean state.
+func ClearStateTransitions[T ~string]() {
+	delete(stateTransitionRegistry, reflect.TypeFor[T]())
+}
+
+// IsRegisteredState checks if the state was registered for its type via RegisterStateTransitions.
+func IsRegisteredState[T ~string](state T) bool {
+	_, ok := transitionsFor[T]()[state]
+	return ok
+}
+
+// NewStateMachine creates a new StateMachine in the given initial state.
+// Returns an error if the state was not registered for its type.
+func NewStateMachine[T ~string](initial T) (StateMachine[T], error) {
+	if !IsRegisteredState(initial) {
+		return StateMachine[T]{}, fault.New(
+			"state is not registered for this state machine",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("state", string(initial)),
+			fault.WithContext("state_type", reflect.TypeFor[T]().String()),
+		)
+	}
+	return StateMachine[T]{current: initial}, nil
+}
+
+// Current returns the current state.
+func (sm StateMachine[T]) Current() T {
+	return sm.current
+}
+
+// Is checks if the machine is currently in the given state.
+func (sm StateMachine[T]) Is(state T) bool {
+	return sm.current == state
+}
+
+// IsZero returns true if the StateMachine has no state.
+func (sm StateMachine[T]) IsZero() bool {
+	return sm.current == ""
+}
+
+// CanTransitionTo checks if moving from the current state to the given state is allowed.
+func (sm StateMachine[T]) CanTransitionTo(to T) bool {
+	_, ok := transitionsFor[T]()[sm.current][to]
+	return ok
+}
+
+// AllowedTransitions returns the states reachable from the current state, sorted alphabetically.
+func (sm StateMachine[T]) AllowedTransitions() []T {
+	targets := transitionsFor[T]()[sm.current]
+	allowed := make([]T, 0, len(targets))
+	for to := range targets {
+		allowed = append(allowed, to)
+	}
+	sort.Slice(allowed, func(i, j int) bool { return allowed[i] < allowed[j] })
+	return allowed
+}
+
+// IsTerminal returns true if no transitions are allowed from the current state.
+func (sm StateMachine[T]) IsTerminal() bool {
+	return !sm.IsZero() && len(transitionsFor[T]()[sm.current]) == 0
+}
+
+// Transition returns a new StateMachine moved to the given state on behalf of actor.
+// It returns a fault.DomainViolation error if the move is not registered,
+// and a fault.Invalid error if the target state is unknown or the machine has no state.
+func (sm StateMachine[T]) Transition(to T, actor AuditUser) (StateMachine[T], error) {
+	if sm.IsZero() {
+		return sm, fault.New(
+			"cannot transition a state machine without a current state",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("to_state", string(to)),
+			fault.WithContext("actor", actor.String()),
+		)
+	}
+
+	if !IsRegisteredState(to) {
+		return sm, fault.New(
+			"target state is not registered for this state machine",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("from_state", string(sm.current)),
+			fault.WithContext("to_state", string(to)),
+			fault.WithContext("actor", actor.String()),
+		)
+	}
+
+	if !sm.CanTransitionTo(to) {
+		return sm, fault.New(
+			"state transition is not allowed",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("from_state", string(sm.current)),
+			fault.WithContext("to_state", string(to)),
+			fault.WithContext("actor", actor.String()),
+		)
+	}
+
+	return StateMachine[T]{current: to}, nil
+}
+
+// TransitionAndTouch performs Transition and, if it succeeds, records the change
+// on the entity's audit trail by calling audit.Touch(actor).
+// The audit trail is left untouched when the transition fails.
+func (sm StateMachine[T]) TransitionAndTouch(to T, actor AuditUser, audit *Audit) (StateMachine[T], error) {
+	next, err := sm.Transition(to, actor)
+	if err != nil {
+		return sm, err
+	}
+	if audit != nil {
+		audit.Touch(actor)
+	}
+	return next, nil
+}
+
+// String returns the current state as a string.
+func (sm StateMachine[T]) String() string {
+	return string(sm.current)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the current state as a JSON string, or null if the machine has no state.
+func (sm StateMachine[T]) MarshalJSON() ([]byte, error) {
+	if sm.IsZero() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(sm.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a StateMachine, validating the state against the registry.
+func (sm *StateMachine[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*sm = StateMachine[T]{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "StateMachine must be a valid JSON string or null", fault.WithCode(fault.Invalid))
+	}
+
+	if s == "" {
+		*sm = StateMachine[T]{}
+		return nil
+	}
+
+	machine, err := NewStateMachine(T(s))
+	if err != nil {
+		return err
+	}
+	*sm = machine
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the current state as a string or nil if the machine has no state.
+func (sm StateMachine[T]) Value() (driver.Value, error) {
+	if sm.IsZero() {
+		return nil, nil
+	}
+	return sm.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values and validates them against the registered states.
+func (sm *StateMachine[T]) Scan(src interface{}) error {
+	if src == nil {
+		*sm = StateMachine[T]{}
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for StateMachine",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	if s == "" {
+		*sm = StateMachine[T]{}
+		return nil
+	}
+
+	machine, err := NewStateMachine(T(s))
+	if err != nil {
+		return err
+	}
+	*sm = machine
+	return nil
+}
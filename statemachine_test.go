@@ -0,0 +1,174 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type orderState string
+
+const (
+	orderPending   orderState = "pending"
+	orderPaid      orderState = "paid"
+	orderShipped   orderState = "shipped"
+	orderCancelled orderState = "cancelled"
+)
+
+type StateMachineSuite struct {
+	suite.Suite
+	actor wisp.AuditUser
+}
+
+func TestStateMachineSuite(t *testing.T) {
+	suite.Run(t, new(StateMachineSuite))
+}
+
+func (s *StateMachineSuite) SetupTest() {
+	wisp.ClearStateTransitions[orderState]()
+	wisp.RegisterStateTransitions(map[orderState][]orderState{
+		orderPending: {orderPaid, orderCancelled},
+		orderPaid:    {orderShipped, orderCancelled},
+	})
+	s.actor, _ = wisp.NewAuditUser("clerk@example.com")
+}
+
+func (s *StateMachineSuite) TestNewStateMachine() {
+	s.Run("should create a machine in a registered state", func() {
+		sm, err := wisp.NewStateMachine(orderPending)
+		s.Require().NoError(err)
+		s.Equal(orderPending, sm.Current())
+		s.True(sm.Is(orderPending))
+		s.False(sm.IsZero())
+	})
+
+	s.Run("should accept target-only states", func() {
+		sm, err := wisp.NewStateMachine(orderShipped)
+		s.Require().NoError(err)
+		s.True(sm.IsTerminal())
+	})
+
+	s.Run("should fail for an unregistered state", func() {
+		_, err := wisp.NewStateMachine[orderState]("lost")
+		s.Require().Error(err)
+		faultErr, ok := err.(*fault.Error)
+		s.Require().True(ok)
+		s.Equal(fault.Invalid, faultErr.Code)
+	})
+
+	s.Run("should keep registries separate per type", func() {
+		type otherState string
+		_, err := wisp.NewStateMachine[otherState]("pending")
+		s.Require().Error(err)
+	})
+}
+
+func (s *StateMachineSuite) TestStateMachine_Transition() {
+	sm, _ := wisp.NewStateMachine(orderPending)
+
+	s.Run("should follow allowed transitions immutably", func() {
+		paid, err := sm.Transition(orderPaid, s.actor)
+		s.Require().NoError(err)
+		s.Equal(orderPaid, paid.Current())
+		s.Equal(orderPending, sm.Current())
+
+		shipped, err := paid.Transition(orderShipped, s.actor)
+		s.Require().NoError(err)
+		s.True(shipped.IsTerminal())
+	})
+
+	s.Run("should reject illegal moves with a domain violation", func() {
+		_, err := sm.Transition(orderShipped, s.actor)
+		s.Require().Error(err)
+		faultErr, ok := err.(*fault.Error)
+		s.Require().True(ok)
+		s.Equal(fault.DomainViolation, faultErr.Code)
+	})
+
+	s.Run("should reject unknown target states", func() {
+		_, err := sm.Transition("lost", s.actor)
+		s.Require().Error(err)
+		faultErr, ok := err.(*fault.Error)
+		s.Require().True(ok)
+		s.Equal(fault.Invalid, faultErr.Code)
+	})
+
+	s.Run("should reject transitions from the zero value", func() {
+		var zero wisp.StateMachine[orderState]
+		_, err := zero.Transition(orderPaid, s.actor)
+		s.Require().Error(err)
+	})
+
+	s.Run("should list allowed transitions", func() {
+		s.Equal([]orderState{orderCancelled, orderPaid}, sm.AllowedTransitions())
+		s.True(sm.CanTransitionTo(orderPaid))
+		s.False(sm.CanTransitionTo(orderShipped))
+	})
+}
+
+func (s *StateMachineSuite) TestStateMachine_TransitionAndTouch() {
+	sm, _ := wisp.NewStateMachine(orderPending)
+	creator, _ := wisp.NewAuditUser("creator@example.com")
+
+	s.Run("should touch the audit trail on success", func() {
+		audit := wisp.NewAudit(creator)
+
+		next, err := sm.TransitionAndTouch(orderPaid, s.actor, &audit)
+		s.Require().NoError(err)
+		s.Equal(orderPaid, next.Current())
+		s.Equal(s.actor, audit.UpdatedBy)
+		s.Equal(wisp.Version(2), audit.Version)
+	})
+
+	s.Run("should leave the audit trail untouched on failure", func() {
+		audit := wisp.NewAudit(creator)
+
+		_, err := sm.TransitionAndTouch(orderShipped, s.actor, &audit)
+		s.Require().Error(err)
+		s.Equal(creator, audit.UpdatedBy)
+		s.Equal(wisp.Version(1), audit.Version)
+	})
+}
+
+func (s *StateMachineSuite) TestStateMachine_Serialization() {
+	sm, _ := wisp.NewStateMachine(orderPaid)
+
+	s.Run("JSON", func() {
+		data, err := json.Marshal(sm)
+		s.Require().NoError(err)
+		s.Equal(`"paid"`, string(data))
+
+		var decoded wisp.StateMachine[orderState]
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(sm, decoded)
+
+		s.Require().Error(json.Unmarshal([]byte(`"lost"`), &decoded))
+
+		s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+		s.True(decoded.IsZero())
+	})
+
+	s.Run("SQL", func() {
+		val, err := sm.Value()
+		s.Require().NoError(err)
+		s.Equal("paid", val)
+
+		var scanned wisp.StateMachine[orderState]
+		s.Require().NoError(scanned.Scan([]byte("paid")))
+		s.Equal(sm, scanned)
+
+		s.Require().Error(scanned.Scan("lost"))
+		s.Require().Error(scanned.Scan(42))
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+
+		nilVal, err := scanned.Value()
+		s.Require().NoError(err)
+		s.Nil(nilVal)
+	})
+}
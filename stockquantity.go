@@ -0,0 +1,330 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// ErrInsufficientStock is returned when a reservation asks for more than the available stock.
+var ErrInsufficientStock = fault.New("insufficient stock quantity", fault.WithCode(fault.Conflict))
+
+// ErrInsufficientReservation is returned when releasing or committing more than is currently reserved.
+var ErrInsufficientReservation = fault.New("quantity exceeds the reserved stock", fault.WithCode(fault.Conflict))
+
+// StockQuantity is a value object representing the stock of an item in an inventory aggregate.
+// It tracks the quantity on hand and the part of it that is reserved for pending orders,
+// with the same unit and precision as the Quantity it was created from.
+//
+// The stock lifecycle is:
+//   - Reserve: sets aside available stock for an order.
+//   - Release: returns reserved stock to the available pool (e.g., the order was cancelled).
+//   - Commit: consumes reserved stock, removing it from the quantity on hand (e.g., the order shipped).
+//   - Restock: adds new stock on hand.
+//
+// All operations are immutable, returning a new StockQuantity instance.
+//
+// Example:
+//   wisp.RegisterUnits("KG")
+//   onHand, _ := wisp.NewQuantity(10, "KG")
+//   stock, _ := wisp.NewStockQuantity(onHand)
+//   order, _ := wisp.NewQuantity(2.5, "KG")
+//   stock, err := stock.Reserve(order) // 7.5 KG available
+//   stock, err = stock.Commit(order)   // 7.5 KG on hand, nothing reserved
+type StockQuantity struct {
+	onHand    int64
+	reserved  int64
+	unit      Unit
+	precision int
+}
+
+// ZeroStockQuantity represents the zero value for StockQuantity.
+var ZeroStockQuantity = StockQuantity{}
+
+// NewStockQuantity creates a new StockQuantity with the given quantity on hand and nothing reserved.
+// Returns an error if the quantity is negative or has no unit.
+func NewStockQuantity(onHand Quantity) (StockQuantity, error) {
+	return newStockQuantity(onHand.value, 0, onHand.unit, onHand.precision)
+}
+
+// newStockQuantity validates the scaled amounts and builds a StockQuantity.
+func newStockQuantity(onHand, reserved int64, unit Unit, precision int) (StockQuantity, error) {
+	if !unit.IsValid() {
+		return ZeroStockQuantity, fault.New(
+			"unit is not registered as a valid unit of measure",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("unit", unit),
+		)
+	}
+	if onHand < 0 || reserved < 0 {
+		return ZeroStockQuantity, fault.New(
+			"stock quantities cannot be negative",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("on_hand", onHand),
+			fault.WithContext("reserved", reserved),
+		)
+	}
+	if reserved > onHand {
+		return ZeroStockQuantity, fault.New(
+			"reserved stock cannot exceed the stock on hand",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("on_hand", onHand),
+			fault.WithContext("reserved", reserved),
+		)
+	}
+	return StockQuantity{onHand: onHand, reserved: reserved, unit: unit, precision: precision}, nil
+}
+
+// OnHand returns the total quantity physically in stock, including reserved stock.
+func (s StockQuantity) OnHand() Quantity {
+	return s.quantity(s.onHand)
+}
+
+// Reserved returns the quantity set aside for pending orders.
+func (s StockQuantity) Reserved() Quantity {
+	return s.quantity(s.reserved)
+}
+
+// Available returns the quantity that can still be reserved (on hand minus reserved).
+func (s StockQuantity) Available() Quantity {
+	return s.quantity(s.onHand - s.reserved)
+}
+
+// Unit returns the unit of measure of the stock.
+func (s StockQuantity) Unit() Unit {
+	return s.unit
+}
+
+// IsZero returns true if the StockQuantity is the zero value.
+func (s StockQuantity) IsZero() bool {
+	return s == ZeroStockQuantity
+}
+
+// IsOutOfStock returns true if nothing is available to reserve.
+func (s StockQuantity) IsOutOfStock() bool {
+	return s.onHand-s.reserved == 0
+}
+
+// CanReserve checks if the given quantity could be reserved from the available stock.
+func (s StockQuantity) CanReserve(q Quantity) bool {
+	amount, err := s.amountOf(q, "reserve")
+	return err == nil && amount <= s.onHand-s.reserved
+}
+
+// Reserve returns a new StockQuantity with the given quantity set aside.
+// It returns ErrInsufficientStock if the quantity exceeds the available stock.
+func (s StockQuantity) Reserve(q Quantity) (StockQuantity, error) {
+	amount, err := s.amountOf(q, "reserve")
+	if err != nil {
+		return s, err
+	}
+	if amount > s.onHand-s.reserved {
+		return s, ErrInsufficientStock
+	}
+	s.reserved += amount
+	return s, nil
+}
+
+// Release returns a new StockQuantity with the given reserved quantity made available again.
+// It returns ErrInsufficientReservation if the quantity exceeds the reserved stock.
+func (s StockQuantity) Release(q Quantity) (StockQuantity, error) {
+	amount, err := s.amountOf(q, "release")
+	if err != nil {
+		return s, err
+	}
+	if amount > s.reserved {
+		return s, ErrInsufficientReservation
+	}
+	s.reserved -= amount
+	return s, nil
+}
+
+// Commit returns a new StockQuantity with the given reserved quantity consumed,
+// removing it from both the reserved stock and the stock on hand.
+// It returns ErrInsufficientReservation if the quantity exceeds the reserved stock.
+func (s StockQuantity) Commit(q Quantity) (StockQuantity, error) {
+	amount, err := s.amountOf(q, "commit")
+	if err != nil {
+		return s, err
+	}
+	if amount > s.reserved {
+		return s, ErrInsufficientReservation
+	}
+	s.reserved -= amount
+	s.onHand -= amount
+	return s, nil
+}
+
+// Restock returns a new StockQuantity with the given quantity added to the stock on hand.
+// Returns an error if the stock on hand would overflow.
+func (s StockQuantity) Restock(q Quantity) (StockQuantity, error) {
+	amount, err := s.amountOf(q, "restock")
+	if err != nil {
+		return s, err
+	}
+	if amount > math.MaxInt64-s.onHand {
+		return s, fault.New(
+			"stock on hand overflows the supported range",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("on_hand", s.onHand),
+			fault.WithContext("quantity", amount),
+		)
+	}
+	s.onHand += amount
+	return s, nil
+}
+
+// amountOf validates that q is a positive quantity in the same unit and precision as the stock
+// and returns its scaled value.
+func (s StockQuantity) amountOf(q Quantity, operation string) (int64, error) {
+	if q.unit != s.unit || q.precision != s.precision {
+		return 0, fault.New(
+			"quantity must have the same unit and precision as the stock",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("operation", operation),
+			fault.WithContext("stock_unit", s.unit),
+			fault.WithContext("quantity_unit", q.unit),
+			fault.WithContext("stock_precision", s.precision),
+			fault.WithContext("quantity_precision", q.precision),
+		)
+	}
+	if q.value <= 0 {
+		return 0, fault.New(
+			"quantity must be positive",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("operation", operation),
+			fault.WithContext("quantity", q.Float64()),
+		)
+	}
+	return q.value, nil
+}
+
+func (s StockQuantity) quantity(value int64) Quantity {
+	return Quantity{value: value, unit: s.unit, precision: s.precision}
+}
+
+// String returns the stock in "available/on hand unit" format (e.g., "7.5/10 KG").
+func (s StockQuantity) String() string {
+	return fmt.Sprintf("%v/%v %s", s.Available().Float64(), s.OnHand().Float64(), s.unit)
+}
+
+// stockQuantityJSON is the JSON representation of a StockQuantity.
+type stockQuantityJSON struct {
+	OnHand    float64 `json:"on_hand"`
+	Reserved  float64 `json:"reserved"`
+	Unit      Unit    `json:"unit"`
+	Precision int     `json:"precision"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the stock to a JSON object with "on_hand", "reserved", "unit" and "precision" fields,
+// or null for the zero value.
+func (s StockQuantity) MarshalJSON() ([]byte, error) {
+	if s.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(stockQuantityJSON{
+		OnHand:    s.OnHand().Float64(),
+		Reserved:  s.Reserved().Float64(),
+		Unit:      s.unit,
+		Precision: s.precision,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON object into a StockQuantity, with validation; null results in
+// ZeroStockQuantity.
+func (s *StockQuantity) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = ZeroStockQuantity
+		return nil
+	}
+
+	var dto stockQuantityJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for StockQuantity", fault.WithCode(fault.Invalid))
+	}
+	if dto.Precision < 0 || dto.Precision > maxQuantityPrecision {
+		return fault.New(
+			"precision must be between 0 and 18",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("precision", dto.Precision),
+		)
+	}
+
+	factor := math.Pow10(dto.Precision)
+	onHand, ok := scaleStockAmount(dto.OnHand, factor)
+	reserved, okReserved := scaleStockAmount(dto.Reserved, factor)
+	if !ok || !okReserved {
+		return fault.New(
+			"stock quantities are out of the supported range",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("on_hand", dto.OnHand),
+			fault.WithContext("reserved", dto.Reserved),
+			fault.WithContext("precision", dto.Precision),
+		)
+	}
+
+	stock, err := newStockQuantity(onHand, reserved, dto.Unit, dto.Precision)
+	if err != nil {
+		return err
+	}
+	*s = stock
+	return nil
+}
+
+// scaleStockAmount scales a decoded amount by factor, reporting false if the result does not fit
+// in an int64.
+func scaleStockAmount(amount, factor float64) (int64, bool) {
+	scaled := math.RoundToEven(amount * factor)
+	if math.IsNaN(scaled) || scaled < math.MinInt64 || scaled >= math.MaxInt64 {
+		return 0, false
+	}
+	return int64(scaled), true
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the StockQuantity as a JSON string or nil if it's the zero value.
+func (s StockQuantity) Value() (driver.Value, error) {
+	if s.IsZero() {
+		return nil, nil
+	}
+
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal stock quantity for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as StockQuantity.
+func (s *StockQuantity) Scan(src interface{}) error {
+	if src == nil {
+		*s = ZeroStockQuantity
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for StockQuantity",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return s.UnmarshalJSON(data)
+}
@@ -0,0 +1,173 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	wisp "github.com/marcelofabianov/wisp"
+)
+
+type StockQuantitySuite struct {
+	suite.Suite
+}
+
+func TestStockQuantitySuite(t *testing.T) {
+	suite.Run(t, new(StockQuantitySuite))
+}
+
+func (s *StockQuantitySuite) SetupTest() {
+	wisp.ClearRegisteredUnits()
+	wisp.RegisterUnits(UnitKG, UnitUN)
+	wisp.SetDefaultPrecision(3)
+}
+
+func (s *StockQuantitySuite) kg(v float64) wisp.Quantity {
+	q, err := wisp.NewQuantity(v, UnitKG)
+	s.Require().NoError(err)
+	return q
+}
+
+func (s *StockQuantitySuite) TestNewStockQuantity() {
+	s.Run("should start with nothing reserved", func() {
+		stock, err := wisp.NewStockQuantity(s.kg(10))
+		s.Require().NoError(err)
+		s.Equal(10.0, stock.OnHand().Float64())
+		s.Equal(0.0, stock.Reserved().Float64())
+		s.Equal(10.0, stock.Available().Float64())
+		s.Equal(UnitKG, stock.Unit())
+		s.False(stock.IsOutOfStock())
+	})
+
+	s.Run("should fail for negative quantities", func() {
+		_, err := wisp.NewStockQuantity(s.kg(-1))
+		s.Require().Error(err)
+	})
+
+	s.Run("should fail for a quantity without unit", func() {
+		_, err := wisp.NewStockQuantity(wisp.Quantity{})
+		s.Require().Error(err)
+	})
+}
+
+func (s *StockQuantitySuite) TestStockQuantity_Lifecycle() {
+	stock, _ := wisp.NewStockQuantity(s.kg(10))
+
+	reserved, err := stock.Reserve(s.kg(2.5))
+	s.Require().NoError(err)
+	s.Equal(7.5, reserved.Available().Float64())
+	s.Equal(10.0, stock.Available().Float64(), "original must be unchanged")
+
+	released, err := reserved.Release(s.kg(1))
+	s.Require().NoError(err)
+	s.Equal(1.5, released.Reserved().Float64())
+	s.Equal(8.5, released.Available().Float64())
+
+	committed, err := released.Commit(s.kg(1.5))
+	s.Require().NoError(err)
+	s.Equal(8.5, committed.OnHand().Float64())
+	s.Equal(0.0, committed.Reserved().Float64())
+
+	restocked, err := committed.Restock(s.kg(1.5))
+	s.Require().NoError(err)
+	s.Equal(10.0, restocked.OnHand().Float64())
+	s.Equal("10/10 KG", restocked.String())
+}
+
+func (s *StockQuantitySuite) TestStockQuantity_Errors() {
+	stock, _ := wisp.NewStockQuantity(s.kg(5))
+	stock, _ = stock.Reserve(s.kg(4))
+
+	s.Run("should not reserve more than available", func() {
+		s.False(stock.CanReserve(s.kg(2)))
+		s.True(stock.CanReserve(s.kg(1)))
+		_, err := stock.Reserve(s.kg(2))
+		s.True(errors.Is(err, wisp.ErrInsufficientStock))
+
+		full, err := stock.Reserve(s.kg(1))
+		s.Require().NoError(err)
+		s.True(full.IsOutOfStock())
+	})
+
+	s.Run("should not release or commit more than reserved", func() {
+		_, err := stock.Release(s.kg(4.001))
+		s.True(errors.Is(err, wisp.ErrInsufficientReservation))
+		_, err = stock.Commit(s.kg(5))
+		s.True(errors.Is(err, wisp.ErrInsufficientReservation))
+	})
+
+	s.Run("should reject non-positive quantities", func() {
+		_, err := stock.Reserve(s.kg(0))
+		s.Require().Error(err)
+		_, err = stock.Restock(s.kg(-1))
+		s.Require().Error(err)
+	})
+
+	s.Run("should reject mismatched units and precisions", func() {
+		units, _ := wisp.NewQuantity(1, UnitUN)
+		_, err := stock.Reserve(units)
+		s.Require().Error(err)
+		faultErr, ok := err.(*fault.Error)
+		s.Require().True(ok)
+		s.Equal(fault.DomainViolation, faultErr.Code)
+
+		coarse, _ := wisp.NewQuantityWithPrecision(1, UnitKG, 0)
+		_, err = stock.Release(coarse)
+		s.Require().Error(err)
+	})
+
+	s.Run("should not overflow the stock on hand", func() {
+		var large wisp.StockQuantity
+		s.Require().NoError(json.Unmarshal([]byte(`{"on_hand":9e18,"reserved":0,"unit":"KG","precision":0}`), &large))
+		more, _ := wisp.NewQuantityWithPrecision(9e17, UnitKG, 0)
+		_, err := large.Restock(more)
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+	})
+}
+
+func (s *StockQuantitySuite) TestStockQuantity_Serialization() {
+	stock, _ := wisp.NewStockQuantity(s.kg(10))
+	stock, _ = stock.Reserve(s.kg(0.125))
+
+	s.Run("JSON", func() {
+		data, err := json.Marshal(stock)
+		s.Require().NoError(err)
+		s.JSONEq(`{"on_hand":10,"reserved":0.125,"unit":"KG","precision":3}`, string(data))
+
+		var decoded wisp.StockQuantity
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(stock, decoded)
+
+		s.Require().Error(json.Unmarshal([]byte(`{"on_hand":1,"reserved":2,"unit":"KG","precision":0}`), &decoded))
+		s.Require().Error(json.Unmarshal([]byte(`{"on_hand":1,"reserved":0,"unit":"BOX","precision":0}`), &decoded))
+		s.Require().Error(json.Unmarshal([]byte(`{"on_hand":1,"reserved":0,"unit":"KG","precision":400}`), &decoded))
+		s.Require().Error(json.Unmarshal([]byte(`{"on_hand":1e300,"reserved":0,"unit":"KG","precision":3}`), &decoded))
+		s.Require().Error(json.Unmarshal([]byte(`{"on_hand":10,"reserved":1e19,"unit":"KG","precision":0}`), &decoded))
+
+		s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+		s.True(decoded.IsZero())
+		data, err = json.Marshal(wisp.ZeroStockQuantity)
+		s.Require().NoError(err)
+		s.Equal("null", string(data))
+	})
+
+	s.Run("SQL", func() {
+		val, err := stock.Value()
+		s.Require().NoError(err)
+
+		var scanned wisp.StockQuantity
+		s.Require().NoError(scanned.Scan(val))
+		s.Equal(stock, scanned)
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+		nilVal, err := scanned.Value()
+		s.Require().NoError(err)
+		s.Nil(nilVal)
+		s.Require().Error(scanned.Scan(1))
+	})
+}
@@ -0,0 +1,332 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// TaxRate represents a named tax rate (e.g., ISS 5%, PIS 0.65%, COFINS 3%).
+//
+// An inclusive rate is already embedded in the quoted price and is calculated "por dentro":
+// the tax amount is a share of the quoted amount and is deducted from it to obtain the net value.
+// An exclusive rate is calculated "por fora": the tax amount is added on top of the quoted amount.
+//
+// Examples:
+//   fivePercent, _ := wisp.NewPercentageFromFloat(0.05)
+//   iss, err := wisp.NewTaxRate("ISS", fivePercent, true)
+type TaxRate struct {
+	name      string
+	rate      Percentage
+	inclusive bool
+}
+
+// ZeroTaxRate represents the zero value for the TaxRate type.
+var ZeroTaxRate TaxRate
+
+// NewTaxRate creates a new TaxRate with the given name, rate and inclusive flag.
+// It returns an error if the name is empty or the rate is outside the 0% to 100% range.
+func NewTaxRate(name string, rate Percentage, inclusive bool) (TaxRate, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ZeroTaxRate, fault.New("tax rate name cannot be empty", fault.WithCode(fault.Invalid))
+	}
+
+	if rate.IsNegative() || rate > HundredPercent {
+		return ZeroTaxRate, fault.New(
+			"tax rate must be between 0% and 100%",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("name", name),
+			fault.WithContext("rate", rate.String()),
+		)
+	}
+
+	return TaxRate{name: name, rate: rate, inclusive: inclusive}, nil
+}
+
+// Name returns the name of the tax.
+func (t TaxRate) Name() string {
+	return t.name
+}
+
+// Rate returns the tax rate as a Percentage.
+func (t TaxRate) Rate() Percentage {
+	return t.rate
+}
+
+// IsInclusive returns true if the tax is already embedded in the quoted amount.
+func (t TaxRate) IsInclusive() bool {
+	return t.inclusive
+}
+
+// IsZero returns true if the TaxRate is the zero value.
+func (t TaxRate) IsZero() bool {
+	return t == ZeroTaxRate
+}
+
+// String returns a representation of the tax rate, like "ISS 5.00% (inclusive)".
+func (t TaxRate) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	kind := "exclusive"
+	if t.inclusive {
+		kind = "inclusive"
+	}
+	return fmt.Sprintf("%s %s (%s)", t.name, t.rate.String(), kind)
+}
+
+// taxRateJSON is the JSON representation of a TaxRate.
+type taxRateJSON struct {
+	Name      string     `json:"name"`
+	Rate      Percentage `json:"rate"`
+	Inclusive bool       `json:"inclusive"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the TaxRate into a JSON object with "name", "rate" and "inclusive" fields.
+func (t TaxRate) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(taxRateJSON{Name: t.name, Rate: t.rate, Inclusive: t.inclusive})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *TaxRate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = ZeroTaxRate
+		return nil
+	}
+
+	var dto taxRateJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for TaxRate", fault.WithCode(fault.Invalid))
+	}
+
+	rate, err := NewTaxRate(dto.Name, dto.Rate, dto.Inclusive)
+	if err != nil {
+		return err
+	}
+	*t = rate
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the TaxRate as a JSON string or nil if it's the zero value.
+func (t TaxRate) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+
+	data, err := t.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal tax rate for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as TaxRate.
+func (t *TaxRate) Scan(src interface{}) error {
+	if src == nil {
+		*t = ZeroTaxRate
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for TaxRate",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return t.UnmarshalJSON(data)
+}
+
+// TaxLine is a single entry in a tax breakdown: the rate that was applied and the resulting amount.
+type TaxLine struct {
+	rate   TaxRate
+	amount Money
+}
+
+// Rate returns the tax rate applied on this line.
+func (l TaxLine) Rate() TaxRate {
+	return l.rate
+}
+
+// Amount returns the tax amount of this line, rounded to the currency's minor unit.
+func (l TaxLine) Amount() Money {
+	return l.amount
+}
+
+// TaxedAmount is the result of applying one or more tax rates to a quoted amount.
+// It exposes the net value (without taxes), the itemized tax breakdown and the gross value
+// (net plus all taxes).
+//
+// All rates are applied on the quoted amount, which includes inclusive taxes and excludes
+// exclusive ones. Each line is rounded independently with the chosen RoundingMode, in the order
+// the rates were given, so the same inputs always produce the same breakdown and
+// net + sum(lines) == gross holds exactly.
+//
+// Example:
+//   price, _ := wisp.NewMoney(100000, wisp.BRL) // R$1,000.00 with ISS, PIS and COFINS embedded
+//   taxed, err := wisp.NewTaxedAmount(price, wisp.RoundHalfUp, iss, pis, cofins)
+//   taxed.Net()   // BRL 913.50
+//   taxed.Gross() // BRL 1000.00
+type TaxedAmount struct {
+	quoted Money
+	net    Money
+	gross  Money
+	lines  []TaxLine
+}
+
+// NewTaxedAmount applies the given rates to the quoted amount.
+// It returns an error if the amount is zero or negative, the rounding mode is unknown,
+// a rate is zero, two rates share the same name, or the inclusive rates add up to more than 100%.
+func NewTaxedAmount(quoted Money, mode RoundingMode, rates ...TaxRate) (TaxedAmount, error) {
+	if quoted.IsZero() || quoted.IsNegative() {
+		return TaxedAmount{}, fault.New(
+			"taxed amount must be a positive money value",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("amount", quoted.String()),
+		)
+	}
+
+	seen := make(map[string]struct{}, len(rates))
+	var inclusiveTotal Percentage
+	for _, r := range rates {
+		if r.IsZero() {
+			return TaxedAmount{}, fault.New("tax rate cannot be empty", fault.WithCode(fault.Invalid))
+		}
+		key := strings.ToUpper(r.name)
+		if _, dup := seen[key]; dup {
+			return TaxedAmount{}, fault.New(
+				"tax rate is applied more than once",
+				fault.WithCode(fault.Conflict),
+				fault.WithContext("name", r.name),
+			)
+		}
+		seen[key] = struct{}{}
+		if r.inclusive {
+			inclusiveTotal += r.rate
+		}
+	}
+
+	if inclusiveTotal > HundredPercent {
+		return TaxedAmount{}, fault.New(
+			"inclusive tax rates cannot exceed 100% in total",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("inclusive_total", inclusiveTotal.String()),
+		)
+	}
+
+	result := TaxedAmount{quoted: quoted, net: quoted, gross: quoted, lines: make([]TaxLine, 0, len(rates))}
+	for _, r := range rates {
+		amount, err := r.rate.ApplyToWithMode(quoted, mode)
+		if err != nil {
+			return TaxedAmount{}, err
+		}
+
+		if r.inclusive {
+			result.net.amount -= amount.amount
+		} else {
+			result.gross.amount += amount.amount
+		}
+		result.lines = append(result.lines, TaxLine{rate: r, amount: amount})
+	}
+
+	return result, nil
+}
+
+// Quoted returns the amount the taxes were calculated on.
+func (t TaxedAmount) Quoted() Money {
+	return t.quoted
+}
+
+// Net returns the amount without any taxes.
+func (t TaxedAmount) Net() Money {
+	return t.net
+}
+
+// Gross returns the amount including all taxes.
+func (t TaxedAmount) Gross() Money {
+	return t.gross
+}
+
+// Lines returns the itemized tax breakdown, in the order the rates were applied.
+func (t TaxedAmount) Lines() []TaxLine {
+	lines := make([]TaxLine, len(t.lines))
+	copy(lines, t.lines)
+	return lines
+}
+
+// TotalTax returns the sum of all tax lines.
+func (t TaxedAmount) TotalTax() Money {
+	return Money{amount: t.gross.amount - t.net.amount, currency: t.quoted.currency}
+}
+
+// TaxFor returns the amount of the tax with the given name (case-insensitive).
+// The boolean is false if no such tax was applied.
+func (t TaxedAmount) TaxFor(name string) (Money, bool) {
+	name = strings.TrimSpace(name)
+	for _, line := range t.lines {
+		if strings.EqualFold(line.rate.name, name) {
+			return line.amount, true
+		}
+	}
+	return ZeroMoney, false
+}
+
+// IsZero returns true if no calculation has been performed.
+func (t TaxedAmount) IsZero() bool {
+	return t.quoted.IsZero()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the result into a JSON object with "net", "gross", "total_tax" and "taxes" fields,
+// where each tax entry carries its rate and amount.
+func (t TaxedAmount) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return json.Marshal(nil)
+	}
+
+	type taxLineJSON struct {
+		taxRateJSON
+		Amount Money `json:"amount"`
+	}
+
+	taxes := make([]taxLineJSON, len(t.lines))
+	for i, line := range t.lines {
+		taxes[i] = taxLineJSON{
+			taxRateJSON: taxRateJSON{Name: line.rate.name, Rate: line.rate.rate, Inclusive: line.rate.inclusive},
+			Amount:      line.amount,
+		}
+	}
+
+	return json.Marshal(struct {
+		Net      Money         `json:"net"`
+		Gross    Money         `json:"gross"`
+		TotalTax Money         `json:"total_tax"`
+		Taxes    []taxLineJSON `json:"taxes"`
+	}{
+		Net:      t.net,
+		Gross:    t.gross,
+		TotalTax: t.TotalTax(),
+		Taxes:    taxes,
+	})
+}
@@ -0,0 +1,234 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// maxTINLength is the longest Tax Identification Number accepted by TaxResidency.
+const maxTINLength = 30
+
+// documentSeparators removes the punctuation commonly used to format document numbers.
+var documentSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "/", "")
+
+// TaxResidency is a value object representing a country where a person or company is resident for
+// tax purposes, with the Tax Identification Number (TIN) issued there, as declared in FATCA and CRS
+// self-certifications during onboarding. Someone resident in several countries has one
+// TaxResidency per country.
+//
+// The TIN is stored without punctuation, uppercase. For Brazil it must be a valid CPF or CNPJ;
+// for other countries only its characters and length are checked. It may be empty when the country
+// does not issue TINs or the holder has not received one yet.
+//
+// The TIN is personal data: Masked hides all but its last 4 characters, so Redact keeps it out of
+// logs.
+//
+// The zero value is ZeroTaxResidency.
+//
+// Example:
+//   residency, err := wisp.NewTaxResidency(wisp.CountryUnitedStates, "123-45-6789")
+//   residency.RequiresW9()    // true
+//   residency.Masked()        // "US *****6789"
+type TaxResidency struct {
+	country CountryCode
+	tin     string
+}
+
+// ZeroTaxResidency represents the zero value for the TaxResidency type.
+var ZeroTaxResidency TaxResidency
+
+// NewTaxResidency creates a new TaxResidency. The TIN is optional.
+// Returns an error if the country is empty or invalid, or if the TIN has characters other than
+// letters and digits after removing spaces, hyphens, periods and slashes, is longer than 30
+// characters, or is not a valid CPF or CNPJ for Brazil.
+func NewTaxResidency(country CountryCode, tin string) (TaxResidency, error) {
+	if country.IsZero() {
+		return ZeroTaxResidency, fault.New("tax residency country is required", fault.WithCode(fault.Invalid))
+	}
+	if !country.IsValid() {
+		return ZeroTaxResidency, fault.New(
+			"invalid country code",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_code", country),
+		)
+	}
+
+	normalized := strings.ToUpper(documentSeparators.Replace(strings.TrimSpace(tin)))
+	if normalized == "" {
+		return TaxResidency{country: country}, nil
+	}
+
+	if country == CountryBrazil {
+		taxID, err := NewTaxID(normalized)
+		if err != nil {
+			return ZeroTaxResidency, fault.Wrap(err,
+				"Brazilian tax residency requires a valid CPF or CNPJ",
+				fault.WithCode(fault.Invalid),
+			)
+		}
+		return TaxResidency{country: country, tin: taxID.String()}, nil
+	}
+
+	for i := 0; i < len(normalized); i++ {
+		if !isDigit(normalized[i]) && !isUpperLetter(normalized[i]) {
+			return ZeroTaxResidency, fault.New(
+				"TIN must contain only letters and digits",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("country", country),
+			)
+		}
+	}
+	if len(normalized) > maxTINLength {
+		return ZeroTaxResidency, fault.New(
+			"TIN cannot be longer than 30 characters",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("country", country),
+			fault.WithContext("length", len(normalized)),
+		)
+	}
+	return TaxResidency{country: country, tin: normalized}, nil
+}
+
+// Country returns the country of tax residency.
+func (r TaxResidency) Country() CountryCode {
+	return r.country
+}
+
+// TIN returns the Tax Identification Number, or an empty string if none was given.
+func (r TaxResidency) TIN() string {
+	return r.tin
+}
+
+// HasTIN returns true if a Tax Identification Number was given.
+func (r TaxResidency) HasTIN() bool {
+	return r.tin != ""
+}
+
+// IsDomestic returns true if the residency is in Brazil.
+func (r TaxResidency) IsDomestic() bool {
+	return r.country == CountryBrazil
+}
+
+// RequiresW9 returns true if the residency is in the United States, making the holder a US person
+// who certifies its TIN on IRS Form W-9.
+func (r TaxResidency) RequiresW9() bool {
+	return r.country == CountryUnitedStates
+}
+
+// RequiresW8Ben returns true if the residency is outside the United States, so a person receiving
+// US-source income certifies foreign status on IRS Form W-8BEN (W-8BEN-E for companies).
+// US citizens living abroad are still US persons; check Nationality.IsUSCitizen as well.
+func (r TaxResidency) RequiresW8Ben() bool {
+	return !r.IsZero() && !r.RequiresW9()
+}
+
+// IsZero returns true if the TaxResidency is the zero value.
+func (r TaxResidency) IsZero() bool {
+	return r == ZeroTaxResidency
+}
+
+// Equals checks if two tax residencies have the same country and TIN.
+func (r TaxResidency) Equals(other TaxResidency) bool {
+	return r == other
+}
+
+// String returns the country code followed by the TIN, if any (e.g., "US 123456789").
+func (r TaxResidency) String() string {
+	if r.tin == "" {
+		return r.country.String()
+	}
+	return r.country.String() + " " + r.tin
+}
+
+// Masked implements the Masker interface. It returns the country code followed by the TIN with all
+// but its last 4 characters hidden (e.g., "US *****6789").
+func (r TaxResidency) Masked() string {
+	if r.tin == "" {
+		return r.country.String()
+	}
+	visible := min(4, len(r.tin)-1)
+	return r.country.String() + " " + strings.Repeat("*", len(r.tin)-visible) + r.tin[len(r.tin)-visible:]
+}
+
+// taxResidencyJSON is the JSON representation of a TaxResidency.
+type taxResidencyJSON struct {
+	Country CountryCode `json:"country"`
+	TIN     string      `json:"tin,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the TaxResidency into a JSON object with "country" and "tin" fields, or null for
+// the zero value.
+func (r TaxResidency) MarshalJSON() ([]byte, error) {
+	if r.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(taxResidencyJSON{Country: r.country, TIN: r.tin})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The "country" field is required and "tin" is optional.
+func (r *TaxResidency) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*r = ZeroTaxResidency
+		return nil
+	}
+
+	var dto taxResidencyJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return fault.Wrap(err, "invalid JSON format for TaxResidency", fault.WithCode(fault.Invalid))
+	}
+
+	residency, err := NewTaxResidency(dto.Country, dto.TIN)
+	if err != nil {
+		return err
+	}
+	*r = residency
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the TaxResidency as a JSON string, or nil for the zero value.
+func (r TaxResidency) Value() (driver.Value, error) {
+	if r.IsZero() {
+		return nil, nil
+	}
+
+	data, err := r.MarshalJSON()
+	if err != nil {
+		return nil, fault.Wrap(err,
+			"failed to marshal tax residency for database storage",
+			fault.WithCode(fault.Internal),
+		)
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values containing JSON and validates them as TaxResidency.
+func (r *TaxResidency) Scan(src interface{}) error {
+	if src == nil {
+		*r = ZeroTaxResidency
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fault.New(
+			"unsupported scan type for TaxResidency",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	return r.UnmarshalJSON(data)
+}
@@ -0,0 +1,156 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type TaxResidencySuite struct {
+	suite.Suite
+}
+
+func TestTaxResidencySuite(t *testing.T) {
+	suite.Run(t, new(TaxResidencySuite))
+}
+
+func (s *TaxResidencySuite) TestNewTaxResidency() {
+	s.Run("should normalize the TIN", func() {
+		testCases := []struct {
+			country  wisp.CountryCode
+			tin      string
+			expected string
+		}{
+			{country: wisp.CountryUnitedStates, tin: "123-45-6789", expected: "123456789"},
+			{country: "PT", tin: "123 456 789", expected: "123456789"},
+			{country: "GB", tin: "ab 12 34 56 c", expected: "AB123456C"},
+			{country: wisp.CountryBrazil, tin: "529.982.247-25", expected: "52998224725"},
+			{country: wisp.CountryBrazil, tin: "11.222.333/0001-81", expected: "11222333000181"},
+		}
+		for _, tc := range testCases {
+			residency, err := wisp.NewTaxResidency(tc.country, tc.tin)
+			s.Require().NoError(err, tc.tin)
+			s.Equal(tc.country, residency.Country())
+			s.Equal(tc.expected, residency.TIN())
+			s.True(residency.HasTIN())
+		}
+	})
+
+	s.Run("should accept a residency without TIN", func() {
+		residency, err := wisp.NewTaxResidency("AE", "")
+		s.Require().NoError(err)
+		s.False(residency.HasTIN())
+		s.Equal("AE", residency.String())
+	})
+
+	s.Run("should reject invalid values", func() {
+		testCases := []struct {
+			country wisp.CountryCode
+			tin     string
+		}{
+			{country: wisp.EmptyCountryCode, tin: "123"},
+			{country: "XX", tin: "123"},
+			{country: wisp.CountryBrazil, tin: "123.456.789-00"},
+			{country: wisp.CountryUnitedStates, tin: "123#456"},
+			{country: "DE", tin: strings.Repeat("1", 31)},
+		}
+		for _, tc := range testCases {
+			_, err := wisp.NewTaxResidency(tc.country, tc.tin)
+			s.Require().Error(err, tc.tin)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+}
+
+func (s *TaxResidencySuite) TestComplianceHelpers() {
+	us, _ := wisp.NewTaxResidency(wisp.CountryUnitedStates, "123456789")
+	br, _ := wisp.NewTaxResidency(wisp.CountryBrazil, "52998224725")
+	pt, _ := wisp.NewTaxResidency("PT", "")
+
+	s.True(us.RequiresW9())
+	s.False(us.RequiresW8Ben())
+	s.False(us.IsDomestic())
+
+	s.True(br.RequiresW8Ben())
+	s.False(br.RequiresW9())
+	s.True(br.IsDomestic())
+
+	s.True(pt.RequiresW8Ben())
+	s.False(wisp.ZeroTaxResidency.RequiresW8Ben())
+	s.False(wisp.ZeroTaxResidency.RequiresW9())
+}
+
+func (s *TaxResidencySuite) TestMasked() {
+	us, _ := wisp.NewTaxResidency(wisp.CountryUnitedStates, "123-45-6789")
+	s.Equal("US *****6789", us.Masked())
+	s.Equal("US 123456789", us.String())
+
+	short, _ := wisp.NewTaxResidency("DE", "123")
+	s.Equal("DE *23", short.Masked())
+
+	noTIN, _ := wisp.NewTaxResidency("DE", "")
+	s.Equal("DE", noTIN.Masked())
+
+	redacted := wisp.Redact(map[string]any{"residency": us})
+	s.Equal(map[string]any{"residency": "US *****6789"}, redacted)
+}
+
+func (s *TaxResidencySuite) TestJSON() {
+	s.Run("should marshal and unmarshal", func() {
+		residency, _ := wisp.NewTaxResidency(wisp.CountryUnitedStates, "123-45-6789")
+		data, err := json.Marshal(residency)
+		s.Require().NoError(err)
+		s.JSONEq(`{"country":"US","tin":"123456789"}`, string(data))
+
+		var decoded wisp.TaxResidency
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.True(residency.Equals(decoded))
+	})
+
+	s.Run("should omit an empty TIN", func() {
+		residency, _ := wisp.NewTaxResidency("PT", "")
+		data, err := json.Marshal(residency)
+		s.Require().NoError(err)
+		s.JSONEq(`{"country":"PT"}`, string(data))
+	})
+
+	s.Run("should handle null", func() {
+		data, err := json.Marshal(wisp.ZeroTaxResidency)
+		s.Require().NoError(err)
+		s.Equal("null", string(data))
+
+		var decoded wisp.TaxResidency
+		s.Require().NoError(json.Unmarshal([]byte("null"), &decoded))
+		s.True(decoded.IsZero())
+	})
+
+	s.Run("should validate on unmarshal", func() {
+		var decoded wisp.TaxResidency
+		s.Error(json.Unmarshal([]byte(`{"tin":"123"}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`{"country":"BR","tin":"123"}`), &decoded))
+		s.Error(json.Unmarshal([]byte(`[]`), &decoded))
+	})
+}
+
+func (s *TaxResidencySuite) TestSQL() {
+	residency, _ := wisp.NewTaxResidency(wisp.CountryBrazil, "52998224725")
+	value, err := residency.Value()
+	s.Require().NoError(err)
+
+	var scanned wisp.TaxResidency
+	s.Require().NoError(scanned.Scan([]byte(value.(string))))
+	s.True(residency.Equals(scanned))
+
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	value, err = scanned.Value()
+	s.Require().NoError(err)
+	s.Nil(value)
+
+	s.Error(scanned.Scan(1))
+}
@@ -0,0 +1,169 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type TaxSuite struct {
+	suite.Suite
+}
+
+func TestTaxSuite(t *testing.T) {
+	suite.Run(t, new(TaxSuite))
+}
+
+func (s *TaxSuite) rate(name string, value float64, inclusive bool) wisp.TaxRate {
+	p, err := wisp.NewPercentageFromFloat(value)
+	s.Require().NoError(err)
+	r, err := wisp.NewTaxRate(name, p, inclusive)
+	s.Require().NoError(err)
+	return r
+}
+
+func (s *TaxSuite) TestNewTaxRate() {
+	s.Run("should create a valid tax rate", func() {
+		r := s.rate(" ISS ", 0.05, true)
+		s.Equal("ISS", r.Name())
+		s.Equal(wisp.Percentage(500), r.Rate())
+		s.True(r.IsInclusive())
+		s.Equal("ISS 5.00% (inclusive)", r.String())
+	})
+
+	s.Run("should reject an empty name", func() {
+		_, err := wisp.NewTaxRate("  ", wisp.Percentage(500), false)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+
+	s.Run("should reject rates outside 0% to 100%", func() {
+		_, err := wisp.NewTaxRate("IPI", wisp.Percentage(-1), false)
+		s.Error(err)
+		_, err = wisp.NewTaxRate("IPI", wisp.Percentage(10001), false)
+		s.Error(err)
+	})
+}
+
+func (s *TaxSuite) TestTaxRate_JSONAndSQL() {
+	r := s.rate("IPI", 0.1, false)
+
+	data, err := json.Marshal(r)
+	s.Require().NoError(err)
+	s.JSONEq(`{"name":"IPI","rate":0.1,"inclusive":false}`, string(data))
+
+	var decoded wisp.TaxRate
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(r, decoded)
+	s.Error(json.Unmarshal([]byte(`{"name":"","rate":0.1}`), &decoded))
+
+	val, err := r.Value()
+	s.Require().NoError(err)
+	var scanned wisp.TaxRate
+	s.Require().NoError(scanned.Scan(val))
+	s.Equal(r, scanned)
+	s.Error(scanned.Scan(1))
+
+	val, err = wisp.ZeroTaxRate.Value()
+	s.Require().NoError(err)
+	s.Nil(val)
+}
+
+func (s *TaxSuite) TestNewTaxedAmount() {
+	price, _ := wisp.NewMoney(100000, wisp.BRL)
+	iss := s.rate("ISS", 0.05, true)
+	pis := s.rate("PIS", 0.0065, true)
+	cofins := s.rate("COFINS", 0.03, true)
+
+	s.Run("should deduct inclusive taxes from the quoted amount", func() {
+		taxed, err := wisp.NewTaxedAmount(price, wisp.RoundHalfUp, iss, pis, cofins)
+		s.Require().NoError(err)
+		s.Equal(int64(91350), taxed.Net().Amount())
+		s.Equal(int64(100000), taxed.Gross().Amount())
+		s.Equal(int64(8650), taxed.TotalTax().Amount())
+
+		lines := taxed.Lines()
+		s.Require().Len(lines, 3)
+		s.Equal("ISS", lines[0].Rate().Name())
+		s.Equal(int64(5000), lines[0].Amount().Amount())
+		s.Equal(int64(650), lines[1].Amount().Amount())
+		s.Equal(int64(3000), lines[2].Amount().Amount())
+
+		amount, ok := taxed.TaxFor("cofins")
+		s.True(ok)
+		s.Equal(int64(3000), amount.Amount())
+		_, ok = taxed.TaxFor("IPI")
+		s.False(ok)
+	})
+
+	s.Run("should add exclusive taxes on top of the quoted amount", func() {
+		ipi := s.rate("IPI", 0.1, false)
+		taxed, err := wisp.NewTaxedAmount(price, wisp.RoundHalfEven, iss, ipi)
+		s.Require().NoError(err)
+		s.Equal(int64(95000), taxed.Net().Amount())
+		s.Equal(int64(110000), taxed.Gross().Amount())
+		s.Equal(int64(15000), taxed.TotalTax().Amount())
+	})
+
+	s.Run("should round each line deterministically", func() {
+		odd, _ := wisp.NewMoney(1005, wisp.BRL)
+		half := s.rate("HALF", 0.005, false) // 5.025 centavos
+
+		up, err := wisp.NewTaxedAmount(odd, wisp.RoundHalfUp, half)
+		s.Require().NoError(err)
+		s.Equal(int64(5), up.TotalTax().Amount())
+
+		ceil, err := wisp.NewTaxedAmount(odd, wisp.RoundCeil, half)
+		s.Require().NoError(err)
+		s.Equal(int64(6), ceil.TotalTax().Amount())
+		s.Equal(ceil.Net().Amount()+ceil.TotalTax().Amount(), ceil.Gross().Amount())
+	})
+
+	s.Run("should return the quoted amount when there are no rates", func() {
+		taxed, err := wisp.NewTaxedAmount(price, wisp.RoundHalfEven)
+		s.Require().NoError(err)
+		s.Equal(price, taxed.Net())
+		s.Equal(price, taxed.Gross())
+		s.Empty(taxed.Lines())
+	})
+
+	s.Run("should validate inputs", func() {
+		_, err := wisp.NewTaxedAmount(wisp.ZeroMoney, wisp.RoundHalfEven, iss)
+		s.Error(err)
+
+		negative, _ := wisp.NewMoney(-100, wisp.BRL)
+		_, err = wisp.NewTaxedAmount(negative, wisp.RoundHalfEven, iss)
+		s.Error(err)
+
+		_, err = wisp.NewTaxedAmount(price, wisp.RoundingMode(99), iss)
+		s.Error(err)
+
+		_, err = wisp.NewTaxedAmount(price, wisp.RoundHalfEven, iss, s.rate("iss", 0.02, true))
+		s.Require().Error(err)
+		s.Equal(fault.Conflict, err.(*fault.Error).Code)
+
+		_, err = wisp.NewTaxedAmount(price, wisp.RoundHalfEven, s.rate("A", 0.6, true), s.rate("B", 0.5, true))
+		s.Require().Error(err)
+		s.Equal(fault.DomainViolation, err.(*fault.Error).Code)
+
+		_, err = wisp.NewTaxedAmount(price, wisp.RoundHalfEven, wisp.ZeroTaxRate)
+		s.Error(err)
+	})
+
+	s.Run("should marshal the breakdown to JSON", func() {
+		taxed, err := wisp.NewTaxedAmount(price, wisp.RoundHalfUp, iss)
+		s.Require().NoError(err)
+		data, err := json.Marshal(taxed)
+		s.Require().NoError(err)
+		s.JSONEq(`{
+			"net":{"amount":95000,"currency":"BRL"},
+			"gross":{"amount":100000,"currency":"BRL"},
+			"total_tax":{"amount":5000,"currency":"BRL"},
+			"taxes":[{"name":"ISS","rate":0.05,"inclusive":true,"amount":{"amount":5000,"currency":"BRL"}}]
+		}`, string(data))
+	})
+}
@@ -0,0 +1,214 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// TaxIDKind identifies whether a TaxID belongs to an individual (CPF) or a company (CNPJ).
+type TaxIDKind string
+
+const (
+	// TaxIDKindIndividual is the kind of a TaxID holding a CPF.
+	TaxIDKindIndividual TaxIDKind = "individual"
+	// TaxIDKindCompany is the kind of a TaxID holding a CNPJ.
+	TaxIDKindCompany TaxIDKind = "company"
+)
+
+// TaxID represents a Brazilian taxpayer document ("documento") that can be either a CPF or a CNPJ,
+// so APIs and tables accepting both need a single field instead of two nullable ones.
+// Validation is delegated to CPF and CNPJ; the kind is inferred from the number of characters.
+// The value is stored without formatting.
+//
+// Examples:
+//   doc, _ := wisp.NewTaxID("529.982.247-25")     // individual
+//   doc, _ := wisp.NewTaxID("45.543.915/0001-81") // company
+//   doc.Kind()      // TaxIDKindCompany
+//   doc.Formatted() // "45.543.915/0001-81"
+type TaxID string
+
+// EmptyTaxID represents the zero value for the TaxID type.
+var EmptyTaxID TaxID
+
+// parseTaxID validates and normalizes a TaxID from string or []byte input.
+func parseTaxID[T string | []byte](input T) (TaxID, error) {
+	if len(input) == 0 {
+		return EmptyTaxID, nil
+	}
+
+	var n int
+	if alphanumericCNPJEnabled {
+		n = extractCNPJChars(input, nil)
+	} else {
+		n = extractDigits(input, nil)
+	}
+
+	switch n {
+	case 11:
+		cpf, err := parseCPF(input)
+		if err != nil {
+			return EmptyTaxID, err
+		}
+		return TaxID(cpf), nil
+	case 14:
+		cnpj, err := parseCNPJ(input)
+		if err != nil {
+			return EmptyTaxID, err
+		}
+		return TaxID(cnpj), nil
+	default:
+		return EmptyTaxID, fault.New(
+			"tax ID must be a CPF (11 digits) or a CNPJ (14 characters)",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input", string(input)),
+		)
+	}
+}
+
+// NewTaxID creates a new TaxID from a CPF or CNPJ, formatted or not.
+// Returns EmptyTaxID for an empty input, or an error if the input is neither a valid CPF nor a valid CNPJ.
+func NewTaxID(input string) (TaxID, error) {
+	return parseTaxID(input)
+}
+
+// NewTaxIDFromCPF creates a TaxID holding an already validated CPF.
+func NewTaxIDFromCPF(cpf CPF) TaxID {
+	return TaxID(cpf)
+}
+
+// NewTaxIDFromCNPJ creates a TaxID holding an already validated CNPJ.
+func NewTaxIDFromCNPJ(cnpj CNPJ) TaxID {
+	return TaxID(cnpj)
+}
+
+// Kind returns whether the TaxID belongs to an individual or a company,
+// or an empty kind for the zero value.
+func (t TaxID) Kind() TaxIDKind {
+	switch len(t) {
+	case 11:
+		return TaxIDKindIndividual
+	case 14:
+		return TaxIDKindCompany
+	default:
+		return ""
+	}
+}
+
+// IsCPF returns true if the TaxID holds a CPF.
+func (t TaxID) IsCPF() bool {
+	return t.Kind() == TaxIDKindIndividual
+}
+
+// IsCNPJ returns true if the TaxID holds a CNPJ.
+func (t TaxID) IsCNPJ() bool {
+	return t.Kind() == TaxIDKindCompany
+}
+
+// CPF returns the TaxID as a CPF and true, or EmptyCPF and false if it holds a CNPJ.
+func (t TaxID) CPF() (CPF, bool) {
+	if !t.IsCPF() {
+		return EmptyCPF, false
+	}
+	return CPF(t), true
+}
+
+// CNPJ returns the TaxID as a CNPJ and true, or EmptyCNPJ and false if it holds a CPF.
+func (t TaxID) CNPJ() (CNPJ, bool) {
+	if !t.IsCNPJ() {
+		return EmptyCNPJ, false
+	}
+	return CNPJ(t), true
+}
+
+// String returns the TaxID without formatting.
+func (t TaxID) String() string {
+	return string(t)
+}
+
+// Formatted returns the TaxID with the mask of its kind ("XXX.XXX.XXX-XX" or "XX.XXX.XXX/XXXX-XX").
+func (t TaxID) Formatted() string {
+	switch t.Kind() {
+	case TaxIDKindIndividual:
+		return CPF(t).Formatted()
+	case TaxIDKindCompany:
+		return CNPJ(t).Formatted()
+	default:
+		return t.String()
+	}
+}
+
+// Masked returns the TaxID with the masking rules of its kind (see CPF.Masked and CNPJ.Masked).
+func (t TaxID) Masked() string {
+	switch t.Kind() {
+	case TaxIDKindIndividual:
+		return CPF(t).Masked()
+	case TaxIDKindCompany:
+		return CNPJ(t).Masked()
+	default:
+		return ""
+	}
+}
+
+// IsZero returns true if the TaxID is the zero value (EmptyTaxID).
+func (t TaxID) IsZero() bool {
+	return t == EmptyTaxID
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the TaxID as a JSON string without formatting.
+func (t TaxID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a TaxID, performing full validation.
+func (t *TaxID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "TaxID must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	taxID, err := NewTaxID(s)
+	if err != nil {
+		return err
+	}
+	*t = taxID
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the TaxID as a string or nil if zero value.
+func (t TaxID) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts string or []byte values and validates them as TaxID.
+func (t *TaxID) Scan(src interface{}) error {
+	if src == nil {
+		*t = EmptyTaxID
+		return nil
+	}
+
+	var taxID TaxID
+	var err error
+	switch v := src.(type) {
+	case string:
+		taxID, err = parseTaxID(v)
+	case []byte:
+		taxID, err = parseTaxID(v)
+	default:
+		return fault.New("unsupported scan type for TaxID", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+
+	if err != nil {
+		return err
+	}
+	*t = taxID
+	return nil
+}
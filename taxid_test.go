@@ -0,0 +1,119 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type TaxIDSuite struct {
+	suite.Suite
+}
+
+func TestTaxIDSuite(t *testing.T) {
+	suite.Run(t, new(TaxIDSuite))
+}
+
+func (s *TaxIDSuite) TestNewTaxID() {
+	s.Run("should accept a CPF", func() {
+		doc, err := wisp.NewTaxID("529.982.247-25")
+		s.Require().NoError(err)
+		s.Equal("52998224725", doc.String())
+		s.Equal(wisp.TaxIDKindIndividual, doc.Kind())
+		s.True(doc.IsCPF())
+		s.Equal("529.982.247-25", doc.Formatted())
+
+		cpf, ok := doc.CPF()
+		s.True(ok)
+		s.Equal(wisp.CPF("52998224725"), cpf)
+		_, ok = doc.CNPJ()
+		s.False(ok)
+	})
+
+	s.Run("should accept a CNPJ", func() {
+		doc, err := wisp.NewTaxID("45543915000181")
+		s.Require().NoError(err)
+		s.Equal(wisp.TaxIDKindCompany, doc.Kind())
+		s.True(doc.IsCNPJ())
+		s.Equal("45.543.915/0001-81", doc.Formatted())
+
+		cnpj, ok := doc.CNPJ()
+		s.True(ok)
+		s.Equal(wisp.CNPJ("45543915000181"), cnpj)
+	})
+
+	s.Run("should return empty for empty input", func() {
+		doc, err := wisp.NewTaxID("")
+		s.Require().NoError(err)
+		s.True(doc.IsZero())
+		s.Equal(wisp.TaxIDKind(""), doc.Kind())
+	})
+
+	s.Run("should delegate validation", func() {
+		_, err := wisp.NewTaxID("529.982.247-26")
+		s.Require().Error(err)
+		s.Contains(err.Error(), "CPF")
+
+		_, err = wisp.NewTaxID("45.543.915/0001-82")
+		s.Require().Error(err)
+		s.Contains(err.Error(), "CNPJ")
+	})
+
+	s.Run("should reject other lengths", func() {
+		_, err := wisp.NewTaxID("123456789012")
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+
+	s.Run("should accept alphanumeric CNPJs when enabled", func() {
+		wisp.SetAlphanumericCNPJ(true)
+		defer wisp.SetAlphanumericCNPJ(false)
+
+		doc, err := wisp.NewTaxID("12.ABC.345/01DE-35")
+		s.Require().NoError(err)
+		s.True(doc.IsCNPJ())
+	})
+}
+
+func (s *TaxIDSuite) TestFromTypedDocuments() {
+	s.Equal(wisp.TaxIDKindIndividual, wisp.NewTaxIDFromCPF(wisp.CPF("52998224725")).Kind())
+	s.Equal(wisp.TaxIDKindCompany, wisp.NewTaxIDFromCNPJ(wisp.CNPJ("45543915000181")).Kind())
+}
+
+func (s *TaxIDSuite) TestJSONAndSQL() {
+	doc, _ := wisp.NewTaxID("45.543.915/0001-81")
+
+	s.Run("should round trip through JSON", func() {
+		data, err := json.Marshal(doc)
+		s.Require().NoError(err)
+		s.Equal(`"45543915000181"`, string(data))
+
+		var decoded wisp.TaxID
+		s.Require().NoError(json.Unmarshal([]byte(`"529.982.247-25"`), &decoded))
+		s.True(decoded.IsCPF())
+		s.Error(json.Unmarshal([]byte(`"123"`), &decoded))
+		s.Error(json.Unmarshal([]byte(`123`), &decoded))
+	})
+
+	s.Run("should round trip through the database", func() {
+		val, err := doc.Value()
+		s.Require().NoError(err)
+		s.Equal("45543915000181", val)
+
+		var scanned wisp.TaxID
+		s.Require().NoError(scanned.Scan([]byte("52998224725")))
+		s.True(scanned.IsCPF())
+
+		val, err = wisp.EmptyTaxID.Value()
+		s.Require().NoError(err)
+		s.Nil(val)
+
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+		s.Error(scanned.Scan(123))
+	})
+}
@@ -0,0 +1,181 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// TaxRegime represents the Brazilian federal tax regime a company is enrolled in
+// ("regime tributário"), which drives how invoices are issued and which taxes are withheld.
+//
+// Examples:
+//   - Input: "Simples Nacional", "simples-nacional" or "SIMPLES_NACIONAL"
+//   - Stored as: "SIMPLES_NACIONAL"
+type TaxRegime string
+
+const (
+	// TaxRegimeSimplesNacional is the simplified regime for micro and small companies (LC 123/2006).
+	TaxRegimeSimplesNacional TaxRegime = "SIMPLES_NACIONAL"
+	// TaxRegimeLucroPresumido computes income taxes over a presumed profit margin.
+	TaxRegimeLucroPresumido TaxRegime = "LUCRO_PRESUMIDO"
+	// TaxRegimeLucroReal computes income taxes over the actual accounting profit.
+	TaxRegimeLucroReal TaxRegime = "LUCRO_REAL"
+	// TaxRegimeMEI is the individual micro-entrepreneur regime ("Microempreendedor Individual").
+	TaxRegimeMEI TaxRegime = "MEI"
+)
+
+// EmptyTaxRegime represents the zero value for the TaxRegime type.
+var EmptyTaxRegime TaxRegime
+
+// taxRegimeLabels holds the valid regimes and their display names.
+var taxRegimeLabels = map[TaxRegime]string{
+	TaxRegimeSimplesNacional: "Simples Nacional",
+	TaxRegimeLucroPresumido:  "Lucro Presumido",
+	TaxRegimeLucroReal:       "Lucro Real",
+	TaxRegimeMEI:             "MEI",
+}
+
+// NewTaxRegime creates a new TaxRegime from a string.
+// It accepts the stored code or the display name, ignoring case and separators
+// (e.g., "Simples Nacional", "lucro-presumido", "LUCRO_REAL", "mei").
+// Returns an error if the value is not a known regime.
+func NewTaxRegime(input string) (TaxRegime, error) {
+	normalized := strings.TrimSpace(input)
+	if normalized == "" {
+		return EmptyTaxRegime, nil
+	}
+
+	normalized = strings.ToUpper(normalized)
+	normalized = strings.NewReplacer(" ", "_", "-", "_").Replace(normalized)
+
+	regime := TaxRegime(normalized)
+	if !regime.IsValid() {
+		return EmptyTaxRegime, fault.New(
+			"invalid tax regime",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", input),
+		)
+	}
+	return regime, nil
+}
+
+// String returns the tax regime code as a string.
+func (t TaxRegime) String() string {
+	return string(t)
+}
+
+// Label returns the display name of the regime (e.g., "Simples Nacional").
+func (t TaxRegime) Label() string {
+	return taxRegimeLabels[t]
+}
+
+// IsValid checks if the regime is one of the known tax regimes.
+func (t TaxRegime) IsValid() bool {
+	_, ok := taxRegimeLabels[t]
+	return ok
+}
+
+// IsZero returns true if the TaxRegime is the zero value.
+func (t TaxRegime) IsZero() bool {
+	return t == EmptyTaxRegime
+}
+
+// IsSimples returns true for the regimes collected through the Simples Nacional unified
+// payment (DAS): Simples Nacional itself and MEI.
+func (t TaxRegime) IsSimples() bool {
+	return t == TaxRegimeSimplesNacional || t == TaxRegimeMEI
+}
+
+// AllowsISSRetention returns true if the service taker may withhold ISS from the provider.
+// Every regime allows it except MEI, whose ISS is already paid in a fixed monthly amount.
+func (t TaxRegime) AllowsISSRetention() bool {
+	return t.IsValid() && t != TaxRegimeMEI
+}
+
+// HasNonCumulativePISCOFINS returns true if the regime uses the non-cumulative PIS/COFINS
+// system, which allows tax credits on inputs. Only Lucro Real does.
+func (t TaxRegime) HasNonCumulativePISCOFINS() bool {
+	return t == TaxRegimeLucroReal
+}
+
+// CRT returns the "Código de Regime Tributário" used in NF-e issuance:
+// 1 for Simples Nacional, 3 for Lucro Presumido and Lucro Real ("regime normal"), 4 for MEI,
+// and 0 for the zero value.
+func (t TaxRegime) CRT() int {
+	switch t {
+	case TaxRegimeSimplesNacional:
+		return 1
+	case TaxRegimeLucroPresumido, TaxRegimeLucroReal:
+		return 3
+	case TaxRegimeMEI:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the TaxRegime to its code.
+func (t TaxRegime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a TaxRegime, with validation.
+func (t *TaxRegime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = EmptyTaxRegime
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "TaxRegime must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	regime, err := NewTaxRegime(s)
+	if err != nil {
+		return err
+	}
+	*t = regime
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the TaxRegime code as a string or nil if it's the zero value.
+func (t TaxRegime) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a TaxRegime, with validation.
+func (t *TaxRegime) Scan(src interface{}) error {
+	if src == nil {
+		*t = EmptyTaxRegime
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New("unsupported scan type for TaxRegime", fault.WithCode(fault.Invalid), fault.WithContext("received_type", fmt.Sprintf("%T", src)))
+	}
+
+	regime, err := NewTaxRegime(s)
+	if err != nil {
+		return err
+	}
+	*t = regime
+	return nil
+}
@@ -0,0 +1,97 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type TaxRegimeSuite struct {
+	suite.Suite
+}
+
+func TestTaxRegimeSuite(t *testing.T) {
+	suite.Run(t, new(TaxRegimeSuite))
+}
+
+func (s *TaxRegimeSuite) TestNewTaxRegime() {
+	testCases := []struct {
+		input       string
+		expected    wisp.TaxRegime
+		expectError bool
+	}{
+		{input: "SIMPLES_NACIONAL", expected: wisp.TaxRegimeSimplesNacional},
+		{input: "Simples Nacional", expected: wisp.TaxRegimeSimplesNacional},
+		{input: " lucro-presumido ", expected: wisp.TaxRegimeLucroPresumido},
+		{input: "Lucro Real", expected: wisp.TaxRegimeLucroReal},
+		{input: "mei", expected: wisp.TaxRegimeMEI},
+		{input: "", expected: wisp.EmptyTaxRegime},
+		{input: "lucro arbitrado", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.input, func() {
+			regime, err := wisp.NewTaxRegime(tc.input)
+			if tc.expectError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.expected, regime)
+		})
+	}
+}
+
+func (s *TaxRegimeSuite) TestHelpers() {
+	testCases := []struct {
+		regime        wisp.TaxRegime
+		label         string
+		simples       bool
+		issRetention  bool
+		nonCumulative bool
+		crt           int
+	}{
+		{wisp.TaxRegimeSimplesNacional, "Simples Nacional", true, true, false, 1},
+		{wisp.TaxRegimeLucroPresumido, "Lucro Presumido", false, true, false, 3},
+		{wisp.TaxRegimeLucroReal, "Lucro Real", false, true, true, 3},
+		{wisp.TaxRegimeMEI, "MEI", true, false, false, 4},
+		{wisp.EmptyTaxRegime, "", false, false, false, 0},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.regime.String(), func() {
+			s.Equal(tc.label, tc.regime.Label())
+			s.Equal(tc.simples, tc.regime.IsSimples())
+			s.Equal(tc.issRetention, tc.regime.AllowsISSRetention())
+			s.Equal(tc.nonCumulative, tc.regime.HasNonCumulativePISCOFINS())
+			s.Equal(tc.crt, tc.regime.CRT())
+		})
+	}
+}
+
+func (s *TaxRegimeSuite) TestSerialization() {
+	data, err := json.Marshal(wisp.TaxRegimeLucroReal)
+	s.Require().NoError(err)
+	s.Equal(`"LUCRO_REAL"`, string(data))
+
+	var decoded wisp.TaxRegime
+	s.Require().NoError(json.Unmarshal([]byte(`"Simples Nacional"`), &decoded))
+	s.Equal(wisp.TaxRegimeSimplesNacional, decoded)
+	s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.True(decoded.IsZero())
+	s.Require().Error(json.Unmarshal([]byte(`"OTHER"`), &decoded))
+
+	val, err := wisp.TaxRegimeMEI.Value()
+	s.Require().NoError(err)
+	s.Equal("MEI", val)
+
+	var scanned wisp.TaxRegime
+	s.Require().NoError(scanned.Scan([]byte("LUCRO_PRESUMIDO")))
+	s.Equal(wisp.TaxRegimeLucroPresumido, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Require().Error(scanned.Scan(1))
+}
@@ -116,6 +116,12 @@ func (t TimeOfDay) String() string {
 	return fmt.Sprintf("%02d:%02d", t.Hour(), t.Minute())
 }
 
+// appendClock appends the time formatted as HH:MM to dst.
+func (t TimeOfDay) appendClock(dst []byte) []byte {
+	h, m := t.Hour(), t.Minute()
+	return append(dst, byte('0'+h/10), byte('0'+h%10), ':', byte('0'+m/10), byte('0'+m%10))
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 // It serializes the TimeOfDay as an HH:MM formatted JSON string.
 func (t TimeOfDay) MarshalJSON() ([]byte, error) {
@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/marcelofabianov/fault"
 )
@@ -61,6 +62,100 @@ func (tr TimeRange) Contains(t TimeOfDay) bool {
 	return !t.Before(tr.start) && t.Before(tr.end)
 }
 
+// Overlaps checks if two time ranges share at least one minute.
+// Adjacent ranges, such as 09:00-12:00 and 12:00-13:00, do not overlap.
+func (tr TimeRange) Overlaps(other TimeRange) bool {
+	if tr.IsZero() || other.IsZero() {
+		return false
+	}
+	return tr.start.Before(other.end) && other.start.Before(tr.end)
+}
+
+// Minutes returns the length of the range in minutes.
+func (tr TimeRange) Minutes() int {
+	return tr.end.minutesFromMidnight - tr.start.minutesFromMidnight
+}
+
+// MergeTimeRanges normalizes a set of ranges within a single day: overlapping and adjacent ranges
+// are combined, zero ranges are dropped, and the result is sorted by start time.
+//
+// Example:
+//   MergeTimeRanges(09:00-12:00, 11:00-13:00, 13:00-14:00, 15:00-16:00)
+//   // [09:00-14:00, 15:00-16:00]
+func MergeTimeRanges(ranges ...TimeRange) []TimeRange {
+	sorted := make([]TimeRange, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.IsZero() {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].start.Before(sorted[j].start)
+	})
+
+	merged := make([]TimeRange, 0, len(sorted))
+	for _, r := range sorted {
+		last := len(merged) - 1
+		if last >= 0 && !r.start.After(merged[last].end) {
+			if r.end.After(merged[last].end) {
+				merged[last].end = r.end
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// SubtractTimeRanges removes every range in remove from the ranges in from, returning the
+// remaining normalized ranges sorted by start time. It is useful for computing free slots,
+// e.g. business hours minus existing bookings.
+//
+// Example:
+//   SubtractTimeRanges([]TimeRange{09:00-18:00}, []TimeRange{12:00-13:00})
+//   // [09:00-12:00, 13:00-18:00]
+func SubtractTimeRanges(from, remove []TimeRange) []TimeRange {
+	base := MergeTimeRanges(from...)
+	cuts := MergeTimeRanges(remove...)
+
+	result := make([]TimeRange, 0, len(base))
+	for _, r := range base {
+		current := r
+		for _, cut := range cuts {
+			if !cut.end.After(current.start) {
+				continue
+			}
+			if !cut.start.Before(current.end) {
+				break
+			}
+			if current.start.Before(cut.start) {
+				result = append(result, TimeRange{start: current.start, end: cut.start})
+			}
+			current.start = cut.end
+			if !current.start.Before(current.end) {
+				break
+			}
+		}
+		if current.start.Before(current.end) {
+			result = append(result, current)
+		}
+	}
+	return result
+}
+
+// FindTimeRangeGaps returns the uncovered periods of within that are not occupied by any of the
+// given ranges, sorted by start time. Ranges extending beyond within are clipped to it.
+//
+// Example:
+//   FindTimeRangeGaps([]TimeRange{09:00-10:00, 11:00-12:00}, 08:00-13:00)
+//   // [08:00-09:00, 10:00-11:00, 12:00-13:00]
+func FindTimeRangeGaps(ranges []TimeRange, within TimeRange) []TimeRange {
+	if within.IsZero() {
+		return []TimeRange{}
+	}
+	return SubtractTimeRanges([]TimeRange{within}, ranges)
+}
+
 // String returns a formatted string representation of the time range, like "HH:MM-HH:MM".
 func (tr TimeRange) String() string {
 	return fmt.Sprintf("%s-%s", tr.start.String(), tr.end.String())
@@ -69,13 +164,20 @@ func (tr TimeRange) String() string {
 // MarshalJSON implements the json.Marshaler interface.
 // It serializes the TimeRange into a JSON object with "start" and "end" fields.
 func (tr TimeRange) MarshalJSON() ([]byte, error) {
-	return json.Marshal(&struct {
-		Start string `json:"start"`
-		End   string `json:"end"`
-	}{
-		Start: tr.start.String(),
-		End:   tr.end.String(),
-	})
+	buf := getJSONBuffer()
+	defer putJSONBuffer(buf)
+
+	buf.b = tr.appendJSON(buf.b)
+	return buf.bytes(), nil
+}
+
+// appendJSON appends the JSON object representation of the TimeRange to dst.
+func (tr TimeRange) appendJSON(dst []byte) []byte {
+	dst = append(dst, `{"start":"`...)
+	dst = tr.start.appendClock(dst)
+	dst = append(dst, `","end":"`...)
+	dst = tr.end.appendClock(dst)
+	return append(dst, `"}`...)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
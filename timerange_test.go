@@ -85,3 +85,92 @@ func (s *TimeRangeSuite) TestTimeRange_JSON() {
 		s.Require().Error(err)
 	})
 }
+
+func (s *TimeRangeSuite) tr(start, end string) wisp.TimeRange {
+	st, err := wisp.ParseTimeOfDay(start)
+	s.Require().NoError(err)
+	en, err := wisp.ParseTimeOfDay(end)
+	s.Require().NoError(err)
+	r, err := wisp.NewTimeRange(st, en)
+	s.Require().NoError(err)
+	return r
+}
+
+func (s *TimeRangeSuite) rangeStrings(ranges []wisp.TimeRange) []string {
+	out := make([]string, len(ranges))
+	for i, r := range ranges {
+		out[i] = r.String()
+	}
+	return out
+}
+
+func (s *TimeRangeSuite) TestTimeRange_OverlapsAndMinutes() {
+	morning := s.tr("09:00", "12:00")
+	s.True(morning.Overlaps(s.tr("11:59", "13:00")))
+	s.False(morning.Overlaps(s.tr("12:00", "13:00")))
+	s.False(morning.Overlaps(wisp.ZeroTimeRange))
+	s.Equal(180, morning.Minutes())
+}
+
+func (s *TimeRangeSuite) TestMergeTimeRanges() {
+	merged := wisp.MergeTimeRanges(
+		s.tr("15:00", "16:00"),
+		s.tr("09:00", "12:00"),
+		s.tr("13:00", "14:00"),
+		s.tr("11:00", "13:00"),
+		s.tr("09:30", "10:00"),
+		wisp.ZeroTimeRange,
+	)
+	s.Equal([]string{"09:00-14:00", "15:00-16:00"}, s.rangeStrings(merged))
+	s.Empty(wisp.MergeTimeRanges())
+}
+
+func (s *TimeRangeSuite) TestSubtractTimeRanges() {
+	testCases := []struct {
+		name     string
+		from     []wisp.TimeRange
+		remove   []wisp.TimeRange
+		expected []string
+	}{
+		{
+			name:     "lunch break",
+			from:     []wisp.TimeRange{s.tr("09:00", "18:00")},
+			remove:   []wisp.TimeRange{s.tr("12:00", "13:00")},
+			expected: []string{"09:00-12:00", "13:00-18:00"},
+		},
+		{
+			name:     "cuts overlapping the edges",
+			from:     []wisp.TimeRange{s.tr("09:00", "12:00"), s.tr("14:00", "18:00")},
+			remove:   []wisp.TimeRange{s.tr("08:00", "10:00"), s.tr("11:30", "15:00"), s.tr("17:00", "19:00")},
+			expected: []string{"10:00-11:30", "15:00-17:00"},
+		},
+		{
+			name:     "fully removed",
+			from:     []wisp.TimeRange{s.tr("09:00", "10:00")},
+			remove:   []wisp.TimeRange{s.tr("08:00", "11:00")},
+			expected: []string{},
+		},
+		{
+			name:     "nothing to remove",
+			from:     []wisp.TimeRange{s.tr("10:00", "11:00"), s.tr("09:00", "10:00")},
+			remove:   nil,
+			expected: []string{"09:00-11:00"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.expected, s.rangeStrings(wisp.SubtractTimeRanges(tc.from, tc.remove)))
+		})
+	}
+}
+
+func (s *TimeRangeSuite) TestFindTimeRangeGaps() {
+	bookings := []wisp.TimeRange{s.tr("11:00", "12:00"), s.tr("09:00", "10:00"), s.tr("12:30", "14:00")}
+
+	gaps := wisp.FindTimeRangeGaps(bookings, s.tr("08:00", "13:00"))
+	s.Equal([]string{"08:00-09:00", "10:00-11:00", "12:00-12:30"}, s.rangeStrings(gaps))
+
+	s.Empty(wisp.FindTimeRangeGaps(bookings, wisp.ZeroTimeRange))
+	s.Equal([]string{"08:00-18:00"}, s.rangeStrings(wisp.FindTimeRangeGaps(nil, s.tr("08:00", "18:00"))))
+}
@@ -0,0 +1,35 @@
+package wisp
+
+import "time"
+
+// TimestampPrecision defines the resolution of the audit timestamps created by
+// NewCreatedAt, NewUpdatedAt and UpdatedAt.Touch. Matching the precision of the database
+// column (e.g., microseconds for PostgreSQL) avoids values that change after a round trip.
+type TimestampPrecision time.Duration
+
+// Supported timestamp precisions.
+const (
+	TimestampSeconds TimestampPrecision = TimestampPrecision(time.Second)
+	TimestampMillis  TimestampPrecision = TimestampPrecision(time.Millisecond)
+	TimestampMicros  TimestampPrecision = TimestampPrecision(time.Microsecond)
+	TimestampNanos   TimestampPrecision = TimestampPrecision(time.Nanosecond)
+)
+
+// timestampPrecision is the precision used for new audit timestamps.
+// It can be configured globally using SetTimestampPrecision.
+var timestampPrecision = TimestampNanos
+
+// SetTimestampPrecision configures the global precision of new audit timestamps.
+// The default is TimestampNanos, which keeps the full resolution of the system clock.
+// Unsupported values are ignored.
+func SetTimestampPrecision(precision TimestampPrecision) {
+	switch precision {
+	case TimestampSeconds, TimestampMillis, TimestampMicros, TimestampNanos:
+		timestampPrecision = precision
+	}
+}
+
+// nowTimestamp returns the current UTC time truncated to the configured precision.
+func nowTimestamp() time.Time {
+	return time.Now().UTC().Truncate(time.Duration(timestampPrecision))
+}
@@ -0,0 +1,313 @@
+package wisp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+)
+
+const (
+	// MinTokenEntropyBits is the minimum estimated entropy accepted by NewToken.
+	// The estimate is conservative: random tokens of 32 or more URL-safe characters pass.
+	MinTokenEntropyBits = 96
+	// MaxTokenLength is the maximum length accepted by NewToken.
+	MaxTokenLength = 512
+
+	tokenRandomBytes = 32
+)
+
+// Token is a value object representing an opaque bearer token, such as an API key, a session
+// token or a password reset link token, optionally paired with its ExpiresAt.
+//
+// The plaintext must never be persisted: store Hash() instead and compare incoming tokens with
+// TokenHash.Matches. For the same reason Token has no database interface, and it is
+// "[REDACTED]" when printed or serialized to JSON; the value is only available through Reveal.
+//
+// The zero value is EmptyToken.
+//
+// Example:
+//   token, err := wisp.GenerateToken(30 * time.Minute)
+//   repo.Save(userID, token.Hash(), token.ExpiresAt()) // persist only the hash
+//   link := "https://app.example.com/reset?token=" + token.Reveal()
+type Token struct {
+	value     string
+	expiresAt ExpiresAt
+}
+
+// EmptyToken represents the zero value for Token.
+var EmptyToken Token
+
+// GenerateToken creates a random 256-bit token, encoded as 43 URL-safe characters, expiring
+// after ttl. A ttl of zero creates a token without expiry.
+func GenerateToken(ttl time.Duration) (Token, error) {
+	if ttl < 0 {
+		return EmptyToken, fault.New(
+			"token validity cannot be negative",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("ttl", ttl.String()),
+		)
+	}
+
+	buf := make([]byte, tokenRandomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return EmptyToken, fault.Wrap(err, "failed to generate token", fault.WithCode(fault.Internal))
+	}
+
+	token := Token{value: base64.RawURLEncoding.EncodeToString(buf)}
+	if ttl > 0 {
+		token.expiresAt = NewExpiresAtIn(ttl)
+	}
+	return token, nil
+}
+
+// NewToken creates a Token from an existing value, such as one read from an Authorization header,
+// paired with its expiry (ZeroExpiresAt for none). Surrounding whitespace is ignored.
+// It returns an error if the value is empty, too long, contains whitespace or control
+// characters, or its estimated entropy is below MinTokenEntropyBits.
+func NewToken(value string, expiresAt ExpiresAt) (Token, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return EmptyToken, fault.New("token cannot be empty", fault.WithCode(fault.Invalid))
+	}
+	if len(trimmed) > MaxTokenLength {
+		return EmptyToken, fault.New(
+			"token exceeds maximum length",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("length", len(trimmed)),
+			fault.WithContext("max_length", MaxTokenLength),
+		)
+	}
+	for i := 0; i < len(trimmed); i++ {
+		if trimmed[i] <= ' ' || trimmed[i] >= 0x7f {
+			return EmptyToken, fault.New("token must contain only printable ASCII characters", fault.WithCode(fault.Invalid))
+		}
+	}
+	if bits := tokenEntropyBits(trimmed); bits < MinTokenEntropyBits {
+		return EmptyToken, fault.New(
+			"token entropy is too low",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("estimated_bits", int(bits)),
+			fault.WithContext("min_bits", MinTokenEntropyBits),
+		)
+	}
+	return Token{value: trimmed, expiresAt: expiresAt}, nil
+}
+
+// tokenEntropyBits estimates the entropy of s from the frequency of its characters (Shannon
+// entropy per character times its length). Repetitive values such as "aaaa..." score zero.
+func tokenEntropyBits(s string) float64 {
+	var counts [128]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+
+	n := float64(len(s))
+	perChar := 0.0
+	for _, c := range counts {
+		if c > 0 {
+			p := float64(c) / n
+			perChar -= p * math.Log2(p)
+		}
+	}
+	return perChar * n
+}
+
+// Reveal returns the plaintext token, to be handed to its owner. Callers must not log or store it.
+func (t Token) Reveal() string {
+	return t.value
+}
+
+// ExpiresAt returns the expiry of the token, or ZeroExpiresAt if it never expires.
+func (t Token) ExpiresAt() ExpiresAt {
+	return t.expiresAt
+}
+
+// WithExpiry returns a copy of the token with the given expiry.
+func (t Token) WithExpiry(expiresAt ExpiresAt) Token {
+	t.expiresAt = expiresAt
+	return t
+}
+
+// IsExpired returns true if the token has an expiry that has passed.
+func (t Token) IsExpired() bool {
+	return t.expiresAt.IsExpired()
+}
+
+// IsZero returns true if the Token is the zero value.
+func (t Token) IsZero() bool {
+	return t.value == ""
+}
+
+// Hash returns the SHA-256 digest of the token, the form to persist. A fast hash is adequate
+// because tokens are random; passwords need PasswordHash instead.
+// Returns EmptyTokenHash for EmptyToken.
+func (t Token) Hash() TokenHash {
+	if t.IsZero() {
+		return EmptyTokenHash
+	}
+	sum := sha256.Sum256([]byte(t.value))
+	return TokenHash(hex.EncodeToString(sum[:]))
+}
+
+// String returns "[REDACTED]", so the token is never printed by accident.
+func (t Token) String() string {
+	return redactedSecret
+}
+
+// GoString returns "[REDACTED]", protecting the token from the %#v verb.
+func (t Token) GoString() string {
+	return redactedSecret
+}
+
+// Masked implements the Masker interface, so Redact hides the token as well.
+func (t Token) Masked() string {
+	return redactedSecret
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It always serializes the Token as "[REDACTED]".
+func (t Token) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redactedSecret)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a Token without expiry, with validation;
+// null results in EmptyToken.
+func (t *Token) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = EmptyToken
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "Token must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	token, err := NewToken(s, ZeroExpiresAt)
+	if err != nil {
+		return err
+	}
+	*t = token
+	return nil
+}
+
+// TokenHash is the hex-encoded SHA-256 digest of a Token, safe to persist and to index for lookups.
+//
+// The zero value is EmptyTokenHash.
+type TokenHash string
+
+// EmptyTokenHash represents the zero value for TokenHash.
+var EmptyTokenHash TokenHash
+
+// NewTokenHash creates a TokenHash from its hex representation, normalized to lowercase.
+// It returns an error if the value is not 64 hexadecimal characters.
+func NewTokenHash(value string) (TokenHash, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if decoded, err := hex.DecodeString(normalized); err != nil || len(decoded) != sha256.Size {
+		return EmptyTokenHash, fault.New(
+			"token hash must be a hex-encoded SHA-256 digest",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+		)
+	}
+	return TokenHash(normalized), nil
+}
+
+// Matches checks, in constant time, whether the token hashes to h.
+// EmptyTokenHash never matches.
+func (h TokenHash) Matches(token Token) bool {
+	if h.IsZero() || token.IsZero() {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(h), []byte(token.Hash())) == 1
+}
+
+// IsZero returns true if the TokenHash is the zero value.
+func (h TokenHash) IsZero() bool {
+	return h == EmptyTokenHash
+}
+
+// String returns the hex-encoded digest.
+func (h TokenHash) String() string {
+	return string(h)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the TokenHash as a JSON string, or null if it is empty.
+func (h TokenHash) MarshalJSON() ([]byte, error) {
+	if h.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(h.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a TokenHash, with validation; null results in EmptyTokenHash.
+func (h *TokenHash) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*h = EmptyTokenHash
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "TokenHash must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	parsed, err := NewTokenHash(s)
+	if err != nil {
+		return err
+	}
+	*h = parsed
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the TokenHash as a string, or nil if it is empty.
+func (h TokenHash) Value() (driver.Value, error) {
+	if h.IsZero() {
+		return nil, nil
+	}
+	return h.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice and converts it into a TokenHash, with validation.
+func (h *TokenHash) Scan(src interface{}) error {
+	if src == nil {
+		*h = EmptyTokenHash
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for TokenHash",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	parsed, err := NewTokenHash(s)
+	if err != nil {
+		return err
+	}
+	*h = parsed
+	return nil
+}
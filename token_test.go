@@ -0,0 +1,185 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	wisp "github.com/marcelofabianov/wisp"
+)
+
+const sampleToken = "q8Zr3TfK1xLw9VbN2mYp7HcD4sGj6UeA0oRi5Wn"
+
+type TokenSuite struct {
+	suite.Suite
+}
+
+func TestTokenSuite(t *testing.T) {
+	suite.Run(t, new(TokenSuite))
+}
+
+func (s *TokenSuite) TestGenerateToken() {
+	s.Run("should generate distinct url-safe tokens", func() {
+		a, err := wisp.GenerateToken(time.Hour)
+		s.Require().NoError(err)
+		b, _ := wisp.GenerateToken(time.Hour)
+
+		s.Len(a.Reveal(), 43)
+		s.Regexp(`^[A-Za-z0-9_-]+$`, a.Reveal())
+		s.NotEqual(a.Reveal(), b.Reveal())
+		s.InDelta(time.Hour.Seconds(), a.ExpiresAt().Remaining().Seconds(), 5)
+		s.False(a.IsExpired())
+
+		_, err = wisp.NewToken(a.Reveal(), a.ExpiresAt())
+		s.NoError(err, "generated tokens must pass validation")
+	})
+
+	s.Run("should generate tokens without expiry", func() {
+		t, err := wisp.GenerateToken(0)
+		s.Require().NoError(err)
+		s.True(t.ExpiresAt().IsZero())
+		s.False(t.IsExpired())
+	})
+
+	s.Run("should reject a negative ttl", func() {
+		_, err := wisp.GenerateToken(-time.Second)
+		s.Error(err)
+	})
+}
+
+func (s *TokenSuite) TestNewToken() {
+	s.Run("should accept high-entropy values", func() {
+		t, err := wisp.NewToken("  "+sampleToken+"\n", wisp.ZeroExpiresAt)
+		s.Require().NoError(err)
+		s.Equal(sampleToken, t.Reveal())
+
+		hexToken := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+		_, err = wisp.NewToken(hexToken, wisp.ZeroExpiresAt)
+		s.NoError(err)
+	})
+
+	s.Run("should reject weak or malformed values", func() {
+		inputs := []string{
+			"",
+			"password123",
+			strings.Repeat("a", 64),
+			strings.Repeat("ab", 40),
+			"q8Zr3TfK1xLw9VbN 2mYp7HcD4sGj6UeA0oRi5Wn",
+			sampleToken + "é",
+			strings.Repeat(sampleToken, 14),
+		}
+		for _, input := range inputs {
+			t, err := wisp.NewToken(input, wisp.ZeroExpiresAt)
+			s.Require().Error(err, input)
+			s.True(t.IsZero())
+			s.Equal(fault.Invalid, err.(*fault.Error).Code, input)
+		}
+	})
+}
+
+func (s *TokenSuite) TestToken_Expiry() {
+	t, _ := wisp.NewToken(sampleToken, wisp.ZeroExpiresAt)
+	s.False(t.IsExpired())
+
+	expired := t.WithExpiry(wisp.NewExpiresAt(time.Now().Add(-time.Minute)))
+	s.True(expired.IsExpired())
+	s.False(t.IsExpired(), "WithExpiry must not change the original")
+	s.Equal(t.Reveal(), expired.Reveal())
+}
+
+func (s *TokenSuite) TestToken_NeverLeaks() {
+	t, _ := wisp.NewToken(sampleToken, wisp.ZeroExpiresAt)
+
+	s.Equal("[REDACTED]", t.String())
+	s.NotContains(fmt.Sprintf("%v %+v %#v", t, t, t), sampleToken)
+
+	data, err := json.Marshal(t)
+	s.Require().NoError(err)
+	s.Equal(`"[REDACTED]"`, string(data))
+
+	s.Equal(map[string]any{"token": "[REDACTED]"}, wisp.Redact(struct {
+		Token wisp.Token `json:"token"`
+	}{t}))
+}
+
+func (s *TokenSuite) TestToken_UnmarshalJSON() {
+	var t wisp.Token
+	s.Require().NoError(json.Unmarshal([]byte(`"`+sampleToken+`"`), &t))
+	s.Equal(sampleToken, t.Reveal())
+
+	s.Require().NoError(json.Unmarshal([]byte(`null`), &t))
+	s.True(t.IsZero())
+
+	s.Error(json.Unmarshal([]byte(`"weak"`), &t))
+	s.Error(json.Unmarshal([]byte(`42`), &t))
+}
+
+func (s *TokenSuite) TestTokenHash() {
+	t, _ := wisp.NewToken(sampleToken, wisp.ZeroExpiresAt)
+	other, _ := wisp.GenerateToken(0)
+	hash := t.Hash()
+
+	s.Run("should hash deterministically without exposing the token", func() {
+		s.Len(hash.String(), 64)
+		s.Equal(hash, t.Hash())
+		s.NotContains(hash.String(), sampleToken)
+		s.True(wisp.EmptyToken.Hash().IsZero())
+	})
+
+	s.Run("should match only the original token", func() {
+		s.True(hash.Matches(t))
+		s.False(hash.Matches(other))
+		s.False(hash.Matches(wisp.EmptyToken))
+		s.False(wisp.EmptyTokenHash.Matches(t))
+	})
+
+	s.Run("should parse hex digests", func() {
+		parsed, err := wisp.NewTokenHash(strings.ToUpper(hash.String()))
+		s.Require().NoError(err)
+		s.Equal(hash, parsed)
+
+		for _, input := range []string{"", "abc", strings.Repeat("z", 64), hash.String() + "00"} {
+			_, err := wisp.NewTokenHash(input)
+			s.Require().Error(err, input)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code)
+		}
+	})
+
+	s.Run("should round-trip through JSON", func() {
+		data, err := json.Marshal(hash)
+		s.Require().NoError(err)
+
+		var decoded wisp.TokenHash
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.Equal(hash, decoded)
+
+		data, _ = json.Marshal(wisp.EmptyTokenHash)
+		s.Equal("null", string(data))
+		s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+		s.True(decoded.IsZero())
+		s.Error(json.Unmarshal([]byte(`"abc"`), &decoded))
+	})
+
+	s.Run("should round-trip through the database", func() {
+		v, err := hash.Value()
+		s.Require().NoError(err)
+		s.Equal(hash.String(), v)
+		v, _ = wisp.EmptyTokenHash.Value()
+		s.Nil(v)
+
+		var scanned wisp.TokenHash
+		s.Require().NoError(scanned.Scan([]byte(hash.String())))
+		s.Equal(hash, scanned)
+		s.Require().NoError(scanned.Scan(nil))
+		s.True(scanned.IsZero())
+		s.Error(scanned.Scan("abc"))
+		err = scanned.Scan(42)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
@@ -0,0 +1,115 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// maxTradeNameLength is the longest trade name accepted by the CNPJ registry of the Receita Federal.
+const maxTradeNameLength = 55
+
+// TradeName is a value object representing the name a Brazilian company uses with the public
+// (Nome Fantasia), as registered with the Receita Federal next to its CompanyName.
+//
+// The name is trimmed and inner whitespace is collapsed; its casing is kept, since trade names are
+// brands. Equals ignores case, accents and punctuation.
+//
+// The zero value is EmptyTradeName; many companies do not register a trade name.
+//
+// Example:
+//   name, err := wisp.NewTradeName("  Café   do Ponto ")
+//   name.String() // "Café do Ponto"
+type TradeName string
+
+// EmptyTradeName represents the zero value for the TradeName type.
+var EmptyTradeName TradeName
+
+// NewTradeName creates a new TradeName, normalizing whitespace.
+// Returns EmptyTradeName for an empty input, or an error if the name has control characters or
+// more than 55 characters.
+func NewTradeName(input string) (TradeName, error) {
+	name, err := validateBusinessName(strings.Join(strings.Fields(input), " "), maxTradeNameLength, "trade name")
+	if err != nil {
+		return EmptyTradeName, err
+	}
+	return TradeName(name), nil
+}
+
+// String returns the normalized trade name.
+func (t TradeName) String() string {
+	return string(t)
+}
+
+// IsZero returns true if the TradeName is the zero value.
+func (t TradeName) IsZero() bool {
+	return t == EmptyTradeName
+}
+
+// Equals checks if two trade names are the same, ignoring case, accents, spaces and punctuation.
+func (t TradeName) Equals(other TradeName) bool {
+	return businessNameKey(string(t)) == businessNameKey(string(other))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the TradeName as a JSON string.
+func (t TradeName) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a TradeName, with normalization and validation.
+func (t *TradeName) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "TradeName must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+	name, err := NewTradeName(s)
+	if err != nil {
+		return err
+	}
+	*t = name
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the TradeName as a string, or nil for the zero value.
+func (t TradeName) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a TradeName.
+func (t *TradeName) Scan(src interface{}) error {
+	if src == nil {
+		*t = EmptyTradeName
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for TradeName",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	name, err := NewTradeName(s)
+	if err != nil {
+		return err
+	}
+	*t = name
+	return nil
+}
@@ -0,0 +1,85 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type TradeNameSuite struct {
+	suite.Suite
+}
+
+func TestTradeNameSuite(t *testing.T) {
+	suite.Run(t, new(TradeNameSuite))
+}
+
+func (s *TradeNameSuite) TestNewTradeName() {
+	s.Run("should normalize whitespace and keep casing", func() {
+		name, err := wisp.NewTradeName("  Café   do Ponto ")
+		s.Require().NoError(err)
+		s.Equal(wisp.TradeName("Café do Ponto"), name)
+
+		name, err = wisp.NewTradeName("Padaria da Sa")
+		s.Require().NoError(err)
+		s.Equal(wisp.TradeName("Padaria da Sa"), name)
+	})
+
+	s.Run("should return EmptyTradeName for an empty input", func() {
+		name, err := wisp.NewTradeName("")
+		s.Require().NoError(err)
+		s.True(name.IsZero())
+	})
+
+	s.Run("should reject names longer than 55 characters", func() {
+		_, err := wisp.NewTradeName(strings.Repeat("A", 56))
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+
+		_, err = wisp.NewTradeName(strings.Repeat("A", 55))
+		s.NoError(err)
+	})
+}
+
+func (s *TradeNameSuite) TestEquals() {
+	a, _ := wisp.NewTradeName("Café do Ponto!")
+	b, _ := wisp.NewTradeName("CAFE DO PONTO")
+	c, _ := wisp.NewTradeName("Café da Esquina")
+	s.True(a.Equals(b))
+	s.False(a.Equals(c))
+}
+
+func (s *TradeNameSuite) TestJSON() {
+	name, _ := wisp.NewTradeName("Café do Ponto")
+	data, err := json.Marshal(name)
+	s.Require().NoError(err)
+	s.Equal(`"Café do Ponto"`, string(data))
+
+	var decoded wisp.TradeName
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(name, decoded)
+	s.Error(json.Unmarshal([]byte(`1`), &decoded))
+}
+
+func (s *TradeNameSuite) TestSQL() {
+	name, _ := wisp.NewTradeName("Café do Ponto")
+	value, err := name.Value()
+	s.Require().NoError(err)
+	s.Equal("Café do Ponto", value)
+
+	value, err = wisp.EmptyTradeName.Value()
+	s.Require().NoError(err)
+	s.Nil(value)
+
+	var scanned wisp.TradeName
+	s.Require().NoError(scanned.Scan("Café do Ponto"))
+	s.Equal(name, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Error(scanned.Scan(1))
+}
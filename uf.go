@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/marcelofabianov/fault"
@@ -12,6 +13,7 @@ import (
 // UF represents a Brazilian state code (Unidade Federativa).
 // It is a value object that ensures the code is a valid, two-letter, uppercase abbreviation
 // corresponding to one of the Brazilian states or the Federal District.
+// It also exposes the state name, region, time zones and area codes (DDD).
 //
 // Examples:
 //   - Input: "sp" or " SP "
@@ -28,6 +30,66 @@ var validUFs = map[UF]struct{}{
 	"RJ": {}, "RN": {}, "RS": {}, "RO": {}, "RR": {}, "SC": {}, "SP": {}, "SE": {}, "TO": {},
 }
 
+// ufRegions maps every state to its geographic region.
+var ufRegions = map[UF]Region{
+	"AC": RegionNorte, "AP": RegionNorte, "AM": RegionNorte, "PA": RegionNorte, "RO": RegionNorte, "RR": RegionNorte, "TO": RegionNorte,
+	"AL": RegionNordeste, "BA": RegionNordeste, "CE": RegionNordeste, "MA": RegionNordeste, "PB": RegionNordeste,
+	"PE": RegionNordeste, "PI": RegionNordeste, "RN": RegionNordeste, "SE": RegionNordeste,
+	"DF": RegionCentroOeste, "GO": RegionCentroOeste, "MT": RegionCentroOeste, "MS": RegionCentroOeste,
+	"ES": RegionSudeste, "MG": RegionSudeste, "RJ": RegionSudeste, "SP": RegionSudeste,
+	"PR": RegionSul, "RS": RegionSul, "SC": RegionSul,
+}
+
+// ufNames holds the official names of the states.
+var ufNames = map[UF]string{
+	"AC": "Acre", "AL": "Alagoas", "AP": "Amapá", "AM": "Amazonas", "BA": "Bahia", "CE": "Ceará",
+	"DF": "Distrito Federal", "ES": "Espírito Santo", "GO": "Goiás", "MA": "Maranhão", "MT": "Mato Grosso",
+	"MS": "Mato Grosso do Sul", "MG": "Minas Gerais", "PA": "Pará", "PB": "Paraíba", "PR": "Paraná",
+	"PE": "Pernambuco", "PI": "Piauí", "RJ": "Rio de Janeiro", "RN": "Rio Grande do Norte",
+	"RS": "Rio Grande do Sul", "RO": "Rondônia", "RR": "Roraima", "SC": "Santa Catarina",
+	"SP": "São Paulo", "SE": "Sergipe", "TO": "Tocantins",
+}
+
+// ufTimeZones holds the IANA time zones used in each state, the one covering the capital first.
+var ufTimeZones = map[UF][]string{
+	"AC": {"America/Rio_Branco"},
+	"AL": {"America/Maceio"},
+	"AP": {"America/Belem"},
+	"AM": {"America/Manaus", "America/Eirunepe"},
+	"BA": {"America/Bahia"},
+	"CE": {"America/Fortaleza"},
+	"DF": {"America/Sao_Paulo"},
+	"ES": {"America/Sao_Paulo"},
+	"GO": {"America/Sao_Paulo"},
+	"MA": {"America/Fortaleza"},
+	"MT": {"America/Cuiaba"},
+	"MS": {"America/Campo_Grande"},
+	"MG": {"America/Sao_Paulo"},
+	"PA": {"America/Belem", "America/Santarem"},
+	"PB": {"America/Fortaleza"},
+	"PR": {"America/Sao_Paulo"},
+	"PE": {"America/Recife", "America/Noronha"},
+	"PI": {"America/Fortaleza"},
+	"RJ": {"America/Sao_Paulo"},
+	"RN": {"America/Fortaleza"},
+	"RS": {"America/Sao_Paulo"},
+	"RO": {"America/Porto_Velho"},
+	"RR": {"America/Boa_Vista"},
+	"SC": {"America/Sao_Paulo"},
+	"SP": {"America/Sao_Paulo"},
+	"SE": {"America/Maceio"},
+	"TO": {"America/Araguaina"},
+}
+
+// ufIBGECodes holds the 2-digit IBGE codes of the states, used in NF-e access keys and municipality codes.
+var ufIBGECodes = map[UF]string{
+	"RO": "11", "AC": "12", "AM": "13", "RR": "14", "PA": "15", "AP": "16", "TO": "17",
+	"MA": "21", "PI": "22", "CE": "23", "RN": "24", "PB": "25", "PE": "26", "AL": "27", "SE": "28", "BA": "29",
+	"MG": "31", "ES": "32", "RJ": "33", "SP": "35",
+	"PR": "41", "SC": "42", "RS": "43",
+	"MS": "50", "MT": "51", "GO": "52", "DF": "53",
+}
+
 // NewUF creates a new UF from a string.
 // It normalizes the input to uppercase and validates it against the list of official Brazilian state codes.
 // Returns an error if the code is not a valid UF.
@@ -59,6 +121,80 @@ func (u UF) IsValid() bool {
 	return ok
 }
 
+// UFFromDDD returns the state served by a Brazilian area code (DDD), such as "11" for SP.
+// It uses the same table that validates Phone area codes, so Phone.State() and
+// UFFromDDD(phone.AreaCode()) always agree. Returns an error if the DDD does not exist.
+func UFFromDDD(ddd string) (UF, error) {
+	uf, ok := dddStates[strings.TrimSpace(ddd)]
+	if !ok {
+		return EmptyUF, fault.New(
+			"invalid area code (DDD)",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("area_code", ddd),
+		)
+	}
+	return uf, nil
+}
+
+// UFFromIBGECode returns the state with the given 2-digit IBGE code, such as "35" for SP.
+// Returns an error if no state has the code.
+func UFFromIBGECode(code string) (UF, error) {
+	code = strings.TrimSpace(code)
+	for uf, ibge := range ufIBGECodes {
+		if ibge == code {
+			return uf, nil
+		}
+	}
+	return EmptyUF, fault.New(
+		"invalid IBGE state code",
+		fault.WithCode(fault.Invalid),
+		fault.WithContext("ibge_code", code),
+	)
+}
+
+// IBGECode returns the 2-digit IBGE code of the state (e.g., "35" for SP), or an empty string if the
+// UF is not valid.
+func (u UF) IBGECode() string {
+	return ufIBGECodes[u]
+}
+
+// Name returns the official name of the state (e.g., "São Paulo"), or an empty string if the UF is not valid.
+func (u UF) Name() string {
+	return ufNames[u]
+}
+
+// DDDs returns the area codes served by the state, in ascending order.
+func (u UF) DDDs() []string {
+	var ddds []string
+	for ddd, uf := range dddStates {
+		if uf == u {
+			ddds = append(ddds, ddd)
+		}
+	}
+	slices.Sort(ddds)
+	return ddds
+}
+
+// TimeZone returns the IANA time zone of the state capital (e.g., "America/Sao_Paulo"),
+// suitable for time.LoadLocation, or an empty string if the UF is not valid.
+// States spanning more than one zone list them all in TimeZones.
+func (u UF) TimeZone() string {
+	if zones := ufTimeZones[u]; len(zones) > 0 {
+		return zones[0]
+	}
+	return ""
+}
+
+// TimeZones returns every IANA time zone used in the state, starting with the capital's.
+func (u UF) TimeZones() []string {
+	return slices.Clone(ufTimeZones[u])
+}
+
+// Region returns the geographic region of the state, or an empty Region if the UF is not valid.
+func (u UF) Region() Region {
+	return ufRegions[u]
+}
+
 // IsZero returns true if the UF is the zero value.
 func (u UF) IsZero() bool {
 	return u == EmptyUF
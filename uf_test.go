@@ -3,6 +3,7 @@ package wisp_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/marcelofabianov/fault"
 	"github.com/stretchr/testify/suite"
@@ -62,6 +63,82 @@ func (s *UFSuite) TestUF_IsValidAndZero() {
 	s.True(wisp.EmptyUF.IsZero())
 }
 
+func (s *UFSuite) TestUF_Region() {
+	s.Equal(wisp.RegionSudeste, wisp.UF("SP").Region())
+	s.Equal(wisp.RegionCentroOeste, wisp.UF("DF").Region())
+	s.Equal("Centro-Oeste", wisp.UF("GO").Region().Name())
+	s.Equal(wisp.RegionNorte, wisp.UF("TO").Region())
+	s.True(wisp.UF("XX").Region().IsZero())
+
+	for _, code := range []string{"AC", "AL", "AP", "AM", "BA", "CE", "DF", "ES", "GO", "MA", "MT", "MS", "MG", "PA",
+		"PB", "PR", "PE", "PI", "RJ", "RN", "RS", "RO", "RR", "SC", "SP", "SE", "TO"} {
+		s.True(wisp.UF(code).Region().IsValid(), code)
+	}
+}
+
+func (s *UFSuite) TestUF_NameAndTimeZones() {
+	s.Equal("São Paulo", wisp.UF("SP").Name())
+	s.Equal("Distrito Federal", wisp.UF("DF").Name())
+	s.Equal("", wisp.UF("XX").Name())
+
+	s.Equal("America/Sao_Paulo", wisp.UF("SP").TimeZone())
+	s.Equal("America/Manaus", wisp.UF("AM").TimeZone())
+	s.Equal([]string{"America/Recife", "America/Noronha"}, wisp.UF("PE").TimeZones())
+	s.Equal("", wisp.EmptyUF.TimeZone())
+	s.Empty(wisp.EmptyUF.TimeZones())
+
+	for _, code := range []string{"AC", "AL", "AP", "AM", "BA", "CE", "DF", "ES", "GO", "MA", "MT", "MS", "MG", "PA",
+		"PB", "PR", "PE", "PI", "RJ", "RN", "RS", "RO", "RR", "SC", "SP", "SE", "TO"} {
+		uf := wisp.UF(code)
+		s.NotEmpty(uf.Name(), code)
+		s.NotEmpty(uf.DDDs(), code)
+		for _, zone := range uf.TimeZones() {
+			_, err := time.LoadLocation(zone)
+			s.NoError(err, zone)
+		}
+	}
+}
+
+func (s *UFSuite) TestUF_DDDs() {
+	s.Equal([]string{"11", "12", "13", "14", "15", "16", "17", "18", "19"}, wisp.UF("SP").DDDs())
+	s.Equal([]string{"61"}, wisp.UF("DF").DDDs())
+	s.Empty(wisp.EmptyUF.DDDs())
+
+	uf, err := wisp.UFFromDDD("47")
+	s.Require().NoError(err)
+	s.Equal(wisp.UF("SC"), uf)
+
+	_, err = wisp.UFFromDDD("20")
+	s.Require().Error(err)
+
+	phone, _ := wisp.NewPhone("(85) 98765-4321")
+	uf, err = wisp.UFFromDDD(phone.AreaCode())
+	s.Require().NoError(err)
+	s.Equal(phone.State(), uf)
+}
+
+func (s *UFSuite) TestUF_IBGECode() {
+	s.Equal("35", wisp.UF("SP").IBGECode())
+	s.Equal("53", wisp.UF("DF").IBGECode())
+	s.Empty(wisp.EmptyUF.IBGECode())
+
+	uf, err := wisp.UFFromIBGECode("43")
+	s.Require().NoError(err)
+	s.Equal(wisp.UF("RS"), uf)
+
+	_, err = wisp.UFFromIBGECode("34")
+	s.Require().Error(err)
+
+	for _, code := range []string{"AC", "AL", "AP", "AM", "BA", "CE", "DF", "ES", "GO", "MA", "MT", "MS", "MG", "PA",
+		"PB", "PR", "PE", "PI", "RJ", "RN", "RS", "RO", "RR", "SC", "SP", "SE", "TO"} {
+		uf := wisp.UF(code)
+		s.Len(uf.IBGECode(), 2, code)
+		back, err := wisp.UFFromIBGECode(uf.IBGECode())
+		s.Require().NoError(err)
+		s.Equal(uf, back)
+	}
+}
+
 func (s *UFSuite) TestUF_JSONMarshaling() {
 	s.Run("should marshal and unmarshal a valid UF", func() {
 		uf, _ := wisp.NewUF("MG")
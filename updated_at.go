@@ -18,15 +18,41 @@ import (
 //	myObject.UpdatedAt.Touch() // Updates the timestamp to the current time
 type UpdatedAt time.Time
 
-// NewUpdatedAt creates a new UpdatedAt timestamp, capturing the current time in UTC.
+// NewUpdatedAt creates a new UpdatedAt timestamp, capturing the current time in UTC
+// truncated to the configured precision (see SetTimestampPrecision).
 func NewUpdatedAt() UpdatedAt {
-	return UpdatedAt(time.Now().UTC())
+	return UpdatedAt(nowTimestamp())
 }
 
-// Touch updates the UpdatedAt timestamp to the current time in UTC.
-// This method should be called whenever the associated entity is modified.
+// NewUpdatedAtSince creates a new UpdatedAt timestamp for an entity created at created.
+// It captures the current time like NewUpdatedAt, but never returns a time before created,
+// even if the wall clock was adjusted backwards in the meantime.
+func NewUpdatedAtSince(created CreatedAt) UpdatedAt {
+	u := NewUpdatedAt()
+	if u.Time().Before(created.Time()) {
+		return UpdatedAt(created.Time())
+	}
+	return u
+}
+
+// Touch updates the UpdatedAt timestamp to the current time in UTC, truncated to the configured
+// precision. This method should be called whenever the associated entity is modified.
+// The timestamp never moves backwards: if the wall clock is behind the current value
+// (e.g., after a clock adjustment), the current value is kept.
 func (u *UpdatedAt) Touch() {
-	*u = UpdatedAt(time.Now().UTC())
+	now := nowTimestamp()
+	if now.Before(u.Time()) {
+		return
+	}
+	*u = UpdatedAt(now)
+}
+
+// TouchSince works like Touch, and additionally guarantees the timestamp is not before created.
+func (u *UpdatedAt) TouchSince(created CreatedAt) {
+	u.Touch()
+	if u.Time().Before(created.Time()) {
+		*u = UpdatedAt(created.Time())
+	}
 }
 
 // Time returns the underlying time.Time value.
@@ -0,0 +1,322 @@
+package wisp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// MaxURLLength is the maximum length accepted for a URL, the limit most browsers and proxies support.
+const MaxURLLength = 2048
+
+// defaultURLSchemes are the schemes accepted by NewURL until SetAllowedURLSchemes is called.
+var defaultURLSchemes = []string{"http", "https", "mailto"}
+
+// allowedURLSchemes holds the schemes accepted by NewURL.
+var allowedURLSchemes = newURLSchemeSet(defaultURLSchemes)
+
+// defaultURLPorts maps schemes to the port that is removed during normalization.
+var defaultURLPorts = map[string]string{"http": "80", "https": "443", "ws": "80", "wss": "443", "ftp": "21"}
+
+// trackingParams holds the query parameters removed by URL.WithoutTrackingParams, besides any "utm_" parameter.
+var trackingParams = map[string]struct{}{
+	"fbclid": {}, "gclid": {}, "gclsrc": {}, "dclid": {}, "msclkid": {}, "yclid": {},
+	"mc_cid": {}, "mc_eid": {}, "_hsenc": {}, "_hsmi": {}, "igshid": {}, "ttclid": {},
+}
+
+// newURLSchemeSet builds the set of allowed schemes, normalized to lowercase.
+func newURLSchemeSet(schemes []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(schemes))
+	for _, s := range schemes {
+		normalized := strings.ToLower(strings.TrimSpace(s))
+		if normalized != "" {
+			set[normalized] = struct{}{}
+		}
+	}
+	return set
+}
+
+// SetAllowedURLSchemes replaces the schemes accepted by NewURL (by default "http", "https" and
+// "mailto"), such as restricting user-provided links to "https". Calling it without arguments
+// restores the defaults.
+func SetAllowedURLSchemes(schemes ...string) {
+	if len(schemes) == 0 {
+		schemes = defaultURLSchemes
+	}
+	allowedURLSchemes = newURLSchemeSet(schemes)
+}
+
+// URL is a value object representing a validated and normalized absolute URL, such as a website,
+// a profile link or a "mailto:" address. Only schemes in the allow-list are accepted, which keeps
+// values like "javascript:" out of links rendered to users.
+//
+// Normalization lowercases the scheme and host and removes the default port of the scheme, so
+// equal addresses compare equal.
+//
+// The zero value is EmptyURL.
+//
+// Examples:
+//   u, err := wisp.NewURL("HTTPS://Example.com:443/Path?utm_source=news&id=7")
+//   u.String()                         // "https://example.com/Path?utm_source=news&id=7"
+//   u.WithoutTrackingParams().String() // "https://example.com/Path?id=7"
+//   u.IsSecure()                       // true
+type URL string
+
+// EmptyURL represents the zero value for the URL type.
+var EmptyURL URL
+
+// NewURL creates a new URL from a string, trimming whitespace and normalizing it.
+// It returns an error if the URL is empty, too long, malformed, not absolute, or uses a scheme
+// outside the allow-list.
+func NewURL(value string) (URL, error) {
+	u, err := parseURL(value)
+	if err != nil {
+		return EmptyURL, err
+	}
+	if _, ok := allowedURLSchemes[u.Scheme()]; !ok {
+		return EmptyURL, fault.New(
+			"url scheme is not allowed",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("scheme", u.Scheme()),
+		)
+	}
+	return u, nil
+}
+
+// parseURL validates and normalizes an absolute URL of any scheme.
+func parseURL(value string) (URL, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return EmptyURL, fault.New("url cannot be empty", fault.WithCode(fault.Invalid))
+	}
+	if len(trimmed) > MaxURLLength {
+		return EmptyURL, fault.New(
+			"url exceeds maximum length",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("length", len(trimmed)),
+			fault.WithContext("max_length", MaxURLLength),
+		)
+	}
+
+	u, err := url.Parse(trimmed)
+	if err != nil {
+		return EmptyURL, fault.Wrap(err,
+			"url has an invalid format",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+		)
+	}
+
+	if u.Scheme == "" {
+		return EmptyURL, fault.New(
+			"url must be absolute",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+		)
+	}
+
+	if u.Scheme == "mailto" {
+		email, err := parseEmail(u.Opaque)
+		if err != nil {
+			return EmptyURL, err
+		}
+		return URL("mailto:" + email.String()), nil
+	}
+
+	if u.Opaque != "" || u.Hostname() == "" {
+		return EmptyURL, fault.New(
+			"url must have a host",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("input_value", value),
+		)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if port := u.Port(); port != "" && port != defaultURLPorts[u.Scheme] {
+		host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	u.Host = host
+
+	return URL(u.String()), nil
+}
+
+// MustNewURL is like NewURL but panics if the URL is invalid.
+func MustNewURL(value string) URL {
+	u, err := NewURL(value)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// parsed returns the URL parsed by net/url. Valid URLs always parse.
+func (u URL) parsed() *url.URL {
+	parsed, err := url.Parse(string(u))
+	if err != nil {
+		return &url.URL{}
+	}
+	return parsed
+}
+
+// String returns the normalized URL.
+func (u URL) String() string {
+	return string(u)
+}
+
+// IsZero returns true if the URL is the zero value.
+func (u URL) IsZero() bool {
+	return u == EmptyURL
+}
+
+// Scheme returns the scheme of the URL, like "https" or "mailto".
+func (u URL) Scheme() string {
+	return u.parsed().Scheme
+}
+
+// Host returns the host name without the port (e.g., "example.com").
+// Returns an empty string for "mailto:" URLs.
+func (u URL) Host() string {
+	return u.parsed().Hostname()
+}
+
+// Port returns the explicit port of the URL, or an empty string when the scheme default is used.
+func (u URL) Port() string {
+	return u.parsed().Port()
+}
+
+// Path returns the decoded path of the URL (e.g., "/blog/post").
+func (u URL) Path() string {
+	return u.parsed().Path
+}
+
+// Domain returns the domain the URL points to, without a leading "www." (e.g., "example.com" for
+// "https://www.example.com/a"). For "mailto:" URLs it is the domain of the email address.
+func (u URL) Domain() string {
+	p := u.parsed()
+	if p.Scheme == "mailto" {
+		return Email(p.Opaque).Domain()
+	}
+	return strings.TrimPrefix(p.Hostname(), "www.")
+}
+
+// IsSecure returns true if the URL uses an encrypted transport ("https" or "wss").
+func (u URL) IsSecure() bool {
+	scheme := u.Scheme()
+	return scheme == "https" || scheme == "wss"
+}
+
+// WithoutTrackingParams returns a copy of the URL without marketing query parameters, such as
+// "utm_source", "fbclid" and "gclid". Other parameters keep their order.
+func (u URL) WithoutTrackingParams() URL {
+	p := u.parsed()
+	if p.RawQuery == "" {
+		return u
+	}
+
+	kept := make([]string, 0, strings.Count(p.RawQuery, "&")+1)
+	for _, pair := range strings.Split(p.RawQuery, "&") {
+		key, _, _ := strings.Cut(pair, "=")
+		if name, err := url.QueryUnescape(key); err == nil {
+			name = strings.ToLower(name)
+			if _, tracking := trackingParams[name]; tracking || strings.HasPrefix(name, "utm_") {
+				continue
+			}
+		}
+		kept = append(kept, pair)
+	}
+
+	p.RawQuery = strings.Join(kept, "&")
+	p.ForceQuery = false
+	return URL(p.String())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the URL as a JSON string, or null if it is empty.
+func (u URL) MarshalJSON() ([]byte, error) {
+	if u.IsZero() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(u.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a URL, with validation; null results in EmptyURL.
+func (u *URL) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = EmptyURL
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "URL must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	parsed, err := NewURL(s)
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (u URL) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (u *URL) UnmarshalText(text []byte) error {
+	parsed, err := NewURL(string(text))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the URL as a string, or nil if it is empty.
+func (u URL) Value() (driver.Value, error) {
+	if u.IsZero() {
+		return nil, nil
+	}
+	return u.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a URL, with validation.
+func (u *URL) Scan(src interface{}) error {
+	if src == nil {
+		*u = EmptyURL
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for URL",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	parsed, err := NewURL(s)
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
@@ -0,0 +1,163 @@
+package wisp_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	wisp "github.com/marcelofabianov/wisp"
+)
+
+type URLSuite struct {
+	suite.Suite
+}
+
+func TestURLSuite(t *testing.T) {
+	suite.Run(t, new(URLSuite))
+}
+
+func (s *URLSuite) TearDownTest() {
+	wisp.SetAllowedURLSchemes()
+}
+
+func (s *URLSuite) TestNewURL() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected wisp.URL
+	}{
+		{name: "should keep a simple https url", input: "https://example.com/path?q=1#top", expected: "https://example.com/path?q=1#top"},
+		{name: "should lowercase scheme and host", input: "  HTTPS://WWW.Example.COM/Path ", expected: "https://www.example.com/Path"},
+		{name: "should strip the default https port", input: "https://example.com:443/a", expected: "https://example.com/a"},
+		{name: "should strip the default http port", input: "http://example.com:80", expected: "http://example.com"},
+		{name: "should keep other ports", input: "http://localhost:8080/health", expected: "http://localhost:8080/health"},
+		{name: "should handle ipv6 hosts", input: "https://[2001:DB8::1]:443/", expected: "https://[2001:db8::1]/"},
+		{name: "should normalize mailto links", input: "mailto:Ana@Example.com", expected: "mailto:ana@example.com"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			u, err := wisp.NewURL(tc.input)
+			s.Require().NoError(err)
+			s.Equal(tc.expected, u)
+		})
+	}
+
+	s.Run("should reject invalid urls", func() {
+		inputs := []string{
+			"",
+			"example.com/path",
+			"/relative/path",
+			"javascript:alert(1)",
+			"ftp://example.com/file",
+			"https://",
+			"https://exa mple.com",
+			"mailto:not-an-email",
+			"https://example.com/" + strings.Repeat("a", wisp.MaxURLLength),
+		}
+		for _, input := range inputs {
+			u, err := wisp.NewURL(input)
+			s.Require().Error(err, input)
+			s.Equal(wisp.EmptyURL, u)
+			s.Equal(fault.Invalid, err.(*fault.Error).Code, input)
+		}
+	})
+}
+
+func (s *URLSuite) TestSetAllowedURLSchemes() {
+	wisp.SetAllowedURLSchemes("HTTPS", "ftp")
+
+	_, err := wisp.NewURL("http://example.com")
+	s.Error(err)
+	_, err = wisp.NewURL("mailto:ana@example.com")
+	s.Error(err)
+
+	u, err := wisp.NewURL("ftp://files.example.com:21/pub")
+	s.Require().NoError(err)
+	s.Equal(wisp.URL("ftp://files.example.com/pub"), u)
+
+	wisp.SetAllowedURLSchemes()
+	_, err = wisp.NewURL("http://example.com")
+	s.NoError(err)
+}
+
+func (s *URLSuite) TestURL_Accessors() {
+	u := wisp.MustNewURL("https://www.example.com:8443/blog/post?id=7")
+
+	s.Equal("https", u.Scheme())
+	s.Equal("www.example.com", u.Host())
+	s.Equal("8443", u.Port())
+	s.Equal("/blog/post", u.Path())
+	s.Equal("example.com", u.Domain())
+	s.True(u.IsSecure())
+
+	mail := wisp.MustNewURL("mailto:ana@acme.com.br")
+	s.Equal("mailto", mail.Scheme())
+	s.Equal("", mail.Host())
+	s.Equal("acme.com.br", mail.Domain())
+	s.False(mail.IsSecure())
+
+	s.False(wisp.MustNewURL("http://example.com").IsSecure())
+	s.True(wisp.EmptyURL.IsZero())
+	s.Equal("", wisp.EmptyURL.Domain())
+	s.Panics(func() { wisp.MustNewURL("javascript:void(0)") })
+}
+
+func (s *URLSuite) TestURL_WithoutTrackingParams() {
+	u := wisp.MustNewURL("https://example.com/p?utm_source=news&id=7&fbclid=abc&UTM_Campaign=x&b=2#frag")
+	s.Equal(wisp.URL("https://example.com/p?id=7&b=2#frag"), u.WithoutTrackingParams())
+
+	onlyTracking := wisp.MustNewURL("https://example.com/p?gclid=1")
+	s.Equal(wisp.URL("https://example.com/p"), onlyTracking.WithoutTrackingParams())
+
+	clean := wisp.MustNewURL("https://example.com/p")
+	s.Equal(clean, clean.WithoutTrackingParams())
+}
+
+func (s *URLSuite) TestURL_JSON() {
+	u := wisp.MustNewURL("https://example.com/a")
+
+	data, err := json.Marshal(u)
+	s.Require().NoError(err)
+	s.Equal(`"https://example.com/a"`, string(data))
+
+	data, err = json.Marshal(wisp.EmptyURL)
+	s.Require().NoError(err)
+	s.Equal("null", string(data))
+
+	var decoded wisp.URL
+	s.Require().NoError(json.Unmarshal([]byte(`"HTTPS://Example.com:443/a"`), &decoded))
+	s.Equal(u, decoded)
+	s.Require().NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.True(decoded.IsZero())
+	s.Error(json.Unmarshal([]byte(`"javascript:alert(1)"`), &decoded))
+	s.Error(json.Unmarshal([]byte(`1`), &decoded))
+
+	s.Require().NoError(decoded.UnmarshalText([]byte("http://example.com")))
+	text, _ := decoded.MarshalText()
+	s.Equal("http://example.com", string(text))
+}
+
+func (s *URLSuite) TestURL_Database() {
+	u := wisp.MustNewURL("https://example.com/a")
+
+	v, err := u.Value()
+	s.Require().NoError(err)
+	s.Equal("https://example.com/a", v)
+	v, err = wisp.EmptyURL.Value()
+	s.Require().NoError(err)
+	s.Nil(v)
+
+	var scanned wisp.URL
+	s.Require().NoError(scanned.Scan([]byte("https://EXAMPLE.com/a")))
+	s.Equal(u, scanned)
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+	s.Error(scanned.Scan("not a url"))
+	err = scanned.Scan(42)
+	s.Require().Error(err)
+	s.Equal(fault.Invalid, err.(*fault.Error).Code)
+}
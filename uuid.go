@@ -1,8 +1,13 @@
 package wisp
 
 import (
+	"crypto/rand"
 	"database/sql/driver"
 	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/marcelofabianov/fault"
@@ -24,11 +29,97 @@ type UUID uuid.UUID
 // Nil represents the zero value for UUID type (all bits zero).
 var Nil UUID
 
+// uuidRandBufferSize is the amount of random bytes each pooled generator reads from crypto/rand at once.
+const uuidRandBufferSize = 4096
+
+// uuidPoolEnabled reports whether NewUUID uses the pooled generator instead of uuid.NewV7.
+var uuidPoolEnabled atomic.Bool
+
+// uuidSourcePool holds the per-goroutine generators used when pooling is enabled.
+var uuidSourcePool = sync.Pool{
+	New: func() any {
+		return &uuidV7Source{pos: uuidRandBufferSize}
+	},
+}
+
+// EnableUUIDPool makes NewUUID draw from a pool of v7 generators instead of uuid.NewV7.
+// Each generator keeps its own buffer of random bytes and its own monotonic counter,
+// so concurrent callers no longer contend on a single lock or read crypto/rand for every ID.
+//
+// Generated IDs are still valid, time-ordered v7 UUIDs. Ordering within the same millisecond
+// is only guaranteed for IDs produced by the same generator, which is enough for database keys.
+// This should be called at application startup, before IDs are generated.
+func EnableUUIDPool() {
+	uuidPoolEnabled.Store(true)
+}
+
+// DisableUUIDPool restores the default behavior of NewUUID, which delegates to uuid.NewV7.
+func DisableUUIDPool() {
+	uuidPoolEnabled.Store(false)
+}
+
+// uuidV7Source is a single pooled v7 generator with its own random buffer and counter.
+type uuidV7Source struct {
+	rand   [uuidRandBufferSize]byte
+	pos    int
+	lastMs int64
+	seq    uint16
+}
+
+// next builds a v7 UUID: 48 bits of Unix milliseconds, a 12-bit counter in rand_a
+// (seeded randomly each millisecond) and 62 random bits in rand_b.
+func (s *uuidV7Source) next() (uuid.UUID, error) {
+	if s.pos+10 > len(s.rand) {
+		if _, err := io.ReadFull(rand.Reader, s.rand[:]); err != nil {
+			return uuid.Nil, err
+		}
+		s.pos = 0
+	}
+
+	ms := time.Now().UnixMilli()
+	if ms > s.lastMs {
+		s.lastMs = ms
+		// Keep the top bit of the counter clear so there is room to increment within the millisecond.
+		s.seq = (uint16(s.rand[s.pos])<<8 | uint16(s.rand[s.pos+1])) & 0x07ff
+		s.pos += 2
+	} else {
+		s.seq++
+		if s.seq > 0x0fff {
+			s.lastMs++
+			s.seq = 0
+		}
+	}
+
+	var id uuid.UUID
+	id[0] = byte(s.lastMs >> 40)
+	id[1] = byte(s.lastMs >> 32)
+	id[2] = byte(s.lastMs >> 24)
+	id[3] = byte(s.lastMs >> 16)
+	id[4] = byte(s.lastMs >> 8)
+	id[5] = byte(s.lastMs)
+	id[6] = 0x70 | byte(s.seq>>8)
+	id[7] = byte(s.seq)
+	copy(id[8:], s.rand[s.pos:s.pos+8])
+	id[8] = id[8]&0x3f | 0x80
+	s.pos += 8
+
+	return id, nil
+}
+
+// newPooledV7 generates a v7 UUID using a generator borrowed from the pool.
+func newPooledV7() (uuid.UUID, error) {
+	src := uuidSourcePool.Get().(*uuidV7Source)
+	id, err := src.next()
+	uuidSourcePool.Put(src)
+	return id, err
+}
+
 // NewUUID generates a new UUID version 7 (time-ordered).
 // UUID v7 is preferred over v4 for database primary keys because it's time-ordered,
 // which provides better database performance for indexes and reduces fragmentation.
 //
 // Returns an error if the system cannot generate a UUID (e.g., insufficient entropy).
+// See EnableUUIDPool for a generator better suited to high-throughput inserts.
 //
 // Example:
 //   id, err := NewUUID()
@@ -37,7 +128,13 @@ var Nil UUID
 //   }
 //   fmt.Println(id.String()) // Output: "01234567-89ab-7def-8123-456789abcdef"
 func NewUUID() (UUID, error) {
-	id, err := uuid.NewV7()
+	var id uuid.UUID
+	var err error
+	if uuidPoolEnabled.Load() {
+		id, err = newPooledV7()
+	} else {
+		id, err = uuid.NewV7()
+	}
 	if err != nil {
 		return Nil, fault.Wrap(err,
 			"failed to generate v7 UUID",
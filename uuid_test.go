@@ -1,7 +1,9 @@
 package wisp_test
 
 import (
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/marcelofabianov/fault"
@@ -34,6 +36,58 @@ func (s *UUIDSuite) TestNewUUID_Success() {
 	})
 }
 
+func (s *UUIDSuite) TestNewUUID_Pooled() {
+	wisp.EnableUUIDPool()
+	defer wisp.DisableUUIDPool()
+
+	s.Run("should generate valid v7 UUIDs carrying the current time", func() {
+		before := time.Now().Add(-time.Second)
+
+		for i := 0; i < 10000; i++ {
+			id, err := wisp.NewUUID()
+			s.Require().NoError(err)
+
+			parsed, err := uuid.Parse(id.String())
+			s.Require().NoError(err)
+			s.Equal(uuid.Version(7), parsed.Version())
+			s.Equal(uuid.RFC4122, parsed.Variant())
+
+			sec, nsec := parsed.Time().UnixTime()
+			s.WithinDuration(before.Add(time.Second), time.Unix(sec, nsec), time.Second)
+		}
+	})
+
+	s.Run("should generate unique UUIDs across goroutines", func() {
+		const workers, perWorker = 8, 2000
+		results := make([][]wisp.UUID, workers)
+
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for i := 0; i < perWorker; i++ {
+					id, err := wisp.NewUUID()
+					if err != nil {
+						return
+					}
+					results[w] = append(results[w], id)
+				}
+			}(w)
+		}
+		wg.Wait()
+
+		seen := make(map[wisp.UUID]struct{}, workers*perWorker)
+		for _, ids := range results {
+			s.Require().Len(ids, perWorker)
+			for _, id := range ids {
+				seen[id] = struct{}{}
+			}
+		}
+		s.Len(seen, workers*perWorker)
+	})
+}
+
 func (s *UUIDSuite) TestParseUUID() {
 	s.Run("should parse a valid UUID string successfully", func() {
 		id, err := wisp.ParseUUID(validUUIDString)
@@ -207,3 +261,28 @@ func (s *UUIDSuite) TestUUID_Scan() {
 		})
 	}
 }
+
+func BenchmarkNewUUID(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := wisp.NewUUID(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkNewUUID_Pooled(b *testing.B) {
+	wisp.EnableUUIDPool()
+	defer wisp.DisableUUIDPool()
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := wisp.NewUUID(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package wisp
+
+import (
+	"strconv"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// VariableMeasureKind is what the value embedded in a variable-measure barcode means.
+type VariableMeasureKind string
+
+// Defines the kinds of embedded values.
+const (
+	// VariableMeasurePrice embeds the total price of the item, in centavos.
+	VariableMeasurePrice VariableMeasureKind = "price"
+	// VariableMeasureWeight embeds the weight of the item, in grams.
+	VariableMeasureWeight VariableMeasureKind = "weight"
+)
+
+// VariableMeasureLayout describes how the scales of a store print variable-measure barcodes: after
+// the leading "2", CodeDigits digits of product code, then the value up to the check digit.
+type VariableMeasureLayout struct {
+	// CodeDigits is the number of digits of the product code, from 4 to 6. The value takes the
+	// remaining 11 - CodeDigits digits.
+	CodeDigits int
+	// Kind is what the value means.
+	Kind VariableMeasureKind
+}
+
+// defaultVariableMeasureLayout is the most common layout of Brazilian scales:
+// "2 CCCCC VVVVVV D", a 5-digit product code and a 6-digit price in centavos.
+var defaultVariableMeasureLayout = VariableMeasureLayout{CodeDigits: 5, Kind: VariableMeasurePrice}
+
+// variableMeasureLayout is the global layout used to parse variable-measure barcodes.
+var variableMeasureLayout = defaultVariableMeasureLayout
+
+// SetVariableMeasureLayout configures the global layout used to parse variable-measure barcodes,
+// to match the scales of the store.
+// Returns an error if the code does not have 4 to 6 digits or the kind is unknown.
+//
+// Example:
+//   // "2 1234 0001500 D": product 1234 weighing 1.5 kg
+//   err := wisp.SetVariableMeasureLayout(wisp.VariableMeasureLayout{CodeDigits: 4, Kind: wisp.VariableMeasureWeight})
+func SetVariableMeasureLayout(layout VariableMeasureLayout) error {
+	if layout.CodeDigits < 4 || layout.CodeDigits > 6 {
+		return fault.New(
+			"variable-measure product code must have 4 to 6 digits",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("code_digits", layout.CodeDigits),
+		)
+	}
+	if layout.Kind != VariableMeasurePrice && layout.Kind != VariableMeasureWeight {
+		return fault.New("unknown variable-measure kind", fault.WithCode(fault.Invalid), fault.WithContext("kind", layout.Kind))
+	}
+	variableMeasureLayout = layout
+	return nil
+}
+
+// ResetVariableMeasureLayout restores the default layout (5-digit code and 6-digit price).
+// This is primarily for testing purposes to ensure a clean state.
+func ResetVariableMeasureLayout() {
+	variableMeasureLayout = defaultVariableMeasureLayout
+}
+
+// VariableMeasureItem is the content of a variable-measure barcode printed by an in-store scale.
+type VariableMeasureItem struct {
+	// ProductCode is the code of the product in the store's scale and POS system.
+	ProductCode ProductCode
+	// Kind is what the embedded value means.
+	Kind VariableMeasureKind
+	// Price is the total price of the item in BRL, or ZeroMoney when the barcode embeds the weight.
+	Price Money
+	// Weight is the weight of the item, or ZeroWeight when the barcode embeds the price.
+	Weight Weight
+}
+
+// IsVariableMeasure returns true if the GTIN is an EAN-13 starting with "2", the range that in-store
+// scales use for items sold by weight, with the product code and the price or weight embedded.
+func (g GTIN) IsVariableMeasure() bool {
+	return g.Kind() == GTINKindEAN13 && g[0] == '2'
+}
+
+// VariableMeasure extracts the product code and the embedded price or weight of a variable-measure
+// barcode, following the global layout configured with SetVariableMeasureLayout.
+// Returns an error if the GTIN is not a variable-measure EAN-13.
+//
+// Example:
+//   gtin, _ := wisp.NewGTIN("2012340012504")
+//   item, _ := gtin.VariableMeasure()
+//   item.ProductCode // "01234"
+//   item.Price       // BRL 12.50
+func (g GTIN) VariableMeasure() (VariableMeasureItem, error) {
+	if !g.IsVariableMeasure() {
+		return VariableMeasureItem{}, fault.New(
+			"GTIN is not a variable-measure barcode",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("gtin", g.String()),
+		)
+	}
+
+	layout := variableMeasureLayout
+	code := string(g[1 : 1+layout.CodeDigits])
+	value, _ := strconv.ParseInt(string(g[1+layout.CodeDigits:12]), 10, 64)
+
+	item := VariableMeasureItem{ProductCode: ProductCode(code), Kind: layout.Kind}
+	if layout.Kind == VariableMeasureWeight {
+		item.Weight = Weight{milligrams: value * mgInAGram}
+	} else {
+		item.Price = Money{amount: value, currency: BRL}
+	}
+	return item, nil
+}
@@ -0,0 +1,89 @@
+package wisp_test
+
+import (
+	"testing"
+
+	"github.com/marcelofabianov/fault"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/marcelofabianov/wisp"
+)
+
+type VariableMeasureSuite struct {
+	suite.Suite
+}
+
+func TestVariableMeasureSuite(t *testing.T) {
+	suite.Run(t, new(VariableMeasureSuite))
+}
+
+func (s *VariableMeasureSuite) TearDownTest() {
+	wisp.ResetVariableMeasureLayout()
+}
+
+func (s *VariableMeasureSuite) gtin(input string) wisp.GTIN {
+	gtin, err := wisp.NewGTIN(input)
+	s.Require().NoError(err)
+	return gtin
+}
+
+func (s *VariableMeasureSuite) TestIsVariableMeasure() {
+	s.True(s.gtin("2012340012504").IsVariableMeasure())
+	s.False(s.gtin("7891000315507").IsVariableMeasure())
+	s.False(s.gtin("20123451").IsVariableMeasure(), "EAN-8 is not a variable-measure barcode")
+	s.False(wisp.EmptyGTIN.IsVariableMeasure())
+}
+
+func (s *VariableMeasureSuite) TestVariableMeasure() {
+	s.Run("should extract the product code and price with the default layout", func() {
+		item, err := s.gtin("2012340012504").VariableMeasure()
+		s.Require().NoError(err)
+		s.Equal(wisp.ProductCode("01234"), item.ProductCode)
+		s.Equal(wisp.VariableMeasurePrice, item.Kind)
+		s.Equal(int64(1250), item.Price.Amount())
+		s.Equal(wisp.BRL, item.Price.Currency())
+		s.True(item.Weight.Equals(wisp.ZeroWeight))
+	})
+
+	s.Run("should extract the weight with a configured layout", func() {
+		s.Require().NoError(wisp.SetVariableMeasureLayout(wisp.VariableMeasureLayout{CodeDigits: 4, Kind: wisp.VariableMeasureWeight}))
+
+		item, err := s.gtin("2123400015004").VariableMeasure()
+		s.Require().NoError(err)
+		s.Equal(wisp.ProductCode("1234"), item.ProductCode)
+		s.Equal(wisp.VariableMeasureWeight, item.Kind)
+		s.Equal("1.500 kg", item.Weight.String())
+		s.True(item.Price.IsZero())
+	})
+
+	s.Run("should extract a 6-digit product code", func() {
+		s.Require().NoError(wisp.SetVariableMeasureLayout(wisp.VariableMeasureLayout{CodeDigits: 6, Kind: wisp.VariableMeasurePrice}))
+
+		item, err := s.gtin("2000420039992").VariableMeasure()
+		s.Require().NoError(err)
+		s.Equal(wisp.ProductCode("000420"), item.ProductCode)
+		s.Equal(int64(3999), item.Price.Amount())
+	})
+
+	s.Run("should reject barcodes outside the variable-measure range", func() {
+		_, err := s.gtin("7891000315507").VariableMeasure()
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	})
+}
+
+func (s *VariableMeasureSuite) TestSetVariableMeasureLayout() {
+	for _, layout := range []wisp.VariableMeasureLayout{
+		{CodeDigits: 3, Kind: wisp.VariableMeasurePrice},
+		{CodeDigits: 7, Kind: wisp.VariableMeasureWeight},
+		{CodeDigits: 5, Kind: "volume"},
+	} {
+		err := wisp.SetVariableMeasureLayout(layout)
+		s.Require().Error(err)
+		s.Equal(fault.Invalid, err.(*fault.Error).Code)
+	}
+
+	item, err := s.gtin("2012340012504").VariableMeasure()
+	s.Require().NoError(err)
+	s.Equal(int64(1250), item.Price.Amount(), "failed calls must keep the current layout")
+}
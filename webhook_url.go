@@ -0,0 +1,359 @@
+package wisp
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/marcelofabianov/fault"
+)
+
+// MaxWebhookURLLength is the maximum length accepted for a WebhookURL.
+const MaxWebhookURLLength = 1024
+
+// WebhookResolver performs the DNS lookups used by WebhookURL.VerifyHost.
+// *net.Resolver satisfies it; tests can provide a fake.
+type WebhookResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+var (
+	// webhookResolver is the resolver used by VerifyHost.
+	webhookResolver WebhookResolver = net.DefaultResolver
+	// webhookLookupTimeout bounds each VerifyHost call, on top of any deadline in its context.
+	webhookLookupTimeout = 5 * time.Second
+)
+
+// blockedWebhookPrefixes are the networks a webhook may not target, besides the loopback,
+// private, link-local, multicast and unspecified ranges recognized by net/netip.
+var blockedWebhookPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"),  // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),  // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),    // reserved, including broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64, may embed an internal IPv4 address
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local-use NAT64
+	netip.MustParsePrefix("2002::/16"),      // 6to4, embeds an IPv4 address
+	netip.MustParsePrefix("::/96"),          // deprecated IPv4-compatible addresses
+	netip.MustParsePrefix("2001:db8::/32"),  // documentation
+	netip.MustParsePrefix("fec0::/10"),      // deprecated site-local
+}
+
+// blockedWebhookHostSuffixes are host names that only resolve inside private networks.
+var blockedWebhookHostSuffixes = []string{".localhost", ".local", ".internal", ".home.arpa"}
+
+// SetWebhookResolver configures the resolver used by WebhookURL.VerifyHost.
+// Passing nil restores net.DefaultResolver.
+func SetWebhookResolver(resolver WebhookResolver) {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	webhookResolver = resolver
+}
+
+// WebhookURL is a value object representing the endpoint of a webhook registered by a third party.
+// It protects against server-side request forgery (SSRF) at the type level: the URL must use https,
+// cannot carry credentials, and cannot point to loopback, private, link-local or other internal
+// addresses, whether given as an IP literal (including shorthand forms such as "127.1") or as a
+// well-known internal host name ("localhost", "*.internal", with or without a trailing dot).
+//
+// Host names are not resolved by NewWebhookURL, so validation stays offline; VerifyHost performs
+// the DNS check when registering the webhook. Since DNS answers can change, the HTTP client that
+// delivers webhooks should still check the address it connects to.
+//
+// The zero value is EmptyWebhookURL.
+//
+// Example:
+//   hook, err := wisp.NewWebhookURL("https://hooks.partner.com/wisp")
+//   if err == nil {
+//       err = hook.VerifyHost(ctx) // rejects names resolving to 10.0.0.0/8, 127.0.0.1, ...
+//   }
+type WebhookURL string
+
+// EmptyWebhookURL represents the zero value for WebhookURL.
+var EmptyWebhookURL WebhookURL
+
+// NewWebhookURL creates a new WebhookURL from a string, normalized like URL.
+// It returns an error if the URL is invalid, longer than MaxWebhookURLLength, not https,
+// contains credentials, or targets an internal host.
+func NewWebhookURL(value string) (WebhookURL, error) {
+	trimmed := strings.TrimSpace(value)
+	if len(trimmed) > MaxWebhookURLLength {
+		return EmptyWebhookURL, fault.New(
+			"webhook url exceeds maximum length",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("length", len(trimmed)),
+			fault.WithContext("max_length", MaxWebhookURLLength),
+		)
+	}
+
+	u, err := parseURL(trimmed)
+	if err != nil {
+		return EmptyWebhookURL, err
+	}
+	if u.Scheme() != "https" {
+		return EmptyWebhookURL, fault.New(
+			"webhook url must use https",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("scheme", u.Scheme()),
+		)
+	}
+	if u.parsed().User != nil {
+		return EmptyWebhookURL, fault.New("webhook url cannot contain credentials", fault.WithCode(fault.Invalid))
+	}
+
+	host := strings.TrimRight(u.Host(), ".")
+	if addr, ok, err := parseWebhookHostAddr(host); err != nil {
+		return EmptyWebhookURL, err
+	} else if ok {
+		if !isPublicAddr(addr) {
+			return EmptyWebhookURL, errWebhookInternalTarget(host, addr.String())
+		}
+	} else {
+		for _, suffix := range blockedWebhookHostSuffixes {
+			if strings.HasSuffix(host, suffix) || host == strings.TrimPrefix(suffix, ".") {
+				return EmptyWebhookURL, errWebhookInternalTarget(host, "")
+			}
+		}
+		if !strings.Contains(host, ".") {
+			return EmptyWebhookURL, fault.New(
+				"webhook url host must be a fully qualified domain name",
+				fault.WithCode(fault.Invalid),
+				fault.WithContext("host", host),
+			)
+		}
+	}
+
+	return WebhookURL(u), nil
+}
+
+// parseWebhookHostAddr parses a host given as an IP address. Besides the forms net/netip accepts,
+// a host whose last label is a number is parsed as IPv4 the way inet_aton and browsers do, with
+// shorthand, octal and hex parts ("127.1", "0177.0.0.1", "0x7f000001"), since HTTP clients connect
+// to those addresses. It reports false for host names, and returns an error for numeric hosts that
+// are not a valid address.
+func parseWebhookHostAddr(host string) (netip.Addr, bool, error) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr, true, nil
+	}
+
+	labels := strings.Split(host, ".")
+	if _, ok := parseInetAtonPart(labels[len(labels)-1]); !ok {
+		return netip.Addr{}, false, nil
+	}
+
+	invalid := fault.New("webhook url host is not a valid IP address", fault.WithCode(fault.Invalid), fault.WithContext("host", host))
+	if len(labels) > 4 {
+		return netip.Addr{}, false, invalid
+	}
+	var ip uint64
+	for i, label := range labels {
+		part, ok := parseInetAtonPart(label)
+		if !ok {
+			return netip.Addr{}, false, invalid
+		}
+		if i < len(labels)-1 {
+			if part > 0xff {
+				return netip.Addr{}, false, invalid
+			}
+			ip |= part << (8 * (3 - i))
+			continue
+		}
+		// The last part fills the remaining bytes: "127.1" is 127.0.0.1.
+		if part >= 1<<(8*(4-i)) {
+			return netip.Addr{}, false, invalid
+		}
+		ip |= part
+	}
+	return netip.AddrFrom4([4]byte{byte(ip >> 24), byte(ip >> 16), byte(ip >> 8), byte(ip)}), true, nil
+}
+
+// parseInetAtonPart parses a part of an inet_aton IPv4 address: hex with a "0x" prefix, octal
+// with a leading zero, or decimal.
+func parseInetAtonPart(label string) (uint64, bool) {
+	if label == "" {
+		return 0, false
+	}
+	base := 10
+	lower := strings.ToLower(label)
+	switch {
+	case strings.HasPrefix(lower, "0x"):
+		base, label = 16, lower[2:]
+		if label == "" {
+			return 0, true
+		}
+	case len(label) > 1 && label[0] == '0':
+		base, label = 8, label[1:]
+	}
+	part, err := strconv.ParseUint(label, base, 32)
+	if err != nil {
+		return 0, false
+	}
+	return part, true
+}
+
+// isPublicAddr reports whether addr is a globally routable unicast address.
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return false
+	}
+	for _, prefix := range blockedWebhookPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// errWebhookInternalTarget builds the error returned when a webhook targets an internal address.
+func errWebhookInternalTarget(host, ip string) error {
+	opts := []fault.Option{
+		fault.WithCode(fault.DomainViolation),
+		fault.WithContext("host", host),
+	}
+	if ip != "" {
+		opts = append(opts, fault.WithContext("ip", ip))
+	}
+	return fault.New("webhook url cannot target an internal address", opts...)
+}
+
+// VerifyHost resolves the host name and checks that none of its addresses is internal.
+// IP literals are accepted without a lookup, since NewWebhookURL already checked them.
+//
+// It returns a DomainViolation error if the name does not resolve or resolves to an internal
+// address, and an InfraError error if the lookup fails or times out.
+func (w WebhookURL) VerifyHost(ctx context.Context) error {
+	if w.IsZero() {
+		return fault.New("cannot verify the host of an empty webhook url", fault.WithCode(fault.Invalid))
+	}
+
+	host := strings.TrimRight(w.Host(), ".")
+	if _, ok, _ := parseWebhookHostAddr(host); ok {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookLookupTimeout)
+	defer cancel()
+
+	addrs, err := webhookResolver.LookupIPAddr(ctx, host)
+	if err != nil && !isDNSNotFound(err) {
+		return fault.Wrap(err,
+			"failed to resolve webhook host",
+			fault.WithCode(fault.InfraError),
+			fault.WithContext("host", host),
+		)
+	}
+	if len(addrs) == 0 {
+		return fault.New(
+			"webhook host does not resolve",
+			fault.WithCode(fault.DomainViolation),
+			fault.WithContext("host", host),
+		)
+	}
+
+	for _, a := range addrs {
+		addr, ok := netip.AddrFromSlice(a.IP)
+		if !ok || !isPublicAddr(addr) {
+			return errWebhookInternalTarget(host, a.IP.String())
+		}
+	}
+	return nil
+}
+
+// URL returns the webhook endpoint as a URL.
+func (w WebhookURL) URL() URL {
+	return URL(w)
+}
+
+// Host returns the host name of the endpoint without the port.
+func (w WebhookURL) Host() string {
+	return URL(w).Host()
+}
+
+// String returns the normalized URL.
+func (w WebhookURL) String() string {
+	return string(w)
+}
+
+// IsZero returns true if the WebhookURL is the zero value.
+func (w WebhookURL) IsZero() bool {
+	return w == EmptyWebhookURL
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It serializes the WebhookURL as a JSON string, or null if it is empty.
+func (w WebhookURL) MarshalJSON() ([]byte, error) {
+	if w.IsZero() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(w.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It deserializes a JSON string into a WebhookURL, with validation; null results in EmptyWebhookURL.
+func (w *WebhookURL) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*w = EmptyWebhookURL
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fault.Wrap(err, "WebhookURL must be a valid JSON string", fault.WithCode(fault.Invalid))
+	}
+
+	parsed, err := NewWebhookURL(s)
+	if err != nil {
+		return err
+	}
+	*w = parsed
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database storage.
+// It returns the WebhookURL as a string, or nil if it is empty.
+func (w WebhookURL) Value() (driver.Value, error) {
+	if w.IsZero() {
+		return nil, nil
+	}
+	return w.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval.
+// It accepts a string or byte slice from the database and converts it into a WebhookURL, with validation.
+func (w *WebhookURL) Scan(src interface{}) error {
+	if src == nil {
+		*w = EmptyWebhookURL
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fault.New(
+			"unsupported scan type for WebhookURL",
+			fault.WithCode(fault.Invalid),
+			fault.WithContext("received_type", fmt.Sprintf("%T", src)),
+		)
+	}
+
+	parsed, err := NewWebhookURL(s)
+	if err != nil {
+		return err
+	}
+	*w = parsed
+	return nil
+}
@@ -121,13 +121,19 @@ func (w Weight) String() string {
 // It serializes the Weight to a JSON object with its value in kilograms.
 func (w Weight) MarshalJSON() ([]byte, error) {
 	kg, _ := w.In(Kilogram)
-	return json.Marshal(&struct {
-		Value float64    `json:"value"`
-		Unit  WeightUnit `json:"unit"`
-	}{
-		Value: kg,
-		Unit:  Kilogram,
-	})
+
+	buf := getJSONBuffer()
+	defer putJSONBuffer(buf)
+
+	var err error
+	buf.b = append(buf.b, `{"value":`...)
+	if buf.b, err = appendJSONFloat(buf.b, kg); err != nil {
+		return nil, err
+	}
+	buf.b = append(buf.b, `,"unit":`...)
+	buf.b = appendJSONString(buf.b, string(Kilogram))
+	buf.b = append(buf.b, '}')
+	return buf.bytes(), nil
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.